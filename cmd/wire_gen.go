@@ -27,14 +27,17 @@ import (
 	"github.com/apache/answer/internal/repo/collection"
 	"github.com/apache/answer/internal/repo/comment"
 	"github.com/apache/answer/internal/repo/config"
+	"github.com/apache/answer/internal/repo/contract"
+	"github.com/apache/answer/internal/repo/conversation"
 	"github.com/apache/answer/internal/repo/export"
 	"github.com/apache/answer/internal/repo/file_record"
 	"github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/repo/limit"
 	"github.com/apache/answer/internal/repo/meta"
-	notification2 "github.com/apache/answer/internal/repo/notification"
+	"github.com/apache/answer/internal/repo/notification"
 	"github.com/apache/answer/internal/repo/plugin_config"
 	"github.com/apache/answer/internal/repo/question"
+	"github.com/apache/answer/internal/repo/queue"
 	"github.com/apache/answer/internal/repo/rank"
 	"github.com/apache/answer/internal/repo/reason"
 	"github.com/apache/answer/internal/repo/report"
@@ -49,6 +52,7 @@ import (
 	"github.com/apache/answer/internal/repo/user"
 	"github.com/apache/answer/internal/repo/user_external_login"
 	"github.com/apache/answer/internal/repo/user_notification_config"
+	"github.com/apache/answer/internal/repo/webhook"
 	"github.com/apache/answer/internal/router"
 	"github.com/apache/answer/internal/service/action"
 	activity2 "github.com/apache/answer/internal/service/activity"
@@ -63,23 +67,30 @@ import (
 	"github.com/apache/answer/internal/service/comment_common"
 	config2 "github.com/apache/answer/internal/service/config"
 	"github.com/apache/answer/internal/service/content"
+	contract2 "github.com/apache/answer/internal/service/contract"
+	conversation2 "github.com/apache/answer/internal/service/conversation"
+	"github.com/apache/answer/internal/service/currency"
 	"github.com/apache/answer/internal/service/dashboard"
+	"github.com/apache/answer/internal/service/email_reply"
+	"github.com/apache/answer/internal/service/email_reply_common"
 	"github.com/apache/answer/internal/service/event_queue"
 	export2 "github.com/apache/answer/internal/service/export"
 	file_record2 "github.com/apache/answer/internal/service/file_record"
 	"github.com/apache/answer/internal/service/follow"
 	freelancer2 "github.com/apache/answer/internal/service/freelancer"
 	"github.com/apache/answer/internal/service/importer"
+	"github.com/apache/answer/internal/service/job_matching"
 	meta2 "github.com/apache/answer/internal/service/meta"
 	"github.com/apache/answer/internal/service/meta_common"
 	"github.com/apache/answer/internal/service/notice_queue"
-	"github.com/apache/answer/internal/service/notification"
+	notification2 "github.com/apache/answer/internal/service/notification"
 	"github.com/apache/answer/internal/service/notification_common"
 	"github.com/apache/answer/internal/service/object_info"
 	"github.com/apache/answer/internal/service/plugin_common"
 	"github.com/apache/answer/internal/service/question_common"
 	rank2 "github.com/apache/answer/internal/service/rank"
 	reason2 "github.com/apache/answer/internal/service/reason"
+	"github.com/apache/answer/internal/service/related_question"
 	report2 "github.com/apache/answer/internal/service/report"
 	"github.com/apache/answer/internal/service/report_handle"
 	review2 "github.com/apache/answer/internal/service/review"
@@ -96,6 +107,7 @@ import (
 	"github.com/apache/answer/internal/service/user_common"
 	user_external_login2 "github.com/apache/answer/internal/service/user_external_login"
 	user_notification_config2 "github.com/apache/answer/internal/service/user_notification_config"
+	webhook2 "github.com/apache/answer/internal/service/webhook"
 	"github.com/segmentfault/pacman"
 	"github.com/segmentfault/pacman/log"
 )
@@ -138,7 +150,10 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	emailService := export2.NewEmailService(configService, emailRepo, siteInfoCommonService)
 	userRoleRelRepo := role.NewUserRoleRelRepo(dataData)
 	roleRepo := role.NewRoleRepo(dataData)
-	roleService := role2.NewRoleService(roleRepo)
+	powerRepo := role.NewPowerRepo(dataData)
+	rolePowerRelRepo := role.NewRolePowerRelRepo(dataData)
+	roleAuditLogRepo := role.NewRoleAuditLogRepo(dataData)
+	roleService := role2.NewRoleService(roleRepo, powerRepo, rolePowerRelRepo, userRoleRelRepo, roleAuditLogRepo, authService)
 	userRoleRelService := role2.NewUserRoleRelService(userRoleRelRepo, roleService)
 	userCommon := usercommon.NewUserCommon(userRepo, userRoleRelService, authService, siteInfoCommonService)
 	userExternalLoginRepo := user_external_login.NewUserExternalLoginRepo(dataData)
@@ -162,20 +177,23 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	metaRepo := meta.NewMetaRepo(dataData)
 	metaCommonService := metacommon.NewMetaCommonService(metaRepo)
 	questionCommon := questioncommon.NewQuestionCommon(questionRepo, answerRepo, voteRepo, followRepo, tagCommonService, userCommon, collectionCommon, answerCommon, metaCommonService, configService, activityQueueService, revisionRepo, siteInfoCommonService, dataData)
-	eventQueueService := event_queue.NewEventQueueService()
+	store := queue.NewQueueRepo(dataData, serviceConf)
+	eventQueueService, cleanup3 := event_queue.NewEventQueueService(store, serviceConf)
 	fileRecordRepo := file_record.NewFileRecordRepo(dataData)
-	fileRecordService := file_record2.NewFileRecordService(fileRecordRepo, revisionRepo, serviceConf, siteInfoCommonService, userCommon)
+	portfolioItemRepo := freelancer.NewPortfolioItemRepo(dataData)
+	fileRecordService := file_record2.NewFileRecordService(fileRecordRepo, revisionRepo, serviceConf, siteInfoCommonService, userCommon, portfolioItemRepo)
 	userService := content.NewUserService(userRepo, userActiveActivityRepo, activityRepo, emailService, authService, siteInfoCommonService, userRoleRelService, userCommon, userExternalLoginService, userNotificationConfigRepo, userNotificationConfigService, questionCommon, eventQueueService, fileRecordService)
 	captchaRepo := captcha.NewCaptchaRepo(dataData)
 	captchaService := action.NewCaptchaService(captchaRepo)
 	userController := controller.NewUserController(authService, userService, captchaService, emailService, siteInfoCommonService, userNotificationConfigService)
 	commentRepo := comment.NewCommentRepo(dataData, uniqueIDRepo)
 	commentCommonRepo := comment.NewCommentCommonRepo(dataData, uniqueIDRepo)
-	objService := object_info.NewObjService(answerRepo, questionRepo, commentCommonRepo, tagCommonRepo, tagCommonService)
-	notificationQueueService := notice_queue.NewNotificationQueueService()
-	externalNotificationQueueService := notice_queue.NewNewQuestionNotificationQueueService()
+	freelancerRepo := freelancer.NewFreelancerRepo(dataData, uniqueIDRepo)
+	contractReviewRepo := contract.NewContractReviewRepo(dataData, uniqueIDRepo, freelancerRepo)
+	objService := object_info.NewObjService(answerRepo, questionRepo, commentCommonRepo, tagCommonRepo, tagCommonService, contractReviewRepo, freelancerRepo)
+	notificationQueueService, cleanup4 := notice_queue.NewNotificationQueueService(store, serviceConf)
+	externalNotificationQueueService, cleanup5 := notice_queue.NewNewQuestionNotificationQueueService(store, serviceConf)
 	commentService := comment2.NewCommentService(commentRepo, commentCommonRepo, userCommon, objService, voteRepo, emailService, userRepo, notificationQueueService, externalNotificationQueueService, activityQueueService, eventQueueService)
-	rolePowerRelRepo := role.NewRolePowerRelRepo(dataData)
 	rolePowerRelService := role2.NewRolePowerRelService(rolePowerRelRepo, userRoleRelService)
 	rankService := rank2.NewRankService(userCommon, userRankRepo, objService, userRoleRelService, rolePowerRelService, configService)
 	limitRepo := limit.NewRateLimitRepo(dataData)
@@ -185,12 +203,16 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	tagService := tag2.NewTagService(tagRepo, tagCommonService, revisionService, followRepo, siteInfoCommonService, activityQueueService)
 	answerActivityRepo := activity.NewAnswerActivityRepo(dataData, activityRepo, userRankRepo, notificationQueueService)
 	answerActivityService := activity2.NewAnswerActivityService(answerActivityRepo, configService)
-	externalNotificationService := notification.NewExternalNotificationService(dataData, userNotificationConfigRepo, followRepo, emailService, userRepo, externalNotificationQueueService, userExternalLoginRepo, siteInfoCommonService)
+	notificationDigestRepo := notification.NewNotificationDigestRepo(dataData)
+	emailReplyCommon := email_reply_common.NewEmailReplyCommon(serviceConf)
+	externalNotificationService := notification2.NewExternalNotificationService(dataData, userNotificationConfigRepo, followRepo, emailService, userRepo, externalNotificationQueueService, userExternalLoginRepo, siteInfoCommonService, notificationDigestRepo, emailReplyCommon)
 	reviewRepo := review.NewReviewRepo(dataData)
-	reviewService := review2.NewReviewService(reviewRepo, objService, userCommon, userRepo, questionRepo, answerRepo, userRoleRelService, externalNotificationQueueService, tagCommonService, questionCommon, notificationQueueService, siteInfoCommonService)
+	reviewService := review2.NewReviewService(reviewRepo, objService, userCommon, userRepo, questionRepo, answerRepo, userRoleRelService, externalNotificationQueueService, tagCommonService, questionCommon, notificationQueueService, siteInfoCommonService, contractReviewRepo)
 	questionService := content.NewQuestionService(activityRepo, questionRepo, answerRepo, tagCommonService, tagService, questionCommon, userCommon, userRepo, userRoleRelService, revisionService, metaCommonService, collectionCommon, answerActivityService, emailService, notificationQueueService, externalNotificationQueueService, activityQueueService, siteInfoCommonService, externalNotificationService, reviewService, configService, eventQueueService, reviewRepo)
 	answerService := content.NewAnswerService(answerRepo, questionRepo, questionCommon, userCommon, collectionCommon, userRepo, revisionService, answerActivityService, answerCommon, voteRepo, emailService, userRoleRelService, notificationQueueService, externalNotificationQueueService, activityQueueService, reviewService, eventQueueService)
-	reportHandle := report_handle.NewReportHandle(questionService, answerService, commentService)
+	contractRepo := contract.NewContractRepo(dataData)
+	contractService := contract2.NewContractService(contractRepo, contractReviewRepo, freelancerRepo, userRepo, userCommon, reviewService)
+	reportHandle := report_handle.NewReportHandle(questionService, answerService, commentService, contractService)
 	reportService := report2.NewReportService(reportRepo, objService, userCommon, answerRepo, questionRepo, commentCommonRepo, reportHandle, configService, eventQueueService)
 	reportController := controller.NewReportController(reportService, rankService, captchaService)
 	contentVoteRepo := activity.NewVoteRepo(dataData, activityRepo, userRankRepo, notificationQueueService)
@@ -203,34 +225,39 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	collectionGroupRepo := collection.NewCollectionGroupRepo(dataData)
 	collectionService := collection2.NewCollectionService(collectionRepo, collectionGroupRepo, questionCommon)
 	collectionController := controller.NewCollectionController(collectionService)
-	questionController := controller.NewQuestionController(questionService, answerService, rankService, siteInfoCommonService, captchaService, rateLimitMiddleware)
+	relatedQuestionRepo := question.NewRelatedQuestionRepo(dataData)
+	relatedQuestionService := related_question.NewRelatedQuestionService(dataData, relatedQuestionRepo)
+	questionController := controller.NewQuestionController(questionService, answerService, rankService, siteInfoCommonService, captchaService, rateLimitMiddleware, relatedQuestionService)
 	answerController := controller.NewAnswerController(answerService, rankService, captchaService, siteInfoCommonService, rateLimitMiddleware)
-	searchParser := search_parser.NewSearchParser(tagCommonService, userCommon)
+	searchParser := search_parser.NewSearchParser(tagCommonService, userCommon, collectionRepo, followRepo)
 	searchRepo := search_common.NewSearchRepo(dataData, uniqueIDRepo, userCommon, tagCommonService)
-	searchService := content.NewSearchService(searchParser, searchRepo)
-	searchController := controller.NewSearchController(searchService, captchaService)
+	searchSuggestRepo := search_common.NewSearchSuggestRepo(dataData)
+	searchService := content.NewSearchService(dataData, searchParser, searchRepo, searchSuggestRepo, userCommon)
+	searchController := controller.NewSearchController(searchService, captchaService, rateLimitMiddleware)
 	reviewActivityRepo := activity.NewReviewActivityRepo(dataData, activityRepo, userRankRepo, configService)
 	contentRevisionService := content.NewRevisionService(revisionRepo, userCommon, questionCommon, answerService, objService, questionRepo, answerRepo, tagRepo, tagCommonService, notificationQueueService, activityQueueService, reportRepo, reviewService, reviewActivityRepo)
 	revisionController := controller.NewRevisionController(contentRevisionService, rankService)
 	rankController := controller.NewRankController(rankService)
 	userAdminRepo := user.NewUserAdminRepo(dataData, authRepo)
-	notificationRepo := notification2.NewNotificationRepo(dataData)
+	notificationRepo := notification.NewNotificationRepo(dataData)
 	pluginUserConfigRepo := plugin_config.NewPluginUserConfigRepo(dataData)
 	badgeAwardRepo := badge_award.NewBadgeAwardRepo(dataData, uniqueIDRepo)
-	userAdminService := user_admin.NewUserAdminService(userAdminRepo, userRoleRelService, authService, userCommon, userActiveActivityRepo, siteInfoCommonService, emailService, questionRepo, answerRepo, commentCommonRepo, userExternalLoginRepo, notificationRepo, pluginUserConfigRepo, badgeAwardRepo)
+	userAdminService := user_admin.NewUserAdminService(userAdminRepo, userRoleRelService, roleService, authService, userCommon, userActiveActivityRepo, siteInfoCommonService, emailService, questionRepo, answerRepo, commentCommonRepo, userExternalLoginRepo, notificationRepo, pluginUserConfigRepo, badgeAwardRepo)
 	userAdminController := controller_admin.NewUserAdminController(userAdminService)
 	reasonRepo := reason.NewReasonRepo(configService)
 	reasonService := reason2.NewReasonService(reasonRepo)
 	reasonController := controller.NewReasonController(reasonService)
 	themeController := controller_admin.NewThemeController()
-	siteInfoService := siteinfo.NewSiteInfoService(siteInfoRepo, siteInfoCommonService, emailService, tagCommonService, configService, questionCommon, fileRecordService)
+	currencyService := currency.NewCurrencyService(siteInfoCommonService, freelancerRepo)
+	siteInfoService := siteinfo.NewSiteInfoService(siteInfoRepo, siteInfoCommonService, emailService, tagCommonService, configService, questionCommon, fileRecordService, currencyService)
 	siteInfoController := controller_admin.NewSiteInfoController(siteInfoService)
 	controllerSiteInfoController := controller.NewSiteInfoController(siteInfoCommonService)
 	notificationCommon := notificationcommon.NewNotificationCommon(dataData, notificationRepo, userCommon, activityRepo, followRepo, objService, notificationQueueService, userExternalLoginRepo, siteInfoCommonService)
 	badgeRepo := badge.NewBadgeRepo(dataData, uniqueIDRepo)
-	notificationService := notification.NewNotificationService(dataData, notificationRepo, notificationCommon, revisionService, userRepo, reportRepo, reviewService, badgeRepo)
+	notificationService := notification2.NewNotificationService(dataData, notificationRepo, notificationCommon, revisionService, userRepo, reportRepo, reviewService, badgeRepo)
 	notificationController := controller.NewNotificationController(notificationService, rankService)
-	dashboardService := dashboard.NewDashboardService(questionRepo, answerRepo, commentCommonRepo, voteRepo, userRepo, reportRepo, configService, siteInfoCommonService, serviceConf, reviewService, revisionRepo, dataData)
+	analyticsRepo := freelancer.NewAnalyticsRepo(dataData)
+	dashboardService := dashboard.NewDashboardService(questionRepo, answerRepo, commentCommonRepo, voteRepo, userRepo, reportRepo, configService, siteInfoCommonService, serviceConf, reviewService, revisionRepo, dataData, analyticsRepo, tagCommonService)
 	dashboardController := controller.NewDashboardController(dashboardService)
 	uploaderService := uploader.NewUploaderService(serviceConf, siteInfoCommonService, fileRecordService)
 	uploadController := controller.NewUploadController(uploaderService)
@@ -256,17 +283,29 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	badgeService := badge2.NewBadgeService(badgeRepo, badgeGroupRepo, badgeAwardRepo, badgeEventService, siteInfoCommonService)
 	badgeController := controller.NewBadgeController(badgeService, badgeAwardService)
 	controller_adminBadgeController := controller_admin.NewBadgeController(badgeService)
-	freelancerRepo := freelancer.NewFreelancerRepo(dataData)
-	freelancerService := freelancer2.NewFreelancerService(freelancerRepo, userRepo, emailService, siteInfoCommonService)
-	freelancerController := controller.NewFreelancerController(freelancerService)
-	answerAPIRouter := router.NewAnswerAPIRouter(langController, userController, commentController, reportController, voteController, tagController, followController, collectionController, questionController, answerController, searchController, revisionController, rankController, userAdminController, reasonController, themeController, siteInfoController, controllerSiteInfoController, notificationController, dashboardController, uploadController, activityController, roleController, pluginController, permissionController, userPluginController, reviewController, metaController, badgeController, controller_adminBadgeController, freelancerController)
+	conversationRepo := conversation.NewConversationRepo(dataData)
+	conversationService := conversation2.NewConversationService(conversationRepo, contractRepo, freelancerRepo, userRepo, userCommon, fileRecordService, notificationQueueService, externalNotificationQueueService)
+	freelancerVerificationRepo := freelancer.NewFreelancerVerificationRepo(dataData, freelancerRepo)
+	savedJobSearchRepo := freelancer.NewSavedJobSearchRepo(dataData)
+	freelancerService := freelancer2.NewFreelancerService(freelancerRepo, userRepo, siteInfoCommonService, revisionService, notificationQueueService, tagCommonService, contractService, conversationService, freelancerVerificationRepo, userCommon, fileRecordService, badgeAwardService, configService, savedJobSearchRepo, externalNotificationQueueService, userNotificationConfigService, portfolioItemRepo, answerRepo, questionRepo, currencyService, reviewService)
+	jobMatchingService := job_matching.NewJobMatchingService(freelancerRepo, userRepo, tagCommonService)
+	freelancerController := controller.NewFreelancerController(freelancerService, rankService, jobMatchingService, captchaService, rateLimitMiddleware)
+	contractController := controller.NewContractController(contractService)
+	conversationController := controller.NewConversationController(conversationService)
+	emailReplyService, cleanup6 := email_reply.NewEmailReplyService(emailReplyCommon, dataData, userRepo, userRoleRelService, rankService, captchaService, answerService, commentService, commentCommonRepo, siteInfoCommonService)
+	emailReplyController := controller.NewEmailReplyController(emailReplyService)
+	freelancerVerificationController := controller_admin.NewFreelancerVerificationController(freelancerService)
+	webhookRepo := webhook.NewWebhookRepo(dataData)
+	webhookService, cleanup7 := webhook2.NewWebhookService(webhookRepo, eventQueueService, store, serviceConf)
+	webhookController := controller_admin.NewWebhookController(webhookService)
+	answerAPIRouter := router.NewAnswerAPIRouter(langController, userController, commentController, reportController, voteController, tagController, followController, collectionController, questionController, answerController, searchController, revisionController, rankController, userAdminController, reasonController, themeController, siteInfoController, controllerSiteInfoController, notificationController, dashboardController, uploadController, activityController, roleController, pluginController, permissionController, userPluginController, reviewController, metaController, badgeController, controller_adminBadgeController, freelancerController, contractController, conversationController, emailReplyController, freelancerVerificationController, webhookController)
 	swaggerRouter := router.NewSwaggerRouter(swaggerConf)
 	uiRouter := router.NewUIRouter(controllerSiteInfoController, siteInfoCommonService)
 	authUserMiddleware := middleware.NewAuthUserMiddleware(authService, siteInfoCommonService)
 	avatarMiddleware := middleware.NewAvatarMiddleware(serviceConf, uploaderService)
 	shortIDMiddleware := middleware.NewShortIDMiddleware(siteInfoCommonService)
-	templateRenderController := templaterender.NewTemplateRenderController(questionService, userService, tagService, answerService, commentService, siteInfoCommonService, questionRepo)
-	templateController := controller.NewTemplateController(templateRenderController, siteInfoCommonService, eventQueueService, userService, questionService)
+	templateRenderController := templaterender.NewTemplateRenderController(questionService, userService, tagService, answerService, commentService, siteInfoCommonService, questionRepo, freelancerService, freelancerRepo, userCommon)
+	templateController := controller.NewTemplateController(templateRenderController, siteInfoCommonService, eventQueueService, userService, questionService, relatedQuestionService)
 	templateRouter := router.NewTemplateRouter(templateController, templateRenderController, siteInfoController, authUserMiddleware)
 	connectorController := controller.NewConnectorController(siteInfoCommonService, emailService, userExternalLoginService)
 	userCenterLoginService := user_external_login2.NewUserCenterLoginService(userRepo, userCommon, userExternalLoginRepo, userActiveActivityRepo, siteInfoCommonService)
@@ -276,9 +315,14 @@ func initApplication(debug bool, serverConf *conf.Server, dbConf *data.Database,
 	renderController := controller.NewRenderController()
 	pluginAPIRouter := router.NewPluginAPIRouter(connectorController, userCenterController, captchaController, embedController, renderController)
 	ginEngine := server.NewHTTPServer(debug, staticRouter, answerAPIRouter, swaggerRouter, uiRouter, authUserMiddleware, avatarMiddleware, shortIDMiddleware, templateRouter, pluginAPIRouter, uiConf)
-	scheduledTaskManager := cron.NewScheduledTaskManager(siteInfoCommonService, questionService, fileRecordService, userAdminService, serviceConf)
+	scheduledTaskManager := cron.NewScheduledTaskManager(siteInfoCommonService, questionService, fileRecordService, userAdminService, serviceConf, freelancerService, currencyService, externalNotificationService)
 	application := newApplication(serverConf, ginEngine, scheduledTaskManager)
 	return application, func() {
+		cleanup7()
+		cleanup6()
+		cleanup5()
+		cleanup4()
+		cleanup3()
 		cleanup2()
 		cleanup()
 	}, nil
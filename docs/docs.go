@@ -276,6 +276,99 @@ const docTemplate = `{
                 }
             }
         },
+        "/answer/admin/api/dashboard/marketplace": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get the statistical of the job postings, applications and freelancers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "get marketplace statistical",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "days",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MarketplaceStatisticalResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/admin/api/dashboard/marketplace/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "export the marketplace statistical as csv",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "export marketplace statistical",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 30,
+                        "description": "days",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "summary",
+                            "jobs"
+                        ],
+                        "type": "string",
+                        "description": "export type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
         "/answer/admin/api/delete/permanently": {
             "delete": {
                 "security": [
@@ -315,6 +408,160 @@ const docTemplate = `{
                 }
             }
         },
+        "/answer/admin/api/freelancer/verification/evidence/{filepath}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "download the evidence file uploaded to the local storage, only the admin can download it",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "AdminFreelancerVerification"
+                ],
+                "summary": "download the evidence file of the freelancer verification request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the download path such as hash/123.pdf",
+                        "name": "filepath",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/admin/api/freelancer/verification/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "approve or reject the pending request, the reason_type is required when it is rejected",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AdminFreelancerVerification"
+                ],
+                "summary": "approve or reject the freelancer verification request",
+                "parameters": [
+                    {
+                        "description": "ReviewFreelancerVerificationReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ReviewFreelancerVerificationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/admin/api/freelancer/verifications": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "list the freelancer verification requests by page, the pending requests are listed from the oldest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "AdminFreelancerVerification"
+                ],
+                "summary": "list the freelancer verification requests by page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "",
+                            "pending",
+                            "approved",
+                            "rejected"
+                        ],
+                        "type": "string",
+                        "description": "verification status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.FreelancerVerificationResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
         "/answer/admin/api/language/options": {
             "get": {
                 "security": [
@@ -519,6 +766,46 @@ const docTemplate = `{
                 }
             }
         },
+        "/answer/admin/api/powers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get the powers which could be assigned to the custom roles",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "get the powers which could be assigned to the custom roles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetPowerResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
         "/answer/admin/api/question/page": {
             "get": {
                 "security": [
@@ -674,61 +961,72 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/roles": {
-            "get": {
+        "/answer/admin/api/role": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get role list",
+                "description": "update the name and description of the custom role",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get role list",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetRoleResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                "summary": "update custom role",
+                "parameters": [
+                    {
+                        "description": "UpdateRoleReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateRoleReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/setting/privileges": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "GetPrivilegesConfig get privileges config",
+                "description": "add custom role with the powers",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "GetPrivilegesConfig get privileges config",
+                "summary": "add custom role",
+                "parameters": [
+                    {
+                        "description": "AddRoleReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddRoleReq"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -741,7 +1039,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetPrivilegesConfigResp"
+                                            "$ref": "#/definitions/schema.GetRoleResp"
                                         }
                                     }
                                 }
@@ -750,28 +1048,31 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update privileges config",
+                "description": "remove the custom role which is not assigned to any user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update privileges config",
+                "summary": "remove custom role",
                 "parameters": [
                     {
-                        "description": "config",
+                        "description": "RemoveRoleReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdatePrivilegesConfigReq"
+                            "$ref": "#/definitions/schema.RemoveRoleReq"
                         }
                     }
                 ],
@@ -785,21 +1086,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/setting/smtp": {
+        "/answer/admin/api/role/audit-logs": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "GetSMTPConfig get smtp config",
+                "description": "list the changes of the roles, their powers and their users by page, the latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "GetSMTPConfig get smtp config",
+                "summary": "list the role audit logs by page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "role id",
+                        "name": "role_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -812,7 +1133,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetSMTPConfigResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.RoleAuditLogResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -820,100 +1156,120 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/answer/admin/api/role/powers": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update smtp config",
+                "description": "get the power types of the role",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update smtp config",
+                "summary": "get the power types of the role",
                 "parameters": [
                     {
-                        "description": "smtp config",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateSMTPConfigReq"
-                        }
+                        "type": "integer",
+                        "description": "role id",
+                        "name": "role_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/siteinfo/branding": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site interface",
+                "description": "replace the powers of the custom role, the users of the role get the new powers at once",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site interface",
+                "summary": "update the powers of the custom role",
+                "parameters": [
+                    {
+                        "description": "UpdateRolePowersReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateRolePowersReq"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.SiteBrandingResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/answer/admin/api/role/users": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site info branding",
+                "description": "assign the role to the users, the users are logged out to refresh their role",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site info branding",
+                "summary": "assign the role to the users",
                 "parameters": [
                     {
-                        "description": "branding info",
+                        "description": "UpdateRoleUsersReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteBrandingReq"
+                            "$ref": "#/definitions/schema.UpdateRoleUsersReq"
                         }
                     }
                 ],
@@ -927,21 +1283,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/custom-css-html": {
+        "/answer/admin/api/roles": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site info custom html css config",
+                "description": "get role list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site info custom html css config",
+                "summary": "get role list",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -954,7 +1310,47 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteCustomCssHTMLResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetRoleResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/admin/api/setting/privileges": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "GetPrivilegesConfig get privileges config",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "GetPrivilegesConfig get privileges config",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetPrivilegesConfigResp"
                                         }
                                     }
                                 }
@@ -969,22 +1365,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site custom css html config",
+                "description": "update privileges config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site custom css html config",
+                "summary": "update privileges config",
                 "parameters": [
                     {
-                        "description": "login info",
+                        "description": "config",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteCustomCssHTMLReq"
+                            "$ref": "#/definitions/schema.UpdatePrivilegesConfigReq"
                         }
                     }
                 ],
@@ -998,21 +1394,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/general": {
+        "/answer/admin/api/setting/smtp": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site general information",
+                "description": "GetSMTPConfig get smtp config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site general information",
+                "summary": "GetSMTPConfig get smtp config",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1025,7 +1421,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteGeneralResp"
+                                            "$ref": "#/definitions/schema.GetSMTPConfigResp"
                                         }
                                     }
                                 }
@@ -1040,22 +1436,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site general information",
+                "description": "update smtp config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site general information",
+                "summary": "update smtp config",
                 "parameters": [
                     {
-                        "description": "general",
+                        "description": "smtp config",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteGeneralReq"
+                            "$ref": "#/definitions/schema.UpdateSMTPConfigReq"
                         }
                     }
                 ],
@@ -1069,7 +1465,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/interface": {
+        "/answer/admin/api/siteinfo/branding": {
             "get": {
                 "security": [
                     {
@@ -1096,7 +1492,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteInterfaceResp"
+                                            "$ref": "#/definitions/schema.SiteBrandingResp"
                                         }
                                     }
                                 }
@@ -1111,22 +1507,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site info interface",
+                "description": "update site info branding",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site info interface",
+                "summary": "update site info branding",
                 "parameters": [
                     {
-                        "description": "general",
+                        "description": "branding info",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteInterfaceReq"
+                            "$ref": "#/definitions/schema.SiteBrandingReq"
                         }
                     }
                 ],
@@ -1140,21 +1536,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/legal": {
+        "/answer/admin/api/siteinfo/currency": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Set the legal information for the site",
+                "description": "get the base currency and the static exchange rates",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Set the legal information for the site",
+                "summary": "get site currency config",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1167,7 +1563,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteLegalResp"
+                                            "$ref": "#/definitions/schema.SiteCurrencyResp"
                                         }
                                     }
                                 }
@@ -1182,22 +1578,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site legal info",
+                "description": "update the base currency and the static exchange rates used when no exchange rate plugin is enabled",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site legal info",
+                "summary": "update site currency config",
                 "parameters": [
                     {
-                        "description": "write info",
+                        "description": "currency config",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteLegalReq"
+                            "$ref": "#/definitions/schema.SiteCurrencyReq"
                         }
                     }
                 ],
@@ -1211,21 +1607,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/login": {
+        "/answer/admin/api/siteinfo/custom-css-html": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site info login config",
+                "description": "get site info custom html css config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site info login config",
+                "summary": "get site info custom html css config",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1238,7 +1634,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteLoginResp"
+                                            "$ref": "#/definitions/schema.SiteCustomCssHTMLResp"
                                         }
                                     }
                                 }
@@ -1253,14 +1649,14 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site login",
+                "description": "update site custom css html config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site login",
+                "summary": "update site custom css html config",
                 "parameters": [
                     {
                         "description": "login info",
@@ -1268,7 +1664,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteLoginReq"
+                            "$ref": "#/definitions/schema.SiteCustomCssHTMLReq"
                         }
                     }
                 ],
@@ -1282,21 +1678,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/seo": {
+        "/answer/admin/api/siteinfo/general": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site seo information",
+                "description": "get site general information",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site seo information",
+                "summary": "get site general information",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1309,7 +1705,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteSeoResp"
+                                            "$ref": "#/definitions/schema.SiteGeneralResp"
                                         }
                                     }
                                 }
@@ -1324,22 +1720,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site seo information",
+                "description": "update site general information",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site seo information",
+                "summary": "update site general information",
                 "parameters": [
                     {
-                        "description": "seo",
+                        "description": "general",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteSeoReq"
+                            "$ref": "#/definitions/schema.SiteGeneralReq"
                         }
                     }
                 ],
@@ -1353,21 +1749,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/theme": {
+        "/answer/admin/api/siteinfo/interface": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site info theme config",
+                "description": "get site interface",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site info theme config",
+                "summary": "get site interface",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1380,7 +1776,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteThemeResp"
+                                            "$ref": "#/definitions/schema.SiteInterfaceResp"
                                         }
                                     }
                                 }
@@ -1395,22 +1791,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site custom css html config",
+                "description": "update site info interface",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site custom css html config",
+                "summary": "update site info interface",
                 "parameters": [
                     {
-                        "description": "login info",
+                        "description": "general",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteThemeReq"
+                            "$ref": "#/definitions/schema.SiteInterfaceReq"
                         }
                     }
                 ],
@@ -1424,21 +1820,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/users": {
+        "/answer/admin/api/siteinfo/job": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site user config",
+                "description": "get site job posting config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site user config",
+                "summary": "get site job posting config",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1451,7 +1847,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteUsersResp"
+                                            "$ref": "#/definitions/schema.SiteJobResp"
                                         }
                                     }
                                 }
@@ -1466,22 +1862,22 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site info config about users",
+                "description": "update site job posting config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site info config about users",
+                "summary": "update site job posting config",
                 "parameters": [
                     {
-                        "description": "users info",
+                        "description": "job posting config",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteUsersReq"
+                            "$ref": "#/definitions/schema.SiteJobReq"
                         }
                     }
                 ],
@@ -1495,21 +1891,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/siteinfo/write": {
+        "/answer/admin/api/siteinfo/legal": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get site interface",
+                "description": "Set the legal information for the site",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get site interface",
+                "summary": "Set the legal information for the site",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1522,7 +1918,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteWriteResp"
+                                            "$ref": "#/definitions/schema.SiteLegalResp"
                                         }
                                     }
                                 }
@@ -1537,14 +1933,14 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update site write info",
+                "description": "update site legal info",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update site write info",
+                "summary": "update site legal info",
                 "parameters": [
                     {
                         "description": "write info",
@@ -1552,7 +1948,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SiteWriteReq"
+                            "$ref": "#/definitions/schema.SiteLegalReq"
                         }
                     }
                 ],
@@ -1566,57 +1962,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/theme/options": {
+        "/answer/admin/api/siteinfo/login": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get theme options",
+                "description": "get site info login config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "Get theme options",
+                "summary": "get site info login config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteLoginResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/user": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "update site login",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "add user",
+                "summary": "update site login",
                 "parameters": [
                     {
-                        "description": "user",
+                        "description": "login info",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AddUserReq"
+                            "$ref": "#/definitions/schema.SiteLoginReq"
                         }
                     }
                 ],
@@ -1630,30 +2033,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/user/activation": {
+        "/answer/admin/api/siteinfo/seo": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get user activation",
+                "description": "get site seo information",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get user activation",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "user id",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
+                "summary": "get site seo information",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1666,7 +2060,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetUserActivationResp"
+                                            "$ref": "#/definitions/schema.SiteSeoResp"
                                         }
                                     }
                                 }
@@ -1674,34 +2068,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/user/password": {
+            },
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update user password",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "update site seo information",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update user password",
+                "summary": "update site seo information",
                 "parameters": [
                     {
-                        "description": "user",
+                        "description": "seo",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserPasswordReq"
+                            "$ref": "#/definitions/schema.SiteSeoReq"
                         }
                     }
                 ],
@@ -1715,71 +2104,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/user/profile": {
-            "put": {
+        "/answer/admin/api/siteinfo/theme": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "edit user profile",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get site info theme config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "edit user profile",
-                "parameters": [
-                    {
-                        "description": "user",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.EditUserProfileReq"
-                        }
-                    }
-                ],
+                "summary": "get site info theme config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteThemeResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/user/role": {
+            },
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update user role",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "update site custom css html config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update user role",
+                "summary": "update site custom css html config",
                 "parameters": [
                     {
-                        "description": "user",
+                        "description": "login info",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserRoleReq"
+                            "$ref": "#/definitions/schema.SiteThemeReq"
                         }
                     }
                 ],
@@ -1793,71 +2175,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/user/status": {
-            "put": {
+        "/answer/admin/api/siteinfo/users": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get site user config",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "update user",
-                "parameters": [
-                    {
-                        "description": "user",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserStatusReq"
-                        }
-                    }
-                ],
+                "summary": "get site user config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteUsersResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/admin/api/users": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add users",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "update site info config about users",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "add users",
+                "summary": "update site info config about users",
                 "parameters": [
                     {
-                        "description": "user",
+                        "description": "users info",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AddUsersReq"
+                            "$ref": "#/definitions/schema.SiteUsersReq"
                         }
                     }
                 ],
@@ -1871,29 +2246,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/users/activation": {
-            "post": {
+        "/answer/admin/api/siteinfo/write": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "send user activation",
+                "description": "get site interface",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "send user activation",
+                "summary": "get site interface",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteWriteResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update site write info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "update site write info",
                 "parameters": [
                     {
-                        "description": "SendUserActivationReq",
+                        "description": "write info",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.SendUserActivationReq"
+                            "$ref": "#/definitions/schema.SiteWriteReq"
                         }
                     }
                 ],
@@ -1907,173 +2317,90 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/admin/api/users/page": {
+        "/answer/admin/api/theme/options": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get user page",
+                "description": "Get theme options",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "admin"
                 ],
-                "summary": "get user page",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "search query: email, username or id:[id]",
-                        "name": "query",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "staff user",
-                        "name": "staff",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "suspended",
-                            "deleted",
-                            "inactive"
-                        ],
-                        "type": "string",
-                        "description": "user status",
-                        "name": "status",
-                        "in": "query"
-                    }
-                ],
+                "summary": "Get theme options",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "records": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetUserPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/activity/timeline": {
-            "get": {
-                "description": "get object timeline",
+        "/answer/admin/api/user": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "admin"
                 ],
-                "summary": "get object timeline",
+                "summary": "add user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "object id",
-                        "name": "object_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "tag slug name",
-                        "name": "tag_slug_name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "question",
-                            "answer",
-                            "tag"
-                        ],
-                        "type": "string",
-                        "description": "object type",
-                        "name": "object_type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "is show vote",
-                        "name": "show_vote",
-                        "in": "query"
+                        "description": "user",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddUserReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetObjectTimelineResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/activity/timeline/detail": {
+        "/answer/admin/api/user/activation": {
             "get": {
-                "description": "get object timeline detail",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get user activation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "admin"
                 ],
-                "summary": "get object timeline detail",
+                "summary": "get user activation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "revision id",
-                        "name": "revision_id",
+                        "description": "user id",
+                        "name": "user_id",
                         "in": "query",
                         "required": true
                     }
@@ -2090,7 +2417,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetObjectTimelineResp"
+                                            "$ref": "#/definitions/schema.GetUserActivationResp"
                                         }
                                     }
                                 }
@@ -2100,14 +2427,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/answer": {
+        "/answer/admin/api/user/password": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update Answer",
+                "description": "update user password",
                 "consumes": [
                     "application/json"
                 ],
@@ -2115,17 +2442,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "Update Answer",
+                "summary": "update user password",
                 "parameters": [
                     {
-                        "description": "AnswerUpdateReq",
+                        "description": "user",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AnswerUpdateReq"
+                            "$ref": "#/definitions/schema.UpdateUserPasswordReq"
                         }
                     }
                 ],
@@ -2137,14 +2464,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/answer/admin/api/user/profile": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add answer",
+                "description": "edit user profile",
                 "consumes": [
                     "application/json"
                 ],
@@ -2152,17 +2481,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "Add Answer",
+                "summary": "edit user profile",
                 "parameters": [
                     {
-                        "description": "add answer request",
+                        "description": "user",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AnswerAddReq"
+                            "$ref": "#/definitions/schema.EditUserProfileReq"
                         }
                     }
                 ],
@@ -2174,14 +2503,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/answer/admin/api/user/role": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete answer",
+                "description": "update user role",
                 "consumes": [
                     "application/json"
                 ],
@@ -2189,17 +2520,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "delete answer",
+                "summary": "update user role",
                 "parameters": [
                     {
-                        "description": "answer",
+                        "description": "user",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.RemoveAnswerReq"
+                            "$ref": "#/definitions/schema.UpdateUserRoleReq"
                         }
                     }
                 ],
@@ -2213,14 +2544,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/answer/acceptance": {
-            "post": {
+        "/answer/admin/api/user/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Accept Answer",
+                "description": "update user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2228,17 +2559,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "Accept Answer",
+                "summary": "update user",
                 "parameters": [
                     {
-                        "description": "AcceptAnswerReq",
+                        "description": "user",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AcceptAnswerReq"
+                            "$ref": "#/definitions/schema.UpdateUserStatusReq"
                         }
                     }
                 ],
@@ -2252,53 +2583,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/answer/info": {
-            "get": {
-                "description": "Get Answer Detail",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Answer"
-                ],
-                "summary": "Get Answer Detail",
-                "parameters": [
+        "/answer/admin/api/users": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "ApiKeyAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetAnswerInfoResp"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/answer/page": {
-            "get": {
-                "description": "AnswerList \u003cbr\u003e \u003cb\u003eorder\u003c/b\u003e (default or updated)",
+                "description": "add users",
                 "consumes": [
                     "application/json"
                 ],
@@ -2306,75 +2598,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "AnswerList",
+                "summary": "add users",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "question_id",
-                        "name": "question_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "order",
-                        "name": "order",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "page_size",
-                        "name": "page_size",
-                        "in": "query",
-                        "required": true
+                        "description": "user",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddUsersReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/answer/recover": {
+        "/answer/admin/api/users/activation": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "recover the deleted answer",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "send user activation",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Answer"
+                    "admin"
                 ],
-                "summary": "recover answer",
+                "summary": "send user activation",
                 "parameters": [
                     {
-                        "description": "answer",
+                        "description": "SendUserActivationReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.RecoverAnswerReq"
+                            "$ref": "#/definitions/schema.SendUserActivationReq"
                         }
                     }
                 ],
@@ -2388,27 +2658,56 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/badge": {
+        "/answer/admin/api/users/page": {
             "get": {
-                "description": "get badge info",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "get user page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "api-badge"
+                    "admin"
                 ],
-                "summary": "get badge info",
+                "summary": "get user page",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "default": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "search query: email, username or id:[id]",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "staff user",
+                        "name": "staff",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "suspended",
+                            "deleted",
+                            "inactive"
+                        ],
+                        "type": "string",
+                        "description": "user status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2423,7 +2722,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetBadgeInfoResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "records": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetUserPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -2433,9 +2747,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/badge/awards/page": {
-            "get": {
-                "description": "get badge award list",
+        "/answer/admin/api/webhook": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update webhook, the secret is kept if it is empty",
                 "consumes": [
                     "application/json"
                 ],
@@ -2443,61 +2762,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "api-badge"
+                    "AdminWebhook"
                 ],
-                "summary": "get badge award list",
+                "summary": "update webhook",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "badge id",
-                        "name": "badge_id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "only list the award by username",
-                        "name": "username",
-                        "in": "query"
+                        "description": "UpdateWebhookReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateWebhookReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetBadgeInfoResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/badge/user/awards": {
-            "get": {
-                "description": "get user badge award list",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add webhook, the secret is generated and returned if it is empty",
                 "consumes": [
                     "application/json"
                 ],
@@ -2505,16 +2799,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "api-badge"
+                    "AdminWebhook"
                 ],
-                "summary": "get user badge award list",
+                "summary": "add webhook",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "user name",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
+                        "description": "AddWebhookReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddWebhookReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -2529,10 +2825,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetUserBadgeAwardListResp"
-                                            }
+                                            "$ref": "#/definitions/schema.WebhookResp"
                                         }
                                     }
                                 }
@@ -2540,11 +2833,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/badge/user/awards/recent": {
-            "get": {
-                "description": "get user badge award list",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "remove webhook",
                 "consumes": [
                     "application/json"
                 ],
@@ -2552,56 +2848,77 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "api-badge"
+                    "AdminWebhook"
                 ],
-                "summary": "get user badge award list",
+                "summary": "remove webhook",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "user name",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
+                        "description": "RemoveWebhookReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RemoveWebhookReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetUserBadgeAwardListResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/badges": {
+        "/answer/admin/api/webhook/deliveries": {
             "get": {
-                "description": "list all badges group by group",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "list the webhook deliveries by page, the latest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "api-badge"
+                    "AdminWebhook"
+                ],
+                "summary": "list the webhook deliveries by page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "webhook id",
+                        "name": "webhook_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "",
+                            "pending",
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "list all badges group by group",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2614,10 +2931,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetBadgeListResp"
-                                            }
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.WebhookDeliveryResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -2627,14 +2956,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/collection/switch": {
+        "/answer/admin/api/webhook/delivery/redeliver": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add collection",
+                "description": "deliver the payload of the delivery again as a new delivery",
                 "consumes": [
                     "application/json"
                 ],
@@ -2642,17 +2971,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Collection"
+                    "AdminWebhook"
                 ],
-                "summary": "add collection",
+                "summary": "redeliver the webhook delivery",
                 "parameters": [
                     {
-                        "description": "collection",
+                        "description": "RedeliverWebhookReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.CollectionSwitchReq"
+                            "$ref": "#/definitions/schema.RedeliverWebhookReq"
                         }
                     }
                 ],
@@ -2668,7 +2997,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CollectionSwitchResp"
+                                            "$ref": "#/definitions/schema.WebhookDeliveryResp"
                                         }
                                     }
                                 }
@@ -2678,25 +3007,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/comment": {
+        "/answer/admin/api/webhook/events": {
             "get": {
-                "description": "get comment by id",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get the events which could be subscribed by the webhook",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
-                ],
-                "summary": "get comment by id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "AdminWebhook"
                 ],
+                "summary": "get the events which could be subscribed",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2709,22 +3034,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetCommentResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
                                         }
                                     }
                                 }
@@ -2732,14 +3045,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/answer/admin/api/webhook/ping": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update comment",
+                "description": "send the ping event to the webhook right now and return the delivery result",
                 "consumes": [
                     "application/json"
                 ],
@@ -2747,17 +3062,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "AdminWebhook"
                 ],
-                "summary": "update comment",
+                "summary": "send the ping event to the webhook",
                 "parameters": [
                     {
-                        "description": "comment",
+                        "description": "PingWebhookReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateCommentReq"
+                            "$ref": "#/definitions/schema.PingWebhookReq"
                         }
                     }
                 ],
@@ -2765,39 +3080,39 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.WebhookDeliveryResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/answer/admin/api/webhooks": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add comment",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get all webhooks, the secrets are not returned",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
-                ],
-                "summary": "add comment",
-                "parameters": [
-                    {
-                        "description": "comment",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.AddCommentReq"
-                        }
-                    }
+                    "AdminWebhook"
                 ],
+                "summary": "get all webhooks",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2810,7 +3125,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetCommentResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.WebhookResp"
+                                            }
                                         }
                                     }
                                 }
@@ -2818,83 +3136,88 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "remove comment",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/answer/api/v1/activity/timeline": {
+            "get": {
+                "description": "get object timeline",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Comment"
                 ],
-                "summary": "remove comment",
+                "summary": "get object timeline",
                 "parameters": [
                     {
-                        "description": "comment",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.RemoveCommentReq"
-                        }
+                        "type": "string",
+                        "description": "object id",
+                        "name": "object_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tag slug name",
+                        "name": "tag_slug_name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "question",
+                            "answer",
+                            "tag"
+                        ],
+                        "type": "string",
+                        "description": "object type",
+                        "name": "object_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "is show vote",
+                        "name": "show_vote",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetObjectTimelineResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/comment/page": {
+        "/answer/api/v1/activity/timeline/detail": {
             "get": {
-                "description": "get comment page",
+                "description": "get object timeline detail",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Comment"
                 ],
-                "summary": "get comment page",
+                "summary": "get object timeline detail",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "object id",
-                        "name": "object_id",
+                        "description": "revision id",
+                        "name": "revision_id",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "vote"
-                        ],
-                        "type": "string",
-                        "description": "query condition",
-                        "name": "query_cond",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2909,22 +3232,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetCommentResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.GetObjectTimelineResp"
                                         }
                                     }
                                 }
@@ -2934,9 +3242,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/connector/binding/email": {
-            "post": {
-                "description": "external login binding user send email",
+        "/answer/api/v1/answer": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update Answer",
                 "consumes": [
                     "application/json"
                 ],
@@ -2944,17 +3257,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PluginConnector"
+                    "Answer"
                 ],
-                "summary": "external login binding user send email",
+                "summary": "Update Answer",
                 "parameters": [
                     {
-                        "description": "external login binding user send email",
+                        "description": "AnswerUpdateReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.ExternalLoginBindingUserSendEmailReq"
+                            "$ref": "#/definitions/schema.AnswerUpdateReq"
                         }
                     }
                 ],
@@ -2962,112 +3275,94 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.ExternalLoginBindingUserSendEmailResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/connector/info": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get all enabled connectors",
+                "description": "add answer",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PluginConnector"
+                    "Answer"
+                ],
+                "summary": "Add Answer",
+                "parameters": [
+                    {
+                        "description": "add answer request",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AnswerAddReq"
+                        }
+                    }
                 ],
-                "summary": "get all enabled connectors",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.ConnectorInfoResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/connector/user/info": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get all connectors info about user",
+                "description": "delete answer",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PluginConnector"
+                    "Answer"
+                ],
+                "summary": "delete answer",
+                "parameters": [
+                    {
+                        "description": "answer",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RemoveAnswerReq"
+                        }
+                    }
                 ],
-                "summary": "get all connectors info about user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.ConnectorUserInfoResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/connector/user/unbinding": {
-            "delete": {
+        "/answer/api/v1/answer/acceptance": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "unbind external user login",
+                "description": "Accept Answer",
                 "consumes": [
                     "application/json"
                 ],
@@ -3075,17 +3370,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PluginConnector"
+                    "Answer"
                 ],
-                "summary": "unbind external user login",
+                "summary": "Accept Answer",
                 "parameters": [
                     {
-                        "description": "ExternalLoginUnbindingReq",
+                        "description": "AcceptAnswerReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.ExternalLoginUnbindingReq"
+                            "$ref": "#/definitions/schema.AcceptAnswerReq"
                         }
                     }
                 ],
@@ -3099,9 +3394,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/embed/config": {
+        "/answer/api/v1/answer/info": {
             "get": {
-                "description": "get embed plugin config",
+                "description": "Get Answer Detail",
                 "consumes": [
                     "application/json"
                 ],
@@ -3109,9 +3404,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Plugin"
+                    "Answer"
+                ],
+                "summary": "Get Answer Detail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "get embed plugin config",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3124,10 +3428,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/plugin.EmbedConfig"
-                                            }
+                                            "$ref": "#/definitions/schema.GetAnswerInfoResp"
                                         }
                                     }
                                 }
@@ -3137,40 +3438,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/file": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "upload file",
+        "/answer/api/v1/answer/page": {
+            "get": {
+                "description": "AnswerList \u003cbr\u003e \u003cb\u003eorder\u003c/b\u003e (default or updated)",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "Upload"
+                    "Answer"
                 ],
-                "summary": "upload file",
+                "summary": "AnswerList",
                 "parameters": [
                     {
-                        "enum": [
-                            "post",
-                            "post_attachment",
-                            "avatar",
-                            "branding"
-                        ],
                         "type": "string",
-                        "description": "identify the source of the file upload",
-                        "name": "source",
-                        "in": "formData",
+                        "description": "question_id",
+                        "name": "question_id",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "file",
-                        "name": "file",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "order",
+                        "name": "order",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -3178,32 +3485,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/follow": {
+        "/answer/api/v1/answer/recover": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "follow object or cancel follow operation",
+                "description": "recover the deleted answer",
                 "consumes": [
                     "application/json"
                 ],
@@ -3211,19 +3506,52 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activity"
+                    "Answer"
                 ],
-                "summary": "follow object or cancel follow operation",
+                "summary": "recover answer",
                 "parameters": [
                     {
-                        "description": "follow",
+                        "description": "answer",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.FollowReq"
+                            "$ref": "#/definitions/schema.RecoverAnswerReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
+                }
+            }
+        },
+        "/answer/api/v1/badge": {
+            "get": {
+                "description": "get badge info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "api-badge"
+                ],
+                "summary": "get badge info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -3237,7 +3565,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.FollowResp"
+                                            "$ref": "#/definitions/schema.GetBadgeInfoResp"
                                         }
                                     }
                                 }
@@ -3247,14 +3575,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/follow/tags": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "update user follow tags",
+        "/answer/api/v1/badge/awards/page": {
+            "get": {
+                "description": "get badge award list",
                 "consumes": [
                     "application/json"
                 ],
@@ -3262,38 +3585,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activity"
+                    "api-badge"
                 ],
-                "summary": "update user follow tags",
+                "summary": "get badge award list",
                 "parameters": [
                     {
-                        "description": "follow",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateFollowTagsReq"
-                        }
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "badge id",
+                        "name": "badge_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "only list the award by username",
+                        "name": "username",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetBadgeInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/freelancer/hire": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Send hiring message to freelancer",
+        "/answer/api/v1/badge/user/awards": {
+            "get": {
+                "description": "get user badge award list",
                 "consumes": [
                     "application/json"
                 ],
@@ -3301,18 +3647,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Freelancer"
+                    "api-badge"
                 ],
-                "summary": "Hire freelancer",
+                "summary": "get user badge award list",
                 "parameters": [
                     {
-                        "description": "HireFreelancer",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.HireFreelancerReq"
-                        }
+                        "type": "string",
+                        "description": "user name",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3327,7 +3671,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.HireFreelancerResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetUserBadgeAwardListResp"
+                                            }
                                         }
                                     }
                                 }
@@ -3337,9 +3684,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/freelancer/profile": {
+        "/answer/api/v1/badge/user/awards/recent": {
             "get": {
-                "description": "Get freelancer profile",
+                "description": "get user badge award list",
                 "consumes": [
                     "application/json"
                 ],
@@ -3347,14 +3694,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Freelancer"
+                    "api-badge"
                 ],
-                "summary": "Get freelancer profile",
+                "summary": "get user badge award list",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "user_id",
-                        "name": "user_id",
+                        "description": "user name",
+                        "name": "username",
                         "in": "query",
                         "required": true
                     }
@@ -3371,7 +3718,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.FreelancerProfileResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetUserBadgeAwardListResp"
+                                            }
                                         }
                                     }
                                 }
@@ -3379,14 +3729,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update freelancer profile",
+            }
+        },
+        "/answer/api/v1/badges": {
+            "get": {
+                "description": "list all badges group by group",
                 "consumes": [
                     "application/json"
                 ],
@@ -3394,36 +3741,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Freelancer"
-                ],
-                "summary": "Update freelancer profile",
-                "parameters": [
-                    {
-                        "description": "UpdateFreelancerProfile",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateFreelancerProfileReq"
-                        }
-                    }
+                    "api-badge"
                 ],
+                "summary": "list all badges group by group",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetBadgeListResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
+            }
+        },
+        "/answer/api/v1/collection/switch": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create freelancer profile",
+                "description": "add collection",
                 "consumes": [
                     "application/json"
                 ],
@@ -3431,17 +3784,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Freelancer"
+                    "Collection"
                 ],
-                "summary": "Create freelancer profile",
+                "summary": "add collection",
                 "parameters": [
                     {
-                        "description": "CreateFreelancerProfile",
+                        "description": "collection",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.CreateFreelancerProfileReq"
+                            "$ref": "#/definitions/schema.CollectionSwitchReq"
                         }
                     }
                 ],
@@ -3449,67 +3802,41 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CollectionSwitchResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/freelancer/profiles": {
+        "/answer/api/v1/comment": {
             "get": {
-                "description": "Get freelancer profiles",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get comment by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Freelancer"
+                    "Comment"
                 ],
-                "summary": "Get freelancer profiles",
+                "summary": "get comment by id",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page_size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "skills",
-                        "name": "skills",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "location",
-                        "name": "location",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "min_rate",
-                        "name": "min_rate",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "max_rate",
-                        "name": "max_rate",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "currency",
-                        "name": "currency",
-                        "in": "query"
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -3524,7 +3851,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetFreelancerProfilesResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetCommentResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -3532,16 +3874,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/job/application": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create job application",
+                "description": "update comment",
                 "consumes": [
                     "application/json"
                 ],
@@ -3549,17 +3889,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "Comment"
                 ],
-                "summary": "Create job application",
+                "summary": "update comment",
                 "parameters": [
                     {
-                        "description": "CreateJobApplication",
+                        "description": "comment",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.CreateJobApplicationReq"
+                            "$ref": "#/definitions/schema.UpdateCommentReq"
                         }
                     }
                 ],
@@ -3571,16 +3911,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/job/posting": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create job posting",
+                "description": "add comment",
                 "consumes": [
                     "application/json"
                 ],
@@ -3588,17 +3926,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "Comment"
                 ],
-                "summary": "Create job posting",
+                "summary": "add comment",
                 "parameters": [
                     {
-                        "description": "CreateJobPosting",
+                        "description": "comment",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.CreateJobPostingReq"
+                            "$ref": "#/definitions/schema.AddCommentReq"
                         }
                     }
                 ],
@@ -3606,15 +3944,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetCommentResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/job/posting/{id}": {
-            "get": {
-                "description": "Get job posting by ID",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "remove comment",
                 "consumes": [
                     "application/json"
                 ],
@@ -3622,53 +3975,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "Comment"
                 ],
-                "summary": "Get job posting",
+                "summary": "remove comment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "job_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "comment",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RemoveCommentReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.JobPostingResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/job/postings": {
+        "/answer/api/v1/comment/page": {
             "get": {
-                "description": "Get job postings",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "get comment page",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Job"
+                    "Comment"
                 ],
-                "summary": "Get job postings",
+                "summary": "get comment page",
                 "parameters": [
                     {
                         "type": "integer",
@@ -3678,44 +4018,24 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "page_size",
+                        "description": "page size",
                         "name": "page_size",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "skills",
-                        "name": "skills",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "location",
-                        "name": "location",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "min_budget",
-                        "name": "min_budget",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "max_budget",
-                        "name": "max_budget",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "currency",
-                        "name": "currency",
-                        "in": "query"
+                        "description": "object id",
+                        "name": "object_id",
+                        "in": "query",
+                        "required": true
                     },
                     {
+                        "enum": [
+                            "vote"
+                        ],
                         "type": "string",
-                        "description": "status",
-                        "name": "status",
+                        "description": "query condition",
+                        "name": "query_cond",
                         "in": "query"
                     }
                 ],
@@ -3731,7 +4051,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetJobPostingsResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetCommentResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -3741,77 +4076,107 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/language/config": {
-            "get": {
-                "description": "get language config mapping",
+        "/answer/api/v1/connector/binding/email": {
+            "post": {
+                "description": "external login binding user send email",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lang"
+                    "PluginConnector"
                 ],
-                "summary": "get language config mapping",
+                "summary": "external login binding user send email",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Accept-Language",
-                        "name": "Accept-Language",
-                        "in": "header",
-                        "required": true
+                        "description": "external login binding user send email",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ExternalLoginBindingUserSendEmailReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ExternalLoginBindingUserSendEmailResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/language/options": {
+        "/answer/api/v1/connector/info": {
             "get": {
-                "description": "Get language options",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get all enabled connectors",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lang"
+                    "PluginConnector"
                 ],
-                "summary": "Get language options",
+                "summary": "get all enabled connectors",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ConnectorInfoResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/meta/reaction": {
+        "/answer/api/v1/connector/user/info": {
             "get": {
-                "description": "get reaction for an object",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "get all connectors info about user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Meta"
-                ],
-                "summary": "get reaction",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "object_id",
-                        "name": "object_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "PluginConnector"
                 ],
+                "summary": "get all connectors info about user",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3824,7 +4189,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ReactionRespItem"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ConnectorUserInfoResp"
+                                            }
                                         }
                                     }
                                 }
@@ -3832,14 +4200,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/answer/api/v1/connector/user/unbinding": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update reaction. if not exist, add one",
+                "description": "unbind external user login",
                 "consumes": [
                     "application/json"
                 ],
@@ -3847,17 +4217,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Meta"
+                    "PluginConnector"
                 ],
-                "summary": "add or update reaction",
+                "summary": "unbind external user login",
                 "parameters": [
                     {
-                        "description": "reaction",
+                        "description": "ExternalLoginUnbindingReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateReactionReq"
+                            "$ref": "#/definitions/schema.ExternalLoginUnbindingReq"
                         }
                     }
                 ],
@@ -3871,14 +4241,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/notification/page": {
-            "get": {
+        "/answer/api/v1/contract": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get notification list",
+                "description": "Create a contract with the freelancer, the login user is the client",
                 "consumes": [
                     "application/json"
                 ],
@@ -3886,65 +4256,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notification"
+                    "Contract"
                 ],
-                "summary": "get notification list",
+                "summary": "Create contract",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "inbox",
-                            "achievement"
-                        ],
-                        "type": "string",
-                        "description": "type",
-                        "name": "type",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "all",
-                            "posts",
-                            "invites",
-                            "votes"
-                        ],
-                        "type": "string",
-                        "description": "inbox_type",
-                        "name": "inbox_type",
-                        "in": "query",
-                        "required": true
+                        "description": "CreateContract",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreateContractReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CreateContractResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/notification/read/state": {
+        "/answer/api/v1/contract/milestone/{id}/status": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "ClearUnRead",
+                "description": "Fund, submit, approve, dispute or release the milestone",
                 "consumes": [
                     "application/json"
                 ],
@@ -3952,17 +4307,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notification"
+                    "Contract"
                 ],
-                "summary": "ClearUnRead",
+                "summary": "Update milestone status",
                 "parameters": [
                     {
-                        "description": "NotificationClearIDRequest",
+                        "type": "string",
+                        "description": "milestone_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateMilestoneStatus",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.NotificationClearIDRequest"
+                            "$ref": "#/definitions/schema.UpdateMilestoneStatusReq"
                         }
                     }
                 ],
@@ -3976,14 +4338,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/notification/read/state/all": {
-            "put": {
+        "/answer/api/v1/contract/review": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "ClearUnRead",
+                "description": "Remove the review, only the reviewer and admin can do it",
                 "consumes": [
                     "application/json"
                 ],
@@ -3991,17 +4353,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notification"
+                    "Contract"
                 ],
-                "summary": "ClearUnRead",
+                "summary": "Remove contract review",
                 "parameters": [
                     {
-                        "description": "NotificationClearRequest",
+                        "description": "RemoveContractReview",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.NotificationClearRequest"
+                            "$ref": "#/definitions/schema.RemoveContractReviewReq"
                         }
                     }
                 ],
@@ -4015,40 +4377,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/notification/status": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "GetRedDot",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Notification"
-                ],
-                "summary": "GetRedDot",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
-                        }
-                    }
-                }
-            },
+        "/answer/api/v1/contract/review/{id}/reply": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "DelRedDot",
+                "description": "Reply the review, only the reviewee can reply once",
                 "consumes": [
                     "application/json"
                 ],
@@ -4056,17 +4392,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notification"
+                    "Contract"
                 ],
-                "summary": "DelRedDot",
+                "summary": "Reply contract review",
                 "parameters": [
                     {
-                        "description": "NotificationClearRequest",
+                        "type": "string",
+                        "description": "review_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ReplyContractReview",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.NotificationClearRequest"
+                            "$ref": "#/definitions/schema.ReplyContractReviewReq"
                         }
                     }
                 ],
@@ -4080,75 +4423,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/permission": {
+        "/answer/api/v1/contract/reviews": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+                "description": "Get the available reviews of the user with the average rating",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "check user permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Permission"
+                    "Contract"
                 ],
-                "summary": "check user permission",
+                "summary": "Get contract reviews of the user",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "access-token",
-                        "name": "Authorization",
-                        "in": "header",
+                        "description": "reviewee user id",
+                        "name": "user_id",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "enum": [
-                            "question.add",
-                            "question.edit",
-                            "question.edit_without_review",
-                            "question.delete",
-                            "question.close",
-                            "question.reopen",
-                            "question.vote_up",
-                            "question.vote_down",
-                            "question.pin",
-                            "question.unpin",
-                            "question.hide",
-                            "question.show",
-                            "answer.add",
-                            "answer.edit",
-                            "answer.edit_without_review",
-                            "answer.delete",
-                            "answer.accept",
-                            "answer.vote_up",
-                            "answer.vote_down",
-                            "answer.invite_someone_to_answer",
-                            "comment.add",
-                            "comment.edit",
-                            "comment.delete",
-                            "comment.vote_up",
-                            "comment.vote_down",
-                            "report.add",
-                            "tag.add",
-                            "tag.edit",
-                            "tag.edit_slug_name",
-                            "tag.edit_without_review",
-                            "tag.delete",
-                            "tag.synonym",
-                            "link.url_limit",
-                            "vote.detail",
-                            "answer.audit",
-                            "question.audit",
-                            "tag.audit",
-                            "tag.use_reserved_tag"
+                            "client",
+                            "freelancer"
                         ],
                         "type": "string",
-                        "description": "permission key",
-                        "name": "action",
-                        "in": "query",
-                        "required": true
+                        "description": "role of the reviewer",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -4163,10 +4479,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": {
-                                                "type": "boolean"
-                                            }
+                                            "$ref": "#/definitions/schema.GetContractReviewsResp"
                                         }
                                     }
                                 }
@@ -4176,14 +4489,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/personal/answer/page": {
+        "/answer/api/v1/contract/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "list personal answers",
+                "description": "Get the contract with its milestones, only the parties of the contract can see it",
                 "consumes": [
                     "application/json"
                 ],
@@ -4191,43 +4504,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Personal"
+                    "Contract"
                 ],
-                "summary": "list personal answers",
+                "summary": "Get contract",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "newest",
-                            "score"
-                        ],
-                        "type": "string",
-                        "description": "order",
-                        "name": "order",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "0",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "20",
-                        "description": "page_size",
-                        "name": "page_size",
-                        "in": "query",
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4235,20 +4520,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ContractResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/personal/collection/page": {
-            "get": {
+        "/answer/api/v1/contract/{id}/accept": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "list personal collections",
+                "description": "Accept the offered contract, only the freelancer can do it",
                 "consumes": [
                     "application/json"
                 ],
@@ -4256,24 +4553,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Collection"
+                    "Contract"
                 ],
-                "summary": "list personal collections",
+                "summary": "Accept contract",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "0",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "default": "20",
-                        "description": "page_size",
-                        "name": "page_size",
-                        "in": "query",
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4287,76 +4575,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/personal/comment/page": {
-            "get": {
-                "description": "user personal comment list",
+        "/answer/api/v1/contract/{id}/cancel": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Cancel the offered or active contract when no milestone is held in escrow",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Comment"
+                    "Contract"
                 ],
-                "summary": "user personal comment list",
+                "summary": "Cancel contract",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query"
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetCommentPersonalWithPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/personal/qa/top": {
-            "get": {
-                "description": "UserTop",
+        "/answer/api/v1/contract/{id}/complete": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "The freelancer confirms the work is done, the client completes the accepted contract when no milestone is held in escrow and a milestone is released or the freelancer has confirmed",
                 "consumes": [
                     "application/json"
                 ],
@@ -4364,16 +4627,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Contract"
                 ],
-                "summary": "UserTop",
+                "summary": "Complete contract",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query",
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -4387,81 +4649,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/personal/rank/page": {
-            "get": {
-                "description": "user personal rank list",
+        "/answer/api/v1/contract/{id}/milestone": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Add a draft milestone to the contract, only the client can do it",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Rank"
+                    "Contract"
                 ],
-                "summary": "user personal rank list",
+                "summary": "Add milestone",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
+                        "type": "string",
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query"
+                        "description": "CreateMilestone",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreateMilestoneReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetRankPersonalPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/personal/user/info": {
-            "get": {
+        "/answer/api/v1/contract/{id}/review": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "GetOtherUserInfoByUsername",
+                "description": "Review the other party of the completed contract, each party can review once",
                 "consumes": [
                     "application/json"
                 ],
@@ -4469,16 +4710,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Contract"
                 ],
-                "summary": "GetOtherUserInfoByUsername",
+                "summary": "Add contract review",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query",
+                        "description": "contract_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "AddContractReview",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddContractReviewReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -4493,7 +4743,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetOtherUserInfoResp"
+                                            "$ref": "#/definitions/schema.ContractReviewResp"
                                         }
                                     }
                                 }
@@ -4503,14 +4753,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/personal/vote/page": {
+        "/answer/api/v1/contracts": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get user personal votes",
+                "description": "Get contracts of login user as client or freelancer",
                 "consumes": [
                     "application/json"
                 ],
@@ -4518,13 +4768,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activity"
+                    "Contract"
                 ],
-                "summary": "get user personal votes",
+                "summary": "Get contracts of login user",
                 "parameters": [
+                    {
+                        "enum": [
+                            "client",
+                            "freelancer"
+                        ],
+                        "type": "string",
+                        "description": "role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "offered",
+                            "active",
+                            "completed",
+                            "cancelled"
+                        ],
+                        "type": "string",
+                        "description": "status",
+                        "name": "status",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "page size",
+                        "description": "page",
                         "name": "page",
                         "in": "query"
                     },
@@ -4557,7 +4829,7 @@ const docTemplate = `{
                                                         "list": {
                                                             "type": "array",
                                                             "items": {
-                                                                "$ref": "#/definitions/schema.GetVoteWithPageResp"
+                                                                "$ref": "#/definitions/schema.ContractResp"
                                                             }
                                                         }
                                                     }
@@ -4572,19 +4844,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/plugin/status": {
-            "get": {
-                "description": "get all plugins status",
-                "consumes": [
-                    "application/json"
-                ],
+        "/answer/api/v1/conversation": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send message to the other party of the contract or the job application",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Plugin"
+                    "Conversation"
+                ],
+                "summary": "Start conversation",
+                "parameters": [
+                    {
+                        "description": "StartConversation",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.StartConversationReq"
+                        }
+                    }
                 ],
-                "summary": "get all plugins status",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4597,10 +4885,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetPluginListResp"
-                                            }
+                                            "$ref": "#/definitions/schema.StartConversationResp"
                                         }
                                     }
                                 }
@@ -4610,53 +4895,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/post/render": {
-            "post": {
+        "/answer/api/v1/conversation/attachment/{filepath}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "render post content",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download the attachment of the message, only the members of the conversation can download it",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Upload"
+                    "Conversation"
                 ],
-                "summary": "render post content",
+                "summary": "Download message attachment",
                 "parameters": [
                     {
-                        "description": "PostRenderReq",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.PostRenderReq"
-                        }
+                        "type": "string",
+                        "description": "the download path such as hash/123.pdf",
+                        "name": "filepath",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "type": "file"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/question": {
-            "put": {
+        "/answer/api/v1/conversation/{id}/message": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update question",
+                "description": "Send message to the conversation",
                 "consumes": [
                     "application/json"
                 ],
@@ -4664,17 +4944,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Conversation"
                 ],
-                "summary": "update question",
+                "summary": "Send message",
                 "parameters": [
                     {
-                        "description": "question",
+                        "type": "string",
+                        "description": "conversation_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SendMessage",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.QuestionUpdate"
+                            "$ref": "#/definitions/schema.SendMessageReq"
                         }
                     }
                 ],
@@ -4682,18 +4969,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MessageResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/answer/api/v1/conversation/{id}/messages": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add question",
+                "description": "Get messages of the conversation with the read receipts, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -4701,36 +5002,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Conversation"
                 ],
-                "summary": "add question",
+                "summary": "Get messages of conversation",
                 "parameters": [
                     {
-                        "description": "question",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.QuestionAdd"
-                        }
+                        "type": "string",
+                        "description": "conversation_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.MessageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/answer/api/v1/conversation/{id}/read": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "delete question",
+                "description": "Mark all messages of the conversation as read by login user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4738,18 +5078,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Conversation"
                 ],
-                "summary": "delete question",
+                "summary": "Mark conversation as read",
                 "parameters": [
                     {
-                        "description": "question",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.RemoveQuestionReq"
-                        }
+                        "type": "string",
+                        "description": "conversation_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -4762,14 +5100,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/question/answer": {
-            "post": {
+        "/answer/api/v1/conversations": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add question and answer",
+                "description": "Get conversations of login user with the last message and the unread count",
                 "consumes": [
                     "application/json"
                 ],
@@ -4777,66 +5115,104 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Conversation"
                 ],
-                "summary": "add question and answer",
+                "summary": "Get conversations of login user",
                 "parameters": [
                     {
-                        "description": "question",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.QuestionAddByAnswer"
-                        }
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.ConversationResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/question/info": {
-            "get": {
-                "description": "get question details",
+        "/answer/api/v1/email/reply": {
+            "post": {
+                "description": "post the raw inbound email forwarded by the mail server as an answer or a comment",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "EmailReply"
                 ],
-                "summary": "get question details",
+                "summary": "ingest the reply to the notification email",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "1",
-                        "description": "Question TagID",
-                        "name": "id",
-                        "in": "query",
+                        "description": "the ingest token in the config",
+                        "name": "X-Answer-Ingest-Token",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "the raw email in the RFC 5322 format",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/question/invite": {
+        "/answer/api/v1/embed/config": {
             "get": {
-                "description": "get question invite user info",
+                "description": "get embed plugin config",
                 "consumes": [
                     "application/json"
                 ],
@@ -4844,112 +5220,123 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
-                ],
-                "summary": "get question invite user info",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "1",
-                        "description": "Question ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Plugin"
                 ],
+                "summary": "get embed plugin config",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "update question invite user",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/plugin.EmbedConfig"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/file": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "upload file",
+                "consumes": [
+                    "multipart/form-data"
                 ],
                 "tags": [
-                    "Question"
+                    "Upload"
                 ],
-                "summary": "update question invite user",
+                "summary": "upload file",
                 "parameters": [
                     {
-                        "description": "question",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.QuestionUpdateInviteUser"
-                        }
+                        "enum": [
+                            "post",
+                            "post_attachment",
+                            "avatar",
+                            "branding",
+                            "message_attachment",
+                            "verification_evidence",
+                            "portfolio_file"
+                        ],
+                        "type": "string",
+                        "description": "identify the source of the file upload",
+                        "name": "source",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/question/link": {
-            "get": {
-                "description": "get question link",
+        "/answer/api/v1/follow": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "follow object or cancel follow operation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Question"
+                    "Activity"
                 ],
-                "summary": "get question link",
+                "summary": "follow object or cancel follow operation",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "in_days",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "newest",
-                            "active",
-                            "hot",
-                            "score",
-                            "unanswered",
-                            "recommend",
-                            "frequent"
-                        ],
-                        "type": "string",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "question_id",
-                        "in": "query",
-                        "required": true
+                        "description": "follow",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.FollowReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -4964,22 +5351,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.QuestionPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.FollowResp"
                                         }
                                     }
                                 }
@@ -4989,14 +5361,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/question/operation": {
+        "/answer/api/v1/follow/tags": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Operation question \\n operation [pin unpin hide show]",
+                "description": "update user follow tags",
                 "consumes": [
                     "application/json"
                 ],
@@ -5004,17 +5376,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Activity"
                 ],
-                "summary": "Operation question",
+                "summary": "update user follow tags",
                 "parameters": [
                     {
-                        "description": "question",
+                        "description": "follow",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.OperationQuestionReq"
+                            "$ref": "#/definitions/schema.UpdateFollowTagsReq"
                         }
                     }
                 ],
@@ -5028,9 +5400,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/question/page": {
-            "get": {
-                "description": "get questions by page",
+        "/answer/api/v1/freelancer/hire": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Send hiring message to freelancer",
                 "consumes": [
                     "application/json"
                 ],
@@ -5038,17 +5415,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Freelancer"
                 ],
-                "summary": "get questions by page",
+                "summary": "Hire freelancer",
                 "parameters": [
                     {
-                        "description": "QuestionPageReq",
+                        "description": "HireFreelancer",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.QuestionPageReq"
+                            "$ref": "#/definitions/schema.HireFreelancerReq"
                         }
                     }
                 ],
@@ -5064,22 +5441,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.QuestionPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.HireFreelancerResp"
                                         }
                                     }
                                 }
@@ -5089,9 +5451,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/question/recommend/page": {
+        "/answer/api/v1/freelancer/portfolio": {
             "get": {
-                "description": "get recommend questions by page",
+                "description": "Get the portfolio items of the freelancer",
                 "consumes": [
                     "application/json"
                 ],
@@ -5099,18 +5461,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Freelancer"
                 ],
-                "summary": "get recommend questions by page",
+                "summary": "Get portfolio items",
                 "parameters": [
                     {
-                        "description": "QuestionPageReq",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.QuestionPageReq"
-                        }
+                        "type": "string",
+                        "description": "user id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -5125,22 +5485,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.QuestionPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.PortfolioItemResp"
+                                            }
                                         }
                                     }
                                 }
@@ -5150,14 +5498,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/question/recover": {
+        "/answer/api/v1/freelancer/portfolio/item": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "recover deleted question",
+                "description": "Add the portfolio item with the uploaded images and files and the linked answers",
                 "consumes": [
                     "application/json"
                 ],
@@ -5165,17 +5513,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Freelancer"
                 ],
-                "summary": "recover deleted question",
+                "summary": "Add portfolio item",
                 "parameters": [
                     {
-                        "description": "question",
+                        "description": "AddPortfolioItemReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.QuestionRecoverReq"
+                            "$ref": "#/definitions/schema.AddPortfolioItemReq"
                         }
                     }
                 ],
@@ -5183,92 +5531,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/question/reopen": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "reopen question",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Question"
-                ],
-                "summary": "reopen question",
-                "parameters": [
-                    {
-                        "description": "question",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.ReopenQuestionReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.PortfolioItemResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/question/similar": {
-            "get": {
+        "/answer/api/v1/freelancer/portfolio/item/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "fuzzy query similar questions based on title",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Question"
-                ],
-                "summary": "fuzzy query similar questions based on title",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "string",
-                        "description": "title",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/question/similar/tag": {
-            "get": {
-                "description": "Search Similar Question",
+                "description": "Update the portfolio item of the login user",
                 "consumes": [
                     "application/json"
                 ],
@@ -5276,90 +5564,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Question"
+                    "Freelancer"
                 ],
-                "summary": "Search Similar Question",
+                "summary": "Update portfolio item",
                 "parameters": [
                     {
                         "type": "string",
-                        "default": "",
-                        "description": "question_id",
-                        "name": "question_id",
-                        "in": "query",
+                        "description": "portfolio item id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/question/status": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Close question",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Question"
-                ],
-                "summary": "Close question",
-                "parameters": [
+                    },
                     {
-                        "description": "question",
+                        "description": "AddPortfolioItemReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.CloseQuestionReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "$ref": "#/definitions/schema.AddPortfolioItemReq"
                         }
                     }
-                }
-            }
-        },
-        "/answer/api/v1/question/tags": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get tag list",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Tag"
-                ],
-                "summary": "get tag list",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "tag",
-                        "name": "tag",
-                        "in": "query"
-                    }
                 ],
                 "responses": {
                     "200": {
@@ -5373,10 +5597,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetTagBasicResp"
-                                            }
+                                            "$ref": "#/definitions/schema.PortfolioItemResp"
                                         }
                                     }
                                 }
@@ -5384,16 +5605,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/reasons": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get reasons by object type and action",
+                "description": "Remove the portfolio item of the login user",
                 "consumes": [
                     "application/json"
                 ],
@@ -5401,34 +5620,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "reason"
+                    "Freelancer"
                 ],
-                "summary": "get reasons by object type and action",
+                "summary": "Remove portfolio item",
                 "parameters": [
                     {
-                        "enum": [
-                            "question",
-                            "answer",
-                            "comment",
-                            "user"
-                        ],
-                        "type": "string",
-                        "description": "object_type",
-                        "name": "object_type",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "status",
-                            "close",
-                            "flag",
-                            "review"
-                        ],
                         "type": "string",
-                        "description": "action",
-                        "name": "action",
-                        "in": "query",
+                        "description": "portfolio item id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -5442,9 +5642,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/render/config": {
+        "/answer/api/v1/freelancer/profile": {
             "get": {
-                "description": "GetRenderConfig",
+                "description": "Get freelancer profile",
                 "consumes": [
                     "application/json"
                 ],
@@ -5452,9 +5652,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PluginRender"
+                    "Freelancer"
+                ],
+                "summary": "Get freelancer profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "user_id",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "GetRenderConfig",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5467,7 +5676,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/plugin.RenderConfig"
+                                            "$ref": "#/definitions/schema.FreelancerProfileResp"
                                         }
                                     }
                                 }
@@ -5475,16 +5684,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/report": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add report \u003cbr\u003e source (question, answer, comment, user)",
+                "description": "Update freelancer profile",
                 "consumes": [
                     "application/json"
                 ],
@@ -5492,17 +5699,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Report"
+                    "Freelancer"
                 ],
-                "summary": "add report",
+                "summary": "Update freelancer profile",
                 "parameters": [
                     {
-                        "description": "report",
+                        "description": "UpdateFreelancerProfile",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AddReportReq"
+                            "$ref": "#/definitions/schema.UpdateFreelancerProfileReq"
                         }
                     }
                 ],
@@ -5514,16 +5721,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/report/review": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "review report",
+                "description": "Create freelancer profile",
                 "consumes": [
                     "application/json"
                 ],
@@ -5531,17 +5736,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Report"
+                    "Freelancer"
                 ],
-                "summary": "review report",
+                "summary": "Create freelancer profile",
                 "parameters": [
                     {
-                        "description": "flag",
+                        "description": "CreateFreelancerProfile",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.ReviewReportReq"
+                            "$ref": "#/definitions/schema.CreateFreelancerProfileReq"
                         }
                     }
                 ],
@@ -5555,14 +5760,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/report/unreviewed/post": {
+        "/answer/api/v1/freelancer/profiles": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get unreviewed report post page",
+                "description": "Get freelancer profiles",
                 "consumes": [
                     "application/json"
                 ],
@@ -5570,15 +5770,126 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Report"
+                    "Freelancer"
                 ],
-                "summary": "get unreviewed report post page",
+                "summary": "Get freelancer profiles",
                 "parameters": [
                     {
                         "type": "integer",
                         "description": "page",
                         "name": "page",
                         "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "keywords",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated skills",
+                        "name": "skills",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "skill_match",
+                        "name": "skill_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "min_rate",
+                        "name": "min_rate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "max_rate",
+                        "name": "max_rate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "currency",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "entry",
+                            "intermediate",
+                            "senior",
+                            "expert"
+                        ],
+                        "type": "string",
+                        "description": "experience_level",
+                        "name": "experience_level",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "remote",
+                            "onsite",
+                            "hybrid"
+                        ],
+                        "type": "string",
+                        "description": "location_type",
+                        "name": "location_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "availability",
+                        "name": "availability",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "time zone window start, e.g. UTC-3",
+                        "name": "time_zone_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "time zone window end, e.g. UTC+2",
+                        "name": "time_zone_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "verified_only",
+                        "name": "verified_only",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "budget",
+                            "rating",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 code of min_rate, max_rate and the displayed rates",
+                        "name": "display_currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5593,22 +5904,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetReportListPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.GetFreelancerProfilesResp"
                                         }
                                     }
                                 }
@@ -5618,53 +5914,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/review/pending/post": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "update review",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Review"
-                ],
-                "summary": "update review",
-                "parameters": [
-                    {
-                        "description": "review",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateReviewReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/review/pending/post/page": {
+        "/answer/api/v1/freelancer/recommended-jobs": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get unreviewed post page",
+                "description": "Get the open job postings which match the login user best, ordered by match score",
                 "consumes": [
                     "application/json"
                 ],
@@ -5672,20 +5929,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Review"
+                    "Freelancer"
                 ],
-                "summary": "get unreviewed post page",
+                "summary": "Get recommended job postings",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "object_id",
-                        "name": "object_id",
+                        "description": "limit",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -5701,22 +5952,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetUnreviewedPostPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.RecommendedJobResp"
+                                            }
                                         }
                                     }
                                 }
@@ -5726,21 +5965,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/reviewing/type": {
+        "/answer/api/v1/freelancer/verification": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get reviewing type",
+                "description": "Get the latest verification request of the login user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Revision"
+                    "Freelancer"
                 ],
-                "summary": "get reviewing type",
+                "summary": "Get my freelancer verification",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -5753,10 +5995,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetReviewingTypeResp"
-                                            }
+                                            "$ref": "#/definitions/schema.FreelancerVerificationResp"
                                         }
                                     }
                                 }
@@ -5764,25 +6003,33 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/revisions": {
-            "get": {
-                "description": "get revision list",
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Request the identity verification with the evidence files uploaded with the verification_evidence source",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Revision"
+                    "Freelancer"
                 ],
-                "summary": "get revision list",
+                "summary": "Request freelancer verification",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "object id",
-                        "name": "object_id",
-                        "in": "query",
-                        "required": true
+                        "description": "RequestFreelancerVerificationReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RequestFreelancerVerificationReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -5797,10 +6044,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetRevisionResp"
-                                            }
+                                            "$ref": "#/definitions/schema.FreelancerVerificationResp"
                                         }
                                     }
                                 }
@@ -5810,29 +6054,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/revisions/audit": {
-            "put": {
+        "/answer/api/v1/job/application": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "revision audit operation:approve or reject",
+                "description": "Create job application",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Revision"
+                    "Job"
                 ],
-                "summary": "revision audit",
+                "summary": "Create job application",
                 "parameters": [
                     {
-                        "description": "audit",
+                        "description": "CreateJobApplication",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.RevisionAuditReq"
+                            "$ref": "#/definitions/schema.CreateJobApplicationReq"
                         }
                     }
                 ],
@@ -5846,14 +6093,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/revisions/edit/check": {
-            "get": {
+        "/answer/api/v1/job/application/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "check can update revision",
+                "description": "The poster accepts or rejects an application, the applicant withdraws it",
                 "consumes": [
                     "application/json"
                 ],
@@ -5861,17 +6108,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Revision"
+                    "Job"
                 ],
-                "summary": "check can update revision",
+                "summary": "Update job application status",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "string",
-                        "description": "id",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "UpdateJobApplicationStatus",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateJobApplicationStatusReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -5884,28 +6132,60 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/revisions/unreviewed": {
+        "/answer/api/v1/job/applications": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get unreviewed revision list",
+                "description": "Get applications of the job posted by login user, or the applications submitted by login user if job_id is empty",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Revision"
+                    "Job"
                 ],
-                "summary": "get unreviewed revision list",
+                "summary": "Get job applications",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page id",
+                        "description": "job_id",
+                        "name": "job_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "accepted",
+                            "rejected",
+                            "withdrawn"
+                        ],
+                        "type": "string",
+                        "description": "status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
                         "name": "page",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 code of the displayed rates",
+                        "name": "display_currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -5920,22 +6200,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetUnreviewedRevisionResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.GetJobApplicationsResp"
                                         }
                                     }
                                 }
@@ -5945,75 +6210,67 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/search": {
-            "get": {
+        "/answer/api/v1/job/posting": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "search object",
+                "description": "Create job posting",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "Job"
                 ],
-                "summary": "search object",
+                "summary": "Create job posting",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "query string",
-                        "name": "q",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "newest",
-                            "active",
-                            "score",
-                            "relevance"
-                        ],
-                        "type": "string",
-                        "description": "order",
-                        "name": "order",
-                        "in": "query",
-                        "required": true
+                        "description": "CreateJobPosting",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreateJobPostingReq"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.SearchResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/search/desc": {
+        "/answer/api/v1/job/posting/{id}": {
             "get": {
-                "description": "get search description",
+                "description": "Get job posting by ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "Job"
+                ],
+                "summary": "Get job posting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "get search description",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -6026,7 +6283,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SearchResp"
+                                            "$ref": "#/definitions/schema.JobPostingResp"
                                         }
                                     }
                                 }
@@ -6034,60 +6291,111 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/siteinfo": {
-            "get": {
-                "description": "get site info",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update job posting, only the poster or the user who has edit permission can do it",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "site"
+                    "Job"
+                ],
+                "summary": "Update job posting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateJobPosting",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateJobPostingReq"
+                        }
+                    }
                 ],
-                "summary": "get site info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.SiteInfoResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove job posting and all of its applications",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Remove job posting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/siteinfo/legal": {
+        "/answer/api/v1/job/posting/{id}/analytics": {
             "get": {
-                "description": "get site legal info",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the views, applications and hiring funnel of the job posting, only for the poster and admin",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "site"
+                    "Job"
                 ],
-                "summary": "get site legal info",
+                "summary": "Get job posting analytics",
                 "parameters": [
                     {
-                        "enum": [
-                            "tos",
-                            "privacy"
-                        ],
                         "type": "string",
-                        "description": "legal information type",
-                        "name": "info_type",
-                        "in": "query",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -6103,7 +6411,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetSiteLegalInfoResp"
+                                            "$ref": "#/definitions/schema.JobPostingAnalyticsResp"
                                         }
                                     }
                                 }
@@ -6113,9 +6421,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/tag": {
+        "/answer/api/v1/job/posting/{id}/recommended-freelancers": {
             "get": {
-                "description": "get tag one",
+                "description": "Get the available freelancers which match the job posting best, ordered by match score",
                 "consumes": [
                     "application/json"
                 ],
@@ -6123,23 +6431,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "get tag one",
+                "summary": "Get recommended freelancers",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "tag id",
-                        "name": "tag_id",
-                        "in": "query",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "tag name",
-                        "name": "tag_name",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -6154,7 +6461,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetTagResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.RecommendedFreelancerResp"
+                                            }
                                         }
                                     }
                                 }
@@ -6162,14 +6472,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/answer/api/v1/job/posting/{id}/renew": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update tag",
+                "description": "Extend the expiration time of the job posting, only the poster can do it",
                 "consumes": [
                     "application/json"
                 ],
@@ -6177,17 +6489,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "update tag",
+                "summary": "Renew job posting",
                 "parameters": [
                     {
-                        "description": "tag",
-                        "name": "data",
+                        "type": "string",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "RenewJobPosting",
+                        "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateTagReq"
+                            "$ref": "#/definitions/schema.RenewJobPostingReq"
                         }
                     }
                 ],
@@ -6199,14 +6518,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/answer/api/v1/job/posting/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add tag",
+                "description": "Close, fill or reopen the job posting",
                 "consumes": [
                     "application/json"
                 ],
@@ -6214,17 +6535,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "add tag",
+                "summary": "Update job posting status",
                 "parameters": [
                     {
-                        "description": "tag",
+                        "type": "string",
+                        "description": "job_id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateJobPostingStatus",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AddTagReq"
+                            "$ref": "#/definitions/schema.UpdateJobPostingStatusReq"
                         }
                     }
                 ],
@@ -6236,14 +6564,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "delete tag",
+            }
+        },
+        "/answer/api/v1/job/postings": {
+            "get": {
+                "description": "Get job postings",
                 "consumes": [
                     "application/json"
                 ],
@@ -6251,38 +6576,158 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "delete tag",
+                "summary": "Get job postings",
                 "parameters": [
                     {
-                        "description": "tag",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.RemoveTagReq"
-                        }
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "keywords",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated skills",
+                        "name": "skills",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "skill_match",
+                        "name": "skill_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "location",
+                        "name": "location",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "min_budget",
+                        "name": "min_budget",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "max_budget",
+                        "name": "max_budget",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "currency",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "entry",
+                            "intermediate",
+                            "senior",
+                            "expert"
+                        ],
+                        "type": "string",
+                        "description": "experience_level",
+                        "name": "experience_level",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "remote",
+                            "onsite",
+                            "hybrid"
+                        ],
+                        "type": "string",
+                        "description": "location_type",
+                        "name": "location_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "verified_only",
+                        "name": "verified_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "posted in the last days",
+                        "name": "posted_within",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "budget",
+                            "rating",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "description": "sort",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 code of min_budget, max_budget and the displayed budgets",
+                        "name": "display_currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetJobPostingsResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/tag/merge": {
+        "/answer/api/v1/job/saved-search": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "merge tag",
+                "description": "Save the job postings filter to be alerted of the new matching postings immediately or by a daily digest",
                 "consumes": [
                     "application/json"
                 ],
@@ -6290,17 +6735,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "merge tag",
+                "summary": "Save job search",
                 "parameters": [
                     {
-                        "description": "tag",
+                        "description": "SaveJobSearchReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.AddTagReq"
+                            "$ref": "#/definitions/schema.SaveJobSearchReq"
                         }
                     }
                 ],
@@ -6308,20 +6753,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SavedJobSearchResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/tag/recover": {
-            "post": {
+        "/answer/api/v1/job/saved-search/{id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "recover delete tag",
+                "description": "Update the filter and the alert frequency of the saved job search",
                 "consumes": [
                     "application/json"
                 ],
@@ -6329,17 +6786,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "recover delete tag",
+                "summary": "Update saved job search",
                 "parameters": [
                     {
-                        "description": "tag",
+                        "type": "string",
+                        "description": "saved search id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SaveJobSearchReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.RecoverTagReq"
+                            "$ref": "#/definitions/schema.SaveJobSearchReq"
                         }
                     }
                 ],
@@ -6347,20 +6811,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SavedJobSearchResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/tag/synonym": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update tag",
+                "description": "Remove the saved job search and stop its alerts",
                 "consumes": [
                     "application/json"
                 ],
@@ -6368,18 +6842,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Job"
                 ],
-                "summary": "update tag",
+                "summary": "Remove saved job search",
                 "parameters": [
                     {
-                        "description": "tag",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateTagSynonymReq"
-                        }
+                        "type": "string",
+                        "description": "saved search id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -6392,25 +6864,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/tag/synonyms": {
+        "/answer/api/v1/job/saved-searches": {
             "get": {
-                "description": "get tag synonyms",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get the saved job searches of the login user",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
-                ],
-                "summary": "get tag synonyms",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "tag id",
-                        "name": "tag_id",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Job"
                 ],
+                "summary": "Get saved job searches",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -6423,7 +6894,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetTagSynonymsResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.SavedJobSearchResp"
+                                            }
                                         }
                                     }
                                 }
@@ -6433,132 +6907,75 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/tags": {
+        "/answer/api/v1/language/config": {
             "get": {
-                "description": "get tags list by slug name",
+                "description": "get language config mapping",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Lang"
                 ],
-                "summary": "get tags list",
+                "summary": "get language config mapping",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "string collection",
-                        "name": "tags",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Accept-Language",
+                        "name": "Accept-Language",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetTagBasicResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/tags/following": {
+        "/answer/api/v1/language/options": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get following tag list",
+                "description": "Get language options",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Lang"
                 ],
-                "summary": "get following tag list",
+                "summary": "Get language options",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetFollowingTagsResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/tags/page": {
+        "/answer/api/v1/meta/reaction": {
             "get": {
-                "description": "get tag page",
+                "description": "get reaction for an object",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Tag"
+                    "Meta"
                 ],
-                "summary": "get tag page",
+                "summary": "get reaction",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page size",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "slug_name",
-                        "name": "slug_name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "popular",
-                            "name",
-                            "newest"
-                        ],
                         "type": "string",
-                        "description": "query condition",
-                        "name": "query_cond",
-                        "in": "query"
+                        "description": "object_id",
+                        "name": "object_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -6573,22 +6990,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "allOf": [
-                                                {
-                                                    "$ref": "#/definitions/pager.PageModel"
-                                                },
-                                                {
-                                                    "type": "object",
-                                                    "properties": {
-                                                        "list": {
-                                                            "type": "array",
-                                                            "items": {
-                                                                "$ref": "#/definitions/schema.GetTagPageResp"
-                                                            }
-                                                        }
-                                                    }
-                                                }
-                                            ]
+                                            "$ref": "#/definitions/schema.ReactionRespItem"
                                         }
                                     }
                                 }
@@ -6596,30 +6998,97 @@ const docTemplate = `{
                         }
                     }
                 }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update reaction. if not exist, add one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Meta"
+                ],
+                "summary": "add or update reaction",
+                "parameters": [
+                    {
+                        "description": "reaction",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateReactionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
             }
         },
-        "/answer/api/v1/user/action/record": {
+        "/answer/api/v1/notification/page": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "ActionRecord",
+                "description": "get notification list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "Notification"
                 ],
-                "summary": "ActionRecord",
+                "summary": "get notification list",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
                     {
                         "enum": [
-                            "login",
-                            "e_mail",
-                            "find_pass"
+                            "inbox",
+                            "achievement"
                         ],
                         "type": "string",
-                        "description": "action",
-                        "name": "action",
+                        "description": "type",
+                        "name": "type",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "all",
+                            "posts",
+                            "invites",
+                            "votes"
+                        ],
+                        "type": "string",
+                        "description": "inbox_type",
+                        "name": "inbox_type",
                         "in": "query",
                         "required": true
                     }
@@ -6628,32 +7097,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.ActionRecordResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/email": {
+        "/answer/api/v1/notification/read/state": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "user change email verification",
+                "description": "ClearUnRead",
                 "consumes": [
                     "application/json"
                 ],
@@ -6661,17 +7118,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Notification"
                 ],
-                "summary": "user change email verification",
+                "summary": "ClearUnRead",
                 "parameters": [
                     {
-                        "description": "UserChangeEmailVerifyReq",
+                        "description": "NotificationClearIDRequest",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserChangeEmailVerifyReq"
+                            "$ref": "#/definitions/schema.NotificationClearIDRequest"
                         }
                     }
                 ],
@@ -6685,14 +7142,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/email/change/code": {
-            "post": {
+        "/answer/api/v1/notification/read/state/all": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "send email to the user email then change their email",
+                "description": "ClearUnRead",
                 "consumes": [
                     "application/json"
                 ],
@@ -6700,17 +7157,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Notification"
                 ],
-                "summary": "send email to the user email then change their email",
+                "summary": "ClearUnRead",
                 "parameters": [
                     {
-                        "description": "UserChangeEmailSendCodeReq",
+                        "description": "NotificationClearRequest",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserChangeEmailSendCodeReq"
+                            "$ref": "#/definitions/schema.NotificationClearRequest"
                         }
                     }
                 ],
@@ -6724,9 +7181,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/email/verification": {
-            "post": {
-                "description": "UserVerifyEmail",
+        "/answer/api/v1/notification/status": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "GetRedDot",
                 "consumes": [
                     "application/json"
                 ],
@@ -6734,49 +7196,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
-                ],
-                "summary": "UserVerifyEmail",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "",
-                        "description": "code",
-                        "name": "code",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Notification"
                 ],
+                "summary": "GetRedDot",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.UserLoginResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/user/email/verification/send": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "UserVerifyEmailSend",
+                "description": "DelRedDot",
                 "consumes": [
                     "application/json"
                 ],
@@ -6784,53 +7222,101 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Notification"
                 ],
-                "summary": "UserVerifyEmailSend",
+                "summary": "DelRedDot",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "",
-                        "description": "captcha_id",
-                        "name": "captcha_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "",
-                        "description": "captcha_code",
-                        "name": "captcha_code",
-                        "in": "query"
+                        "description": "NotificationClearRequest",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.NotificationClearRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/info": {
+        "/answer/api/v1/permission": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "get user info, if user no login response http code is 200, but user info is null",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "check user permission",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Permission"
+                ],
+                "summary": "check user permission",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "access-token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "question.add",
+                            "question.edit",
+                            "question.edit_without_review",
+                            "question.delete",
+                            "question.close",
+                            "question.reopen",
+                            "question.vote_up",
+                            "question.vote_down",
+                            "question.pin",
+                            "question.unpin",
+                            "question.hide",
+                            "question.show",
+                            "answer.add",
+                            "answer.edit",
+                            "answer.edit_without_review",
+                            "answer.delete",
+                            "answer.accept",
+                            "answer.vote_up",
+                            "answer.vote_down",
+                            "answer.invite_someone_to_answer",
+                            "comment.add",
+                            "comment.edit",
+                            "comment.delete",
+                            "comment.vote_up",
+                            "comment.vote_down",
+                            "report.add",
+                            "tag.add",
+                            "tag.edit",
+                            "tag.edit_slug_name",
+                            "tag.edit_without_review",
+                            "tag.delete",
+                            "tag.synonym",
+                            "link.url_limit",
+                            "vote.detail",
+                            "answer.audit",
+                            "question.audit",
+                            "tag.audit",
+                            "tag.use_reserved_tag"
+                        ],
+                        "type": "string",
+                        "description": "permission key",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "GetUserInfoByUserID",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -6843,7 +7329,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetCurrentLoginUserInfoResp"
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "boolean"
+                                            }
                                         }
                                     }
                                 }
@@ -6851,14 +7340,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/answer/api/v1/personal/answer/page": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "UserUpdateInfo update user info",
+                "description": "list personal answers",
                 "consumes": [
                     "application/json"
                 ],
@@ -6866,25 +7357,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Personal"
                 ],
-                "summary": "UserUpdateInfo update user info",
+                "summary": "list personal answers",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "access-token",
-                        "name": "Authorization",
-                        "in": "header",
+                        "default": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "UpdateInfoRequest",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateInfoRequest"
-                        }
+                        "enum": [
+                            "newest",
+                            "score"
+                        ],
+                        "type": "string",
+                        "description": "order",
+                        "name": "order",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "0",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "20",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -6897,14 +7407,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/info/search": {
+        "/answer/api/v1/personal/collection/page": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "SearchUserListByName",
+                "description": "list personal collections",
                 "consumes": [
                     "application/json"
                 ],
@@ -6912,14 +7422,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Collection"
                 ],
-                "summary": "SearchUserListByName",
+                "summary": "list personal collections",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "username",
-                        "name": "username",
+                        "default": "0",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "20",
+                        "description": "page_size",
+                        "name": "page_size",
                         "in": "query",
                         "required": true
                     }
@@ -6928,73 +7447,82 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetOtherUserInfoResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/interface": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "UserUpdateInterface update user interface config",
-                "consumes": [
-                    "application/json"
-                ],
+        "/answer/api/v1/personal/comment/page": {
+            "get": {
+                "description": "user personal comment list",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Comment"
                 ],
-                "summary": "UserUpdateInterface update user interface config",
+                "summary": "user personal comment list",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "access-token",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "UpdateInfoRequest",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserInterfaceRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetCommentPersonalWithPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/login/email": {
-            "post": {
-                "description": "UserEmailLogin",
+        "/answer/api/v1/personal/qa/top": {
+            "get": {
+                "description": "UserTop",
                 "consumes": [
                     "application/json"
                 ],
@@ -7002,19 +7530,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "UserEmailLogin",
+                "summary": "UserTop",
                 "parameters": [
                     {
-                        "description": "UserEmailLogin",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "default": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/schema.UserEmailLoginReq"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
+                }
+            }
+        },
+        "/answer/api/v1/personal/rank/page": {
+            "get": {
+                "description": "user personal rank list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Rank"
+                ],
+                "summary": "user personal rank list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query"
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -7028,7 +7595,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.UserLoginResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetRankPersonalPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -7038,14 +7620,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/logout": {
+        "/answer/api/v1/personal/user/info": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "user logout",
+                "description": "GetOtherUserInfoByUsername",
                 "consumes": [
                     "application/json"
                 ],
@@ -7055,25 +7637,46 @@ const docTemplate = `{
                 "tags": [
                     "User"
                 ],
-                "summary": "user logout",
+                "summary": "GetOtherUserInfoByUsername",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetOtherUserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/notification/config": {
-            "put": {
+        "/answer/api/v1/personal/vote/page": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update user's notification config",
+                "description": "get user personal votes",
                 "consumes": [
                     "application/json"
                 ],
@@ -7081,36 +7684,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Activity"
                 ],
-                "summary": "update user's notification config",
+                "summary": "get user personal votes",
                 "parameters": [
                     {
-                        "description": "UpdateUserNotificationConfigReq",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserNotificationConfigReq"
-                        }
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetVoteWithPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get user's notification config",
+            }
+        },
+        "/answer/api/v1/plugin/status": {
+            "get": {
+                "description": "get all plugins status",
                 "consumes": [
                     "application/json"
                 ],
@@ -7118,9 +7748,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Plugin"
                 ],
-                "summary": "get user's notification config",
+                "summary": "get all plugins status",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7133,7 +7763,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GetUserNotificationConfigResp"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetPluginListResp"
+                                            }
                                         }
                                     }
                                 }
@@ -7143,9 +7776,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/notification/unsubscribe": {
-            "put": {
-                "description": "unsubscribe notification",
+        "/answer/api/v1/post/render": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "render post content",
                 "consumes": [
                     "application/json"
                 ],
@@ -7153,17 +7791,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Upload"
                 ],
-                "summary": "unsubscribe notification",
+                "summary": "render post content",
                 "parameters": [
                     {
-                        "description": "UserUnsubscribeNotificationReq",
+                        "description": "PostRenderReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserUnsubscribeNotificationReq"
+                            "$ref": "#/definitions/schema.PostRenderReq"
                         }
                     }
                 ],
@@ -7177,14 +7815,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/password": {
+        "/answer/api/v1/question": {
             "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "UserModifyPassWord",
+                "description": "update question",
                 "consumes": [
                     "application/json"
                 ],
@@ -7192,17 +7830,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "UserModifyPassWord",
+                "summary": "update question",
                 "parameters": [
                     {
-                        "description": "UserModifyPasswordReq",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserModifyPasswordReq"
+                            "$ref": "#/definitions/schema.QuestionUpdate"
                         }
                     }
                 ],
@@ -7214,11 +7852,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/user/password/replacement": {
+            },
             "post": {
-                "description": "UseRePassWord",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add question",
                 "consumes": [
                     "application/json"
                 ],
@@ -7226,17 +7867,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "UseRePassWord",
+                "summary": "add question",
                 "parameters": [
                     {
-                        "description": "UserRePassWordRequest",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserRePassWordRequest"
+                            "$ref": "#/definitions/schema.QuestionAdd"
                         }
                     }
                 ],
@@ -7244,15 +7885,18 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/user/password/reset": {
-            "post": {
-                "description": "RetrievePassWord",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete question",
                 "consumes": [
                     "application/json"
                 ],
@@ -7260,17 +7904,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "RetrievePassWord",
+                "summary": "delete question",
                 "parameters": [
                     {
-                        "description": "UserRetrievePassWordRequest",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserRetrievePassWordRequest"
+                            "$ref": "#/definitions/schema.RemoveQuestionReq"
                         }
                     }
                 ],
@@ -7278,64 +7922,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/plugin/config": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get user plugin config",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "UserPlugin"
-                ],
-                "summary": "get user plugin config",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "plugin_slug_name",
-                        "name": "plugin_slug_name",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetPluginConfigResp"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            },
-            "put": {
+        "/answer/api/v1/question/answer": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "update user plugin config",
+                "description": "add question and answer",
                 "consumes": [
                     "application/json"
                 ],
@@ -7343,17 +7943,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UserPlugin"
+                    "Question"
                 ],
-                "summary": "update user plugin config",
+                "summary": "add question and answer",
                 "parameters": [
                     {
-                        "description": "UpdatePluginConfigReq",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UpdateUserPluginConfigReq"
+                            "$ref": "#/definitions/schema.QuestionAddByAnswer"
                         }
                     }
                 ],
@@ -7367,14 +7967,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/user/plugin/configs": {
+        "/answer/api/v1/question/info": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "get plugin list that used for user.",
+                "description": "get question details",
                 "consumes": [
                     "application/json"
                 ],
@@ -7382,37 +7977,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "UserPlugin"
+                    "Question"
+                ],
+                "summary": "get question details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "1",
+                        "description": "Question TagID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "get plugin list that used for user.",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.GetUserPluginListResp"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/ranking": {
+        "/answer/api/v1/question/invite": {
             "get": {
-                "description": "get user ranking",
+                "description": "get question invite user info",
                 "consumes": [
                     "application/json"
                 ],
@@ -7420,34 +8010,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
+                ],
+                "summary": "get question invite user info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "1",
+                        "description": "Question ID",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "get user ranking",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.UserRankingResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     }
                 }
-            }
-        },
-        "/answer/api/v1/user/register/email": {
-            "post": {
-                "description": "UserRegisterByEmail",
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update question invite user",
                 "consumes": [
                     "application/json"
                 ],
@@ -7455,17 +8046,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "UserRegisterByEmail",
+                "summary": "update question invite user",
                 "parameters": [
                     {
-                        "description": "UserRegisterReq",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.UserRegisterReq"
+                            "$ref": "#/definitions/schema.QuestionUpdateInviteUser"
                         }
                     }
                 ],
@@ -7473,102 +8064,58 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.UserLoginResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/answer/api/v1/user/staff": {
+        "/answer/api/v1/question/link": {
             "get": {
-                "description": "get user staff",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "get question link",
                 "tags": [
-                    "User"
+                    "Question"
                 ],
-                "summary": "get user staff",
+                "summary": "get question link",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "username",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "in_days",
+                        "in": "query"
                     },
                     {
+                        "enum": [
+                            "newest",
+                            "active",
+                            "hot",
+                            "score",
+                            "unanswered",
+                            "recommend",
+                            "frequent"
+                        ],
                         "type": "string",
-                        "description": "page_size",
-                        "name": "page_size",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.GetUserStaffResp"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/answer/api/v1/vote/down": {
-            "post": {
-                "security": [
+                        "name": "order",
+                        "in": "query"
+                    },
                     {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "add vote",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Activity"
-                ],
-                "summary": "vote down",
-                "parameters": [
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
                     {
-                        "description": "vote",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/schema.VoteReq"
-                        }
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "question_id",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -7583,7 +8130,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.VoteResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.QuestionPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -7593,14 +8155,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/answer/api/v1/vote/up": {
-            "post": {
+        "/answer/api/v1/question/operation": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "add vote",
+                "description": "Operation question \\n operation [pin unpin hide show]",
                 "consumes": [
                     "application/json"
                 ],
@@ -7608,17 +8170,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Activity"
+                    "Question"
                 ],
-                "summary": "vote up",
+                "summary": "Operation question",
                 "parameters": [
                     {
-                        "description": "vote",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/schema.VoteReq"
+                            "$ref": "#/definitions/schema.OperationQuestionReq"
                         }
                     }
                 ],
@@ -7626,47 +8188,15 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/handler.RespBody"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.VoteResp"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/handler.RespBody"
                         }
                     }
                 }
             }
         },
-        "/custom.css": {
+        "/answer/api/v1/question/page": {
             "get": {
-                "description": "get site custom CSS",
-                "produces": [
-                    "text/css"
-                ],
-                "tags": [
-                    "site"
-                ],
-                "summary": "get site custom CSS",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/installation/base-info": {
-            "post": {
-                "description": "init base info",
+                "description": "get questions by page",
                 "consumes": [
                     "application/json"
                 ],
@@ -7674,43 +8204,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "installation"
+                    "Question"
                 ],
-                "summary": "init base info",
+                "summary": "get questions by page",
                 "parameters": [
                     {
-                        "description": "InitBaseInfoReq",
+                        "description": "QuestionPageReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/install.InitBaseInfoReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
+                            "$ref": "#/definitions/schema.QuestionPageReq"
                         }
                     }
-                }
-            }
-        },
-        "/installation/config-file/check": {
-            "post": {
-                "description": "check config file if exist when installation",
-                "consumes": [
-                    "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "installation"
-                ],
-                "summary": "check config file if exist when installation",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7723,7 +8230,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/install.CheckConfigFileResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.QuestionPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -7733,9 +8255,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/installation/db/check": {
-            "post": {
-                "description": "check database if exist when installation",
+        "/answer/api/v1/question/recommend/page": {
+            "get": {
+                "description": "get recommend questions by page",
                 "consumes": [
                     "application/json"
                 ],
@@ -7743,17 +8265,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "installation"
+                    "Question"
                 ],
-                "summary": "check database if exist when installation",
+                "summary": "get recommend questions by page",
                 "parameters": [
                     {
-                        "description": "CheckDatabaseReq",
+                        "description": "QuestionPageReq",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/install.CheckDatabaseReq"
+                            "$ref": "#/definitions/schema.QuestionPageReq"
                         }
                     }
                 ],
@@ -7769,7 +8291,22 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/install.CheckConfigFileResp"
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.QuestionPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
                                         }
                                     }
                                 }
@@ -7779,9 +8316,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/installation/init": {
+        "/answer/api/v1/question/recover": {
             "post": {
-                "description": "init environment",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "recover deleted question",
                 "consumes": [
                     "application/json"
                 ],
@@ -7789,17 +8331,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "installation"
+                    "Question"
                 ],
-                "summary": "init environment",
+                "summary": "recover deleted question",
                 "parameters": [
                     {
-                        "description": "CheckDatabaseReq",
+                        "description": "question",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/install.CheckDatabaseReq"
+                            "$ref": "#/definitions/schema.QuestionRecoverReq"
                         }
                     }
                 ],
@@ -7813,45 +8355,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/installation/language/config": {
+        "/answer/api/v1/question/related": {
             "get": {
-                "description": "get installation language config mapping",
+                "description": "get the questions related by shared tags, links between questions and common title terms",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Lang"
+                    "Question"
                 ],
-                "summary": "get installation language config mapping",
+                "summary": "get related questions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "installation language",
-                        "name": "lang",
+                        "description": "question_id",
+                        "name": "question_id",
                         "in": "query",
                         "required": true
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.RespBody"
-                        }
-                    }
-                }
-            }
-        },
-        "/installation/language/options": {
-            "get": {
-                "description": "get installation language options",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Lang"
-                ],
-                "summary": "get installation language options",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -7866,7 +8388,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/translator.LangOption"
+                                                "$ref": "#/definitions/schema.QuestionBaseInfo"
                                             }
                                         }
                                     }
@@ -7877,14 +8399,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/personal/question/page": {
-            "get": {
+        "/answer/api/v1/question/reopen": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "list personal questions",
+                "description": "reopen question",
                 "consumes": [
                     "application/json"
                 ],
@@ -7892,42 +8414,230 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Personal"
+                    "Question"
                 ],
-                "summary": "list personal questions",
+                "summary": "reopen question",
+                "parameters": [
+                    {
+                        "description": "question",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ReopenQuestionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/question/similar": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "fuzzy query similar questions based on title",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Question"
+                ],
+                "summary": "fuzzy query similar questions based on title",
                 "parameters": [
                     {
                         "type": "string",
                         "default": "string",
-                        "description": "username",
-                        "name": "username",
+                        "description": "title",
+                        "name": "title",
                         "in": "query",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/question/similar/tag": {
+            "get": {
+                "description": "Search Similar Question",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Question"
+                ],
+                "summary": "Search Similar Question",
+                "parameters": [
                     {
-                        "enum": [
-                            "newest",
-                            "score"
-                        ],
                         "type": "string",
-                        "description": "order",
-                        "name": "order",
+                        "default": "",
+                        "description": "question_id",
+                        "name": "question_id",
                         "in": "query",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/question/status": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Close question",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Question"
+                ],
+                "summary": "Close question",
+                "parameters": [
+                    {
+                        "description": "question",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CloseQuestionReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/question/tags": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get tag list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get tag list",
+                "parameters": [
                     {
                         "type": "string",
-                        "default": "0",
-                        "description": "page",
-                        "name": "page",
+                        "description": "tag",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetTagBasicResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/reasons": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get reasons by object type and action",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reason"
+                ],
+                "summary": "get reasons by object type and action",
+                "parameters": [
+                    {
+                        "enum": [
+                            "question",
+                            "answer",
+                            "comment",
+                            "user"
+                        ],
+                        "type": "string",
+                        "description": "object_type",
+                        "name": "object_type",
                         "in": "query",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "status",
+                            "close",
+                            "flag",
+                            "review"
+                        ],
                         "type": "string",
-                        "default": "20",
-                        "description": "page_size",
-                        "name": "page_size",
+                        "description": "action",
+                        "name": "action",
                         "in": "query",
                         "required": true
                     }
@@ -7942,415 +8652,3929 @@ const docTemplate = `{
                 }
             }
         },
-        "/robots.txt": {
+        "/answer/api/v1/render/config": {
             "get": {
-                "description": "get site robots information",
+                "description": "GetRenderConfig",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "site"
+                    "PluginRender"
                 ],
-                "summary": "get site robots information",
+                "summary": "GetRenderConfig",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/plugin.RenderConfig"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
+        },
+        "/answer/api/v1/report": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add report \u003cbr\u003e source (question, answer, comment, user)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "add report",
+                "parameters": [
+                    {
+                        "description": "report",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddReportReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/report/review": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "review report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "review report",
+                "parameters": [
+                    {
+                        "description": "flag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ReviewReportReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/report/unreviewed/post": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get unreviewed report post page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "get unreviewed report post page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetReportListPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/review/pending/post": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "update review",
+                "parameters": [
+                    {
+                        "description": "review",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateReviewReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/review/pending/post/page": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get unreviewed post page",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "get unreviewed post page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "object_id",
+                        "name": "object_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetUnreviewedPostPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/reviewing/type": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get reviewing type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Revision"
+                ],
+                "summary": "get reviewing type",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetReviewingTypeResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/revisions": {
+            "get": {
+                "description": "get revision list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Revision"
+                ],
+                "summary": "get revision list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "object id",
+                        "name": "object_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetRevisionResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/revisions/audit": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "revision audit operation:approve or reject",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Revision"
+                ],
+                "summary": "revision audit",
+                "parameters": [
+                    {
+                        "description": "audit",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RevisionAuditReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/revisions/edit/check": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "check can update revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Revision"
+                ],
+                "summary": "check can update revision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/revisions/unreviewed": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get unreviewed revision list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Revision"
+                ],
+                "summary": "get unreviewed revision list",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "page id",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetUnreviewedRevisionResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "search object",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "search object",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "query string",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "active",
+                            "score",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "description": "order",
+                        "name": "order",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SearchResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/search/desc": {
+            "get": {
+                "description": "get search description",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "get search description",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SearchResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/search/suggest": {
+            "get": {
+                "description": "get questions, tags and users matching the typed query, ranked by prefix match and popularity",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "search suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "query string",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "size",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.SearchSuggestResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/siteinfo": {
+            "get": {
+                "description": "get site info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "site"
+                ],
+                "summary": "get site info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/siteinfo/legal": {
+            "get": {
+                "description": "get site legal info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "site"
+                ],
+                "summary": "get site legal info",
+                "parameters": [
+                    {
+                        "enum": [
+                            "tos",
+                            "privacy"
+                        ],
+                        "type": "string",
+                        "description": "legal information type",
+                        "name": "info_type",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetSiteLegalInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag": {
+            "get": {
+                "description": "get tag one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get tag one",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tag id",
+                        "name": "tag_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "tag name",
+                        "name": "tag_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetTagResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "update tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "add tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "delete tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "delete tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RemoveTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag/merge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "merge tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "merge tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag/recover": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "recover delete tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "recover delete tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.RecoverTagReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag/skill": {
+            "get": {
+                "description": "Get the freelancers and the open job postings whose skills contain the tag or its synonyms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "Get freelancers and open jobs of the skill tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "tag slug name",
+                        "name": "tag_name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetSkillTagResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag/synonym": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "update tag",
+                "parameters": [
+                    {
+                        "description": "tag",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateTagSynonymReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tag/synonyms": {
+            "get": {
+                "description": "get tag synonyms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get tag synonyms",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "tag id",
+                        "name": "tag_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetTagSynonymsResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tags": {
+            "get": {
+                "description": "get tags list by slug name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get tags list",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "string collection",
+                        "name": "tags",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetTagBasicResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tags/following": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get following tag list",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get following tag list",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetFollowingTagsResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/tags/page": {
+            "get": {
+                "description": "get tag page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tag"
+                ],
+                "summary": "get tag page",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "slug_name",
+                        "name": "slug_name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "popular",
+                            "name",
+                            "newest"
+                        ],
+                        "type": "string",
+                        "description": "query condition",
+                        "name": "query_cond",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "allOf": [
+                                                {
+                                                    "$ref": "#/definitions/pager.PageModel"
+                                                },
+                                                {
+                                                    "type": "object",
+                                                    "properties": {
+                                                        "list": {
+                                                            "type": "array",
+                                                            "items": {
+                                                                "$ref": "#/definitions/schema.GetTagPageResp"
+                                                            }
+                                                        }
+                                                    }
+                                                }
+                                            ]
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/action/record": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "ActionRecord",
+                "tags": [
+                    "User"
+                ],
+                "summary": "ActionRecord",
+                "parameters": [
+                    {
+                        "enum": [
+                            "login",
+                            "e_mail",
+                            "find_pass"
+                        ],
+                        "type": "string",
+                        "description": "action",
+                        "name": "action",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ActionRecordResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/email": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "user change email verification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "user change email verification",
+                "parameters": [
+                    {
+                        "description": "UserChangeEmailVerifyReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserChangeEmailVerifyReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/email/change/code": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "send email to the user email then change their email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "send email to the user email then change their email",
+                "parameters": [
+                    {
+                        "description": "UserChangeEmailSendCodeReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserChangeEmailSendCodeReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/email/verification": {
+            "post": {
+                "description": "UserVerifyEmail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserVerifyEmail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserLoginResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/email/verification/send": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "UserVerifyEmailSend",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserVerifyEmailSend",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "captcha_id",
+                        "name": "captcha_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "",
+                        "description": "captcha_code",
+                        "name": "captcha_code",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/info": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get user info, if user no login response http code is 200, but user info is null",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "GetUserInfoByUserID",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetCurrentLoginUserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "UserUpdateInfo update user info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserUpdateInfo update user info",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "access-token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateInfoRequest",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateInfoRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/info/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "SearchUserListByName",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "SearchUserListByName",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetOtherUserInfoResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/interface": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "UserUpdateInterface update user interface config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserUpdateInterface update user interface config",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "access-token",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "UpdateInfoRequest",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateUserInterfaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/login/email": {
+            "post": {
+                "description": "UserEmailLogin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserEmailLogin",
+                "parameters": [
+                    {
+                        "description": "UserEmailLogin",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserEmailLoginReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserLoginResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/logout": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "user logout",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "user logout",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/notification/config": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update user's notification config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "update user's notification config",
+                "parameters": [
+                    {
+                        "description": "UpdateUserNotificationConfigReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateUserNotificationConfigReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get user's notification config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "get user's notification config",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetUserNotificationConfigResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/notification/unsubscribe": {
+            "put": {
+                "description": "unsubscribe notification",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "unsubscribe notification",
+                "parameters": [
+                    {
+                        "description": "UserUnsubscribeNotificationReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserUnsubscribeNotificationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/password": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "UserModifyPassWord",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserModifyPassWord",
+                "parameters": [
+                    {
+                        "description": "UserModifyPasswordReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserModifyPasswordReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/password/replacement": {
+            "post": {
+                "description": "UseRePassWord",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UseRePassWord",
+                "parameters": [
+                    {
+                        "description": "UserRePassWordRequest",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserRePassWordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/password/reset": {
+            "post": {
+                "description": "RetrievePassWord",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "RetrievePassWord",
+                "parameters": [
+                    {
+                        "description": "UserRetrievePassWordRequest",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserRetrievePassWordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/plugin/config": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get user plugin config",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UserPlugin"
+                ],
+                "summary": "get user plugin config",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "plugin_slug_name",
+                        "name": "plugin_slug_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetPluginConfigResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "update user plugin config",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UserPlugin"
+                ],
+                "summary": "update user plugin config",
+                "parameters": [
+                    {
+                        "description": "UpdatePluginConfigReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateUserPluginConfigReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/plugin/configs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "get plugin list that used for user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "UserPlugin"
+                ],
+                "summary": "get plugin list that used for user.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.GetUserPluginListResp"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/ranking": {
+            "get": {
+                "description": "get user ranking",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "get user ranking",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserRankingResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/register/email": {
+            "post": {
+                "description": "UserRegisterByEmail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "UserRegisterByEmail",
+                "parameters": [
+                    {
+                        "description": "UserRegisterReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UserRegisterReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserLoginResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/user/staff": {
+            "get": {
+                "description": "get user staff",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "get user staff",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GetUserStaffResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/vote/down": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add vote",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Activity"
+                ],
+                "summary": "vote down",
+                "parameters": [
+                    {
+                        "description": "vote",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.VoteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.VoteResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/answer/api/v1/vote/up": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "add vote",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Activity"
+                ],
+                "summary": "vote up",
+                "parameters": [
+                    {
+                        "description": "vote",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.VoteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.VoteResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/custom.css": {
+            "get": {
+                "description": "get site custom CSS",
+                "produces": [
+                    "text/css"
+                ],
+                "tags": [
+                    "site"
+                ],
+                "summary": "get site custom CSS",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/base-info": {
+            "post": {
+                "description": "init base info",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installation"
+                ],
+                "summary": "init base info",
+                "parameters": [
+                    {
+                        "description": "InitBaseInfoReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/install.InitBaseInfoReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/config-file/check": {
+            "post": {
+                "description": "check config file if exist when installation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installation"
+                ],
+                "summary": "check config file if exist when installation",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/install.CheckConfigFileResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/db/check": {
+            "post": {
+                "description": "check database if exist when installation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installation"
+                ],
+                "summary": "check database if exist when installation",
+                "parameters": [
+                    {
+                        "description": "CheckDatabaseReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/install.CheckDatabaseReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/install.CheckConfigFileResp"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/init": {
+            "post": {
+                "description": "init environment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "installation"
+                ],
+                "summary": "init environment",
+                "parameters": [
+                    {
+                        "description": "CheckDatabaseReq",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/install.CheckDatabaseReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/language/config": {
+            "get": {
+                "description": "get installation language config mapping",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lang"
+                ],
+                "summary": "get installation language config mapping",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "installation language",
+                        "name": "lang",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/installation/language/options": {
+            "get": {
+                "description": "get installation language options",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Lang"
+                ],
+                "summary": "get installation language options",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/handler.RespBody"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/translator.LangOption"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/personal/question/page": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "list personal questions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Personal"
+                ],
+                "summary": "list personal questions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "string",
+                        "description": "username",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "score"
+                        ],
+                        "type": "string",
+                        "description": "order",
+                        "name": "order",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "0",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "20",
+                        "description": "page_size",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.RespBody"
+                        }
+                    }
+                }
+            }
+        },
+        "/robots.txt": {
+            "get": {
+                "description": "get site robots information",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "site"
+                ],
+                "summary": "get site robots information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
         "constant.NotificationChannelKey": {
             "type": "string",
             "enum": [
                 "email"
             ],
-            "x-enum-varnames": [
-                "EmailChannel"
-            ]
+            "x-enum-varnames": [
+                "EmailChannel"
+            ]
+        },
+        "constant.NotificationFrequency": {
+            "type": "string",
+            "enum": [
+                "immediate",
+                "daily",
+                "weekly"
+            ],
+            "x-enum-varnames": [
+                "NotificationFrequencyImmediate",
+                "NotificationFrequencyDaily",
+                "NotificationFrequencyWeekly"
+            ]
+        },
+        "constant.Privilege": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "entity.BadgeLevel": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-varnames": [
+                "BadgeLevelBronze",
+                "BadgeLevelSilver",
+                "BadgeLevelGold"
+            ]
+        },
+        "handler.RespBody": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "http code",
+                    "type": "integer"
+                },
+                "data": {
+                    "description": "response data"
+                },
+                "msg": {
+                    "description": "response message",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "reason key",
+                    "type": "string"
+                }
+            }
+        },
+        "install.CheckConfigFileResp": {
+            "type": "object",
+            "properties": {
+                "config_file_exist": {
+                    "type": "boolean"
+                },
+                "db_connection_success": {
+                    "type": "boolean"
+                },
+                "db_table_exist": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "install.CheckDatabaseReq": {
+            "type": "object",
+            "required": [
+                "db_type"
+            ],
+            "properties": {
+                "db_file": {
+                    "type": "string"
+                },
+                "db_host": {
+                    "type": "string"
+                },
+                "db_name": {
+                    "type": "string"
+                },
+                "db_password": {
+                    "type": "string"
+                },
+                "db_type": {
+                    "type": "string",
+                    "enum": [
+                        "postgres",
+                        "sqlite3",
+                        "mysql"
+                    ]
+                },
+                "db_username": {
+                    "type": "string"
+                },
+                "ssl_cert": {
+                    "type": "string"
+                },
+                "ssl_enabled": {
+                    "type": "boolean"
+                },
+                "ssl_key": {
+                    "type": "string"
+                },
+                "ssl_mode": {
+                    "type": "string"
+                },
+                "ssl_root_cert": {
+                    "type": "string"
+                }
+            }
+        },
+        "install.InitBaseInfoReq": {
+            "type": "object",
+            "required": [
+                "contact_email",
+                "email",
+                "external_content_display",
+                "lang",
+                "name",
+                "password",
+                "site_name",
+                "site_url"
+            ],
+            "properties": {
+                "contact_email": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "external_content_display": {
+                    "type": "string",
+                    "enum": [
+                        "always_display",
+                        "ask_before_display"
+                    ]
+                },
+                "lang": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "login_required": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                },
+                "site_name": {
+                    "type": "string",
+                    "maxLength": 30
+                },
+                "site_url": {
+                    "type": "string",
+                    "maxLength": 512
+                }
+            }
+        },
+        "pager.PageModel": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "list": {}
+            }
+        },
+        "plugin.EmbedConfig": {
+            "type": "object",
+            "properties": {
+                "enable": {
+                    "type": "boolean"
+                },
+                "platform": {
+                    "type": "string"
+                }
+            }
+        },
+        "plugin.RenderConfig": {
+            "type": "object",
+            "properties": {
+                "select_theme": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AcceptAnswerReq": {
+            "type": "object",
+            "required": [
+                "question_id"
+            ],
+            "properties": {
+                "answer_id": {
+                    "type": "string"
+                },
+                "question_id": {
+                    "type": "string",
+                    "maxLength": 30
+                }
+            }
+        },
+        "schema.ActObjectInfo": {
+            "type": "object",
+            "properties": {
+                "answer_id": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "main_tag_slug_name": {
+                    "type": "string"
+                },
+                "object_type": {
+                    "type": "string"
+                },
+                "question_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ActObjectTimeline": {
+            "type": "object",
+            "properties": {
+                "activity_id": {
+                    "type": "string"
+                },
+                "activity_type": {
+                    "type": "string"
+                },
+                "cancelled": {
+                    "type": "boolean"
+                },
+                "cancelled_at": {
+                    "type": "integer"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "object_id": {
+                    "type": "string"
+                },
+                "object_type": {
+                    "type": "string"
+                },
+                "revision_id": {
+                    "type": "string"
+                },
+                "user_info": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                }
+            }
+        },
+        "schema.ActionRecordResp": {
+            "type": "object",
+            "properties": {
+                "captcha_id": {
+                    "type": "string"
+                },
+                "captcha_img": {
+                    "type": "string"
+                },
+                "verify": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.AddCommentReq": {
+            "type": "object",
+            "required": [
+                "object_id",
+                "original_text"
+            ],
+            "properties": {
+                "captcha_code": {
+                    "type": "string"
+                },
+                "captcha_id": {
+                    "type": "string"
+                },
+                "mention_username_list": {
+                    "description": "@ user id list",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "object_id": {
+                    "description": "object id",
+                    "type": "string"
+                },
+                "original_text": {
+                    "description": "original comment content",
+                    "type": "string",
+                    "maxLength": 600,
+                    "minLength": 2
+                },
+                "reply_comment_id": {
+                    "description": "reply comment id",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AddContractReviewReq": {
+            "type": "object",
+            "required": [
+                "content",
+                "rating"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "rating": {
+                    "description": "rating from 1 to 5",
+                    "type": "integer",
+                    "maximum": 5,
+                    "minimum": 1
+                }
+            }
+        },
+        "schema.AddPortfolioItemReq": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "answer_ids": {
+                    "description": "the ids of the own answers which have enough votes",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "description": "the description in markdown",
+                    "type": "string",
+                    "maxLength": 65535
+                },
+                "external_url": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "files": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "images": {
+                    "description": "the urls of the images and the files uploaded with the portfolio_file source",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 150
+                }
+            }
+        },
+        "schema.AddReportReq": {
+            "type": "object",
+            "required": [
+                "object_id",
+                "report_type"
+            ],
+            "properties": {
+                "captcha_code": {
+                    "type": "string"
+                },
+                "captcha_id": {
+                    "description": "captcha_id",
+                    "type": "string"
+                },
+                "content": {
+                    "description": "report content",
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "object_id": {
+                    "description": "object id",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "report_type": {
+                    "description": "report type",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.AddRoleReq": {
+            "type": "object",
+            "required": [
+                "name",
+                "power_types"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "power_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schema.AddTagReq": {
+            "type": "object",
+            "required": [
+                "display_name",
+                "original_text",
+                "slug_name"
+            ],
+            "properties": {
+                "display_name": {
+                    "description": "display_name",
+                    "type": "string",
+                    "maxLength": 35
+                },
+                "original_text": {
+                    "description": "original text",
+                    "type": "string",
+                    "maxLength": 65536
+                },
+                "slug_name": {
+                    "description": "slug_name",
+                    "type": "string",
+                    "maxLength": 35
+                }
+            }
+        },
+        "schema.AddUserReq": {
+            "type": "object",
+            "required": [
+                "display_name",
+                "email",
+                "password"
+            ],
+            "properties": {
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 2
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 32,
+                    "minLength": 8
+                }
+            }
+        },
+        "schema.AddUsersReq": {
+            "type": "object",
+            "properties": {
+                "users": {
+                    "description": "users info line by line",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AddWebhookReq": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "description": "the subscribed event types, empty means all the events",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "secret": {
+                    "description": "the secret is generated if it is empty",
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 512
+                }
+            }
+        },
+        "schema.AdminUpdateAnswerStatusReq": {
+            "type": "object",
+            "required": [
+                "answer_id",
+                "status"
+            ],
+            "properties": {
+                "answer_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "available",
+                        "deleted"
+                    ]
+                }
+            }
+        },
+        "schema.AdminUpdateQuestionStatusReq": {
+            "type": "object",
+            "required": [
+                "question_id",
+                "status"
+            ],
+            "properties": {
+                "question_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "available",
+                        "closed",
+                        "deleted"
+                    ]
+                }
+            }
+        },
+        "schema.AnswerAddReq": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "captcha_code": {
+                    "type": "string"
+                },
+                "captcha_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 65535,
+                    "minLength": 6
+                },
+                "question_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AnswerInfo": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "collected": {
+                    "type": "boolean"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "create_time": {
+                    "type": "integer"
+                },
+                "html": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_actions": {
+                    "description": "MemberActions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PermissionMemberAction"
+                    }
+                },
+                "question_id": {
+                    "type": "string"
+                },
+                "question_info": {
+                    "$ref": "#/definitions/schema.QuestionInfoResp"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "update_time": {
+                    "type": "integer"
+                },
+                "update_user_info": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                },
+                "user_info": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                },
+                "vote_count": {
+                    "type": "integer"
+                },
+                "vote_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AnswerUpdateReq": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "captcha_code": {
+                    "type": "string"
+                },
+                "captcha_id": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 65535,
+                    "minLength": 6
+                },
+                "edit_summary": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "question_id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
         },
-        "constant.Privilege": {
+        "schema.AvatarInfo": {
             "type": "object",
             "properties": {
-                "key": {
+                "custom": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "gravatar": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "type": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "schema.BadgeListInfo": {
+            "type": "object",
+            "properties": {
+                "award_count": {
+                    "description": "badge award count",
+                    "type": "integer"
+                },
+                "earned_count": {
+                    "description": "badge earned count",
+                    "type": "integer"
+                },
+                "icon": {
+                    "description": "badge icon",
                     "type": "string"
                 },
-                "label": {
+                "id": {
+                    "description": "badge id",
                     "type": "string"
                 },
-                "value": {
-                    "type": "integer",
-                    "minimum": 1
+                "level": {
+                    "description": "badge level",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BadgeLevel"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "badge name",
+                    "type": "string"
                 }
             }
         },
-        "entity.BadgeLevel": {
-            "type": "integer",
+        "schema.BadgeStatus": {
+            "type": "string",
             "enum": [
-                1,
-                2,
-                3
+                "active",
+                "inactive"
             ],
             "x-enum-varnames": [
-                "BadgeLevelBronze",
-                "BadgeLevelSilver",
-                "BadgeLevelGold"
+                "BadgeStatusActive",
+                "BadgeStatusInactive"
             ]
         },
-        "handler.RespBody": {
+        "schema.CloseQuestionReq": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "code": {
-                    "description": "http code",
+                "close_msg": {
+                    "description": "close_type",
+                    "type": "string"
+                },
+                "close_type": {
+                    "description": "close_type",
                     "type": "integer"
                 },
-                "data": {
-                    "description": "response data"
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CollectionSwitchReq": {
+            "type": "object",
+            "required": [
+                "group_id",
+                "object_id"
+            ],
+            "properties": {
+                "bookmark": {
+                    "type": "boolean"
                 },
-                "msg": {
-                    "description": "response message",
+                "group_id": {
                     "type": "string"
                 },
-                "reason": {
-                    "description": "reason key",
+                "object_id": {
                     "type": "string"
                 }
             }
         },
-        "install.CheckConfigFileResp": {
+        "schema.CollectionSwitchResp": {
             "type": "object",
             "properties": {
-                "config_file_exist": {
+                "object_collection_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ConfigField": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ConfigFieldOption"
+                    }
+                },
+                "required": {
                     "type": "boolean"
                 },
-                "db_connection_success": {
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "ui_options": {
+                    "$ref": "#/definitions/schema.ConfigFieldUIOptions"
+                },
+                "value": {}
+            }
+        },
+        "schema.ConfigFieldOption": {
+            "type": "object",
+            "properties": {
+                "label": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ConfigFieldUIOptions": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/schema.UIOptionAction"
+                },
+                "class_name": {
+                    "type": "string"
+                },
+                "field_class_name": {
+                    "type": "string"
+                },
+                "input_type": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "placeholder": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "variant": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ConnectorInfoResp": {
+            "type": "object",
+            "properties": {
+                "icon": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ConnectorUserInfoResp": {
+            "type": "object",
+            "properties": {
+                "binding": {
                     "type": "boolean"
                 },
-                "db_table_exist": {
-                    "type": "boolean"
+                "external_id": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "install.CheckDatabaseReq": {
+        "schema.ContractResp": {
             "type": "object",
-            "required": [
-                "db_type"
-            ],
             "properties": {
-                "db_file": {
+                "application_id": {
                     "type": "string"
                 },
-                "db_host": {
+                "client_id": {
                     "type": "string"
                 },
-                "db_name": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "currency": {
                     "type": "string"
                 },
-                "db_password": {
+                "description": {
                     "type": "string"
                 },
-                "db_type": {
-                    "type": "string",
-                    "enum": [
-                        "postgres",
-                        "sqlite3",
-                        "mysql"
-                    ]
+                "freelancer_id": {
+                    "type": "string"
                 },
-                "db_username": {
+                "id": {
                     "type": "string"
                 },
-                "ssl_cert": {
+                "job_id": {
                     "type": "string"
                 },
-                "ssl_enabled": {
-                    "type": "boolean"
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MilestoneResp"
+                    }
                 },
-                "ssl_key": {
+                "payment_provider": {
                     "type": "string"
                 },
-                "ssl_mode": {
+                "status": {
                     "type": "string"
                 },
-                "ssl_root_cert": {
+                "title": {
                     "type": "string"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "work_confirmed": {
+                    "type": "boolean"
                 }
             }
         },
-        "install.InitBaseInfoReq": {
+        "schema.ContractReviewResp": {
             "type": "object",
-            "required": [
-                "contact_email",
-                "email",
-                "external_content_display",
-                "lang",
-                "name",
-                "password",
-                "site_name",
-                "site_url"
-            ],
             "properties": {
-                "contact_email": {
-                    "type": "string",
-                    "maxLength": 500
+                "content": {
+                    "type": "string"
                 },
-                "email": {
-                    "type": "string",
-                    "maxLength": 500
+                "contract_id": {
+                    "type": "string"
                 },
-                "external_content_display": {
-                    "type": "string",
-                    "enum": [
-                        "always_display",
-                        "ask_before_display"
-                    ]
+                "created_at": {
+                    "type": "integer"
                 },
-                "lang": {
-                    "type": "string",
-                    "maxLength": 30
+                "html": {
+                    "type": "string"
                 },
-                "login_required": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2
+                "rating": {
+                    "type": "integer"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 32,
-                    "minLength": 8
+                "replied_at": {
+                    "type": "integer"
                 },
-                "site_name": {
-                    "type": "string",
-                    "maxLength": 30
+                "reply_content": {
+                    "type": "string"
                 },
-                "site_url": {
-                    "type": "string",
-                    "maxLength": 512
+                "reply_html": {
+                    "type": "string"
+                },
+                "reviewee_id": {
+                    "type": "string"
+                },
+                "reviewer": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                },
+                "reviewer_role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
                 }
             }
         },
-        "pager.PageModel": {
+        "schema.ConversationResp": {
             "type": "object",
             "properties": {
-                "count": {
+                "application_id": {
+                    "type": "string"
+                },
+                "contract_id": {
+                    "type": "string"
+                },
+                "created_at": {
                     "type": "integer"
                 },
-                "list": {}
+                "id": {
+                    "type": "string"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "last_message": {
+                    "$ref": "#/definitions/schema.MessageResp"
+                },
+                "last_message_at": {
+                    "type": "integer"
+                },
+                "participant": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                },
+                "role": {
+                    "description": "the role of login user in the conversation, client or freelancer",
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
             }
         },
-        "plugin.EmbedConfig": {
+        "schema.CreateContractReq": {
             "type": "object",
+            "required": [
+                "freelancer_user_id",
+                "title"
+            ],
             "properties": {
-                "enable": {
-                    "type": "boolean"
+                "application_id": {
+                    "type": "string"
                 },
-                "platform": {
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 65535
+                },
+                "freelancer_user_id": {
+                    "type": "string"
+                },
+                "job_id": {
                     "type": "string"
+                },
+                "milestones": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.CreateMilestoneReq"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "plugin.RenderConfig": {
+        "schema.CreateContractResp": {
             "type": "object",
             "properties": {
-                "select_theme": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "schema.AcceptAnswerReq": {
+        "schema.CreateFreelancerProfileReq": {
             "type": "object",
-            "required": [
-                "question_id"
-            ],
             "properties": {
-                "answer_id": {
+                "availability": {
                     "type": "string"
                 },
-                "question_id": {
+                "bio": {
+                    "type": "string"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "experience": {
+                    "type": "string"
+                },
+                "experience_level": {
                     "type": "string",
-                    "maxLength": 30
+                    "enum": [
+                        "entry",
+                        "intermediate",
+                        "senior",
+                        "expert"
+                    ]
+                },
+                "github_profile": {
+                    "type": "string"
+                },
+                "hourly_rate": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "is_available": {
+                    "type": "boolean"
+                },
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "linkedin_profile": {
+                    "type": "string"
+                },
+                "location_type": {
+                    "type": "string",
+                    "enum": [
+                        "remote",
+                        "onsite",
+                        "hybrid"
+                    ]
+                },
+                "preferred_projects": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "response_time": {
+                    "type": "string"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "time_zone": {
+                    "type": "string"
+                },
+                "website": {
+                    "type": "string"
                 }
             }
         },
-        "schema.ActObjectInfo": {
+        "schema.CreateJobApplicationReq": {
             "type": "object",
+            "required": [
+                "job_id"
+            ],
             "properties": {
-                "answer_id": {
-                    "type": "string"
-                },
-                "display_name": {
+                "captcha_code": {
                     "type": "string"
                 },
-                "main_tag_slug_name": {
+                "captcha_id": {
                     "type": "string"
                 },
-                "object_type": {
+                "cover_letter": {
                     "type": "string"
                 },
-                "question_id": {
+                "currency": {
                     "type": "string"
                 },
-                "title": {
+                "job_id": {
                     "type": "string"
                 },
-                "username": {
+                "message": {
                     "type": "string"
+                },
+                "proposed_rate": {
+                    "type": "number",
+                    "minimum": 0
                 }
             }
         },
-        "schema.ActObjectTimeline": {
+        "schema.CreateJobPostingReq": {
             "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
             "properties": {
-                "activity_id": {
-                    "type": "string"
+                "budget": {
+                    "type": "number",
+                    "minimum": 0
                 },
-                "activity_type": {
+                "budget_type": {
                     "type": "string"
                 },
-                "cancelled": {
-                    "type": "boolean"
-                },
-                "cancelled_at": {
-                    "type": "integer"
-                },
-                "comment": {
+                "captcha_code": {
                     "type": "string"
                 },
-                "created_at": {
-                    "type": "integer"
-                },
-                "object_id": {
+                "captcha_id": {
                     "type": "string"
                 },
-                "object_type": {
+                "contact_email": {
                     "type": "string"
                 },
-                "revision_id": {
+                "currency": {
                     "type": "string"
                 },
-                "user_info": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
-                }
-            }
-        },
-        "schema.ActionRecordResp": {
-            "type": "object",
-            "properties": {
-                "captcha_id": {
+                "description": {
                     "type": "string"
                 },
-                "captcha_img": {
+                "duration": {
                     "type": "string"
                 },
-                "verify": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "schema.AddCommentReq": {
-            "type": "object",
-            "required": [
-                "object_id",
-                "original_text"
-            ],
-            "properties": {
-                "captcha_code": {
+                "experience_level": {
                     "type": "string"
                 },
-                "captcha_id": {
+                "expires_at": {
+                    "type": "integer"
+                },
+                "location": {
                     "type": "string"
                 },
-                "mention_username_list": {
-                    "description": "@ user id list",
+                "skills": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "object_id": {
-                    "description": "object id",
-                    "type": "string"
-                },
-                "original_text": {
-                    "description": "original comment content",
-                    "type": "string",
-                    "maxLength": 600,
-                    "minLength": 2
-                },
-                "reply_comment_id": {
-                    "description": "reply comment id",
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "schema.AddReportReq": {
+        "schema.CreateMilestoneReq": {
             "type": "object",
             "required": [
-                "object_id",
-                "report_type"
+                "amount",
+                "title"
             ],
             "properties": {
-                "captcha_code": {
-                    "type": "string"
-                },
-                "captcha_id": {
-                    "description": "captcha_id",
-                    "type": "string"
+                "amount": {
+                    "type": "number"
                 },
-                "content": {
-                    "description": "report content",
+                "description": {
                     "type": "string",
-                    "maxLength": 500
+                    "maxLength": 65535
                 },
-                "object_id": {
-                    "description": "object id",
-                    "type": "string",
-                    "maxLength": 20
+                "due_at": {
+                    "description": "due time of the milestone, unix timestamp in seconds, optional",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "report_type": {
-                    "description": "report type",
-                    "type": "integer"
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "schema.AddTagReq": {
+        "schema.DeletePermanentlyReq": {
             "type": "object",
             "required": [
-                "display_name",
-                "original_text",
-                "slug_name"
+                "type"
             ],
             "properties": {
-                "display_name": {
-                    "description": "display_name",
-                    "type": "string",
-                    "maxLength": 35
-                },
-                "original_text": {
-                    "description": "original text",
-                    "type": "string",
-                    "maxLength": 65536
-                },
-                "slug_name": {
-                    "description": "slug_name",
+                "type": {
                     "type": "string",
-                    "maxLength": 35
+                    "enum": [
+                        "users",
+                        "questions",
+                        "answers"
+                    ]
                 }
             }
         },
-        "schema.AddUserReq": {
+        "schema.EditUserProfileReq": {
             "type": "object",
             "required": [
                 "display_name",
                 "email",
-                "password"
+                "user_id"
             ],
             "properties": {
                 "display_name": {
@@ -8362,192 +12586,258 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 500
                 },
-                "password": {
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string",
-                    "maxLength": 32,
-                    "minLength": 8
+                    "maxLength": 30,
+                    "minLength": 2
                 }
             }
         },
-        "schema.AddUsersReq": {
+        "schema.ExternalLoginBindingUserSendEmailReq": {
             "type": "object",
+            "required": [
+                "binding_key",
+                "email"
+            ],
             "properties": {
-                "users": {
-                    "description": "users info line by line",
+                "binding_key": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 512
+                },
+                "must": {
+                    "description": "If must is true, whatever email if exists, try to bind user.\nIf must is false, when email exist, will only be prompted with a warning.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.ExternalLoginBindingUserSendEmailResp": {
+            "type": "object",
+            "properties": {
+                "access_token": {
                     "type": "string"
+                },
+                "email_exist_and_must_be_confirmed": {
+                    "type": "boolean"
                 }
             }
         },
-        "schema.AdminUpdateAnswerStatusReq": {
+        "schema.ExternalLoginUnbindingReq": {
             "type": "object",
             "required": [
-                "answer_id",
-                "status"
+                "external_id"
             ],
             "properties": {
-                "answer_id": {
-                    "type": "string"
-                },
-                "status": {
+                "external_id": {
                     "type": "string",
-                    "enum": [
-                        "available",
-                        "deleted"
-                    ]
+                    "maxLength": 128
                 }
             }
         },
-        "schema.AdminUpdateQuestionStatusReq": {
+        "schema.FollowReq": {
             "type": "object",
             "required": [
-                "question_id",
-                "status"
+                "object_id"
             ],
             "properties": {
-                "question_id": {
+                "is_cancel": {
+                    "description": "is cancel",
+                    "type": "boolean"
+                },
+                "object_id": {
+                    "description": "object id",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.FollowResp": {
+            "type": "object",
+            "properties": {
+                "follows": {
+                    "description": "the followers of object",
+                    "type": "integer"
+                },
+                "is_followed": {
+                    "description": "if user is followed object will be true,otherwise false",
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.FreelancerProfileResp": {
+            "type": "object",
+            "properties": {
+                "availability": {
+                    "type": "string"
+                },
+                "base_hourly_rate": {
+                    "type": "number"
+                },
+                "bio": {
+                    "type": "string"
+                },
+                "bio_html": {
+                    "type": "string"
+                },
+                "client_satisfaction": {
+                    "type": "number"
+                },
+                "completed_projects": {
+                    "type": "integer"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "display_currency": {
+                    "type": "string"
+                },
+                "display_hourly_rate": {
+                    "type": "number"
+                },
+                "experience": {
                     "type": "string"
                 },
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "available",
-                        "closed",
-                        "deleted"
-                    ]
-                }
-            }
-        },
-        "schema.AnswerAddReq": {
-            "type": "object",
-            "required": [
-                "content"
-            ],
-            "properties": {
-                "captcha_code": {
+                "experience_level": {
                     "type": "string"
                 },
-                "captcha_id": {
+                "github_profile": {
                     "type": "string"
                 },
-                "content": {
-                    "type": "string",
-                    "maxLength": 65535,
-                    "minLength": 6
+                "hourly_rate": {
+                    "type": "number"
                 },
-                "question_id": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "schema.AnswerInfo": {
-            "type": "object",
-            "properties": {
-                "accepted": {
-                    "type": "integer"
                 },
-                "collected": {
+                "is_available": {
                     "type": "boolean"
                 },
-                "content": {
-                    "type": "string"
+                "is_verified": {
+                    "type": "boolean"
                 },
-                "create_time": {
-                    "type": "integer"
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "html": {
+                "linkedin_profile": {
                     "type": "string"
                 },
-                "id": {
+                "location_type": {
                     "type": "string"
                 },
-                "member_actions": {
-                    "description": "MemberActions",
+                "portfolio": {
+                    "description": "Portfolio the portfolio items, only returned with the single profile",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.PermissionMemberAction"
+                        "$ref": "#/definitions/schema.PortfolioItemResp"
                     }
                 },
-                "question_id": {
+                "preferred_projects": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "response_time": {
                     "type": "string"
                 },
-                "question_info": {
-                    "$ref": "#/definitions/schema.QuestionInfoResp"
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "status": {
-                    "type": "integer"
+                "time_zone": {
+                    "type": "string"
                 },
-                "update_time": {
+                "updated_at": {
                     "type": "integer"
                 },
-                "update_user_info": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
-                },
-                "user_info": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
+                "user_id": {
+                    "type": "string"
                 },
-                "vote_count": {
+                "verification_date": {
                     "type": "integer"
                 },
-                "vote_status": {
+                "website": {
                     "type": "string"
                 }
             }
         },
-        "schema.AnswerUpdateReq": {
+        "schema.FreelancerVerificationResp": {
             "type": "object",
-            "required": [
-                "content"
-            ],
             "properties": {
-                "captcha_code": {
-                    "type": "string"
+                "created_at": {
+                    "type": "integer"
                 },
-                "captcha_id": {
-                    "type": "string"
+                "evidence": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "content": {
-                    "type": "string",
-                    "maxLength": 65535,
-                    "minLength": 6
+                "expires_at": {
+                    "description": "the time the verification lapses, 0 if it does not lapse or is not approved",
+                    "type": "integer"
                 },
-                "edit_summary": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "note": {
                     "type": "string"
                 },
-                "question_id": {
+                "reason": {
+                    "$ref": "#/definitions/schema.ReasonItem"
+                },
+                "reason_msg": {
                     "type": "string"
                 },
-                "title": {
+                "reviewed_at": {
+                    "type": "integer"
+                },
+                "status": {
                     "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
                 }
             }
         },
-        "schema.AvatarInfo": {
+        "schema.GetAnswerInfoResp": {
             "type": "object",
             "properties": {
-                "custom": {
-                    "type": "string",
-                    "maxLength": 200
-                },
-                "gravatar": {
-                    "type": "string",
-                    "maxLength": 200
+                "info": {
+                    "$ref": "#/definitions/schema.AnswerInfo"
                 },
-                "type": {
-                    "type": "string",
-                    "maxLength": 100
+                "question": {
+                    "$ref": "#/definitions/schema.QuestionInfoResp"
                 }
             }
         },
-        "schema.BadgeListInfo": {
+        "schema.GetBadgeInfoResp": {
             "type": "object",
             "properties": {
                 "award_count": {
                     "description": "badge award count",
                     "type": "integer"
                 },
+                "description": {
+                    "description": "badge description",
+                    "type": "string"
+                },
                 "earned_count": {
                     "description": "badge earned count",
                     "type": "integer"
@@ -8560,6 +12850,10 @@ const docTemplate = `{
                     "description": "badge id",
                     "type": "string"
                 },
+                "is_single": {
+                    "description": "badge is single or multiple",
+                    "type": "boolean"
+                },
                 "level": {
                     "description": "badge level",
                     "allOf": [
@@ -8574,638 +12868,669 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.BadgeStatus": {
-            "type": "string",
-            "enum": [
-                "active",
-                "inactive"
-            ],
-            "x-enum-varnames": [
-                "BadgeStatusActive",
-                "BadgeStatusInactive"
-            ]
-        },
-        "schema.CloseQuestionReq": {
+        "schema.GetBadgeListPagedResp": {
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "close_msg": {
-                    "description": "close_type",
+                "award_count": {
+                    "description": "badge award count",
+                    "type": "integer"
+                },
+                "description": {
+                    "description": "badge description",
                     "type": "string"
                 },
-                "close_type": {
-                    "description": "close_type",
-                    "type": "integer"
+                "earned": {
+                    "description": "badge earned count",
+                    "type": "boolean"
+                },
+                "group_name": {
+                    "description": "badge group name",
+                    "type": "string"
+                },
+                "icon": {
+                    "description": "badge icon",
+                    "type": "string"
                 },
                 "id": {
+                    "description": "badge id",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "badge level",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BadgeLevel"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "badge name",
                     "type": "string"
+                },
+                "status": {
+                    "description": "badge status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.BadgeStatus"
+                        }
+                    ]
                 }
             }
         },
-        "schema.CollectionSwitchReq": {
+        "schema.GetBadgeListResp": {
             "type": "object",
-            "required": [
-                "group_id",
-                "object_id"
-            ],
             "properties": {
-                "bookmark": {
-                    "type": "boolean"
+                "badges": {
+                    "description": "badge list info",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.BadgeListInfo"
+                    }
+                },
+                "group_name": {
+                    "description": "badge group name",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.GetCommentPersonalWithPageResp": {
+            "type": "object",
+            "properties": {
+                "answer_id": {
+                    "description": "answer id",
+                    "type": "string"
+                },
+                "comment_id": {
+                    "description": "comment id",
+                    "type": "string"
+                },
+                "content": {
+                    "description": "content",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "create time",
+                    "type": "integer"
+                },
+                "object_id": {
+                    "description": "object id",
+                    "type": "string"
+                },
+                "object_type": {
+                    "description": "object type",
+                    "type": "string",
+                    "enum": [
+                        "question",
+                        "answer",
+                        "tag",
+                        "comment"
+                    ]
                 },
-                "group_id": {
+                "question_id": {
+                    "description": "question id",
                     "type": "string"
                 },
-                "object_id": {
+                "title": {
+                    "description": "title",
+                    "type": "string"
+                },
+                "url_title": {
+                    "description": "url title",
                     "type": "string"
                 }
             }
         },
-        "schema.CollectionSwitchResp": {
-            "type": "object",
-            "properties": {
-                "object_collection_count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "schema.ConfigField": {
+        "schema.GetCommentResp": {
             "type": "object",
             "properties": {
-                "description": {
+                "comment_id": {
+                    "description": "comment id",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "created_at": {
+                    "description": "create time",
+                    "type": "integer"
                 },
-                "options": {
+                "is_vote": {
+                    "description": "current user if already vote this comment",
+                    "type": "boolean"
+                },
+                "member_actions": {
+                    "description": "MemberActions",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.ConfigFieldOption"
+                        "$ref": "#/definitions/schema.PermissionMemberAction"
                     }
                 },
-                "required": {
-                    "type": "boolean"
-                },
-                "title": {
+                "object_id": {
+                    "description": "object id",
                     "type": "string"
                 },
-                "type": {
+                "original_text": {
+                    "description": "original comment content",
                     "type": "string"
                 },
-                "ui_options": {
-                    "$ref": "#/definitions/schema.ConfigFieldUIOptions"
+                "parsed_text": {
+                    "description": "parsed comment content",
+                    "type": "string"
                 },
-                "value": {}
-            }
-        },
-        "schema.ConfigFieldOption": {
-            "type": "object",
-            "properties": {
-                "label": {
+                "reply_comment_id": {
+                    "description": "reply comment id",
                     "type": "string"
                 },
-                "value": {
+                "reply_user_display_name": {
+                    "description": "reply user display name",
                     "type": "string"
-                }
-            }
-        },
-        "schema.ConfigFieldUIOptions": {
-            "type": "object",
-            "properties": {
-                "action": {
-                    "$ref": "#/definitions/schema.UIOptionAction"
                 },
-                "class_name": {
+                "reply_user_id": {
+                    "description": "reply user id",
                     "type": "string"
                 },
-                "field_class_name": {
+                "reply_user_status": {
+                    "description": "reply user status",
                     "type": "string"
                 },
-                "input_type": {
+                "reply_username": {
+                    "description": "reply user username",
                     "type": "string"
                 },
-                "label": {
+                "user_avatar": {
+                    "description": "user avatar",
                     "type": "string"
                 },
-                "placeholder": {
+                "user_display_name": {
+                    "description": "user display name",
                     "type": "string"
                 },
-                "rows": {
+                "user_id": {
+                    "description": "user id",
                     "type": "string"
                 },
-                "text": {
+                "user_status": {
+                    "description": "user status",
                     "type": "string"
                 },
-                "variant": {
+                "username": {
+                    "description": "username",
                     "type": "string"
+                },
+                "vote_count": {
+                    "description": "user vote amount",
+                    "type": "integer"
                 }
             }
         },
-        "schema.ConnectorInfoResp": {
+        "schema.GetContractReviewsResp": {
             "type": "object",
             "properties": {
-                "icon": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "link": {
-                    "type": "string"
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ContractReviewResp"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "rating": {
+                    "description": "average rating of all available reviews",
+                    "type": "number"
                 }
             }
         },
-        "schema.ConnectorUserInfoResp": {
+        "schema.GetCurrentLoginUserInfoResp": {
             "type": "object",
             "properties": {
-                "binding": {
-                    "type": "boolean"
-                },
-                "external_id": {
+                "access_token": {
+                    "description": "access token",
                     "type": "string"
                 },
-                "icon": {
-                    "type": "string"
+                "answer_count": {
+                    "description": "answer count",
+                    "type": "integer"
                 },
-                "link": {
-                    "type": "string"
+                "authority_group": {
+                    "description": "authority group",
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
-        "schema.CreateFreelancerProfileReq": {
-            "type": "object",
-            "properties": {
-                "availability": {
-                    "type": "string"
+                "avatar": {
+                    "$ref": "#/definitions/schema.AvatarInfo"
                 },
                 "bio": {
+                    "description": "bio markdown",
                     "type": "string"
                 },
-                "contact_email": {
+                "bio_html": {
+                    "description": "bio html",
                     "type": "string"
                 },
-                "currency": {
+                "color_scheme": {
+                    "description": "Color scheme",
                     "type": "string"
                 },
-                "experience": {
+                "created_at": {
+                    "description": "create time",
+                    "type": "integer"
+                },
+                "display_name": {
+                    "description": "display name",
                     "type": "string"
                 },
-                "github_profile": {
+                "e_mail": {
+                    "description": "email",
                     "type": "string"
                 },
-                "hourly_rate": {
-                    "type": "number"
+                "follow_count": {
+                    "description": "follow count",
+                    "type": "integer"
                 },
-                "is_available": {
+                "have_password": {
+                    "description": "user have password",
                     "type": "boolean"
                 },
-                "languages": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "linkedin_profile": {
+                "id": {
+                    "description": "user id",
                     "type": "string"
                 },
-                "portfolio": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "language": {
+                    "description": "language",
+                    "type": "string"
                 },
-                "preferred_projects": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "last_login_date": {
+                    "description": "last login date",
+                    "type": "integer"
                 },
-                "response_time": {
+                "location": {
+                    "description": "location",
                     "type": "string"
                 },
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "mail_status": {
+                    "description": "mail status(1 pass 2 to be verified)",
+                    "type": "integer"
                 },
-                "time_zone": {
+                "mobile": {
+                    "description": "mobile",
                     "type": "string"
                 },
-                "website": {
-                    "type": "string"
-                }
-            }
-        },
-        "schema.CreateJobApplicationReq": {
-            "type": "object",
-            "required": [
-                "job_id"
-            ],
-            "properties": {
-                "cover_letter": {
-                    "type": "string"
+                "notice_status": {
+                    "description": "notice status(1 on 2off)",
+                    "type": "integer"
                 },
-                "currency": {
+                "question_count": {
+                    "description": "question count",
+                    "type": "integer"
+                },
+                "rank": {
+                    "description": "rank",
+                    "type": "integer"
+                },
+                "role_id": {
+                    "description": "role id",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "user status",
                     "type": "string"
                 },
-                "job_id": {
+                "suspended_until": {
+                    "description": "suspended until timestamp",
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "username",
                     "type": "string"
                 },
-                "message": {
+                "visit_token": {
+                    "description": "visit token",
                     "type": "string"
                 },
-                "proposed_rate": {
-                    "type": "number"
+                "website": {
+                    "description": "website",
+                    "type": "string"
                 }
             }
         },
-        "schema.CreateJobPostingReq": {
+        "schema.GetFollowingTagsResp": {
             "type": "object",
-            "required": [
-                "description",
-                "title"
-            ],
             "properties": {
-                "budget": {
-                    "type": "number"
-                },
-                "budget_type": {
+                "display_name": {
+                    "description": "display name",
                     "type": "string"
                 },
-                "contact_email": {
+                "main_tag_slug_name": {
+                    "description": "if main tag slug name is not empty, this tag is synonymous with the main tag",
                     "type": "string"
                 },
-                "currency": {
-                    "type": "string"
+                "recommend": {
+                    "type": "boolean"
                 },
-                "description": {
-                    "type": "string"
+                "reserved": {
+                    "type": "boolean"
                 },
-                "duration": {
+                "slug_name": {
+                    "description": "slug name",
                     "type": "string"
                 },
-                "experience_level": {
+                "tag_id": {
+                    "description": "tag id",
                     "type": "string"
-                },
-                "expires_at": {
+                }
+            }
+        },
+        "schema.GetFreelancerProfilesResp": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
                 },
-                "location": {
-                    "type": "string"
-                },
-                "skills": {
+                "list": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/schema.FreelancerProfileResp"
                     }
-                },
-                "title": {
-                    "type": "string"
                 }
             }
         },
-        "schema.DeletePermanentlyReq": {
+        "schema.GetJobApplicationsResp": {
             "type": "object",
-            "required": [
-                "type"
-            ],
             "properties": {
-                "type": {
-                    "type": "string",
-                    "enum": [
-                        "users",
-                        "questions",
-                        "answers"
-                    ]
+                "count": {
+                    "type": "integer"
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobApplicationResp"
+                    }
                 }
             }
         },
-        "schema.EditUserProfileReq": {
+        "schema.GetJobPostingsReq": {
             "type": "object",
-            "required": [
-                "display_name",
-                "email",
-                "user_id"
-            ],
             "properties": {
-                "display_name": {
-                    "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2
+                "currency": {
+                    "type": "string"
                 },
-                "email": {
+                "display_currency": {
+                    "description": "the currency of min_budget and max_budget and of the displayed budgets, default the base currency of the site",
+                    "type": "string"
+                },
+                "experience_level": {
                     "type": "string",
-                    "maxLength": 500
+                    "enum": [
+                        "entry",
+                        "intermediate",
+                        "senior",
+                        "expert"
+                    ]
                 },
-                "user_id": {
+                "location": {
                     "type": "string"
                 },
-                "username": {
+                "location_type": {
                     "type": "string",
-                    "maxLength": 30,
-                    "minLength": 2
-                }
-            }
-        },
-        "schema.ExternalLoginBindingUserSendEmailReq": {
-            "type": "object",
-            "required": [
-                "binding_key",
-                "email"
-            ],
-            "properties": {
-                "binding_key": {
+                    "enum": [
+                        "remote",
+                        "onsite",
+                        "hybrid"
+                    ]
+                },
+                "max_budget": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "min_budget": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "page": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "page_size": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "posted_within": {
+                    "description": "only the postings created in the last days",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 1
+                },
+                "q": {
+                    "description": "keywords searched in the title and description",
                     "type": "string",
                     "maxLength": 100
                 },
-                "email": {
+                "skill_match": {
+                    "description": "the posting must require all or any of the skills, default any",
                     "type": "string",
-                    "maxLength": 512
+                    "enum": [
+                        "any",
+                        "all"
+                    ]
                 },
-                "must": {
-                    "description": "If must is true, whatever email if exists, try to bind user.\nIf must is false, when email exist, will only be prompted with a warning.",
-                    "type": "boolean"
-                }
-            }
-        },
-        "schema.ExternalLoginBindingUserSendEmailResp": {
-            "type": "object",
-            "properties": {
-                "access_token": {
+                "skills": {
+                    "description": "comma separated skill names",
                     "type": "string"
                 },
-                "email_exist_and_must_be_confirmed": {
+                "sort": {
+                    "description": "budget sorts by the budget from high to low, rating by the rating of the poster",
+                    "type": "string",
+                    "enum": [
+                        "newest",
+                        "budget",
+                        "rating",
+                        "relevance"
+                    ]
+                },
+                "status": {
+                    "type": "string"
+                },
+                "verified_only": {
+                    "description": "only the postings of verified freelancers and clients",
                     "type": "boolean"
                 }
             }
         },
-        "schema.ExternalLoginUnbindingReq": {
+        "schema.GetJobPostingsResp": {
             "type": "object",
-            "required": [
-                "external_id"
-            ],
             "properties": {
-                "external_id": {
-                    "type": "string",
-                    "maxLength": 128
+                "count": {
+                    "type": "integer"
+                },
+                "list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.JobPostingResp"
+                    }
                 }
             }
         },
-        "schema.FollowReq": {
+        "schema.GetObjectTimelineResp": {
             "type": "object",
-            "required": [
-                "object_id"
-            ],
             "properties": {
-                "is_cancel": {
-                    "description": "is cancel",
-                    "type": "boolean"
+                "object_info": {
+                    "$ref": "#/definitions/schema.ActObjectInfo"
                 },
-                "object_id": {
-                    "description": "object id",
-                    "type": "string"
+                "timeline": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ActObjectTimeline"
+                    }
                 }
             }
         },
-        "schema.FollowResp": {
+        "schema.GetOtherUserInfoByUsernameResp": {
             "type": "object",
             "properties": {
-                "follows": {
-                    "description": "the followers of object",
+                "answer_count": {
+                    "description": "answer count",
                     "type": "integer"
                 },
-                "is_followed": {
-                    "description": "if user is followed object will be true,otherwise false",
-                    "type": "boolean"
-                }
-            }
-        },
-        "schema.FreelancerProfileResp": {
-            "type": "object",
-            "properties": {
-                "availability": {
+                "avatar": {
+                    "description": "avatar",
                     "type": "string"
                 },
                 "bio": {
+                    "description": "bio markdown",
                     "type": "string"
                 },
                 "bio_html": {
-                    "type": "string"
-                },
-                "client_satisfaction": {
-                    "type": "number"
-                },
-                "completed_projects": {
-                    "type": "integer"
-                },
-                "contact_email": {
+                    "description": "bio html",
                     "type": "string"
                 },
                 "created_at": {
+                    "description": "create time",
                     "type": "integer"
                 },
-                "currency": {
-                    "type": "string"
-                },
-                "experience": {
-                    "type": "string"
-                },
-                "github_profile": {
+                "display_name": {
+                    "description": "display name",
                     "type": "string"
                 },
-                "hourly_rate": {
-                    "type": "number"
+                "follow_count": {
+                    "description": "email\nfollow count",
+                    "type": "integer"
                 },
                 "id": {
+                    "description": "user id",
                     "type": "string"
                 },
-                "is_available": {
-                    "type": "boolean"
-                },
-                "is_verified": {
-                    "type": "boolean"
+                "last_login_date": {
+                    "description": "last login date",
+                    "type": "integer"
                 },
-                "languages": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "location": {
+                    "description": "location",
+                    "type": "string"
                 },
-                "linkedin_profile": {
+                "mobile": {
+                    "description": "mobile",
                     "type": "string"
                 },
-                "portfolio": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "question_count": {
+                    "description": "question count",
+                    "type": "integer"
                 },
-                "preferred_projects": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "rank": {
+                    "description": "rank",
+                    "type": "integer"
                 },
-                "response_time": {
+                "status": {
                     "type": "string"
                 },
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "time_zone": {
+                "status_msg": {
                     "type": "string"
                 },
-                "updated_at": {
+                "suspended_until": {
+                    "description": "suspended until timestamp",
                     "type": "integer"
                 },
-                "user_id": {
+                "username": {
+                    "description": "username",
                     "type": "string"
                 },
-                "verification_date": {
-                    "type": "integer"
-                },
                 "website": {
+                    "description": "website",
                     "type": "string"
                 }
             }
         },
-        "schema.GetAnswerInfoResp": {
+        "schema.GetOtherUserInfoResp": {
             "type": "object",
             "properties": {
                 "info": {
-                    "$ref": "#/definitions/schema.AnswerInfo"
-                },
-                "question": {
-                    "$ref": "#/definitions/schema.QuestionInfoResp"
+                    "$ref": "#/definitions/schema.GetOtherUserInfoByUsernameResp"
                 }
             }
         },
-        "schema.GetBadgeInfoResp": {
+        "schema.GetPluginConfigResp": {
             "type": "object",
             "properties": {
-                "award_count": {
-                    "description": "badge award count",
-                    "type": "integer"
+                "config_fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ConfigField"
+                    }
                 },
                 "description": {
-                    "description": "badge description",
                     "type": "string"
                 },
-                "earned_count": {
-                    "description": "badge earned count",
-                    "type": "integer"
-                },
-                "icon": {
-                    "description": "badge icon",
+                "name": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "badge id",
+                "slug_name": {
                     "type": "string"
                 },
-                "is_single": {
-                    "description": "badge is single or multiple",
-                    "type": "boolean"
-                },
-                "level": {
-                    "description": "badge level",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.BadgeLevel"
-                        }
-                    ]
-                },
-                "name": {
-                    "description": "badge name",
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetBadgeListPagedResp": {
+        "schema.GetPluginListResp": {
             "type": "object",
             "properties": {
-                "award_count": {
-                    "description": "badge award count",
-                    "type": "integer"
-                },
                 "description": {
-                    "description": "badge description",
                     "type": "string"
                 },
-                "earned": {
-                    "description": "badge earned count",
+                "enabled": {
                     "type": "boolean"
                 },
-                "group_name": {
-                    "description": "badge group name",
+                "have_config": {
+                    "type": "boolean"
+                },
+                "link": {
                     "type": "string"
                 },
-                "icon": {
-                    "description": "badge icon",
+                "name": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "badge id",
+                "slug_name": {
                     "type": "string"
                 },
-                "level": {
-                    "description": "badge level",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.BadgeLevel"
-                        }
-                    ]
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.GetPowerResp": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
                 },
                 "name": {
-                    "description": "badge name",
                     "type": "string"
                 },
-                "status": {
-                    "description": "badge status",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/schema.BadgeStatus"
-                        }
-                    ]
+                "power_type": {
+                    "type": "string"
                 }
             }
         },
-        "schema.GetBadgeListResp": {
+        "schema.GetPrivilegesConfigResp": {
             "type": "object",
             "properties": {
-                "badges": {
-                    "description": "badge list info",
+                "options": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.BadgeListInfo"
+                        "$ref": "#/definitions/schema.PrivilegeOption"
                     }
                 },
-                "group_name": {
-                    "description": "badge group name",
-                    "type": "string"
+                "selected_level": {
+                    "$ref": "#/definitions/schema.PrivilegeLevel"
                 }
             }
         },
-        "schema.GetCommentPersonalWithPageResp": {
+        "schema.GetRankPersonalPageResp": {
             "type": "object",
             "properties": {
                 "answer_id": {
                     "description": "answer id",
                     "type": "string"
                 },
-                "comment_id": {
-                    "description": "comment id",
-                    "type": "string"
-                },
                 "content": {
                     "description": "content",
                     "type": "string"
@@ -9232,6 +13557,14 @@ const docTemplate = `{
                     "description": "question id",
                     "type": "string"
                 },
+                "rank_type": {
+                    "description": "rank type",
+                    "type": "string"
+                },
+                "reputation": {
+                    "description": "reputation",
+                    "type": "integer"
+                },
                 "title": {
                     "description": "title",
                     "type": "string"
@@ -9242,473 +13575,387 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.GetCommentResp": {
+        "schema.GetReportListPageResp": {
             "type": "object",
             "properties": {
-                "comment_id": {
-                    "description": "comment id",
-                    "type": "string"
-                },
-                "created_at": {
-                    "description": "create time",
-                    "type": "integer"
-                },
-                "is_vote": {
-                    "description": "current user if already vote this comment",
+                "answer_accepted": {
                     "type": "boolean"
                 },
-                "member_actions": {
-                    "description": "MemberActions",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.PermissionMemberAction"
-                    }
+                "answer_count": {
+                    "type": "integer"
                 },
-                "object_id": {
-                    "description": "object id",
+                "answer_id": {
                     "type": "string"
                 },
-                "original_text": {
-                    "description": "original comment content",
-                    "type": "string"
+                "author_user_info": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
                 },
-                "parsed_text": {
-                    "description": "parsed comment content",
+                "comment_id": {
                     "type": "string"
                 },
-                "reply_comment_id": {
-                    "description": "reply comment id",
-                    "type": "string"
+                "created_at": {
+                    "type": "integer"
                 },
-                "reply_user_display_name": {
-                    "description": "reply user display name",
+                "flag_id": {
                     "type": "string"
                 },
-                "reply_user_id": {
-                    "description": "reply user id",
+                "object_id": {
                     "type": "string"
                 },
-                "reply_user_status": {
-                    "description": "reply user status",
-                    "type": "string"
+                "object_show_status": {
+                    "type": "integer"
                 },
-                "reply_username": {
-                    "description": "reply user username",
-                    "type": "string"
+                "object_status": {
+                    "type": "integer"
                 },
-                "user_avatar": {
-                    "description": "user avatar",
-                    "type": "string"
+                "object_type": {
+                    "type": "string",
+                    "enum": [
+                        "question",
+                        "answer",
+                        "comment"
+                    ]
                 },
-                "user_display_name": {
-                    "description": "user display name",
+                "original_text": {
                     "type": "string"
                 },
-                "user_id": {
-                    "description": "user id",
+                "parsed_text": {
                     "type": "string"
                 },
-                "user_status": {
-                    "description": "user status",
+                "question_id": {
                     "type": "string"
                 },
-                "username": {
-                    "description": "username",
-                    "type": "string"
+                "reason": {
+                    "$ref": "#/definitions/schema.ReasonItem"
                 },
-                "vote_count": {
-                    "description": "user vote amount",
-                    "type": "integer"
-                }
-            }
-        },
-        "schema.GetCurrentLoginUserInfoResp": {
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "description": "access token",
+                "reason_content": {
                     "type": "string"
                 },
-                "answer_count": {
-                    "description": "answer count",
+                "submit_at": {
                     "type": "integer"
                 },
-                "authority_group": {
-                    "description": "authority group",
-                    "type": "integer"
+                "submitter_user": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
                 },
-                "avatar": {
-                    "$ref": "#/definitions/schema.AvatarInfo"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.TagResp"
+                    }
                 },
-                "bio": {
-                    "description": "bio markdown",
+                "title": {
                     "type": "string"
                 },
-                "bio_html": {
-                    "description": "bio html",
+                "url_title": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.GetReviewingTypeResp": {
+            "type": "object",
+            "properties": {
+                "label": {
                     "type": "string"
                 },
-                "color_scheme": {
-                    "description": "Color scheme",
+                "name": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "create time",
+                "todo_amount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.GetRevisionResp": {
+            "type": "object",
+            "properties": {
+                "content": {},
+                "create_at": {
                     "type": "integer"
                 },
-                "display_name": {
-                    "description": "display name",
+                "id": {
                     "type": "string"
                 },
-                "e_mail": {
-                    "description": "email",
+                "object_id": {
                     "type": "string"
                 },
-                "follow_count": {
-                    "description": "follow count",
-                    "type": "integer"
+                "reason": {
+                    "type": "string"
                 },
-                "have_password": {
-                    "description": "user have password",
-                    "type": "boolean"
+                "status": {
+                    "type": "integer"
                 },
-                "id": {
-                    "description": "user id",
+                "title": {
                     "type": "string"
                 },
-                "language": {
-                    "description": "language",
+                "url_title": {
                     "type": "string"
                 },
-                "last_login_date": {
-                    "description": "last login date",
-                    "type": "integer"
-                },
-                "location": {
-                    "description": "location",
+                "use_id": {
                     "type": "string"
                 },
-                "mail_status": {
-                    "description": "mail status(1 pass 2 to be verified)",
-                    "type": "integer"
+                "user_info": {
+                    "$ref": "#/definitions/schema.UserBasicInfo"
+                }
+            }
+        },
+        "schema.GetRoleResp": {
+            "type": "object",
+            "properties": {
+                "built_in": {
+                    "description": "the built-in roles can not be changed or removed",
+                    "type": "boolean"
                 },
-                "mobile": {
-                    "description": "mobile",
+                "description": {
                     "type": "string"
                 },
-                "notice_status": {
-                    "description": "notice status(1 on 2off)",
-                    "type": "integer"
-                },
-                "question_count": {
-                    "description": "question count",
+                "id": {
                     "type": "integer"
                 },
-                "rank": {
-                    "description": "rank",
-                    "type": "integer"
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.GetSMTPConfigResp": {
+            "type": "object",
+            "properties": {
+                "encryption": {
+                    "description": "\"\" SSL TLS",
+                    "type": "string"
                 },
-                "role_id": {
-                    "description": "role id",
-                    "type": "integer"
+                "from_email": {
+                    "type": "string"
                 },
-                "status": {
-                    "description": "user status",
+                "from_name": {
                     "type": "string"
                 },
-                "suspended_until": {
-                    "description": "suspended until timestamp",
-                    "type": "integer"
+                "smtp_authentication": {
+                    "type": "boolean"
                 },
-                "username": {
-                    "description": "username",
+                "smtp_host": {
                     "type": "string"
                 },
-                "visit_token": {
-                    "description": "visit token",
+                "smtp_password": {
                     "type": "string"
                 },
-                "website": {
-                    "description": "website",
+                "smtp_port": {
+                    "type": "integer"
+                },
+                "smtp_username": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetFollowingTagsResp": {
+        "schema.GetSiteLegalInfoResp": {
             "type": "object",
             "properties": {
-                "display_name": {
-                    "description": "display name",
+                "privacy_policy_original_text": {
                     "type": "string"
                 },
-                "main_tag_slug_name": {
-                    "description": "if main tag slug name is not empty, this tag is synonymous with the main tag",
+                "privacy_policy_parsed_text": {
                     "type": "string"
                 },
-                "recommend": {
-                    "type": "boolean"
-                },
-                "reserved": {
-                    "type": "boolean"
-                },
-                "slug_name": {
-                    "description": "slug name",
+                "terms_of_service_original_text": {
                     "type": "string"
                 },
-                "tag_id": {
-                    "description": "tag id",
+                "terms_of_service_parsed_text": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetFreelancerProfilesResp": {
+        "schema.GetSkillTagResp": {
             "type": "object",
             "properties": {
-                "count": {
+                "display_name": {
+                    "type": "string"
+                },
+                "freelancer_count": {
                     "type": "integer"
                 },
-                "list": {
+                "freelancers": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/schema.FreelancerProfileResp"
                     }
-                }
-            }
-        },
-        "schema.GetJobPostingsResp": {
-            "type": "object",
-            "properties": {
-                "count": {
+                },
+                "job_posting_count": {
                     "type": "integer"
                 },
-                "list": {
+                "job_postings": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/schema.JobPostingResp"
                     }
-                }
-            }
-        },
-        "schema.GetObjectTimelineResp": {
-            "type": "object",
-            "properties": {
-                "object_info": {
-                    "$ref": "#/definitions/schema.ActObjectInfo"
                 },
-                "timeline": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.ActObjectTimeline"
-                    }
+                "slug_name": {
+                    "type": "string"
+                },
+                "tag_id": {
+                    "type": "string"
                 }
             }
         },
-        "schema.GetOtherUserInfoByUsernameResp": {
+        "schema.GetTagBasicResp": {
             "type": "object",
             "properties": {
-                "answer_count": {
-                    "description": "answer count",
-                    "type": "integer"
-                },
-                "avatar": {
-                    "description": "avatar",
-                    "type": "string"
-                },
-                "bio": {
-                    "description": "bio markdown",
-                    "type": "string"
-                },
-                "bio_html": {
-                    "description": "bio html",
-                    "type": "string"
-                },
-                "created_at": {
-                    "description": "create time",
-                    "type": "integer"
-                },
                 "display_name": {
-                    "description": "display name",
                     "type": "string"
                 },
-                "follow_count": {
-                    "description": "email\nfollow count",
-                    "type": "integer"
-                },
-                "id": {
-                    "description": "user id",
-                    "type": "string"
+                "recommend": {
+                    "type": "boolean"
                 },
-                "last_login_date": {
-                    "description": "last login date",
-                    "type": "integer"
+                "reserved": {
+                    "type": "boolean"
                 },
-                "location": {
-                    "description": "location",
+                "slug_name": {
                     "type": "string"
                 },
-                "mobile": {
-                    "description": "mobile",
+                "tag_id": {
                     "type": "string"
-                },
-                "question_count": {
-                    "description": "question count",
-                    "type": "integer"
-                },
-                "rank": {
-                    "description": "rank",
+                }
+            }
+        },
+        "schema.GetTagPageResp": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "created time",
                     "type": "integer"
                 },
-                "status": {
+                "description": {
+                    "description": "description",
                     "type": "string"
                 },
-                "status_msg": {
+                "display_name": {
+                    "description": "display_name",
                     "type": "string"
                 },
-                "suspended_until": {
-                    "description": "suspended until timestamp",
-                    "type": "integer"
-                },
-                "username": {
-                    "description": "username",
+                "excerpt": {
+                    "description": "excerpt",
                     "type": "string"
                 },
-                "website": {
-                    "description": "website",
-                    "type": "string"
-                }
-            }
-        },
-        "schema.GetOtherUserInfoResp": {
-            "type": "object",
-            "properties": {
-                "info": {
-                    "$ref": "#/definitions/schema.GetOtherUserInfoByUsernameResp"
-                }
-            }
-        },
-        "schema.GetPluginConfigResp": {
-            "type": "object",
-            "properties": {
-                "config_fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.ConfigField"
-                    }
+                "follow_count": {
+                    "description": "follower amount",
+                    "type": "integer"
                 },
-                "description": {
+                "is_follower": {
+                    "description": "is follower",
+                    "type": "boolean"
+                },
+                "original_text": {
+                    "description": "original text",
                     "type": "string"
                 },
-                "name": {
+                "parsed_text": {
+                    "description": "parsed_text",
                     "type": "string"
                 },
+                "question_count": {
+                    "description": "question amount",
+                    "type": "integer"
+                },
+                "recommend": {
+                    "type": "boolean"
+                },
+                "reserved": {
+                    "type": "boolean"
+                },
                 "slug_name": {
+                    "description": "slug_name",
                     "type": "string"
                 },
-                "version": {
+                "tag_id": {
+                    "description": "tag_id",
                     "type": "string"
+                },
+                "updated_at": {
+                    "description": "updated time",
+                    "type": "integer"
                 }
             }
         },
-        "schema.GetPluginListResp": {
+        "schema.GetTagResp": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
                 "description": {
                     "type": "string"
                 },
-                "enabled": {
-                    "type": "boolean"
-                },
-                "have_config": {
-                    "type": "boolean"
-                },
-                "link": {
+                "display_name": {
                     "type": "string"
                 },
-                "name": {
+                "excerpt": {
                     "type": "string"
                 },
-                "slug_name": {
-                    "type": "string"
+                "follow_count": {
+                    "type": "integer"
                 },
-                "version": {
+                "is_follower": {
+                    "type": "boolean"
+                },
+                "main_tag_slug_name": {
+                    "description": "if main tag slug name is not empty, this tag is synonymous with the main tag",
                     "type": "string"
-                }
-            }
-        },
-        "schema.GetPrivilegesConfigResp": {
-            "type": "object",
-            "properties": {
-                "options": {
+                },
+                "member_actions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.PrivilegeOption"
+                        "$ref": "#/definitions/schema.PermissionMemberAction"
                     }
                 },
-                "selected_level": {
-                    "$ref": "#/definitions/schema.PrivilegeLevel"
-                }
-            }
-        },
-        "schema.GetRankPersonalPageResp": {
-            "type": "object",
-            "properties": {
-                "answer_id": {
-                    "description": "answer id",
+                "original_text": {
                     "type": "string"
                 },
-                "content": {
-                    "description": "content",
+                "parsed_text": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "create time",
+                "question_count": {
                     "type": "integer"
                 },
-                "object_id": {
-                    "description": "object id",
-                    "type": "string"
+                "recommend": {
+                    "type": "boolean"
                 },
-                "object_type": {
-                    "description": "object type",
-                    "type": "string",
-                    "enum": [
-                        "question",
-                        "answer",
-                        "tag",
-                        "comment"
-                    ]
+                "reserved": {
+                    "type": "boolean"
                 },
-                "question_id": {
-                    "description": "question id",
+                "slug_name": {
                     "type": "string"
                 },
-                "rank_type": {
-                    "description": "rank type",
+                "status": {
                     "type": "string"
                 },
-                "reputation": {
-                    "description": "reputation",
-                    "type": "integer"
-                },
-                "title": {
-                    "description": "title",
+                "tag_id": {
                     "type": "string"
                 },
-                "url_title": {
-                    "description": "url title",
-                    "type": "string"
+                "updated_at": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.GetReportListPageResp": {
+        "schema.GetTagSynonymsResp": {
             "type": "object",
             "properties": {
-                "answer_accepted": {
-                    "type": "boolean"
-                },
-                "answer_count": {
-                    "type": "integer"
+                "member_actions": {
+                    "description": "MemberActions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PermissionMemberAction"
+                    }
                 },
+                "synonyms": {
+                    "description": "synonyms",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.TagSynonym"
+                    }
+                }
+            }
+        },
+        "schema.GetUnreviewedPostPageResp": {
+            "type": "object",
+            "properties": {
                 "answer_id": {
                     "type": "string"
                 },
@@ -9721,9 +13968,6 @@ const docTemplate = `{
                 "created_at": {
                     "type": "integer"
                 },
-                "flag_id": {
-                    "type": "string"
-                },
                 "object_id": {
                     "type": "string"
                 },
@@ -9751,16 +13995,16 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "reason": {
-                    "$ref": "#/definitions/schema.ReasonItem"
-                },
-                "reason_content": {
                     "type": "string"
                 },
+                "review_id": {
+                    "type": "integer"
+                },
                 "submit_at": {
                     "type": "integer"
                 },
-                "submitter_user": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
+                "submitter_display_name": {
+                    "type": "string"
                 },
                 "tags": {
                     "type": "array",
@@ -9776,616 +14020,612 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.GetReviewingTypeResp": {
+        "schema.GetUnreviewedRevisionResp": {
             "type": "object",
             "properties": {
-                "label": {
-                    "type": "string"
+                "info": {
+                    "$ref": "#/definitions/schema.UnreviewedRevisionInfoInfo"
                 },
-                "name": {
+                "type": {
                     "type": "string"
                 },
-                "todo_amount": {
-                    "type": "integer"
+                "unreviewed_info": {
+                    "$ref": "#/definitions/schema.GetRevisionResp"
                 }
             }
         },
-        "schema.GetRevisionResp": {
+        "schema.GetUserActivationResp": {
             "type": "object",
             "properties": {
-                "content": {},
-                "create_at": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "object_id": {
-                    "type": "string"
-                },
-                "reason": {
+                "activation_url": {
                     "type": "string"
-                },
-                "status": {
+                }
+            }
+        },
+        "schema.GetUserBadgeAwardListResp": {
+            "type": "object",
+            "properties": {
+                "earned_count": {
+                    "description": "badge award count",
                     "type": "integer"
                 },
-                "title": {
+                "icon": {
+                    "description": "badge icon",
                     "type": "string"
                 },
-                "url_title": {
+                "id": {
+                    "description": "badge id",
                     "type": "string"
                 },
-                "use_id": {
-                    "type": "string"
+                "level": {
+                    "description": "badge level",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.BadgeLevel"
+                        }
+                    ]
                 },
-                "user_info": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
+                "name": {
+                    "description": "badge name",
+                    "type": "string"
                 }
             }
         },
-        "schema.GetRoleResp": {
+        "schema.GetUserNotificationConfigResp": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
+                "all_new_question": {
+                    "$ref": "#/definitions/schema.NotificationChannelConfig"
                 },
-                "id": {
-                    "type": "integer"
+                "all_new_question_for_following_tags": {
+                    "$ref": "#/definitions/schema.NotificationChannelConfig"
                 },
-                "name": {
-                    "type": "string"
+                "inbox": {
+                    "$ref": "#/definitions/schema.NotificationChannelConfig"
+                },
+                "saved_job_search": {
+                    "$ref": "#/definitions/schema.NotificationChannelConfig"
                 }
             }
         },
-        "schema.GetSMTPConfigResp": {
+        "schema.GetUserPageResp": {
             "type": "object",
             "properties": {
-                "encryption": {
-                    "description": "\"\" SSL TLS",
+                "avatar": {
+                    "description": "avatar",
                     "type": "string"
                 },
-                "from_email": {
+                "created_at": {
+                    "description": "create time",
+                    "type": "integer"
+                },
+                "deleted_at": {
+                    "description": "delete time",
+                    "type": "integer"
+                },
+                "display_name": {
+                    "description": "display name",
+                    "type": "string"
+                },
+                "e_mail": {
+                    "description": "email",
                     "type": "string"
                 },
-                "from_name": {
+                "rank": {
+                    "description": "rank",
+                    "type": "integer"
+                },
+                "role_id": {
+                    "description": "role id",
+                    "type": "integer"
+                },
+                "role_name": {
+                    "description": "role name",
                     "type": "string"
                 },
-                "smtp_authentication": {
-                    "type": "boolean"
-                },
-                "smtp_host": {
+                "status": {
+                    "description": "user status(normal,suspended,deleted,inactive)",
                     "type": "string"
                 },
-                "smtp_password": {
-                    "type": "string"
+                "suspended_at": {
+                    "description": "suspended time",
+                    "type": "integer"
                 },
-                "smtp_port": {
+                "suspended_until": {
+                    "description": "suspended until time",
                     "type": "integer"
                 },
-                "smtp_username": {
+                "user_id": {
+                    "description": "user id",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "username",
                     "type": "string"
                 }
             }
         },
-        "schema.GetSiteLegalInfoResp": {
+        "schema.GetUserPluginListResp": {
             "type": "object",
             "properties": {
-                "privacy_policy_original_text": {
-                    "type": "string"
-                },
-                "privacy_policy_parsed_text": {
-                    "type": "string"
-                },
-                "terms_of_service_original_text": {
+                "name": {
                     "type": "string"
                 },
-                "terms_of_service_parsed_text": {
+                "slug_name": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetTagBasicResp": {
+        "schema.GetUserStaffResp": {
             "type": "object",
             "properties": {
-                "display_name": {
+                "avatar": {
+                    "description": "avatar",
                     "type": "string"
                 },
-                "recommend": {
-                    "type": "boolean"
-                },
-                "reserved": {
-                    "type": "boolean"
-                },
-                "slug_name": {
+                "display_name": {
+                    "description": "display name",
                     "type": "string"
                 },
-                "tag_id": {
+                "username": {
+                    "description": "username",
                     "type": "string"
                 }
             }
         },
-        "schema.GetTagPageResp": {
+        "schema.GetVoteWithPageResp": {
             "type": "object",
             "properties": {
-                "created_at": {
-                    "description": "created time",
-                    "type": "integer"
-                },
-                "description": {
-                    "description": "description",
-                    "type": "string"
-                },
-                "display_name": {
-                    "description": "display_name",
+                "answer_id": {
+                    "description": "answer id",
                     "type": "string"
                 },
-                "excerpt": {
-                    "description": "excerpt",
+                "content": {
+                    "description": "content",
                     "type": "string"
                 },
-                "follow_count": {
-                    "description": "follower amount",
+                "created_at": {
+                    "description": "create time",
                     "type": "integer"
                 },
-                "is_follower": {
-                    "description": "is follower",
-                    "type": "boolean"
-                },
-                "original_text": {
-                    "description": "original text",
-                    "type": "string"
-                },
-                "parsed_text": {
-                    "description": "parsed_text",
+                "object_id": {
+                    "description": "object id",
                     "type": "string"
                 },
-                "question_count": {
-                    "description": "question amount",
-                    "type": "integer"
-                },
-                "recommend": {
-                    "type": "boolean"
+                "object_type": {
+                    "description": "object type",
+                    "type": "string",
+                    "enum": [
+                        "question",
+                        "answer",
+                        "tag",
+                        "comment"
+                    ]
                 },
-                "reserved": {
-                    "type": "boolean"
+                "question_id": {
+                    "description": "question id",
+                    "type": "string"
                 },
-                "slug_name": {
-                    "description": "slug_name",
+                "title": {
+                    "description": "title",
                     "type": "string"
                 },
-                "tag_id": {
-                    "description": "tag_id",
+                "url_title": {
+                    "description": "url title",
                     "type": "string"
                 },
-                "updated_at": {
-                    "description": "updated time",
-                    "type": "integer"
+                "vote_type": {
+                    "description": "vote type",
+                    "type": "string"
                 }
             }
         },
-        "schema.GetTagResp": {
+        "schema.HireFreelancerReq": {
             "type": "object",
+            "required": [
+                "freelancer_user_id",
+                "message",
+                "subject"
+            ],
             "properties": {
-                "created_at": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "display_name": {
-                    "type": "string"
-                },
-                "excerpt": {
+                "application_id": {
                     "type": "string"
                 },
-                "follow_count": {
-                    "type": "integer"
-                },
-                "is_follower": {
-                    "type": "boolean"
-                },
-                "main_tag_slug_name": {
-                    "description": "if main tag slug name is not empty, this tag is synonymous with the main tag",
+                "captcha_code": {
                     "type": "string"
                 },
-                "member_actions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.PermissionMemberAction"
-                    }
-                },
-                "original_text": {
+                "captcha_id": {
                     "type": "string"
                 },
-                "parsed_text": {
+                "freelancer_user_id": {
                     "type": "string"
                 },
-                "question_count": {
-                    "type": "integer"
-                },
-                "recommend": {
-                    "type": "boolean"
-                },
-                "reserved": {
-                    "type": "boolean"
-                },
-                "slug_name": {
+                "job_id": {
+                    "description": "the job posting and the accepted application which the freelancer is hired for, optional",
                     "type": "string"
                 },
-                "status": {
+                "message": {
                     "type": "string"
                 },
-                "tag_id": {
+                "subject": {
                     "type": "string"
-                },
-                "updated_at": {
-                    "type": "integer"
                 }
             }
         },
-        "schema.GetTagSynonymsResp": {
+        "schema.HireFreelancerResp": {
             "type": "object",
             "properties": {
-                "member_actions": {
-                    "description": "MemberActions",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.PermissionMemberAction"
-                    }
+                "contract_id": {
+                    "type": "string"
                 },
-                "synonyms": {
-                    "description": "synonyms",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.TagSynonym"
-                    }
+                "conversation_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "schema.GetUnreviewedPostPageResp": {
+        "schema.JobApplicationResp": {
             "type": "object",
             "properties": {
-                "answer_id": {
+                "applicant_id": {
                     "type": "string"
                 },
-                "author_user_info": {
-                    "$ref": "#/definitions/schema.UserBasicInfo"
+                "base_proposed_rate": {
+                    "type": "number"
                 },
-                "comment_id": {
+                "cover_letter": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "integer"
                 },
-                "object_id": {
+                "currency": {
                     "type": "string"
                 },
-                "object_show_status": {
-                    "type": "integer"
-                },
-                "object_status": {
-                    "type": "integer"
+                "display_currency": {
+                    "type": "string"
                 },
-                "object_type": {
-                    "type": "string",
-                    "enum": [
-                        "question",
-                        "answer",
-                        "comment"
-                    ]
+                "display_proposed_rate": {
+                    "type": "number"
                 },
-                "original_text": {
+                "id": {
                     "type": "string"
                 },
-                "parsed_text": {
+                "job_id": {
                     "type": "string"
                 },
-                "question_id": {
+                "message": {
                     "type": "string"
                 },
-                "reason": {
+                "proposed_rate": {
+                    "type": "number"
+                },
+                "status": {
                     "type": "string"
                 },
-                "review_id": {
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.JobPostingAnalyticsResp": {
+            "type": "object",
+            "properties": {
+                "accepted": {
                     "type": "integer"
                 },
-                "submit_at": {
+                "applications": {
                     "type": "integer"
                 },
-                "submitter_display_name": {
-                    "type": "string"
+                "created_at": {
+                    "type": "integer"
                 },
-                "tags": {
+                "first_application_at": {
+                    "description": "the time of the first application, 0 if there is no application",
+                    "type": "integer"
+                },
+                "funnel": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.TagResp"
+                        "$ref": "#/definitions/schema.JobPostingFunnelStep"
                     }
                 },
-                "title": {
-                    "type": "string"
+                "hours_to_first_application": {
+                    "type": "number"
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "pending": {
+                    "type": "integer"
+                },
+                "rejected": {
+                    "type": "integer"
                 },
-                "url_title": {
+                "status": {
                     "type": "string"
-                }
-            }
-        },
-        "schema.GetUnreviewedRevisionResp": {
-            "type": "object",
-            "properties": {
-                "info": {
-                    "$ref": "#/definitions/schema.UnreviewedRevisionInfoInfo"
                 },
-                "type": {
+                "title": {
                     "type": "string"
                 },
-                "unreviewed_info": {
-                    "$ref": "#/definitions/schema.GetRevisionResp"
+                "views": {
+                    "type": "integer"
+                },
+                "withdrawn": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.GetUserActivationResp": {
+        "schema.JobPostingFunnelStep": {
             "type": "object",
             "properties": {
-                "activation_url": {
+                "count": {
+                    "type": "integer"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "step": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetUserBadgeAwardListResp": {
+        "schema.JobPostingResp": {
             "type": "object",
             "properties": {
-                "earned_count": {
-                    "description": "badge award count",
+                "application_count": {
                     "type": "integer"
                 },
-                "icon": {
-                    "description": "badge icon",
+                "base_budget": {
+                    "type": "number"
+                },
+                "budget": {
+                    "type": "number"
+                },
+                "budget_type": {
                     "type": "string"
                 },
-                "id": {
-                    "description": "badge id",
+                "contact_email": {
                     "type": "string"
                 },
-                "level": {
-                    "description": "badge level",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.BadgeLevel"
-                        }
-                    ]
+                "created_at": {
+                    "type": "integer"
                 },
-                "name": {
-                    "description": "badge name",
+                "currency": {
                     "type": "string"
-                }
-            }
-        },
-        "schema.GetUserNotificationConfigResp": {
-            "type": "object",
-            "properties": {
-                "all_new_question": {
-                    "$ref": "#/definitions/schema.NotificationChannelConfig"
                 },
-                "all_new_question_for_following_tags": {
-                    "$ref": "#/definitions/schema.NotificationChannelConfig"
+                "description": {
+                    "type": "string"
                 },
-                "inbox": {
-                    "$ref": "#/definitions/schema.NotificationChannelConfig"
-                }
-            }
-        },
-        "schema.GetUserPageResp": {
-            "type": "object",
-            "properties": {
-                "avatar": {
-                    "description": "avatar",
+                "description_html": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "create time",
-                    "type": "integer"
+                "display_budget": {
+                    "type": "number"
                 },
-                "deleted_at": {
-                    "description": "delete time",
-                    "type": "integer"
+                "display_currency": {
+                    "type": "string"
                 },
-                "display_name": {
-                    "description": "display name",
+                "duration": {
                     "type": "string"
                 },
-                "e_mail": {
-                    "description": "email",
+                "experience_level": {
                     "type": "string"
                 },
-                "rank": {
-                    "description": "rank",
+                "expires_at": {
                     "type": "integer"
                 },
-                "role_id": {
-                    "description": "role id",
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "role_name": {
-                    "description": "role name",
+                "is_active": {
+                    "type": "boolean"
+                },
+                "location": {
                     "type": "string"
                 },
+                "operation": {
+                    "description": "operation the login user can do with this job posting",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PermissionMemberAction"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "status": {
-                    "description": "user status(normal,suspended,deleted,inactive)",
                     "type": "string"
                 },
-                "suspended_at": {
-                    "description": "suspended time",
-                    "type": "integer"
+                "title": {
+                    "type": "string"
                 },
-                "suspended_until": {
-                    "description": "suspended until time",
+                "updated_at": {
                     "type": "integer"
                 },
                 "user_id": {
-                    "description": "user id",
                     "type": "string"
                 },
-                "username": {
-                    "description": "username",
-                    "type": "string"
+                "views_count": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.GetUserPluginListResp": {
+        "schema.LoadingAction": {
             "type": "object",
             "properties": {
-                "name": {
+                "state": {
                     "type": "string"
                 },
-                "slug_name": {
+                "text": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetUserStaffResp": {
+        "schema.MarketplaceSkillStat": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "description": "avatar",
-                    "type": "string"
+                "demand": {
+                    "description": "the number of the job postings which require the skill in the period",
+                    "type": "integer"
                 },
                 "display_name": {
-                    "description": "display name",
                     "type": "string"
                 },
-                "username": {
-                    "description": "username",
+                "freelancers": {
+                    "type": "integer"
+                },
+                "median_rate": {
+                    "description": "the median hourly rate in the base currency, 0 if no freelancer with the skill has set the rate",
+                    "type": "number"
+                },
+                "slug_name": {
                     "type": "string"
                 }
             }
         },
-        "schema.GetVoteWithPageResp": {
+        "schema.MarketplaceStatisticalResp": {
             "type": "object",
             "properties": {
-                "answer_id": {
-                    "description": "answer id",
-                    "type": "string"
+                "active_verified_freelancers": {
+                    "type": "integer"
                 },
-                "content": {
-                    "description": "content",
+                "applications_per_job": {
+                    "description": "the average applications of the job postings created in the period",
+                    "type": "number"
+                },
+                "avg_hours_to_first_application": {
+                    "description": "the hours from posting to the first application, only the job postings with applications are counted",
+                    "type": "number"
+                },
+                "base_currency": {
                     "type": "string"
                 },
-                "created_at": {
-                    "description": "create time",
+                "days": {
                     "type": "integer"
                 },
-                "object_id": {
-                    "description": "object id",
-                    "type": "string"
+                "hire_conversion_rate": {
+                    "description": "the percentage of the job postings created in the period which have hired a freelancer",
+                    "type": "number"
                 },
-                "object_type": {
-                    "description": "object type",
-                    "type": "string",
-                    "enum": [
-                        "question",
-                        "answer",
-                        "tag",
-                        "comment"
-                    ]
+                "median_hours_to_first_application": {
+                    "type": "number"
                 },
-                "question_id": {
-                    "description": "question id",
-                    "type": "string"
+                "postings_created": {
+                    "description": "the number of the job postings created, filled and expired in the period",
+                    "type": "integer"
                 },
-                "title": {
-                    "description": "title",
-                    "type": "string"
+                "postings_expired": {
+                    "type": "integer"
                 },
-                "url_title": {
-                    "description": "url title",
-                    "type": "string"
+                "postings_filled": {
+                    "type": "integer"
                 },
-                "vote_type": {
-                    "description": "vote type",
-                    "type": "string"
-                }
-            }
-        },
-        "schema.HireFreelancerReq": {
-            "type": "object",
-            "required": [
-                "freelancer_user_id",
-                "message",
-                "subject"
-            ],
-            "properties": {
-                "freelancer_user_id": {
-                    "type": "string"
+                "postings_open": {
+                    "description": "the number of the currently open job postings",
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string"
+                "top_skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MarketplaceSkillStat"
+                    }
                 },
-                "subject": {
-                    "type": "string"
+                "trend": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MarketplaceTrendItem"
+                    }
                 }
             }
         },
-        "schema.HireFreelancerResp": {
+        "schema.MarketplaceTrendItem": {
             "type": "object",
             "properties": {
-                "message": {
+                "created": {
+                    "type": "integer"
+                },
+                "date": {
                     "type": "string"
                 },
-                "success": {
-                    "type": "boolean"
+                "expired": {
+                    "type": "integer"
+                },
+                "filled": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.JobPostingResp": {
+        "schema.MessageResp": {
             "type": "object",
             "properties": {
-                "application_count": {
-                    "type": "integer"
-                },
-                "budget": {
-                    "type": "number"
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "budget_type": {
+                "content": {
                     "type": "string"
                 },
-                "contact_email": {
+                "conversation_id": {
                     "type": "string"
                 },
                 "created_at": {
                     "type": "integer"
                 },
-                "currency": {
+                "html": {
                     "type": "string"
                 },
-                "description": {
+                "id": {
                     "type": "string"
                 },
-                "description_html": {
+                "is_read": {
+                    "description": "read receipt, whether the message has been read by the receiver",
+                    "type": "boolean"
+                },
+                "sender_id": {
                     "type": "string"
+                }
+            }
+        },
+        "schema.MilestoneResp": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "duration": {
+                "contract_id": {
                     "type": "string"
                 },
-                "experience_level": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
                 },
-                "expires_at": {
+                "due_at": {
                     "type": "integer"
                 },
-                "id": {
+                "fund_transaction_id": {
                     "type": "string"
                 },
-                "is_active": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "location": {
+                "note": {
                     "type": "string"
                 },
-                "skills": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "release_transaction_id": {
+                    "type": "string"
                 },
                 "status": {
                     "type": "string"
@@ -10395,23 +14635,6 @@ const docTemplate = `{
                 },
                 "updated_at": {
                     "type": "integer"
-                },
-                "user_id": {
-                    "type": "string"
-                },
-                "views_count": {
-                    "type": "integer"
-                }
-            }
-        },
-        "schema.LoadingAction": {
-            "type": "object",
-            "properties": {
-                "state": {
-                    "type": "string"
-                },
-                "text": {
-                    "type": "string"
                 }
             }
         },
@@ -10421,6 +14644,19 @@ const docTemplate = `{
                 "enable": {
                     "type": "boolean"
                 },
+                "frequency": {
+                    "description": "immediate, daily or weekly, only the email of the inbox and the following tags questions can be digested",
+                    "enum": [
+                        "immediate",
+                        "daily",
+                        "weekly"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constant.NotificationFrequency"
+                        }
+                    ]
+                },
                 "key": {
                     "$ref": "#/definitions/constant.NotificationChannelKey"
                 }
@@ -10524,6 +14760,93 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.PingWebhookReq": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.PortfolioAnswerItem": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "question_id": {
+                    "type": "string"
+                },
+                "question_title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "vote_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.PortfolioItemResp": {
+            "type": "object",
+            "properties": {
+                "answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PortfolioAnswerItem"
+                    }
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "description_html": {
+                    "type": "string"
+                },
+                "external_url": {
+                    "type": "string"
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "sort": {
+                    "type": "integer"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                }
+            }
+        },
         "schema.PostRenderReq": {
             "type": "object",
             "properties": {
@@ -10648,6 +14971,38 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.QuestionBaseInfo": {
+            "type": "object",
+            "properties": {
+                "accepted_answer": {
+                    "type": "boolean"
+                },
+                "answer_count": {
+                    "type": "integer"
+                },
+                "collection_count": {
+                    "type": "integer"
+                },
+                "follow_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url_title": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "schema.QuestionInfoResp": {
             "type": "object",
             "properties": {
@@ -11022,6 +15377,44 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.RecommendedFreelancerResp": {
+            "type": "object",
+            "properties": {
+                "matched_skills": {
+                    "description": "the skills of the job posting which the freelancer has",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "profile": {
+                    "$ref": "#/definitions/schema.FreelancerProfileResp"
+                },
+                "score": {
+                    "description": "match score from 0 to 100",
+                    "type": "number"
+                }
+            }
+        },
+        "schema.RecommendedJobResp": {
+            "type": "object",
+            "properties": {
+                "job": {
+                    "$ref": "#/definitions/schema.JobPostingResp"
+                },
+                "matched_skills": {
+                    "description": "the skills of the job posting which the freelancer has",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "score": {
+                    "description": "match score from 0 to 100",
+                    "type": "number"
+                }
+            }
+        },
         "schema.RecoverAnswerReq": {
             "type": "object",
             "required": [
@@ -11044,6 +15437,17 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.RedeliverWebhookReq": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "schema.RemoveAnswerReq": {
             "type": "object",
             "required": [
@@ -11079,42 +15483,145 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.RemoveContractReviewReq": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "schema.RemoveQuestionReq": {
             "type": "object",
             "required": [
-                "id"
+                "id"
+            ],
+            "properties": {
+                "captcha_code": {
+                    "type": "string"
+                },
+                "captcha_id": {
+                    "description": "captcha_id",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "question id",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.RemoveRoleReq": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.RemoveTagReq": {
+            "type": "object",
+            "required": [
+                "tag_id"
+            ],
+            "properties": {
+                "tag_id": {
+                    "description": "tag_id",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.RemoveWebhookReq": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.RenewJobPostingReq": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "description": "new expiration timestamp, if it is 0, the max lifetime is used",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "schema.ReopenQuestionReq": {
+            "type": "object",
+            "properties": {
+                "question_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ReplyContractReviewReq": {
+            "type": "object",
+            "required": [
+                "content"
             ],
             "properties": {
-                "captcha_code": {
-                    "type": "string"
-                },
-                "captcha_id": {
-                    "description": "captcha_id",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "question id",
-                    "type": "string"
+                "content": {
+                    "type": "string",
+                    "maxLength": 5000
                 }
             }
         },
-        "schema.RemoveTagReq": {
+        "schema.RequestFreelancerVerificationReq": {
             "type": "object",
             "required": [
-                "tag_id"
+                "evidence"
             ],
             "properties": {
-                "tag_id": {
-                    "description": "tag_id",
-                    "type": "string"
+                "evidence": {
+                    "description": "the urls of the evidence files uploaded with the verification_evidence source",
+                    "type": "array",
+                    "maxItems": 10,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "maxLength": 2000
                 }
             }
         },
-        "schema.ReopenQuestionReq": {
+        "schema.ReviewFreelancerVerificationReq": {
             "type": "object",
+            "required": [
+                "id",
+                "status"
+            ],
             "properties": {
-                "question_id": {
+                "id": {
                     "type": "string"
+                },
+                "reason_msg": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "reason_type": {
+                    "description": "the reason of the rejection, from the freelancer_verification reject reasons",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "approved",
+                        "rejected"
+                    ]
                 }
             }
         },
@@ -11179,6 +15686,117 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.RoleAuditContent": {
+            "type": "object",
+            "properties": {
+                "added_powers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "old_description": {
+                    "type": "string"
+                },
+                "old_name": {
+                    "type": "string"
+                },
+                "previous_roles": {
+                    "description": "PreviousRoles the role id of each user before the role is assigned",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "removed_powers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schema.RoleAuditLogResp": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "content": {
+                    "$ref": "#/definitions/schema.RoleAuditContent"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "operator_id": {
+                    "type": "string"
+                },
+                "role_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.SaveJobSearchReq": {
+            "type": "object",
+            "required": [
+                "alert_frequency",
+                "filter",
+                "name"
+            ],
+            "properties": {
+                "alert_frequency": {
+                    "description": "none only saves the filter, immediate alerts for each new posting, daily sends a digest",
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "immediate",
+                        "daily"
+                    ]
+                },
+                "filter": {
+                    "$ref": "#/definitions/schema.GetJobPostingsReq"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "schema.SavedJobSearchResp": {
+            "type": "object",
+            "properties": {
+                "alert_frequency": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "filter": {
+                    "$ref": "#/definitions/schema.GetJobPostingsReq"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
         "schema.SearchObject": {
             "type": "object",
             "properties": {
@@ -11282,6 +15900,54 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.SearchSuggestResp": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "only for user",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "object_type": {
+                    "description": "object type: question, tag or user",
+                    "type": "string"
+                },
+                "popularity": {
+                    "description": "question view count, tag question count or user rank",
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "question url title, tag slug name or username",
+                    "type": "string"
+                },
+                "title": {
+                    "description": "question title, tag display name or user display name",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.SendMessageReq": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "attachments": {
+                    "description": "the urls of the files uploaded as message attachment",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 65535
+                }
+            }
+        },
         "schema.SendUserActivationReq": {
             "type": "object",
             "required": [
@@ -11335,6 +16001,44 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.SiteCurrencyReq": {
+            "type": "object",
+            "required": [
+                "base_currency"
+            ],
+            "properties": {
+                "base_currency": {
+                    "description": "the ISO 4217 code of the currency which all amounts are normalized to",
+                    "type": "string"
+                },
+                "rates": {
+                    "description": "the static exchange rates, currency code -\u003e how many units of it one unit of the base currency is worth,\nthey are used when no exchange rate plugin is enabled",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "schema.SiteCurrencyResp": {
+            "type": "object",
+            "required": [
+                "base_currency"
+            ],
+            "properties": {
+                "base_currency": {
+                    "description": "the ISO 4217 code of the currency which all amounts are normalized to",
+                    "type": "string"
+                },
+                "rates": {
+                    "description": "the static exchange rates, currency code -\u003e how many units of it one unit of the base currency is worth,\nthey are used when no exchange rate plugin is enabled",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
         "schema.SiteCustomCssHTMLReq": {
             "type": "object",
             "properties": {
@@ -11548,6 +16252,70 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.SiteJobReq": {
+            "type": "object",
+            "required": [
+                "max_lifetime_days"
+            ],
+            "properties": {
+                "expiry_notice_days": {
+                    "description": "notify the poster this many days before the job posting expires, 0 means no notice",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "max_lifetime_days": {
+                    "description": "the longest days a job posting can stay open after it is created or renewed",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 1
+                },
+                "portfolio_answer_min_votes": {
+                    "description": "the least votes of the answer which the freelancer links to the portfolio",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0
+                },
+                "verification_valid_days": {
+                    "description": "the freelancer verification lapses this many days after it is approved, 0 means it never lapses",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 0
+                }
+            }
+        },
+        "schema.SiteJobResp": {
+            "type": "object",
+            "required": [
+                "max_lifetime_days"
+            ],
+            "properties": {
+                "expiry_notice_days": {
+                    "description": "notify the poster this many days before the job posting expires, 0 means no notice",
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "max_lifetime_days": {
+                    "description": "the longest days a job posting can stay open after it is created or renewed",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 1
+                },
+                "portfolio_answer_min_votes": {
+                    "description": "the least votes of the answer which the freelancer links to the portfolio",
+                    "type": "integer",
+                    "maximum": 10000,
+                    "minimum": 0
+                },
+                "verification_valid_days": {
+                    "description": "the freelancer verification lapses this many days after it is approved, 0 means it never lapses",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 0
+                }
+            }
+        },
         "schema.SiteLegalReq": {
             "type": "object",
             "required": [
@@ -11898,17 +16666,59 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.SiteWriteTag": {
+        "schema.SiteWriteTag": {
+            "type": "object",
+            "required": [
+                "slug_name"
+            ],
+            "properties": {
+                "display_name": {
+                    "type": "string"
+                },
+                "slug_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.StartConversationReq": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "application_id": {
+                    "type": "string"
+                },
+                "attachments": {
+                    "description": "the urls of the files uploaded as message attachment",
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string",
+                    "maxLength": 65535
+                },
+                "contract_id": {
+                    "description": "the contract or the job application which the conversation is about, one of them is required",
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.StartConversationResp": {
             "type": "object",
-            "required": [
-                "slug_name"
-            ],
             "properties": {
-                "display_name": {
+                "conversation_id": {
                     "type": "string"
                 },
-                "slug_name": {
-                    "type": "string"
+                "message": {
+                    "$ref": "#/definitions/schema.MessageResp"
                 }
             }
         },
@@ -12137,11 +16947,21 @@ const docTemplate = `{
                 "experience": {
                     "type": "string"
                 },
+                "experience_level": {
+                    "type": "string",
+                    "enum": [
+                        "entry",
+                        "intermediate",
+                        "senior",
+                        "expert"
+                    ]
+                },
                 "github_profile": {
                     "type": "string"
                 },
                 "hourly_rate": {
-                    "type": "number"
+                    "type": "number",
+                    "minimum": 0
                 },
                 "id": {
                     "type": "string"
@@ -12158,11 +16978,13 @@ const docTemplate = `{
                 "linkedin_profile": {
                     "type": "string"
                 },
-                "portfolio": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "location_type": {
+                    "type": "string",
+                    "enum": [
+                        "remote",
+                        "onsite",
+                        "hybrid"
+                    ]
                 },
                 "preferred_projects": {
                     "type": "array",
@@ -12217,6 +17039,116 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.UpdateJobApplicationStatusReq": {
+            "type": "object",
+            "required": [
+                "id",
+                "status"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "accepted",
+                        "rejected",
+                        "withdrawn"
+                    ]
+                }
+            }
+        },
+        "schema.UpdateJobPostingReq": {
+            "type": "object",
+            "required": [
+                "description",
+                "title"
+            ],
+            "properties": {
+                "budget": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "budget_type": {
+                    "type": "string"
+                },
+                "contact_email": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duration": {
+                    "type": "string"
+                },
+                "edit_summary": {
+                    "description": "edit summary",
+                    "type": "string"
+                },
+                "experience_level": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.UpdateJobPostingStatusReq": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "closed",
+                        "filled"
+                    ]
+                }
+            }
+        },
+        "schema.UpdateMilestoneStatusReq": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "note": {
+                    "description": "the submission note or the dispute reason",
+                    "type": "string",
+                    "maxLength": 5000
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "funded",
+                        "submitted",
+                        "approved",
+                        "disputed",
+                        "released"
+                    ]
+                }
+            }
+        },
         "schema.UpdatePluginConfigReq": {
             "type": "object",
             "required": [
@@ -12317,6 +17249,64 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.UpdateRolePowersReq": {
+            "type": "object",
+            "required": [
+                "power_types",
+                "role_id"
+            ],
+            "properties": {
+                "power_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "role_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.UpdateRoleReq": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "schema.UpdateRoleUsersReq": {
+            "type": "object",
+            "required": [
+                "role_id",
+                "user_ids"
+            ],
+            "properties": {
+                "role_id": {
+                    "type": "integer"
+                },
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "schema.UpdateSMTPConfigReq": {
             "type": "object",
             "properties": {
@@ -12441,6 +17431,9 @@ const docTemplate = `{
                 },
                 "inbox": {
                     "$ref": "#/definitions/schema.NotificationChannelConfig"
+                },
+                "saved_job_search": {
+                    "$ref": "#/definitions/schema.NotificationChannelConfig"
                 }
             }
         },
@@ -12534,6 +17527,42 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.UpdateWebhookReq": {
+            "type": "object",
+            "required": [
+                "events",
+                "id",
+                "name",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "secret": {
+                    "description": "the secret is kept if it is empty",
+                    "type": "string",
+                    "maxLength": 256
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 512
+                }
+            }
+        },
         "schema.UserBasicInfo": {
             "type": "object",
             "properties": {
@@ -12927,6 +17956,83 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.WebhookDeliveryResp": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "integer"
+                },
+                "delivered_at": {
+                    "type": "integer"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "string"
+                },
+                "redelivery_of": {
+                    "type": "string"
+                },
+                "response_body": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "webhook_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.WebhookResp": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "the secret is only returned when it is generated",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
         "translator.LangOption": {
             "type": "object",
             "properties": {
@@ -44,6 +44,10 @@ const (
 	ConnectorUserExternalInfoCacheTime         = 10 * time.Minute
 	SiteMapQuestionCacheKeyPrefix              = "answer:sitemap:question:%d"
 	SiteMapQuestionCacheTime                   = time.Hour
+	SiteMapJobPostingCacheKeyPrefix            = "answer:sitemap:job:%d"
+	SiteMapJobPostingCacheTime                 = 10 * time.Minute
+	SiteMapFreelancerCacheKeyPrefix            = "answer:sitemap:freelancer:%d"
+	SiteMapFreelancerCacheTime                 = time.Hour
 	SitemapMaxSize                             = 50000
 	NewQuestionNotificationLimitCacheKeyPrefix = "answer:new-question-notification-limit:"
 	NewQuestionNotificationLimitCacheTime      = 7 * 24 * time.Hour
@@ -52,4 +56,12 @@ const (
 	RateLimitCacheTime                         = 5 * time.Minute
 	RedDotCacheKey                             = "answer:red-dot:%s:%s"
 	RedDotCacheTime                            = 30 * 24 * time.Hour
+	RolePowerCacheKeyPrefix                    = "answer:role:powers:"
+	RolePowerCacheTime                         = 1 * time.Hour
+	SearchSuggestCacheKeyPrefix                = "answer:search:suggest:"
+	SearchSuggestCacheTime                     = 5 * time.Minute
+	SearchSuggestRateLimitMax                  = 30
+	SearchSuggestRateLimitWindow               = time.Minute
+	RelatedQuestionCacheKeyPrefix              = "answer:related-question:"
+	RelatedQuestionCacheTime                   = 30 * time.Minute
 )
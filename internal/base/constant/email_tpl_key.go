@@ -43,4 +43,19 @@ const (
 
 	EmailTplKeyNewQuestionTitle = "email_tpl.new_question.title"
 	EmailTplKeyNewQuestionBody  = "email_tpl.new_question.body"
+
+	EmailTplKeyNewMessageTitle = "email_tpl.new_message.title"
+	EmailTplKeyNewMessageBody  = "email_tpl.new_message.body"
+
+	EmailTplKeyNewJobMatchTitle = "email_tpl.new_job_match.title"
+	EmailTplKeyNewJobMatchBody  = "email_tpl.new_job_match.body"
+
+	EmailTplKeyNotificationDigestDailyTitle  = "email_tpl.notification_digest.daily_title"
+	EmailTplKeyNotificationDigestWeeklyTitle = "email_tpl.notification_digest.weekly_title"
+	EmailTplKeyNotificationDigestBody        = "email_tpl.notification_digest.body"
+	EmailTplKeyNotificationDigestAnswers     = "email_tpl.notification_digest.answers"
+	EmailTplKeyNotificationDigestComments    = "email_tpl.notification_digest.comments"
+	EmailTplKeyNotificationDigestInvites     = "email_tpl.notification_digest.invites"
+	EmailTplKeyNotificationDigestBadges      = "email_tpl.notification_digest.badges"
+	EmailTplKeyNotificationDigestQuestions   = "email_tpl.notification_digest.questions"
 )
@@ -73,3 +73,12 @@ const (
 	EventCommentVote   EventType = eventComment + "." + eventVote
 	EventCommentFlag   EventType = eventComment + "." + eventFlag
 )
+
+// EventTypes all the event types, the webhooks could subscribe to them
+var EventTypes = []EventType{
+	EventUserUpdate, EventUserShare,
+	EventQuestionCreate, EventQuestionUpdate, EventQuestionDelete, EventQuestionVote,
+	EventQuestionAccept, EventQuestionFlag, EventQuestionReact,
+	EventAnswerCreate, EventAnswerUpdate, EventAnswerDelete, EventAnswerVote, EventAnswerFlag, EventAnswerReact,
+	EventCommentCreate, EventCommentUpdate, EventCommentDelete, EventCommentVote, EventCommentFlag,
+}
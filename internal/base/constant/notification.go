@@ -58,6 +58,16 @@ const (
 	NotificationInvitedYouToAnswer = "notification.action.invited_you_to_answer"
 	// NotificationEarnedBadge earned badge
 	NotificationEarnedBadge = "notification.action.earned_badge"
+	// NotificationYourJobPostingWillExpire your job posting will expire
+	NotificationYourJobPostingWillExpire = "notification.action.your_job_posting_will_expire"
+	// NotificationNewMessage new private message
+	NotificationNewMessage = "notification.action.new_message"
+	// NotificationYourVerificationWasRejected your freelancer verification request was rejected
+	NotificationYourVerificationWasRejected = "notification.action.your_verification_was_rejected"
+	// NotificationYourVerificationHasLapsed your freelancer verification has lapsed
+	NotificationYourVerificationHasLapsed = "notification.action.your_verification_has_lapsed"
+	// NotificationNewJobMatchesSavedSearch new job posting matches the saved search
+	NotificationNewJobMatchesSavedSearch = "notification.action.new_job_matches_saved_search"
 )
 
 type NotificationChannelKey string
@@ -67,12 +77,24 @@ const (
 	InboxSource                          NotificationSource = "inbox"
 	AllNewQuestionSource                 NotificationSource = "all_new_question"
 	AllNewQuestionForFollowingTagsSource NotificationSource = "all_new_question_for_following_tags"
+	SavedJobSearchSource                 NotificationSource = "saved_job_search"
 )
 
 const (
 	EmailChannel NotificationChannelKey = "email"
 )
 
+type NotificationFrequency string
+
+const (
+	NotificationFrequencyImmediate NotificationFrequency = "immediate"
+	NotificationFrequencyDaily     NotificationFrequency = "daily"
+	NotificationFrequencyWeekly    NotificationFrequency = "weekly"
+)
+
+// NotificationDigestMaxItems the max number of the items in each section of the digest email
+const NotificationDigestMaxItems = 20
+
 const (
 	NotificationTypeInbox            = "inbox"
 	NotificationTypeAchievement      = "achievement"
@@ -81,23 +103,29 @@ const (
 
 var (
 	NotificationMsgTypeMapping = map[string]int{
-		NotificationUpdateQuestion:         1,
-		NotificationAnswerTheQuestion:      1,
-		NotificationUpVotedTheQuestion:     2,
-		NotificationDownVotedTheQuestion:   2,
-		NotificationUpdateAnswer:           1,
-		NotificationAcceptAnswer:           1,
-		NotificationUpVotedTheAnswer:       2,
-		NotificationDownVotedTheAnswer:     2,
-		NotificationCommentQuestion:        1,
-		NotificationCommentAnswer:          1,
-		NotificationUpVotedTheComment:      2,
-		NotificationReplyToYou:             1,
-		NotificationMentionYou:             1,
-		NotificationYourQuestionIsClosed:   1,
-		NotificationYourQuestionWasDeleted: 1,
-		NotificationYourAnswerWasDeleted:   1,
-		NotificationYourCommentWasDeleted:  1,
-		NotificationInvitedYouToAnswer:     3,
+		NotificationUpdateQuestion:           1,
+		NotificationAnswerTheQuestion:        1,
+		NotificationUpVotedTheQuestion:       2,
+		NotificationDownVotedTheQuestion:     2,
+		NotificationUpdateAnswer:             1,
+		NotificationAcceptAnswer:             1,
+		NotificationUpVotedTheAnswer:         2,
+		NotificationDownVotedTheAnswer:       2,
+		NotificationCommentQuestion:          1,
+		NotificationCommentAnswer:            1,
+		NotificationUpVotedTheComment:        2,
+		NotificationReplyToYou:               1,
+		NotificationMentionYou:               1,
+		NotificationYourQuestionIsClosed:     1,
+		NotificationYourQuestionWasDeleted:   1,
+		NotificationYourAnswerWasDeleted:     1,
+		NotificationYourCommentWasDeleted:    1,
+		NotificationInvitedYouToAnswer:       3,
+		NotificationYourJobPostingWillExpire: 1,
+		NotificationNewMessage:               1,
+
+		NotificationYourVerificationWasRejected: 1,
+		NotificationYourVerificationHasLapsed:   1,
+		NotificationNewJobMatchesSavedSearch:    1,
 	}
 )
@@ -29,6 +29,12 @@ const (
 	ReportObjectType     = "report"
 	BadgeObjectType      = "badge"
 	BadgeAwardObjectType = "badge_award"
+	JobPostingObjectType = "job_posting"
+
+	ContractReviewObjectType = "contract_review"
+	ConversationObjectType   = "conversation"
+
+	FreelancerVerificationObjectType = "freelancer_verification"
 )
 
 var (
@@ -42,6 +48,12 @@ var (
 		ReportObjectType:     8,
 		BadgeObjectType:      9,
 		BadgeAwardObjectType: 10,
+		JobPostingObjectType: 11,
+
+		ContractReviewObjectType: 12,
+		ConversationObjectType:   13,
+
+		FreelancerVerificationObjectType: 14,
 	}
 
 	ObjectTypeNumberMapping = map[int]string{
@@ -54,5 +66,9 @@ var (
 		8:  ReportObjectType,
 		9:  BadgeObjectType,
 		10: BadgeAwardObjectType,
+		11: JobPostingObjectType,
+		12: ContractReviewObjectType,
+		13: ConversationObjectType,
+		14: FreelancerVerificationObjectType,
 	}
 )
@@ -61,6 +61,9 @@ const (
 	RankQuestionCloseKey             = "rank.question.close"
 	RankQuestionReopenKey            = "rank.question.reopen"
 	RankTagUseReservedTagKey         = "rank.tag.use_reserved_tag"
+	RankJobPostingAddKey             = "rank.job_posting.add"
+	RankJobApplicationAddKey         = "rank.job_application.add"
+	RankFreelancerHireKey            = "rank.freelancer.hire"
 )
 
 var (
@@ -87,5 +90,8 @@ var (
 		{Label: reason.RankTagAuditLabel, Key: RankTagAuditKey},
 		{Label: reason.RankTagEditWithoutReviewLabel, Key: RankTagEditWithoutReviewKey},
 		{Label: reason.RankTagSynonymLabel, Key: RankTagSynonymKey},
+		{Label: reason.RankJobPostingAddLabel, Key: RankJobPostingAddKey},
+		{Label: reason.RankJobApplicationAddLabel, Key: RankJobApplicationAddKey},
+		{Label: reason.RankFreelancerHireLabel, Key: RankFreelancerHireKey},
 	}
 )
@@ -24,4 +24,6 @@ const (
 	QuestionsTitleTrKey       = "question.questions_title"
 	TagsListTitleTrKey        = "tag.tags_title"
 	TagHasNoDescription       = "tag.no_description"
+	JobsTitleTrKey            = "job_posting.jobs_title"
+	FreelancerTitleTrKey      = "freelancer.freelancer_title"
 )
@@ -27,6 +27,17 @@ const (
 	AvatarTypeCustom       = "custom"
 )
 
+const (
+	DefaultJobPostingExpiryNoticeDays = 3
+	DefaultJobPostingMaxLifetimeDays  = 90
+	// DefaultFreelancerVerificationValidDays the freelancer verification lapses after this many days
+	DefaultFreelancerVerificationValidDays = 365
+	// DefaultPortfolioAnswerMinVotes the answer needs this many votes to be linked to the portfolio
+	DefaultPortfolioAnswerMinVotes = 5
+	// DefaultBaseCurrency the amounts are normalized to this currency if the base currency has never been set
+	DefaultBaseCurrency = "USD"
+)
+
 const (
 	// PermalinkQuestionIDAndTitle /questions/10010000000000001/post-title
 	PermalinkQuestionIDAndTitle = iota + 1
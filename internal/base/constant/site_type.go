@@ -31,4 +31,6 @@ const (
 	SiteTypeTheme         = "theme"
 	SiteTypePrivileges    = "privileges"
 	SiteTypeUsers         = "users"
+	SiteTypeJob           = "job"
+	SiteTypeCurrency      = "currency"
 )
@@ -20,10 +20,13 @@
 package constant
 
 const (
-	AvatarSubPath      = "avatar"
-	AvatarThumbSubPath = "avatar_thumb"
-	PostSubPath        = "post"
-	BrandingSubPath    = "branding"
-	FilesPostSubPath   = "files/post"
-	DeletedSubPath     = "deleted"
+	AvatarSubPath            = "avatar"
+	AvatarThumbSubPath       = "avatar_thumb"
+	PostSubPath              = "post"
+	BrandingSubPath          = "branding"
+	FilesPostSubPath         = "files/post"
+	FilesMessageSubPath      = "files/message"
+	FilesVerificationSubPath = "files/verification"
+	FilesPortfolioSubPath    = "files/portfolio"
+	DeletedSubPath           = "deleted"
 )
@@ -24,7 +24,10 @@ import (
 	"fmt"
 
 	"github.com/apache/answer/internal/service/content"
+	"github.com/apache/answer/internal/service/currency"
 	"github.com/apache/answer/internal/service/file_record"
+	"github.com/apache/answer/internal/service/freelancer"
+	"github.com/apache/answer/internal/service/notification"
 	"github.com/apache/answer/internal/service/service_config"
 	"github.com/apache/answer/internal/service/siteinfo_common"
 	"github.com/apache/answer/internal/service/user_admin"
@@ -34,11 +37,14 @@ import (
 
 // ScheduledTaskManager scheduled task manager
 type ScheduledTaskManager struct {
-	siteInfoService   siteinfo_common.SiteInfoCommonService
-	questionService   *content.QuestionService
-	fileRecordService *file_record.FileRecordService
-	userAdminService  *user_admin.UserAdminService
-	serviceConfig     *service_config.ServiceConfig
+	siteInfoService     siteinfo_common.SiteInfoCommonService
+	questionService     *content.QuestionService
+	fileRecordService   *file_record.FileRecordService
+	userAdminService    *user_admin.UserAdminService
+	serviceConfig       *service_config.ServiceConfig
+	freelancerService   *freelancer.FreelancerService
+	currencyService     *currency.CurrencyService
+	notificationService *notification.ExternalNotificationService
 }
 
 // NewScheduledTaskManager new scheduled task manager
@@ -48,13 +54,19 @@ func NewScheduledTaskManager(
 	fileRecordService *file_record.FileRecordService,
 	userAdminService *user_admin.UserAdminService,
 	serviceConfig *service_config.ServiceConfig,
+	freelancerService *freelancer.FreelancerService,
+	currencyService *currency.CurrencyService,
+	notificationService *notification.ExternalNotificationService,
 ) *ScheduledTaskManager {
 	manager := &ScheduledTaskManager{
-		siteInfoService:   siteInfoService,
-		questionService:   questionService,
-		fileRecordService: fileRecordService,
-		userAdminService:  userAdminService,
-		serviceConfig:     serviceConfig,
+		siteInfoService:     siteInfoService,
+		questionService:     questionService,
+		fileRecordService:   fileRecordService,
+		userAdminService:    userAdminService,
+		serviceConfig:       serviceConfig,
+		freelancerService:   freelancerService,
+		currencyService:     currencyService,
+		notificationService: notificationService,
 	}
 	return manager
 }
@@ -63,11 +75,13 @@ func (s *ScheduledTaskManager) Run() {
 	log.Infof("cron job manager start")
 
 	s.questionService.SitemapCron(context.Background())
+	s.freelancerService.SitemapCron(context.Background())
 	c := cron.New()
 	_, err := c.AddFunc("0 */1 * * *", func() {
 		ctx := context.Background()
 		log.Infof("sitemap cron execution")
 		s.questionService.SitemapCron(ctx)
+		s.freelancerService.SitemapCron(ctx)
 	})
 	if err != nil {
 		log.Error(err)
@@ -95,6 +109,56 @@ func (s *ScheduledTaskManager) Run() {
 		log.Error(err)
 	}
 
+	// Close expired job postings and notify the posters before expiry every 10 minutes
+	_, err = c.AddFunc("*/10 * * * *", func() {
+		ctx := context.Background()
+		log.Infof("job posting expiry cron execution")
+		s.freelancerService.JobPostingExpiryCron(ctx)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Revoke the freelancer verifications older than the valid days every hour
+	_, err = c.AddFunc("30 */1 * * *", func() {
+		ctx := context.Background()
+		log.Infof("freelancer verification lapse cron execution")
+		s.freelancerService.VerificationLapseCron(ctx)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Send the daily digest of the saved job searches, every search is checked hourly to be digested once a day
+	_, err = c.AddFunc("45 */1 * * *", func() {
+		ctx := context.Background()
+		log.Infof("saved job search digest cron execution")
+		s.freelancerService.SavedJobSearchDigestCron(ctx)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Convert the amounts to the base currency with the latest exchange rates every hour
+	_, err = c.AddFunc("15 */1 * * *", func() {
+		ctx := context.Background()
+		log.Infof("refresh base currency amounts cron execution")
+		s.currencyService.RefreshBaseAmounts(ctx)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
+	// Send the daily and weekly notification digests, every user is checked hourly to be digested once a period
+	_, err = c.AddFunc("50 */1 * * *", func() {
+		ctx := context.Background()
+		log.Infof("notification digest cron execution")
+		s.notificationService.SendNotificationDigests(ctx)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+
 	if s.serviceConfig.CleanUpUploads {
 		log.Infof("clean up uploads cron enabled")
 
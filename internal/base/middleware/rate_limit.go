@@ -29,6 +29,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/segmentfault/pacman/errors"
 	"github.com/segmentfault/pacman/log"
+	"net/http"
+	"time"
 )
 
 type RateLimitMiddleware struct {
@@ -63,6 +65,29 @@ func (rm *RateLimitMiddleware) DuplicateRequestRejection(ctx *gin.Context, req a
 	return true, key
 }
 
+// FrequentRequestRejection rejects the requests of the same user or ip to the same path
+// if there are more than limit requests in the time window. It works for the requests that are cheap
+// for the client but expensive for the server. Such as search suggestions.
+func (rm *RateLimitMiddleware) FrequentRequestRejection(ctx *gin.Context, limit int64, window time.Duration) (reject bool) {
+	unit := GetLoginUserIDFromContext(ctx)
+	if len(unit) == 0 {
+		unit = ctx.ClientIP()
+	}
+	fullPath := ctx.FullPath()
+	key := encryption.MD5(fmt.Sprintf("%s:%s", unit, fullPath))
+	count, err := rm.limitRepo.CountAndRecord(ctx, key, window)
+	if err != nil {
+		log.Errorf("count and record rate limit error: %s", err.Error())
+		return false
+	}
+	if count <= limit {
+		return false
+	}
+	log.Debugf("frequent request: [%s] %s", fullPath, unit)
+	handler.HandleResponse(ctx, errors.New(http.StatusTooManyRequests, reason.FrequentRequestError), nil)
+	return true
+}
+
 // DuplicateRequestClear clear duplicate request record
 func (rm *RateLimitMiddleware) DuplicateRequestClear(ctx *gin.Context, key string) {
 	err := rm.limitRepo.ClearRecord(ctx, key)
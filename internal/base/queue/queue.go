@@ -0,0 +1,284 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/log"
+)
+
+const (
+	BackendDatabase = "database"
+	BackendCache    = "cache"
+
+	defaultWorkers             = 1
+	defaultBatchSize           = 20
+	defaultMaxAttempts         = 5
+	defaultRetryBackoffSeconds = 10
+	defaultMaxBackoffSeconds   = 3600
+	defaultLeaseSeconds        = 300
+	defaultPollIntervalSeconds = 5
+	defaultDrainTimeoutSeconds = 30
+)
+
+// Config queue config
+type Config struct {
+	// Backend the storage of the queue messages, database or cache
+	Backend             string `json:"backend" mapstructure:"backend" yaml:"backend"`
+	Workers             int    `json:"workers" mapstructure:"workers" yaml:"workers"`
+	BatchSize           int    `json:"batch_size" mapstructure:"batch_size" yaml:"batch_size"`
+	MaxAttempts         int    `json:"max_attempts" mapstructure:"max_attempts" yaml:"max_attempts"`
+	RetryBackoffSeconds int    `json:"retry_backoff_seconds" mapstructure:"retry_backoff_seconds" yaml:"retry_backoff_seconds"`
+	MaxBackoffSeconds   int    `json:"max_backoff_seconds" mapstructure:"max_backoff_seconds" yaml:"max_backoff_seconds"`
+	// LeaseSeconds the message being handled is invisible to the other workers in the lease,
+	// it will be delivered again if the handler does not finish in time
+	LeaseSeconds        int `json:"lease_seconds" mapstructure:"lease_seconds" yaml:"lease_seconds"`
+	PollIntervalSeconds int `json:"poll_interval_seconds" mapstructure:"poll_interval_seconds" yaml:"poll_interval_seconds"`
+	DrainTimeoutSeconds int `json:"drain_timeout_seconds" mapstructure:"drain_timeout_seconds" yaml:"drain_timeout_seconds"`
+}
+
+// WithDefault get the config with the default values filled in, the config could be nil
+func (c *Config) WithDefault() *Config {
+	conf := &Config{}
+	if c != nil {
+		*conf = *c
+	}
+	if conf.Backend != BackendCache {
+		conf.Backend = BackendDatabase
+	}
+	setDefault(&conf.Workers, defaultWorkers)
+	setDefault(&conf.BatchSize, defaultBatchSize)
+	setDefault(&conf.MaxAttempts, defaultMaxAttempts)
+	setDefault(&conf.RetryBackoffSeconds, defaultRetryBackoffSeconds)
+	setDefault(&conf.MaxBackoffSeconds, defaultMaxBackoffSeconds)
+	setDefault(&conf.LeaseSeconds, defaultLeaseSeconds)
+	setDefault(&conf.PollIntervalSeconds, defaultPollIntervalSeconds)
+	setDefault(&conf.DrainTimeoutSeconds, defaultDrainTimeoutSeconds)
+	return conf
+}
+
+// Backoff the delay before the next attempt, it doubles after each failed attempt
+func (c *Config) Backoff(attempts int) time.Duration {
+	backoff := time.Duration(c.RetryBackoffSeconds) * time.Second
+	maxBackoff := time.Duration(c.MaxBackoffSeconds) * time.Second
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+func setDefault(value *int, defaultValue int) {
+	if *value <= 0 {
+		*value = defaultValue
+	}
+}
+
+// Store the durable storage of the queue messages
+type Store interface {
+	// Push add the message to the queue
+	Push(ctx context.Context, queue, payload string) (err error)
+	// Fetch claim the due messages of the queue, the attempts of them are increased,
+	// the claimed messages are invisible to the others until the lease expires
+	Fetch(ctx context.Context, queue string, limit int, lease time.Duration) (msgList []*entity.QueueMessage, err error)
+	// Ack remove the message handled successfully
+	Ack(ctx context.Context, msg *entity.QueueMessage) (err error)
+	// Retry make the message visible again at the next run time
+	Retry(ctx context.Context, msg *entity.QueueMessage, nextRunAt time.Time, lastError string) (err error)
+	// DeadLetter move the message to the dead letters
+	DeadLetter(ctx context.Context, msg *entity.QueueMessage, lastError string) (err error)
+}
+
+// Queue the durable queue which delivers each message to the handler at least once,
+// the failed message is retried with backoff and moved to the dead letters at last
+type Queue[T any] struct {
+	name    string
+	store   Store
+	conf    *Config
+	handler func(ctx context.Context, msg T) error
+
+	lock     sync.RWMutex
+	notify   chan struct{}
+	stopping chan struct{}
+	wg       sync.WaitGroup
+}
+
+// New create a new queue and start the workers
+func New[T any](name string, store Store, conf *Config) *Queue[T] {
+	q := &Queue[T]{
+		name:     name,
+		store:    store,
+		conf:     conf.WithDefault(),
+		notify:   make(chan struct{}, 1),
+		stopping: make(chan struct{}),
+	}
+	for i := 0; i < q.conf.Workers; i++ {
+		q.wg.Add(1)
+		go q.working()
+	}
+	return q
+}
+
+// Send add the message to the queue, it never blocks the caller waiting for the handler
+func (q *Queue[T]) Send(ctx context.Context, msg T) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		log.Errorf("marshal %s queue message failed: %s", q.name, err)
+		return
+	}
+	if err = q.store.Push(context.WithoutCancel(ctx), q.name, string(payload)); err != nil {
+		// the message can not be stored, handle it directly to avoid losing it
+		log.Errorf("push %s queue message failed: %s", q.name, err)
+		go q.handleDirectly(msg)
+		return
+	}
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// RegisterHandler register the handler of the messages, the workers wait until the handler is registered
+func (q *Queue[T]) RegisterHandler(handler func(ctx context.Context, msg T) error) {
+	q.lock.Lock()
+	q.handler = handler
+	q.lock.Unlock()
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Close stop the workers after the due messages are drained or the drain timeout is reached
+func (q *Queue[T]) Close() {
+	log.Infof("draining %s queue", q.name)
+	close(q.stopping)
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Duration(q.conf.DrainTimeoutSeconds) * time.Second):
+		log.Warnf("drain %s queue timeout, the remaining messages will be handled after restart", q.name)
+	}
+}
+
+func (q *Queue[T]) getHandler() func(ctx context.Context, msg T) error {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	return q.handler
+}
+
+func (q *Queue[T]) working() {
+	defer q.wg.Done()
+	ticker := time.NewTicker(time.Duration(q.conf.PollIntervalSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		handled := q.poll()
+		select {
+		case <-q.stopping:
+			if handled == 0 {
+				return
+			}
+			continue
+		default:
+		}
+		if handled > 0 {
+			continue
+		}
+		select {
+		case <-q.stopping:
+		case <-q.notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll handle a batch of the due messages, return the number of the handled messages
+func (q *Queue[T]) poll() (handled int) {
+	handler := q.getHandler()
+	if handler == nil {
+		return 0
+	}
+	ctx := context.Background()
+	msgList, err := q.store.Fetch(ctx, q.name, q.conf.BatchSize, time.Duration(q.conf.LeaseSeconds)*time.Second)
+	if err != nil {
+		log.Errorf("fetch %s queue messages failed: %s", q.name, err)
+		return 0
+	}
+	for _, msg := range msgList {
+		q.handle(ctx, handler, msg)
+	}
+	return len(msgList)
+}
+
+func (q *Queue[T]) handle(ctx context.Context, handler func(ctx context.Context, msg T) error, msg *entity.QueueMessage) {
+	log.Debugf("received %s queue message %s", q.name, msg.ID)
+	err := q.call(ctx, handler, msg)
+	if err == nil {
+		if err = q.store.Ack(ctx, msg); err != nil {
+			log.Errorf("ack %s queue message %s failed: %s", q.name, msg.ID, err)
+		}
+		return
+	}
+
+	log.Errorf("handle %s queue message %s attempt %d failed: %s", q.name, msg.ID, msg.Attempts, err)
+	if msg.Attempts >= q.conf.MaxAttempts {
+		err = q.store.DeadLetter(ctx, msg, err.Error())
+	} else {
+		err = q.store.Retry(ctx, msg, time.Now().Add(q.conf.Backoff(msg.Attempts)), err.Error())
+	}
+	if err != nil {
+		log.Errorf("reschedule %s queue message %s failed: %s", q.name, msg.ID, err)
+	}
+}
+
+// call the handler with the message, the panic of the handler is treated as the failure
+func (q *Queue[T]) call(ctx context.Context, handler func(ctx context.Context, msg T) error,
+	msg *entity.QueueMessage) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panic: %v", r)
+		}
+	}()
+	var data T
+	if err = json.Unmarshal([]byte(msg.Payload), &data); err != nil {
+		return err
+	}
+	return handler(ctx, data)
+}
+
+func (q *Queue[T]) handleDirectly(msg T) {
+	handler := q.getHandler()
+	if handler == nil {
+		log.Warnf("no handler for %s queue", q.name)
+		return
+	}
+	if err := handler(context.Background(), msg); err != nil {
+		log.Error(err)
+	}
+}
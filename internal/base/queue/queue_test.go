@@ -0,0 +1,147 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryStore keeps the messages in memory, the retried messages are due immediately
+type memoryStore struct {
+	lock        sync.Mutex
+	seq         int
+	msgList     []*entity.QueueMessage
+	deadLetters []*entity.QueueMessage
+}
+
+func (s *memoryStore) Push(_ context.Context, queue, payload string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.seq++
+	s.msgList = append(s.msgList, &entity.QueueMessage{ID: strconv.Itoa(s.seq), Queue: queue, Payload: payload})
+	return nil
+}
+
+func (s *memoryStore) Fetch(_ context.Context, _ string, limit int, _ time.Duration) ([]*entity.QueueMessage, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	fetched := make([]*entity.QueueMessage, 0)
+	remaining := make([]*entity.QueueMessage, 0)
+	for _, msg := range s.msgList {
+		if len(fetched) < limit {
+			msg.Attempts++
+			fetched = append(fetched, msg)
+		} else {
+			remaining = append(remaining, msg)
+		}
+	}
+	s.msgList = remaining
+	return fetched, nil
+}
+
+func (s *memoryStore) Ack(_ context.Context, _ *entity.QueueMessage) error {
+	return nil
+}
+
+func (s *memoryStore) Retry(_ context.Context, msg *entity.QueueMessage, _ time.Time, lastError string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	msg.LastError = lastError
+	s.msgList = append(s.msgList, msg)
+	return nil
+}
+
+func (s *memoryStore) DeadLetter(_ context.Context, msg *entity.QueueMessage, lastError string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	msg.LastError = lastError
+	s.deadLetters = append(s.deadLetters, msg)
+	return nil
+}
+
+type testMsg struct {
+	ID int
+}
+
+func TestQueue_RetryAndDeadLetter(t *testing.T) {
+	store := &memoryStore{}
+	q := New[*testMsg]("test", store, &Config{Workers: 2, MaxAttempts: 3})
+
+	var lock sync.Mutex
+	attempts := make(map[int]int)
+	q.RegisterHandler(func(ctx context.Context, msg *testMsg) error {
+		lock.Lock()
+		defer lock.Unlock()
+		attempts[msg.ID]++
+		if msg.ID == 1 && attempts[msg.ID] < 2 {
+			return fmt.Errorf("temporary failure")
+		}
+		if msg.ID == 2 {
+			panic("always failed")
+		}
+		return nil
+	})
+	for i := 1; i <= 3; i++ {
+		q.Send(context.TODO(), &testMsg{ID: i})
+	}
+
+	assert.Eventually(t, func() bool {
+		store.lock.Lock()
+		defer store.lock.Unlock()
+		return len(store.msgList) == 0 && len(store.deadLetters) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	q.Close()
+
+	assert.Equal(t, map[int]int{1: 2, 2: 3, 3: 1}, attempts)
+	assert.Equal(t, 3, store.deadLetters[0].Attempts)
+	assert.Contains(t, store.deadLetters[0].LastError, "always failed")
+}
+
+func TestQueue_DrainOnClose(t *testing.T) {
+	store := &memoryStore{}
+	q := New[*testMsg]("test", store, nil)
+	for i := 1; i <= 50; i++ {
+		q.Send(context.TODO(), &testMsg{ID: i})
+	}
+
+	handled := 0
+	q.RegisterHandler(func(ctx context.Context, msg *testMsg) error {
+		handled++
+		return nil
+	})
+	q.Close()
+	assert.Equal(t, 50, handled)
+}
+
+func TestConfig_Backoff(t *testing.T) {
+	conf := (&Config{RetryBackoffSeconds: 10, MaxBackoffSeconds: 60}).WithDefault()
+	assert.Equal(t, BackendDatabase, conf.Backend)
+	assert.Equal(t, 10*time.Second, conf.Backoff(1))
+	assert.Equal(t, 40*time.Second, conf.Backoff(3))
+	assert.Equal(t, 60*time.Second, conf.Backoff(10))
+}
@@ -47,4 +47,7 @@ const (
 	RankTagAuditLabel                  = "privilege.rank_tag_audit_label"
 	RankTagEditWithoutReviewLabel      = "privilege.rank_tag_edit_without_review_label"
 	RankTagSynonymLabel                = "privilege.rank_tag_synonym_label"
+	RankJobPostingAddLabel             = "privilege.rank_job_posting_add_label"
+	RankJobApplicationAddLabel         = "privilege.rank_job_application_add_label"
+	RankFreelancerHireLabel            = "privilege.rank_freelancer_hire_label"
 )
@@ -34,6 +34,8 @@ const (
 	ForbiddenError = "base.forbidden_error"
 	// DuplicateRequestError duplicate request error
 	DuplicateRequestError = "base.duplicate_request_error"
+	// FrequentRequestError too many requests in a short time
+	FrequentRequestError = "base.frequent_request_error"
 )
 
 const (
@@ -62,6 +64,7 @@ const (
 	OldPasswordVerificationFailed    = "error.object.old_password_verification_failed"
 	NewPasswordSameAsPreviousSetting = "error.object.new_password_same_as_previous_setting"
 	NewObjectAlreadyDeleted          = "error.object.already_deleted"
+	ObjectContentFiltered            = "error.object.content_filtered"
 	UserNotFound                     = "error.user.not_found"
 	UsernameInvalid                  = "error.user.username_invalid"
 	UsernameDuplicate                = "error.user.username_duplicate"
@@ -121,8 +124,52 @@ const (
 const (
 	FreelancerProfileNotFound        = "error.freelancer.profile_not_found"
 	FreelancerProfileAlreadyExists  = "error.freelancer.profile_already_exists"
+	FreelancerTimeZoneInvalid      = "error.freelancer.time_zone_invalid"
+	FreelancerAlreadyVerified      = "error.freelancer.already_verified"
+	FreelancerVerificationPending  = "error.freelancer.verification_pending"
+	FreelancerVerificationNotFound = "error.freelancer.verification_not_found"
+	FreelancerVerificationReviewed = "error.freelancer.verification_reviewed"
+	FreelancerPortfolioNotFound    = "error.freelancer.portfolio_item_not_found"
+	FreelancerPortfolioLimit       = "error.freelancer.portfolio_item_limit_exceeded"
+	FreelancerPortfolioAnswer      = "error.freelancer.portfolio_answer_invalid"
+	FreelancerPortfolioImage       = "error.freelancer.portfolio_image_invalid"
+	FreelancerPortfolioFile        = "error.freelancer.portfolio_file_invalid"
 	JobPostingNotFound              = "error.job.posting_not_found"
 	JobApplicationAlreadyExists     = "error.job.application_already_exists"
+	JobApplicationNotFound          = "error.job.application_not_found"
+	JobApplicationStatusInvalid     = "error.job.application_status_invalid"
+	JobApplicationOwnPosting        = "error.job.application_own_posting"
+	JobPostingNotOpen               = "error.job.posting_not_open"
+	JobPostingStatusInvalid         = "error.job.posting_status_invalid"
+	JobPostingExpiresAtInvalid      = "error.job.posting_expires_at_invalid"
+	JobSavedSearchNotFound         = "error.job.saved_search_not_found"
+	JobSavedSearchLimitExceeded    = "error.job.saved_search_limit_exceeded"
+)
+
+// contract reasons
+const (
+	ContractNotFound           = "error.contract.not_found"
+	ContractNotActive          = "error.contract.not_active"
+	ContractNotOffered         = "error.contract.not_offered"
+	ContractPartyNotAllowed    = "error.contract.party_not_allowed"
+	ContractCannotCancel       = "error.contract.cannot_cancel"
+	ContractCannotComplete     = "error.contract.cannot_complete"
+	ContractNotConfirmed       = "error.contract.not_confirmed"
+	ContractWithYourself       = "error.contract.with_yourself"
+	MilestoneNotFound          = "error.contract.milestone_not_found"
+	MilestoneStatusInvalid     = "error.contract.milestone_status_invalid"
+	PaymentFailed              = "error.contract.payment_failed"
+	PaymentProviderUnavailable = "error.contract.payment_provider_unavailable"
+	ContractNotCompleted       = "error.contract.not_completed"
+	ContractReviewNotFound     = "error.contract.review_not_found"
+	ContractReviewExists       = "error.contract.review_already_exists"
+	ContractReviewReplied      = "error.contract.review_already_replied"
+)
+
+// conversation reasons
+const (
+	ConversationNotFound       = "error.conversation.not_found"
+	ConversationContextInvalid = "error.conversation.context_invalid"
 )
 
 // user external login reasons
@@ -130,3 +177,33 @@ const (
 	UserExternalLoginUnbindingForbidden = "error.user.external_login_unbinding_forbidden"
 	UserExternalLoginMissingUserID      = "error.user.external_login_missing_user_id"
 )
+
+// currency reasons
+const (
+	CurrencyRateUnavailable = "error.currency.rate_unavailable"
+)
+
+// webhook reasons
+const (
+	WebhookNotFound         = "error.webhook.not_found"
+	WebhookEventInvalid     = "error.webhook.event_invalid"
+	WebhookDeliveryNotFound = "error.webhook.delivery_not_found"
+)
+
+// email reply reasons
+const (
+	EmailReplyDisabled        = "error.email_reply.disabled"
+	EmailReplyAddressInvalid  = "error.email_reply.address_invalid"
+	EmailReplySenderMismatch  = "error.email_reply.sender_mismatch"
+	EmailReplyContentEmpty    = "error.email_reply.content_empty"
+	EmailReplyCaptchaRequired = "error.email_reply.captcha_required"
+)
+
+// role reasons
+const (
+	RoleNotFound        = "error.role.not_found"
+	RoleNameDuplicate   = "error.role.name_duplicate"
+	RoleBuiltInReadOnly = "error.role.built_in_read_only"
+	RoleInUse           = "error.role.in_use"
+	RolePowerInvalid    = "error.role.power_invalid"
+)
@@ -42,6 +42,7 @@ var (
 	UploadFilePath    = "/uploads/"
 	I18nPath          = "/i18n/"
 	CacheDir          = "/cache/"
+	SearchIndexDir    = "/search_index/"
 	formatAllPathONCE sync.Once
 )
 
@@ -56,6 +57,7 @@ func FormatAllPath(dataDirPath string) {
 		UploadFilePath = filepath.Join(dataDirPath, UploadFilePath)
 		I18nPath = filepath.Join(dataDirPath, I18nPath)
 		CacheDir = filepath.Join(dataDirPath, CacheDir)
+		SearchIndexDir = filepath.Join(dataDirPath, SearchIndexDir)
 	})
 }
 
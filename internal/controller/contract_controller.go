@@ -0,0 +1,298 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/middleware"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/contract"
+	"github.com/gin-gonic/gin"
+)
+
+// ContractController contract controller
+type ContractController struct {
+	contractService *contract.ContractService
+}
+
+// NewContractController new controller
+func NewContractController(contractService *contract.ContractService) *ContractController {
+	return &ContractController{contractService: contractService}
+}
+
+// CreateContract godoc
+// @Summary Create contract
+// @Description Create a contract with the freelancer, the login user is the client
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.CreateContractReq true "CreateContract"
+// @Success 200 {object} handler.RespBody{data=schema.CreateContractResp}
+// @Router /answer/api/v1/contract [post]
+func (cc *ContractController) CreateContract(ctx *gin.Context) {
+	req := &schema.CreateContractReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.contractService.CreateContract(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetContract godoc
+// @Summary Get contract
+// @Description Get the contract with its milestones, only the parties of the contract can see it
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Success 200 {object} handler.RespBody{data=schema.ContractResp}
+// @Router /answer/api/v1/contract/{id} [get]
+func (cc *ContractController) GetContract(ctx *gin.Context) {
+	req := &schema.GetContractReq{}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+
+	resp, err := cc.contractService.GetContract(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetContractPage godoc
+// @Summary Get contracts of login user
+// @Description Get contracts of login user as client or freelancer
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param role query string false "role" Enums(client, freelancer)
+// @Param status query string false "status" Enums(offered, active, completed, cancelled)
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.ContractResp}}
+// @Router /answer/api/v1/contracts [get]
+func (cc *ContractController) GetContractPage(ctx *gin.Context) {
+	req := &schema.GetContractsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.contractService.GetContractPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// AcceptContract godoc
+// @Summary Accept contract
+// @Description Accept the offered contract, only the freelancer can do it
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/{id}/accept [put]
+func (cc *ContractController) AcceptContract(ctx *gin.Context) {
+	req := &schema.AcceptContractReq{}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.AcceptContract(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// CancelContract godoc
+// @Summary Cancel contract
+// @Description Cancel the offered or active contract when no milestone is held in escrow
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/{id}/cancel [put]
+func (cc *ContractController) CancelContract(ctx *gin.Context) {
+	req := &schema.CancelContractReq{}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.CancelContract(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// CompleteContract godoc
+// @Summary Complete contract
+// @Description The freelancer confirms the work is done, the client completes the accepted contract when no milestone is held in escrow and a milestone is released or the freelancer has confirmed
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/{id}/complete [put]
+func (cc *ContractController) CompleteContract(ctx *gin.Context) {
+	req := &schema.CompleteContractReq{}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.CompleteContract(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// AddMilestone godoc
+// @Summary Add milestone
+// @Description Add a draft milestone to the contract, only the client can do it
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Param data body schema.CreateMilestoneReq true "CreateMilestone"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/{id}/milestone [post]
+func (cc *ContractController) AddMilestone(ctx *gin.Context) {
+	req := &schema.CreateMilestoneReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ContractID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.AddMilestone(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// UpdateMilestoneStatus godoc
+// @Summary Update milestone status
+// @Description Fund, submit, approve, dispute or release the milestone
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "milestone_id"
+// @Param data body schema.UpdateMilestoneStatusReq true "UpdateMilestoneStatus"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/milestone/{id}/status [put]
+func (cc *ContractController) UpdateMilestoneStatus(ctx *gin.Context) {
+	req := &schema.UpdateMilestoneStatusReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.UpdateMilestoneStatus(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// AddContractReview godoc
+// @Summary Add contract review
+// @Description Review the other party of the completed contract, each party can review once
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "contract_id"
+// @Param data body schema.AddContractReviewReq true "AddContractReview"
+// @Success 200 {object} handler.RespBody{data=schema.ContractReviewResp}
+// @Router /answer/api/v1/contract/{id}/review [post]
+func (cc *ContractController) AddContractReview(ctx *gin.Context) {
+	req := &schema.AddContractReviewReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ContractID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	req.IP = ctx.ClientIP()
+	req.UserAgent = ctx.GetHeader("User-Agent")
+
+	resp, err := cc.contractService.AddContractReview(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// ReplyContractReview godoc
+// @Summary Reply contract review
+// @Description Reply the review, only the reviewee can reply once
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "review_id"
+// @Param data body schema.ReplyContractReviewReq true "ReplyContractReview"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/review/{id}/reply [put]
+func (cc *ContractController) ReplyContractReview(ctx *gin.Context) {
+	req := &schema.ReplyContractReviewReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.contractService.ReplyContractReview(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RemoveContractReview godoc
+// @Summary Remove contract review
+// @Description Remove the review, only the reviewer and admin can do it
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.RemoveContractReviewReq true "RemoveContractReview"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/contract/review [delete]
+func (cc *ContractController) RemoveContractReview(ctx *gin.Context) {
+	req := &schema.RemoveContractReviewReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+
+	err := cc.contractService.RemoveContractReview(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetContractReviewPage godoc
+// @Summary Get contract reviews of the user
+// @Description Get the available reviews of the user with the average rating
+// @Tags Contract
+// @Accept json
+// @Produce json
+// @Param user_id query string true "reviewee user id"
+// @Param role query string false "role of the reviewer" Enums(client, freelancer)
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=schema.GetContractReviewsResp}
+// @Router /answer/api/v1/contract/reviews [get]
+func (cc *ContractController) GetContractReviewPage(ctx *gin.Context) {
+	req := &schema.GetContractReviewsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := cc.contractService.GetContractReviewPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
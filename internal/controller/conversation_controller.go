@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/middleware"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/conversation"
+	"github.com/gin-gonic/gin"
+)
+
+// ConversationController conversation controller
+type ConversationController struct {
+	conversationService *conversation.ConversationService
+}
+
+// NewConversationController new controller
+func NewConversationController(conversationService *conversation.ConversationService) *ConversationController {
+	return &ConversationController{conversationService: conversationService}
+}
+
+// StartConversation godoc
+// @Summary Start conversation
+// @Description Send message to the other party of the contract or the job application
+// @Tags Conversation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.StartConversationReq true "StartConversation"
+// @Success 200 {object} handler.RespBody{data=schema.StartConversationResp}
+// @Router /answer/api/v1/conversation [post]
+func (cc *ConversationController) StartConversation(ctx *gin.Context) {
+	req := &schema.StartConversationReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.conversationService.StartConversation(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetConversationPage godoc
+// @Summary Get conversations of login user
+// @Description Get conversations of login user with the last message and the unread count
+// @Tags Conversation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.ConversationResp}}
+// @Router /answer/api/v1/conversations [get]
+func (cc *ConversationController) GetConversationPage(ctx *gin.Context) {
+	req := &schema.GetConversationsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.conversationService.GetConversationPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetMessagePage godoc
+// @Summary Get messages of conversation
+// @Description Get messages of the conversation with the read receipts, the latest first
+// @Tags Conversation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "conversation_id"
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.MessageResp}}
+// @Router /answer/api/v1/conversation/{id}/messages [get]
+func (cc *ConversationController) GetMessagePage(ctx *gin.Context) {
+	req := &schema.GetMessagesReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ConversationID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.conversationService.GetMessagePage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// SendMessage godoc
+// @Summary Send message
+// @Description Send message to the conversation
+// @Tags Conversation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "conversation_id"
+// @Param data body schema.SendMessageReq true "SendMessage"
+// @Success 200 {object} handler.RespBody{data=schema.MessageResp}
+// @Router /answer/api/v1/conversation/{id}/message [post]
+func (cc *ConversationController) SendMessage(ctx *gin.Context) {
+	req := &schema.SendMessageReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ConversationID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := cc.conversationService.SendMessage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// ReadConversation godoc
+// @Summary Mark conversation as read
+// @Description Mark all messages of the conversation as read by login user
+// @Tags Conversation
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "conversation_id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/conversation/{id}/read [put]
+func (cc *ConversationController) ReadConversation(ctx *gin.Context) {
+	req := &schema.ReadConversationReq{}
+	req.ConversationID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := cc.conversationService.ReadConversation(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetMessageAttachment godoc
+// @Summary Download message attachment
+// @Description Download the attachment of the message, only the members of the conversation can download it
+// @Tags Conversation
+// @Produce octet-stream
+// @Security ApiKeyAuth
+// @Param filepath path string true "the download path such as hash/123.pdf"
+// @Success 200 {file} file
+// @Router /answer/api/v1/conversation/attachment/{filepath} [get]
+func (cc *ConversationController) GetMessageAttachment(ctx *gin.Context) {
+	req := &schema.GetMessageAttachmentReq{FilePath: ctx.Param("filepath")}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	localPath, filename, err := cc.conversationService.GetMessageAttachment(ctx, req)
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	ctx.FileAttachment(localPath, filename)
+}
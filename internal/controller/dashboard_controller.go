@@ -20,7 +20,12 @@
 package controller
 
 import (
+	"fmt"
+	"net/http"
+	"time"
+
 	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/dashboard"
 	"github.com/gin-gonic/gin"
 )
@@ -53,3 +58,52 @@ func (ac *DashboardController) DashboardInfo(ctx *gin.Context) {
 		"info": info,
 	})
 }
+
+// MarketplaceStatistical godoc
+// @Summary get marketplace statistical
+// @Description get the statistical of the job postings, applications and freelancers
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param days query int false "days" default(30)
+// @Router /answer/admin/api/dashboard/marketplace [get]
+// @Success 200 {object} handler.RespBody{data=schema.MarketplaceStatisticalResp}
+func (ac *DashboardController) MarketplaceStatistical(ctx *gin.Context) {
+	req := &schema.GetMarketplaceStatisticalReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := ac.dashboardService.MarketplaceStatistical(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// ExportMarketplaceStatistical godoc
+// @Summary export marketplace statistical
+// @Description export the marketplace statistical as csv
+// @Tags admin
+// @Produce text/csv
+// @Security ApiKeyAuth
+// @Param days query int false "days" default(30)
+// @Param type query string false "export type" Enums(summary, jobs)
+// @Router /answer/admin/api/dashboard/marketplace/export [get]
+// @Success 200 {file} file
+func (ac *DashboardController) ExportMarketplaceStatistical(ctx *gin.Context) {
+	req := &schema.ExportMarketplaceStatisticalReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	if len(req.Type) == 0 {
+		req.Type = schema.MarketplaceExportTypeSummary
+	}
+
+	content, err := ac.dashboardService.ExportMarketplaceStatistical(ctx, req)
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	filename := fmt.Sprintf("marketplace-analytics-%s-%s.csv", req.Type, time.Now().Format("20060102"))
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	ctx.Data(http.StatusOK, "text/csv; charset=utf-8", content)
+}
@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller
+
+import (
+	"net/http"
+
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/service/email_reply"
+	"github.com/gin-gonic/gin"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// maxEmailReplySize the max size of the raw inbound email, the larger one is rejected
+const maxEmailReplySize = 10 << 20
+
+// EmailReplyController email reply controller
+type EmailReplyController struct {
+	emailReplyService *email_reply.EmailReplyService
+}
+
+// NewEmailReplyController new controller
+func NewEmailReplyController(emailReplyService *email_reply.EmailReplyService) *EmailReplyController {
+	return &EmailReplyController{emailReplyService: emailReplyService}
+}
+
+// IngestEmailReply ingest the reply to the notification email
+// @Summary ingest the reply to the notification email
+// @Description post the raw inbound email forwarded by the mail server as an answer or a comment
+// @Tags EmailReply
+// @Accept plain
+// @Produce json
+// @Param X-Answer-Ingest-Token header string true "the ingest token in the config"
+// @Param data body string true "the raw email in the RFC 5322 format"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/email/reply [post]
+func (ec *EmailReplyController) IngestEmailReply(ctx *gin.Context) {
+	if !ec.emailReplyService.CheckIngestToken(ctx.GetHeader("X-Answer-Ingest-Token")) {
+		handler.HandleResponse(ctx, errors.Unauthorized(reason.UnauthorizedError), nil)
+		return
+	}
+	body := http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxEmailReplySize)
+	err := ec.emailReplyService.HandleReply(ctx, body)
+	handler.HandleResponse(ctx, err, nil)
+}
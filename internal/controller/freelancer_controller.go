@@ -20,24 +20,47 @@
 package controller
 
 import (
+	"net/http"
+
 	"github.com/apache/answer/internal/base/handler"
 	"github.com/apache/answer/internal/base/middleware"
 	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/base/translator"
+	"github.com/apache/answer/internal/base/validator"
+	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/action"
 	"github.com/apache/answer/internal/service/freelancer"
+	"github.com/apache/answer/internal/service/job_matching"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/apache/answer/internal/service/rank"
 	"github.com/gin-gonic/gin"
 	"github.com/segmentfault/pacman/errors"
 )
 
 // FreelancerController freelancer controller
 type FreelancerController struct {
-	freelancerService *freelancer.FreelancerService
+	freelancerService   *freelancer.FreelancerService
+	rankService         *rank.RankService
+	jobMatchingService  *job_matching.JobMatchingService
+	actionService       *action.CaptchaService
+	rateLimitMiddleware *middleware.RateLimitMiddleware
 }
 
 // NewFreelancerController new freelancer controller
-func NewFreelancerController(freelancerService *freelancer.FreelancerService) *FreelancerController {
+func NewFreelancerController(
+	freelancerService *freelancer.FreelancerService,
+	rankService *rank.RankService,
+	jobMatchingService *job_matching.JobMatchingService,
+	actionService *action.CaptchaService,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
+) *FreelancerController {
 	return &FreelancerController{
-		freelancerService: freelancerService,
+		freelancerService:   freelancerService,
+		rankService:         rankService,
+		jobMatchingService:  jobMatchingService,
+		actionService:       actionService,
+		rateLimitMiddleware: rateLimitMiddleware,
 	}
 }
 
@@ -58,7 +81,14 @@ func (fc *FreelancerController) CreateFreelancerProfile(ctx *gin.Context) {
 	}
 
 	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
-	err := fc.freelancerService.CreateFreelancerProfile(ctx, req)
+	canAddTag, err := fc.rankService.CheckOperationPermission(ctx, req.LoginUserID, permission.TagAdd, "")
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanAddTag = canAddTag
+
+	err = fc.freelancerService.CreateFreelancerProfile(ctx, req)
 	handler.HandleResponse(ctx, err, nil)
 }
 
@@ -79,7 +109,14 @@ func (fc *FreelancerController) UpdateFreelancerProfile(ctx *gin.Context) {
 	}
 
 	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
-	err := fc.freelancerService.UpdateFreelancerProfile(ctx, req)
+	canAddTag, err := fc.rankService.CheckOperationPermission(ctx, req.LoginUserID, permission.TagAdd, "")
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanAddTag = canAddTag
+
+	err = fc.freelancerService.UpdateFreelancerProfile(ctx, req)
 	handler.HandleResponse(ctx, err, nil)
 }
 
@@ -110,11 +147,20 @@ func (fc *FreelancerController) GetFreelancerProfile(ctx *gin.Context) {
 // @Produce json
 // @Param page query int false "page"
 // @Param page_size query int false "page_size"
-// @Param skills query string false "skills"
-// @Param location query string false "location"
+// @Param q query string false "keywords"
+// @Param skills query string false "comma separated skills"
+// @Param skill_match query string false "skill_match" Enums(any, all)
 // @Param min_rate query number false "min_rate"
 // @Param max_rate query number false "max_rate"
 // @Param currency query string false "currency"
+// @Param experience_level query string false "experience_level" Enums(entry, intermediate, senior, expert)
+// @Param location_type query string false "location_type" Enums(remote, onsite, hybrid)
+// @Param availability query string false "availability"
+// @Param time_zone_from query string false "time zone window start, e.g. UTC-3"
+// @Param time_zone_to query string false "time zone window end, e.g. UTC+2"
+// @Param verified_only query boolean false "verified_only"
+// @Param sort query string false "sort" Enums(newest, budget, rating, relevance)
+// @Param display_currency query string false "ISO 4217 code of min_rate, max_rate and the displayed rates"
 // @Success 200 {object} handler.RespBody{data=schema.GetFreelancerProfilesResp}
 // @Router /answer/api/v1/freelancer/profiles [get]
 func (fc *FreelancerController) GetFreelancerProfiles(ctx *gin.Context) {
@@ -142,9 +188,42 @@ func (fc *FreelancerController) CreateJobPosting(ctx *gin.Context) {
 	if handler.BindAndCheck(ctx, req) {
 		return
 	}
+	reject, rejectKey := fc.rateLimitMiddleware.DuplicateRequestRejection(ctx, req)
+	if reject {
+		return
+	}
+	defer func() {
+		// If status is not 200 means that the bad request has been returned, so the record should be cleared
+		if ctx.Writer.Status() != http.StatusOK {
+			fc.rateLimitMiddleware.DuplicateRequestClear(ctx, rejectKey)
+		}
+	}()
 
 	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
-	err := fc.freelancerService.CreateJobPosting(ctx, req)
+	canList, requireRanks, err := fc.rankService.CheckOperationPermissionsForRanks(ctx, req.LoginUserID, []string{
+		permission.JobPostingAdd,
+		permission.TagAdd,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	if !canList[0] {
+		handler.HandleResponse(ctx, noEnoughRankError(ctx, requireRanks[0]), nil)
+		return
+	}
+	req.CanAddTag = canList[1]
+	isAdmin := middleware.GetUserIsAdminModerator(ctx)
+	if !isAdmin && !fc.verifyCaptcha(ctx, entity.CaptchaActionJobPosting, req.LoginUserID, req.CaptchaID, req.CaptchaCode) {
+		return
+	}
+
+	req.IP = ctx.ClientIP()
+	req.UserAgent = ctx.GetHeader("User-Agent")
+	err = fc.freelancerService.CreateJobPosting(ctx, req)
+	if err == nil && !isAdmin {
+		fc.actionService.ActionRecordAdd(ctx, entity.CaptchaActionJobPosting, req.LoginUserID)
+	}
 	handler.HandleResponse(ctx, err, nil)
 }
 
@@ -156,12 +235,20 @@ func (fc *FreelancerController) CreateJobPosting(ctx *gin.Context) {
 // @Produce json
 // @Param page query int false "page"
 // @Param page_size query int false "page_size"
-// @Param skills query string false "skills"
+// @Param q query string false "keywords"
+// @Param skills query string false "comma separated skills"
+// @Param skill_match query string false "skill_match" Enums(any, all)
 // @Param location query string false "location"
 // @Param min_budget query number false "min_budget"
 // @Param max_budget query number false "max_budget"
 // @Param currency query string false "currency"
 // @Param status query string false "status"
+// @Param experience_level query string false "experience_level" Enums(entry, intermediate, senior, expert)
+// @Param location_type query string false "location_type" Enums(remote, onsite, hybrid)
+// @Param verified_only query boolean false "verified_only"
+// @Param posted_within query int false "posted in the last days"
+// @Param sort query string false "sort" Enums(newest, budget, rating, relevance)
+// @Param display_currency query string false "ISO 4217 code of min_budget, max_budget and the displayed budgets"
 // @Success 200 {object} handler.RespBody{data=schema.GetJobPostingsResp}
 // @Router /answer/api/v1/job/postings [get]
 func (fc *FreelancerController) GetJobPostings(ctx *gin.Context) {
@@ -190,7 +277,433 @@ func (fc *FreelancerController) GetJobPosting(ctx *gin.Context) {
 		return
 	}
 
-	resp, err := fc.freelancerService.GetJobPosting(ctx, id)
+	req := &schema.GetJobPostingReq{ID: id}
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canList, err := fc.rankService.CheckOperationPermissions(ctx, req.LoginUserID, []string{
+		permission.JobPostingEdit,
+		permission.JobPostingDelete,
+		permission.JobPostingClose,
+		permission.JobPostingReopen,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanEdit = canList[0]
+	req.CanDelete = canList[1]
+	req.CanClose = canList[2]
+	req.CanReopen = canList[3]
+
+	resp, err := fc.freelancerService.GetJobPosting(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetJobPostingAnalytics godoc
+// @Summary Get job posting analytics
+// @Description Get the views, applications and hiring funnel of the job posting, only for the poster and admin
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "job_id"
+// @Success 200 {object} handler.RespBody{data=schema.JobPostingAnalyticsResp}
+// @Router /answer/api/v1/job/posting/{id}/analytics [get]
+func (fc *FreelancerController) GetJobPostingAnalytics(ctx *gin.Context) {
+	id := ctx.Param("id")
+	if id == "" {
+		handler.HandleResponse(ctx, errors.BadRequest(reason.RequestFormatError), nil)
+		return
+	}
+
+	req := &schema.GetJobPostingAnalyticsReq{ID: id}
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := fc.freelancerService.GetJobPostingAnalytics(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdateJobPosting godoc
+// @Summary Update job posting
+// @Description Update job posting, only the poster or the user who has edit permission can do it
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "job_id"
+// @Param data body schema.UpdateJobPostingReq true "UpdateJobPosting"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/posting/{id} [put]
+func (fc *FreelancerController) UpdateJobPosting(ctx *gin.Context) {
+	req := &schema.UpdateJobPostingReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canList, err := fc.rankService.CheckOperationPermissions(ctx, req.LoginUserID, []string{
+		permission.JobPostingEdit,
+		permission.TagAdd,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanEdit = canList[0]
+	req.CanAddTag = canList[1]
+
+	req.IP = ctx.ClientIP()
+	req.UserAgent = ctx.GetHeader("User-Agent")
+	err = fc.freelancerService.UpdateJobPosting(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// UpdateJobPostingStatus godoc
+// @Summary Update job posting status
+// @Description Close, fill or reopen the job posting
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "job_id"
+// @Param data body schema.UpdateJobPostingStatusReq true "UpdateJobPostingStatus"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/posting/{id}/status [put]
+func (fc *FreelancerController) UpdateJobPostingStatus(ctx *gin.Context) {
+	req := &schema.UpdateJobPostingStatusReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canList, err := fc.rankService.CheckOperationPermissions(ctx, req.LoginUserID, []string{
+		permission.JobPostingClose,
+		permission.JobPostingReopen,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanClose = canList[0]
+	req.CanReopen = canList[1]
+
+	err = fc.freelancerService.UpdateJobPostingStatus(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RenewJobPosting godoc
+// @Summary Renew job posting
+// @Description Extend the expiration time of the job posting, only the poster can do it
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "job_id"
+// @Param data body schema.RenewJobPostingReq true "RenewJobPosting"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/posting/{id}/renew [put]
+func (fc *FreelancerController) RenewJobPosting(ctx *gin.Context) {
+	req := &schema.RenewJobPostingReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := fc.freelancerService.RenewJobPosting(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RemoveJobPosting godoc
+// @Summary Remove job posting
+// @Description Remove job posting and all of its applications
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "job_id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/posting/{id} [delete]
+func (fc *FreelancerController) RemoveJobPosting(ctx *gin.Context) {
+	req := &schema.RemoveJobPostingReq{ID: ctx.Param("id")}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	can, err := fc.rankService.CheckOperationPermission(ctx, req.LoginUserID, permission.JobPostingDelete, "")
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanDelete = can
+
+	err = fc.freelancerService.RemoveJobPosting(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RequestVerification godoc
+// @Summary Request freelancer verification
+// @Description Request the identity verification with the evidence files uploaded with the verification_evidence source
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.RequestFreelancerVerificationReq true "RequestFreelancerVerificationReq"
+// @Success 200 {object} handler.RespBody{data=schema.FreelancerVerificationResp}
+// @Router /answer/api/v1/freelancer/verification [post]
+func (fc *FreelancerController) RequestVerification(ctx *gin.Context) {
+	req := &schema.RequestFreelancerVerificationReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := fc.freelancerService.RequestVerification(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetMyVerification godoc
+// @Summary Get my freelancer verification
+// @Description Get the latest verification request of the login user
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} handler.RespBody{data=schema.FreelancerVerificationResp}
+// @Router /answer/api/v1/freelancer/verification [get]
+func (fc *FreelancerController) GetMyVerification(ctx *gin.Context) {
+	userID := middleware.GetLoginUserIDFromContext(ctx)
+	resp, err := fc.freelancerService.GetMyVerification(ctx, userID)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// SaveJobSearch godoc
+// @Summary Save job search
+// @Description Save the job postings filter to be alerted of the new matching postings immediately or by a daily digest
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.SaveJobSearchReq true "SaveJobSearchReq"
+// @Success 200 {object} handler.RespBody{data=schema.SavedJobSearchResp}
+// @Router /answer/api/v1/job/saved-search [post]
+func (fc *FreelancerController) SaveJobSearch(ctx *gin.Context) {
+	req := &schema.SaveJobSearchReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := fc.freelancerService.SaveJobSearch(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdateSavedJobSearch godoc
+// @Summary Update saved job search
+// @Description Update the filter and the alert frequency of the saved job search
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "saved search id"
+// @Param data body schema.SaveJobSearchReq true "SaveJobSearchReq"
+// @Success 200 {object} handler.RespBody{data=schema.SavedJobSearchResp}
+// @Router /answer/api/v1/job/saved-search/{id} [put]
+func (fc *FreelancerController) UpdateSavedJobSearch(ctx *gin.Context) {
+	req := &schema.UpdateSavedJobSearchReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := fc.freelancerService.UpdateSavedJobSearch(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// RemoveSavedJobSearch godoc
+// @Summary Remove saved job search
+// @Description Remove the saved job search and stop its alerts
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "saved search id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/saved-search/{id} [delete]
+func (fc *FreelancerController) RemoveSavedJobSearch(ctx *gin.Context) {
+	req := &schema.RemoveSavedJobSearchReq{ID: ctx.Param("id")}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := fc.freelancerService.RemoveSavedJobSearch(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetSavedJobSearches godoc
+// @Summary Get saved job searches
+// @Description Get the saved job searches of the login user
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} handler.RespBody{data=[]schema.SavedJobSearchResp}
+// @Router /answer/api/v1/job/saved-searches [get]
+func (fc *FreelancerController) GetSavedJobSearches(ctx *gin.Context) {
+	userID := middleware.GetLoginUserIDFromContext(ctx)
+	resp, err := fc.freelancerService.GetSavedJobSearches(ctx, userID)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// AddPortfolioItem godoc
+// @Summary Add portfolio item
+// @Description Add the portfolio item with the uploaded images and files and the linked answers
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.AddPortfolioItemReq true "AddPortfolioItemReq"
+// @Success 200 {object} handler.RespBody{data=schema.PortfolioItemResp}
+// @Router /answer/api/v1/freelancer/portfolio/item [post]
+func (fc *FreelancerController) AddPortfolioItem(ctx *gin.Context) {
+	req := &schema.AddPortfolioItemReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canAddTag, err := fc.rankService.CheckOperationPermission(ctx, req.LoginUserID, permission.TagAdd, "")
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanAddTag = canAddTag
+
+	resp, err := fc.freelancerService.AddPortfolioItem(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdatePortfolioItem godoc
+// @Summary Update portfolio item
+// @Description Update the portfolio item of the login user
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "portfolio item id"
+// @Param data body schema.AddPortfolioItemReq true "AddPortfolioItemReq"
+// @Success 200 {object} handler.RespBody{data=schema.PortfolioItemResp}
+// @Router /answer/api/v1/freelancer/portfolio/item/{id} [put]
+func (fc *FreelancerController) UpdatePortfolioItem(ctx *gin.Context) {
+	req := &schema.UpdatePortfolioItemReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.ID = ctx.Param("id")
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canAddTag, err := fc.rankService.CheckOperationPermission(ctx, req.LoginUserID, permission.TagAdd, "")
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	req.CanAddTag = canAddTag
+
+	resp, err := fc.freelancerService.UpdatePortfolioItem(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// RemovePortfolioItem godoc
+// @Summary Remove portfolio item
+// @Description Remove the portfolio item of the login user
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path string true "portfolio item id"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/freelancer/portfolio/item/{id} [delete]
+func (fc *FreelancerController) RemovePortfolioItem(ctx *gin.Context) {
+	req := &schema.RemovePortfolioItemReq{ID: ctx.Param("id")}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := fc.freelancerService.RemovePortfolioItem(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetPortfolioItems godoc
+// @Summary Get portfolio items
+// @Description Get the portfolio items of the freelancer
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Param user_id query string true "user id"
+// @Success 200 {object} handler.RespBody{data=[]schema.PortfolioItemResp}
+// @Router /answer/api/v1/freelancer/portfolio [get]
+func (fc *FreelancerController) GetPortfolioItems(ctx *gin.Context) {
+	req := &schema.GetPortfolioItemsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := fc.freelancerService.GetPortfolioItems(ctx, req.UserID)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetSkillTag godoc
+// @Summary Get freelancers and open jobs of the skill tag
+// @Description Get the freelancers and the open job postings whose skills contain the tag or its synonyms
+// @Tags Tag
+// @Accept json
+// @Produce json
+// @Param tag_name query string true "tag slug name"
+// @Param limit query int false "limit"
+// @Success 200 {object} handler.RespBody{data=schema.GetSkillTagResp}
+// @Router /answer/api/v1/tag/skill [get]
+func (fc *FreelancerController) GetSkillTag(ctx *gin.Context) {
+	req := &schema.GetSkillTagReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := fc.freelancerService.GetSkillTag(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetRecommendedFreelancers godoc
+// @Summary Get recommended freelancers
+// @Description Get the available freelancers which match the job posting best, ordered by match score
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Param id path string true "job_id"
+// @Param limit query int false "limit"
+// @Success 200 {object} handler.RespBody{data=[]schema.RecommendedFreelancerResp}
+// @Router /answer/api/v1/job/posting/{id}/recommended-freelancers [get]
+func (fc *FreelancerController) GetRecommendedFreelancers(ctx *gin.Context) {
+	req := &schema.GetRecommendedFreelancersReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.JobID = ctx.Param("id")
+
+	resp, err := fc.jobMatchingService.GetRecommendedFreelancers(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetRecommendedJobs godoc
+// @Summary Get recommended job postings
+// @Description Get the open job postings which match the login user best, ordered by match score
+// @Tags Freelancer
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "limit"
+// @Success 200 {object} handler.RespBody{data=[]schema.RecommendedJobResp}
+// @Router /answer/api/v1/freelancer/recommended-jobs [get]
+func (fc *FreelancerController) GetRecommendedJobs(ctx *gin.Context) {
+	req := &schema.GetRecommendedJobsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	resp, err := fc.jobMatchingService.GetRecommendedJobs(ctx, req)
 	handler.HandleResponse(ctx, err, resp)
 }
 
@@ -209,9 +722,86 @@ func (fc *FreelancerController) CreateJobApplication(ctx *gin.Context) {
 	if handler.BindAndCheck(ctx, req) {
 		return
 	}
+	reject, rejectKey := fc.rateLimitMiddleware.DuplicateRequestRejection(ctx, req)
+	if reject {
+		return
+	}
+	defer func() {
+		// If status is not 200 means that the bad request has been returned, so the record should be cleared
+		if ctx.Writer.Status() != http.StatusOK {
+			fc.rateLimitMiddleware.DuplicateRequestClear(ctx, rejectKey)
+		}
+	}()
+
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canList, requireRanks, err := fc.rankService.CheckOperationPermissionsForRanks(ctx, req.LoginUserID, []string{
+		permission.JobApplicationAdd,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	if !canList[0] {
+		handler.HandleResponse(ctx, noEnoughRankError(ctx, requireRanks[0]), nil)
+		return
+	}
+	isAdmin := middleware.GetUserIsAdminModerator(ctx)
+	if !isAdmin && !fc.verifyCaptcha(ctx, entity.CaptchaActionJobApplication, req.LoginUserID, req.CaptchaID, req.CaptchaCode) {
+		return
+	}
+
+	err = fc.freelancerService.CreateJobApplication(ctx, req)
+	if err == nil && !isAdmin {
+		fc.actionService.ActionRecordAdd(ctx, entity.CaptchaActionJobApplication, req.LoginUserID)
+	}
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetJobApplications godoc
+// @Summary Get job applications
+// @Description Get applications of the job posted by login user, or the applications submitted by login user if job_id is empty
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param job_id query string false "job_id"
+// @Param status query string false "status" Enums(pending, accepted, rejected, withdrawn)
+// @Param page query int false "page"
+// @Param page_size query int false "page_size"
+// @Param display_currency query string false "ISO 4217 code of the displayed rates"
+// @Success 200 {object} handler.RespBody{data=schema.GetJobApplicationsResp}
+// @Router /answer/api/v1/job/applications [get]
+func (fc *FreelancerController) GetJobApplications(ctx *gin.Context) {
+	req := &schema.GetJobApplicationsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+	resp, err := fc.freelancerService.GetJobApplications(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdateJobApplicationStatus godoc
+// @Summary Update job application status
+// @Description The poster accepts or rejects an application, the applicant withdraws it
+// @Tags Job
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.UpdateJobApplicationStatusReq true "UpdateJobApplicationStatus"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/api/v1/job/application/status [put]
+func (fc *FreelancerController) UpdateJobApplicationStatus(ctx *gin.Context) {
+	req := &schema.UpdateJobApplicationStatusReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
 
 	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
-	err := fc.freelancerService.CreateJobApplication(ctx, req)
+	req.IsAdmin = middleware.GetUserIsAdminModerator(ctx)
+	err := fc.freelancerService.UpdateJobApplicationStatus(ctx, req)
 	handler.HandleResponse(ctx, err, nil)
 }
 
@@ -230,8 +820,58 @@ func (fc *FreelancerController) HireFreelancer(ctx *gin.Context) {
 	if handler.BindAndCheck(ctx, req) {
 		return
 	}
+	reject, rejectKey := fc.rateLimitMiddleware.DuplicateRequestRejection(ctx, req)
+	if reject {
+		return
+	}
+	defer func() {
+		// If status is not 200 means that the bad request has been returned, so the record should be cleared
+		if ctx.Writer.Status() != http.StatusOK {
+			fc.rateLimitMiddleware.DuplicateRequestClear(ctx, rejectKey)
+		}
+	}()
 
 	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	canList, requireRanks, err := fc.rankService.CheckOperationPermissionsForRanks(ctx, req.LoginUserID, []string{
+		permission.FreelancerHire,
+	})
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	if !canList[0] {
+		handler.HandleResponse(ctx, noEnoughRankError(ctx, requireRanks[0]), nil)
+		return
+	}
+	isAdmin := middleware.GetUserIsAdminModerator(ctx)
+	if !isAdmin && !fc.verifyCaptcha(ctx, entity.CaptchaActionHire, req.LoginUserID, req.CaptchaID, req.CaptchaCode) {
+		return
+	}
+
 	resp, err := fc.freelancerService.HireFreelancer(ctx, req)
+	if err == nil && !isAdmin {
+		fc.actionService.ActionRecordAdd(ctx, entity.CaptchaActionHire, req.LoginUserID)
+	}
 	handler.HandleResponse(ctx, err, resp)
 }
+
+// verifyCaptcha verify the captcha if the action is done too frequently, the error is responded if failed
+func (fc *FreelancerController) verifyCaptcha(ctx *gin.Context, actionType, userID, captchaID, captchaCode string) (
+	pass bool) {
+	if fc.actionService.ActionRecordVerifyCaptcha(ctx, actionType, userID, captchaID, captchaCode) {
+		return true
+	}
+	errFields := append([]*validator.FormErrorField{}, &validator.FormErrorField{
+		ErrorField: "captcha_code",
+		ErrorMsg:   translator.Tr(handler.GetLang(ctx), reason.CaptchaVerificationFailed),
+	})
+	handler.HandleResponse(ctx, errors.BadRequest(reason.CaptchaVerificationFailed), errFields)
+	return false
+}
+
+// noEnoughRankError the error with the reputation which is required to do the operation
+func noEnoughRankError(ctx *gin.Context, requireRank int) error {
+	msg := translator.TrWithData(handler.GetLang(ctx), reason.NoEnoughRankToOperate,
+		&schema.PermissionTrTplData{Rank: requireRank})
+	return errors.Forbidden(reason.NoEnoughRankToOperate).WithMsg(msg)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/apache/answer/internal/service/content"
 	"github.com/apache/answer/internal/service/permission"
 	"github.com/apache/answer/internal/service/rank"
+	"github.com/apache/answer/internal/service/related_question"
 	"github.com/apache/answer/internal/service/siteinfo_common"
 	"github.com/apache/answer/pkg/uid"
 	"github.com/gin-gonic/gin"
@@ -49,6 +50,7 @@ type QuestionController struct {
 	siteInfoService     siteinfo_common.SiteInfoCommonService
 	actionService       *action.CaptchaService
 	rateLimitMiddleware *middleware.RateLimitMiddleware
+	relatedService      *related_question.RelatedQuestionService
 }
 
 // NewQuestionController new controller
@@ -59,6 +61,7 @@ func NewQuestionController(
 	siteInfoService siteinfo_common.SiteInfoCommonService,
 	actionService *action.CaptchaService,
 	rateLimitMiddleware *middleware.RateLimitMiddleware,
+	relatedService *related_question.RelatedQuestionService,
 ) *QuestionController {
 	return &QuestionController{
 		questionService:     questionService,
@@ -67,6 +70,7 @@ func NewQuestionController(
 		siteInfoService:     siteInfoService,
 		actionService:       actionService,
 		rateLimitMiddleware: rateLimitMiddleware,
+		relatedService:      relatedService,
 	}
 }
 
@@ -290,6 +294,24 @@ func (qc *QuestionController) GetQuestionInviteUserInfo(ctx *gin.Context) {
 
 }
 
+// GetRelatedQuestions godoc
+// @Summary get related questions
+// @Description get the questions related by shared tags, links between questions and common title terms
+// @Tags Question
+// @Produce json
+// @Param question_id query string true "question_id"
+// @Success 200 {object} handler.RespBody{data=[]schema.QuestionBaseInfo}
+// @Router /answer/api/v1/question/related [get]
+func (qc *QuestionController) GetRelatedQuestions(ctx *gin.Context) {
+	questionID := ctx.Query("question_id")
+	if len(questionID) == 0 {
+		handler.HandleResponse(ctx, errors.BadRequest(reason.RequestFormatError), nil)
+		return
+	}
+	resp, err := qc.relatedService.GetRelatedQuestions(ctx, questionID)
+	handler.HandleResponse(ctx, err, resp)
+}
+
 // SimilarQuestion godoc
 // @Summary Search Similar Question
 // @Description Search Similar Question
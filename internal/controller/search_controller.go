@@ -20,6 +20,7 @@
 package controller
 
 import (
+	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/handler"
 	"github.com/apache/answer/internal/base/middleware"
 	"github.com/apache/answer/internal/base/reason"
@@ -36,18 +37,21 @@ import (
 
 // SearchController tag controller
 type SearchController struct {
-	searchService *content.SearchService
-	actionService *action.CaptchaService
+	searchService       *content.SearchService
+	actionService       *action.CaptchaService
+	rateLimitMiddleware *middleware.RateLimitMiddleware
 }
 
 // NewSearchController new controller
 func NewSearchController(
 	searchService *content.SearchService,
 	actionService *action.CaptchaService,
+	rateLimitMiddleware *middleware.RateLimitMiddleware,
 ) *SearchController {
 	return &SearchController{
-		searchService: searchService,
-		actionService: actionService,
+		searchService:       searchService,
+		actionService:       actionService,
+		rateLimitMiddleware: rateLimitMiddleware,
 	}
 }
 
@@ -92,6 +96,28 @@ func (sc *SearchController) Search(ctx *gin.Context) {
 	handler.HandleResponse(ctx, err, resp)
 }
 
+// Suggest godoc
+// @Summary search suggestions
+// @Description get questions, tags and users matching the typed query, ranked by prefix match and popularity
+// @Tags Search
+// @Produce json
+// @Param q query string true "query string"
+// @Param size query int false "size" default(10)
+// @Success 200 {object} handler.RespBody{data=[]schema.SearchSuggestResp}
+// @Router /answer/api/v1/search/suggest [get]
+func (sc *SearchController) Suggest(ctx *gin.Context) {
+	req := &schema.SearchSuggestReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	if sc.rateLimitMiddleware.FrequentRequestRejection(ctx,
+		constant.SearchSuggestRateLimitMax, constant.SearchSuggestRateLimitWindow) {
+		return
+	}
+	resp, err := sc.searchService.Suggest(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
 // SearchDesc get search description
 // @Summary get search description
 // @Description get search description
@@ -29,7 +29,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/apache/answer/internal/base/middleware"
 	"github.com/apache/answer/internal/base/pager"
 	"github.com/apache/answer/internal/service/content"
 	"github.com/apache/answer/internal/service/event_queue"
@@ -41,6 +40,7 @@ import (
 	templaterender "github.com/apache/answer/internal/controller/template_render"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/related_question"
 	"github.com/apache/answer/internal/service/siteinfo_common"
 	"github.com/apache/answer/pkg/checker"
 	"github.com/apache/answer/pkg/converter"
@@ -62,6 +62,7 @@ type TemplateController struct {
 	eventQueueService        event_queue.EventQueueService
 	userService              *content.UserService
 	questionService          *content.QuestionService
+	relatedQuestionService   *related_question.RelatedQuestionService
 }
 
 // NewTemplateController new controller
@@ -71,6 +72,7 @@ func NewTemplateController(
 	eventQueueService event_queue.EventQueueService,
 	userService *content.UserService,
 	questionService *content.QuestionService,
+	relatedQuestionService *related_question.RelatedQuestionService,
 ) *TemplateController {
 	script, css := GetStyle()
 	return &TemplateController{
@@ -81,6 +83,7 @@ func NewTemplateController(
 		eventQueueService:        eventQueueService,
 		userService:              userService,
 		questionService:          questionService,
+		relatedQuestionService:   relatedQuestionService,
 	}
 }
 func GetStyle() (script []string, css string) {
@@ -378,8 +381,10 @@ func (tc *TemplateController) QuestionInfo(ctx *gin.Context) {
 	}
 
 	//related question
-	userID := middleware.GetLoginUserIDFromContext(ctx)
-	relatedQuestion, _, _ := tc.questionService.SimilarQuestion(ctx, id, userID)
+	relatedQuestion, err := tc.relatedQuestionService.GetRelatedQuestions(ctx, id)
+	if err != nil {
+		log.Error(err)
+	}
 
 	siteInfo.Canonical = fmt.Sprintf("%s/questions/%s/%s", siteInfo.General.SiteUrl, id, encodeTitle)
 	if siteInfo.SiteSeo.Permalink == constant.PermalinkQuestionID || siteInfo.SiteSeo.Permalink == constant.PermalinkQuestionIDByShortID {
@@ -565,6 +570,96 @@ func (tc *TemplateController) UserInfo(ctx *gin.Context) {
 
 }
 
+// JobList open job posting list
+func (tc *TemplateController) JobList(ctx *gin.Context) {
+	req := &schema.GetJobPostingsReq{
+		Page:     1,
+		PageSize: constant.DefaultPageSize,
+	}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.Status = entity.JobPostingStatusOpen
+	data, err := tc.templateRenderController.JobList(ctx, req)
+	if err != nil || (len(data.List) == 0 && pager.ValPageOutOfRange(int64(data.Count), req.Page, req.PageSize)) {
+		tc.Page404(ctx)
+		return
+	}
+
+	siteInfo := tc.SiteInfo(ctx)
+	siteInfo.Canonical = fmt.Sprintf("%s/jobs", siteInfo.General.SiteUrl)
+	if req.Page > 1 {
+		siteInfo.Canonical = fmt.Sprintf("%s/jobs?page=%d", siteInfo.General.SiteUrl, req.Page)
+	}
+	siteInfo.Title = fmt.Sprintf("%s - %s", translator.Tr(handler.GetLang(ctx), constant.JobsTitleTrKey), siteInfo.General.Name)
+	tc.html(ctx, http.StatusOK, "jobs.html", siteInfo, gin.H{
+		"data": data,
+		"page": templaterender.Paginator(req.Page, req.PageSize, int64(data.Count)),
+	})
+}
+
+// JobInfo job posting detail, the closed or expired posting is not indexed and has no JobPosting json-ld
+func (tc *TemplateController) JobInfo(ctx *gin.Context) {
+	id := uid.DeShortID(ctx.Param("id"))
+	detail, poster, err := tc.templateRenderController.JobDetail(ctx, id)
+	if err != nil {
+		tc.Page404(ctx)
+		return
+	}
+	closed := templaterender.IsJobPostingClosed(detail, time.Now())
+
+	siteInfo := tc.SiteInfo(ctx)
+	siteInfo.Canonical = fmt.Sprintf("%s/jobs/%s", siteInfo.General.SiteUrl, detail.ID)
+	description := detail.DescriptionHTML
+	if len(description) == 0 {
+		description = detail.Description
+	}
+	if !closed {
+		jsonLD := templaterender.JobPostingJsonLD(detail, poster, siteInfo.General.SiteUrl, siteInfo.Canonical)
+		jsonLDStr, err := json.Marshal(jsonLD)
+		if err == nil {
+			siteInfo.JsonLD = `<script data-react-helmet="true" type="application/ld+json">` + string(jsonLDStr) + ` </script>`
+		}
+	}
+
+	siteInfo.Description = htmltext.FetchExcerpt(description, "...", 240)
+	siteInfo.Keywords = strings.Join(detail.Skills, ",")
+	siteInfo.Title = fmt.Sprintf("%s - %s", detail.Title, siteInfo.General.Name)
+	tc.html(ctx, http.StatusOK, "job-detail.html", siteInfo, gin.H{
+		"detail":  detail,
+		"poster":  poster,
+		"closed":  closed,
+		"noindex": closed,
+	})
+}
+
+// FreelancerInfo freelancer profile
+func (tc *TemplateController) FreelancerInfo(ctx *gin.Context) {
+	username := ctx.Param("username")
+	userInfo, profile, err := tc.templateRenderController.FreelancerInfo(ctx, username)
+	if err != nil {
+		tc.Page404(ctx)
+		return
+	}
+
+	siteInfo := tc.SiteInfo(ctx)
+	siteInfo.Canonical = fmt.Sprintf("%s/freelancers/%s", siteInfo.General.SiteUrl, userInfo.Username)
+	jsonLD := templaterender.FreelancerJsonLD(userInfo, profile, siteInfo.Canonical)
+	jsonLDStr, err := json.Marshal(jsonLD)
+	if err == nil {
+		siteInfo.JsonLD = `<script data-react-helmet="true" type="application/ld+json">` + string(jsonLDStr) + ` </script>`
+	}
+
+	siteInfo.Description = jsonLD.Description
+	siteInfo.Keywords = strings.Join(profile.Skills, ",")
+	siteInfo.Title = fmt.Sprintf("%s - %s - %s", userInfo.DisplayName,
+		translator.Tr(handler.GetLang(ctx), constant.FreelancerTitleTrKey), siteInfo.General.Name)
+	tc.html(ctx, http.StatusOK, "freelancer.html", siteInfo, gin.H{
+		"userinfo": userInfo,
+		"profile":  profile,
+	})
+}
+
 func (tc *TemplateController) Page404(ctx *gin.Context) {
 	tc.html(ctx, http.StatusNotFound, "404.html", tc.SiteInfo(ctx), gin.H{})
 }
@@ -650,18 +745,26 @@ func (tc *TemplateController) SitemapPage(ctx *gin.Context) {
 	}
 	page := 0
 	pageParam := ctx.Param("page")
-	pageRegexp := regexp.MustCompile(`question-(.*).xml`)
+	pageRegexp := regexp.MustCompile(`(question|job|freelancer)-(.*).xml`)
 	pageStr := pageRegexp.FindStringSubmatch(pageParam)
-	if len(pageStr) != 2 {
+	if len(pageStr) != 3 {
 		tc.Page404(ctx)
 		return
 	}
-	page = converter.StringToInt(pageStr[1])
+	page = converter.StringToInt(pageStr[2])
 	if page == 0 {
 		tc.Page404(ctx)
 		return
 	}
-	err := tc.templateRenderController.SitemapPage(ctx, page)
+	var err error
+	switch pageStr[1] {
+	case "job":
+		err = tc.templateRenderController.SitemapJobPostingPage(ctx, page)
+	case "freelancer":
+		err = tc.templateRenderController.SitemapFreelancerPage(ctx, page)
+	default:
+		err = tc.templateRenderController.SitemapPage(ctx, page)
+	}
 	if err != nil {
 		tc.Page404(ctx)
 		return
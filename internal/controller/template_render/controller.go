@@ -22,11 +22,14 @@ package templaterender
 import (
 	"math"
 
+	freelancerrepo "github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/service/content"
+	"github.com/apache/answer/internal/service/freelancer"
 	questioncommon "github.com/apache/answer/internal/service/question_common"
 
 	"github.com/apache/answer/internal/service/comment"
 	"github.com/apache/answer/internal/service/siteinfo_common"
+	usercommon "github.com/apache/answer/internal/service/user_common"
 	"github.com/google/wire"
 
 	"github.com/apache/answer/internal/schema"
@@ -46,6 +49,10 @@ type TemplateRenderController struct {
 	commentService  *comment.CommentService
 	siteInfoService siteinfo_common.SiteInfoCommonService
 	questionRepo    questioncommon.QuestionRepo
+
+	freelancerService *freelancer.FreelancerService
+	freelancerRepo    freelancerrepo.FreelancerRepo
+	userCommon        *usercommon.UserCommon
 }
 
 func NewTemplateRenderController(
@@ -56,6 +63,9 @@ func NewTemplateRenderController(
 	commentService *comment.CommentService,
 	siteInfoService siteinfo_common.SiteInfoCommonService,
 	questionRepo questioncommon.QuestionRepo,
+	freelancerService *freelancer.FreelancerService,
+	freelancerRepo freelancerrepo.FreelancerRepo,
+	userCommon *usercommon.UserCommon,
 ) *TemplateRenderController {
 	return &TemplateRenderController{
 		questionService: questionService,
@@ -65,6 +75,10 @@ func NewTemplateRenderController(
 		commentService:  commentService,
 		questionRepo:    questionRepo,
 		siteInfoService: siteInfoService,
+
+		freelancerService: freelancerService,
+		freelancerRepo:    freelancerRepo,
+		userCommon:        userCommon,
 	}
 }
 
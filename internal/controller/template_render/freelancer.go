@@ -0,0 +1,216 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package templaterender
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/checker"
+	"github.com/apache/answer/pkg/htmltext"
+	"github.com/gin-gonic/gin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+func (t *TemplateRenderController) JobList(ctx context.Context, req *schema.GetJobPostingsReq) (*schema.GetJobPostingsResp, error) {
+	return t.freelancerService.GetJobPostings(ctx, req)
+}
+
+// JobDetail get the job posting and the basic info of the poster
+func (t *TemplateRenderController) JobDetail(ctx context.Context, id string) (
+	resp *schema.JobPostingResp, poster *schema.UserBasicInfo, err error) {
+	resp, err = t.freelancerService.GetJobPosting(ctx, &schema.GetJobPostingReq{ID: id})
+	if err != nil {
+		return nil, nil, err
+	}
+	poster, exist, err := t.userCommon.GetUserBasicInfoByID(ctx, resp.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exist {
+		return nil, nil, errors.NotFound(reason.UserNotFound)
+	}
+	return resp, poster, nil
+}
+
+// FreelancerInfo get the user info and the freelancer profile by the username
+func (t *TemplateRenderController) FreelancerInfo(ctx context.Context, username string) (
+	userInfo *schema.GetOtherUserInfoByUsernameResp, profile *schema.FreelancerProfileResp, err error) {
+	userInfo, err = t.userService.GetOtherUserInfoByUsername(ctx, &schema.GetOtherUserInfoByUsernameReq{Username: username})
+	if err != nil {
+		return nil, nil, err
+	}
+	profile, err = t.freelancerService.GetFreelancerProfile(ctx, &schema.GetFreelancerProfileReq{UserID: userInfo.ID})
+	if err != nil {
+		return nil, nil, err
+	}
+	return userInfo, profile, nil
+}
+
+func (t *TemplateRenderController) SitemapJobPostingPage(ctx *gin.Context, page int) error {
+	general, err := t.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		log.Error("get site general failed:", err)
+		return err
+	}
+	jobPostings, err := t.freelancerRepo.SitemapJobPostings(ctx, page, constant.SitemapMaxSize)
+	if err != nil {
+		log.Errorf("get sitemap job postings failed: %s", err)
+		return err
+	}
+	ctx.Header("Content-Type", "application/xml")
+	ctx.HTML(
+		http.StatusOK, "sitemap.xml", gin.H{
+			"xmlHeader":   template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
+			"jobPostings": jobPostings,
+			"general":     general,
+		},
+	)
+	return nil
+}
+
+func (t *TemplateRenderController) SitemapFreelancerPage(ctx *gin.Context, page int) error {
+	general, err := t.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		log.Error("get site general failed:", err)
+		return err
+	}
+	freelancers, err := t.freelancerRepo.SitemapFreelancers(ctx, page, constant.SitemapMaxSize)
+	if err != nil {
+		log.Errorf("get sitemap freelancers failed: %s", err)
+		return err
+	}
+	ctx.Header("Content-Type", "application/xml")
+	ctx.HTML(
+		http.StatusOK, "sitemap.xml", gin.H{
+			"xmlHeader":   template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
+			"freelancers": freelancers,
+			"general":     general,
+		},
+	)
+	return nil
+}
+
+// sitemapListingPages the sitemap page numbers of the job postings and the freelancers
+func (t *TemplateRenderController) sitemapListingPages(ctx context.Context) (jobPages, freelancerPages []int, err error) {
+	jobPostingNum, err := t.freelancerRepo.GetSitemapJobPostingCount(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	freelancerNum, err := t.freelancerRepo.GetSitemapFreelancerCount(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sitemapPageList(jobPostingNum), sitemapPageList(freelancerNum), nil
+}
+
+func sitemapPageList(count int64) (pageList []int) {
+	totalPages := int(math.Ceil(float64(count) / float64(constant.SitemapMaxSize)))
+	for i := 1; i <= totalPages; i++ {
+		pageList = append(pageList, i)
+	}
+	return pageList
+}
+
+// IsJobPostingClosed the job posting is closed when it is not open, not active or expired
+func IsJobPostingClosed(detail *schema.JobPostingResp, now time.Time) bool {
+	return detail.Status != entity.JobPostingStatusOpen || !detail.IsActive ||
+		(detail.ExpiresAt > 0 && detail.ExpiresAt <= now.Unix())
+}
+
+// JobPostingJsonLD the JobPosting json-ld of the job posting, the poster is the hiring organization
+func JobPostingJsonLD(detail *schema.JobPostingResp, poster *schema.UserBasicInfo, siteURL, canonical string) (
+	jsonLD *schema.JobPostingJsonLD) {
+	description := detail.DescriptionHTML
+	if len(description) == 0 {
+		description = detail.Description
+	}
+	jsonLD = &schema.JobPostingJsonLD{}
+	jsonLD.Context = "https://schema.org"
+	jsonLD.Type = "JobPosting"
+	jsonLD.Title = detail.Title
+	jsonLD.Description = description
+	jsonLD.DatePosted = time.Unix(detail.CreatedAt, 0)
+	if detail.ExpiresAt > 0 {
+		validThrough := time.Unix(detail.ExpiresAt, 0)
+		jsonLD.ValidThrough = &validThrough
+	}
+	jsonLD.URL = canonical
+	jsonLD.HiringOrganization.Type = "Organization"
+	jsonLD.HiringOrganization.Name = poster.DisplayName
+	jsonLD.HiringOrganization.SameAs = fmt.Sprintf("%s/users/%s", siteURL, poster.Username)
+	switch detail.Location {
+	case "remote":
+		jsonLD.JobLocationType = "TELECOMMUTE"
+	case "", "onsite", "hybrid":
+	default:
+		jsonLD.JobLocation = &schema.JobLocationItem{Type: "Place"}
+		jsonLD.JobLocation.Address.Type = "PostalAddress"
+		jsonLD.JobLocation.Address.AddressLocality = detail.Location
+	}
+	if detail.Budget > 0 && len(detail.Currency) > 0 {
+		jsonLD.BaseSalary = &schema.MonetaryAmountItem{Type: "MonetaryAmount", Currency: detail.Currency}
+		jsonLD.BaseSalary.Value.Type = "QuantitativeValue"
+		jsonLD.BaseSalary.Value.Value = detail.Budget
+		if detail.BudgetType == "hourly" {
+			jsonLD.BaseSalary.Value.UnitText = "HOUR"
+		}
+	}
+	jsonLD.Skills = strings.Join(detail.Skills, ", ")
+	jsonLD.ExperienceRequirements = detail.ExperienceLevel
+	return jsonLD
+}
+
+// FreelancerJsonLD the Person json-ld of the freelancer profile
+func FreelancerJsonLD(userInfo *schema.GetOtherUserInfoByUsernameResp, profile *schema.FreelancerProfileResp,
+	canonical string) (jsonLD *schema.PersonJsonLD) {
+	bio := profile.BioHTML
+	if len(bio) == 0 {
+		bio = profile.Bio
+	}
+	jsonLD = &schema.PersonJsonLD{}
+	jsonLD.Context = "https://schema.org"
+	jsonLD.Type = "Person"
+	jsonLD.Name = userInfo.DisplayName
+	jsonLD.AlternateName = userInfo.Username
+	jsonLD.URL = canonical
+	if checker.IsURL(userInfo.Avatar) {
+		jsonLD.Image = userInfo.Avatar
+	}
+	jsonLD.Description = htmltext.FetchExcerpt(bio, "...", 240)
+	jsonLD.KnowsAbout = profile.Skills
+	jsonLD.KnowsLanguage = profile.Languages
+	for _, link := range []string{profile.LinkedInProfile, profile.GitHubProfile, profile.Website} {
+		if checker.IsURL(link) {
+			jsonLD.SameAs = append(jsonLD.SameAs, link)
+		}
+	}
+	return jsonLD
+}
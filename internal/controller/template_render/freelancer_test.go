@@ -0,0 +1,156 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package templaterender
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsJobPostingClosed(t *testing.T) {
+	now := time.Now()
+	open := func() *schema.JobPostingResp {
+		return &schema.JobPostingResp{Status: entity.JobPostingStatusOpen, IsActive: true,
+			ExpiresAt: now.Add(time.Hour).Unix()}
+	}
+	assert.False(t, IsJobPostingClosed(open(), now))
+
+	noExpiry := open()
+	noExpiry.ExpiresAt = 0
+	assert.False(t, IsJobPostingClosed(noExpiry, now))
+
+	expired := open()
+	expired.ExpiresAt = now.Add(-time.Hour).Unix()
+	assert.True(t, IsJobPostingClosed(expired, now))
+
+	filled := open()
+	filled.Status = entity.JobPostingStatusFilled
+	assert.True(t, IsJobPostingClosed(filled, now))
+
+	inactive := open()
+	inactive.IsActive = false
+	assert.True(t, IsJobPostingClosed(inactive, now))
+}
+
+func TestJobPostingJsonLD(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+	detail := &schema.JobPostingResp{
+		ID:              "10010000000000001",
+		Title:           "Build a Go API",
+		Description:     "plain",
+		DescriptionHTML: "<p>html</p>",
+		Budget:          50,
+		Currency:        "EUR",
+		BudgetType:      "hourly",
+		Skills:          []string{"go", "docker"},
+		ExperienceLevel: "senior",
+		Location:        "Berlin",
+		CreatedAt:       createdAt.Unix(),
+		ExpiresAt:       createdAt.AddDate(0, 0, 30).Unix(),
+	}
+	poster := &schema.UserBasicInfo{Username: "acme", DisplayName: "Acme Inc"}
+
+	data, err := json.Marshal(JobPostingJsonLD(detail, poster, "https://example.com",
+		"https://example.com/jobs/10010000000000001"))
+	require.NoError(t, err)
+	result := make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	assert.Equal(t, "https://schema.org", result["@context"])
+	assert.Equal(t, "JobPosting", result["@type"])
+	assert.Equal(t, "Build a Go API", result["title"])
+	assert.Equal(t, "<p>html</p>", result["description"])
+	datePosted, err := time.Parse(time.RFC3339, result["datePosted"].(string))
+	require.NoError(t, err)
+	assert.True(t, createdAt.Equal(datePosted))
+	validThrough, err := time.Parse(time.RFC3339, result["validThrough"].(string))
+	require.NoError(t, err)
+	assert.True(t, createdAt.AddDate(0, 0, 30).Equal(validThrough))
+	assert.Equal(t, "https://example.com/jobs/10010000000000001", result["url"])
+	assert.Equal(t, map[string]any{
+		"@type": "Organization", "name": "Acme Inc", "sameAs": "https://example.com/users/acme",
+	}, result["hiringOrganization"])
+	assert.Equal(t, map[string]any{
+		"@type": "Place",
+		"address": map[string]any{
+			"@type": "PostalAddress", "addressLocality": "Berlin",
+		},
+	}, result["jobLocation"])
+	assert.NotContains(t, result, "jobLocationType")
+	assert.Equal(t, map[string]any{
+		"@type": "MonetaryAmount", "currency": "EUR",
+		"value": map[string]any{
+			"@type": "QuantitativeValue", "value": float64(50), "unitText": "HOUR",
+		},
+	}, result["baseSalary"])
+	assert.Equal(t, "go, docker", result["skills"])
+	assert.Equal(t, "senior", result["experienceRequirements"])
+
+	// the remote job has no location, the negotiable budget and the missing expiry are omitted
+	detail.Location = "remote"
+	detail.Budget = 0
+	detail.ExpiresAt = 0
+	data, err = json.Marshal(JobPostingJsonLD(detail, poster, "https://example.com", ""))
+	require.NoError(t, err)
+	result = make(map[string]any)
+	require.NoError(t, json.Unmarshal(data, &result))
+	assert.Equal(t, "TELECOMMUTE", result["jobLocationType"])
+	assert.NotContains(t, result, "jobLocation")
+	assert.NotContains(t, result, "baseSalary")
+	assert.NotContains(t, result, "validThrough")
+}
+
+func TestFreelancerJsonLD(t *testing.T) {
+	userInfo := &schema.GetOtherUserInfoByUsernameResp{
+		Username: "jane", DisplayName: "Jane Doe", Avatar: "https://example.com/uploads/avatar/jane.png",
+	}
+	profile := &schema.FreelancerProfileResp{
+		Bio:             "plain",
+		BioHTML:         "<p>Go developer</p>",
+		Skills:          []string{"go", "react"},
+		Languages:       []string{"en", "de"},
+		LinkedInProfile: "https://linkedin.com/in/jane",
+		GitHubProfile:   "not a url",
+		Website:         "https://jane.dev",
+	}
+
+	jsonLD := FreelancerJsonLD(userInfo, profile, "https://example.com/freelancers/jane")
+	assert.Equal(t, "Person", jsonLD.Type)
+	assert.Equal(t, "Jane Doe", jsonLD.Name)
+	assert.Equal(t, "jane", jsonLD.AlternateName)
+	assert.Equal(t, "https://example.com/freelancers/jane", jsonLD.URL)
+	assert.Equal(t, "https://example.com/uploads/avatar/jane.png", jsonLD.Image)
+	assert.Equal(t, "Go developer", jsonLD.Description)
+	assert.Equal(t, []string{"go", "react"}, jsonLD.KnowsAbout)
+	assert.Equal(t, []string{"en", "de"}, jsonLD.KnowsLanguage)
+	assert.Equal(t, []string{"https://linkedin.com/in/jane", "https://jane.dev"}, jsonLD.SameAs)
+
+	// the avatar which is not a url is not used as the image
+	userInfo.Avatar = ""
+	data, err := json.Marshal(FreelancerJsonLD(userInfo, profile, ""))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), `"image"`)
+}
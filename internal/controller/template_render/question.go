@@ -56,13 +56,26 @@ func (t *TemplateRenderController) Sitemap(ctx *gin.Context) {
 		return
 	}
 
+	jobPostings, err := t.freelancerRepo.SitemapJobPostings(ctx, 1, constant.SitemapMaxSize)
+	if err != nil {
+		log.Errorf("get sitemap job postings failed: %s", err)
+		return
+	}
+	freelancers, err := t.freelancerRepo.SitemapFreelancers(ctx, 1, constant.SitemapMaxSize)
+	if err != nil {
+		log.Errorf("get sitemap freelancers failed: %s", err)
+		return
+	}
+
 	ctx.Header("Content-Type", "application/xml")
-	if len(questions) < constant.SitemapMaxSize {
+	if len(questions)+len(jobPostings)+len(freelancers) < constant.SitemapMaxSize {
 		ctx.HTML(
 			http.StatusOK, "sitemap.xml", gin.H{
-				"xmlHeader": template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
-				"list":      questions,
-				"general":   general,
+				"xmlHeader":   template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
+				"list":        questions,
+				"jobPostings": jobPostings,
+				"freelancers": freelancers,
+				"general":     general,
 				"hastitle": siteInfo.Permalink == constant.PermalinkQuestionIDAndTitle ||
 					siteInfo.Permalink == constant.PermalinkQuestionIDAndTitleByShortID,
 			},
@@ -80,11 +93,18 @@ func (t *TemplateRenderController) Sitemap(ctx *gin.Context) {
 	for i := 1; i <= totalPages; i++ {
 		pageList = append(pageList, i)
 	}
+	jobPageList, freelancerPageList, err := t.sitemapListingPages(ctx)
+	if err != nil {
+		log.Error("get sitemap listing pages error", err)
+		return
+	}
 	ctx.HTML(
 		http.StatusOK, "sitemap-list.xml", gin.H{
-			"xmlHeader": template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
-			"page":      pageList,
-			"general":   general,
+			"xmlHeader":      template.HTML(`<?xml version="1.0" encoding="UTF-8"?>`),
+			"page":           pageList,
+			"jobPage":        jobPageList,
+			"freelancerPage": freelancerPageList,
+			"general":        general,
 		},
 	)
 }
@@ -39,6 +39,12 @@ const (
 	fileFromAvatar = "avatar"
 	// file is logo/icon images
 	fileFromBranding = "branding"
+	// file is used to upload the private message attachment
+	fileFromMessageAttachment = "message_attachment"
+	// file is used to upload the evidence of the freelancer verification request
+	fileFromVerificationEvidence = "verification_evidence"
+	// file is used to upload the image or the file of the freelancer portfolio item
+	fileFromPortfolioFile = "portfolio_file"
 )
 
 // UploadController upload controller
@@ -59,7 +65,7 @@ func NewUploadController(uploaderService uploader.UploaderService) *UploadContro
 // @Tags Upload
 // @Accept multipart/form-data
 // @Security ApiKeyAuth
-// @Param source formData string true "identify the source of the file upload" Enums(post, post_attachment, avatar, branding)
+// @Param source formData string true "identify the source of the file upload" Enums(post, post_attachment, avatar, branding, message_attachment, verification_evidence, portfolio_file)
 // @Param file formData file true "file"
 // @Success 200 {object} handler.RespBody{data=string}
 // @Router /answer/api/v1/file [post]
@@ -84,6 +90,12 @@ func (uc *UploadController) UploadFile(ctx *gin.Context) {
 		url, err = uc.uploaderService.UploadBrandingFile(ctx, userID)
 	case fileFromPostAttachment:
 		url, err = uc.uploaderService.UploadPostAttachment(ctx, userID)
+	case fileFromMessageAttachment:
+		url, err = uc.uploaderService.UploadMessageAttachment(ctx, userID)
+	case fileFromVerificationEvidence:
+		url, err = uc.uploaderService.UploadVerificationEvidence(ctx, userID)
+	case fileFromPortfolioFile:
+		url, err = uc.uploaderService.UploadPortfolioFile(ctx, userID)
 	default:
 		handler.HandleResponse(ctx, errors.BadRequest(reason.UploadFileSourceUnsupported), nil)
 		return
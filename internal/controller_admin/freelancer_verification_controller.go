@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller_admin
+
+import (
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/middleware"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/freelancer"
+	"github.com/gin-gonic/gin"
+)
+
+type FreelancerVerificationController struct {
+	freelancerService *freelancer.FreelancerService
+}
+
+func NewFreelancerVerificationController(freelancerService *freelancer.FreelancerService) *FreelancerVerificationController {
+	return &FreelancerVerificationController{
+		freelancerService: freelancerService,
+	}
+}
+
+// GetVerificationList list the freelancer verification requests by page
+// @Summary list the freelancer verification requests by page
+// @Description list the freelancer verification requests by page, the pending requests are listed from the oldest
+// @Tags AdminFreelancerVerification
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Param status query string false "verification status" Enums(, pending, approved, rejected)
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.FreelancerVerificationResp}}
+// @Router /answer/admin/api/freelancer/verifications [get]
+func (fc *FreelancerVerificationController) GetVerificationList(ctx *gin.Context) {
+	req := &schema.GetFreelancerVerificationsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := fc.freelancerService.GetVerificationPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// ReviewVerification approve or reject the freelancer verification request
+// @Summary approve or reject the freelancer verification request
+// @Description approve or reject the pending request, the reason_type is required when it is rejected
+// @Tags AdminFreelancerVerification
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.ReviewFreelancerVerificationReq true "ReviewFreelancerVerificationReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/freelancer/verification/status [put]
+func (fc *FreelancerVerificationController) ReviewVerification(ctx *gin.Context) {
+	req := &schema.ReviewFreelancerVerificationReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+
+	err := fc.freelancerService.ReviewVerification(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetVerificationEvidence download the evidence file of the freelancer verification request
+// @Summary download the evidence file of the freelancer verification request
+// @Description download the evidence file uploaded to the local storage, only the admin can download it
+// @Tags AdminFreelancerVerification
+// @Produce octet-stream
+// @Security ApiKeyAuth
+// @Param filepath path string true "the download path such as hash/123.pdf"
+// @Success 200 {file} file
+// @Router /answer/admin/api/freelancer/verification/evidence/{filepath} [get]
+func (fc *FreelancerVerificationController) GetVerificationEvidence(ctx *gin.Context) {
+	localPath, filename, err := fc.freelancerService.GetVerificationEvidence(ctx, ctx.Param("filepath"))
+	if err != nil {
+		handler.HandleResponse(ctx, err, nil)
+		return
+	}
+	ctx.FileAttachment(localPath, filename)
+}
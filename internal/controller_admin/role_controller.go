@@ -21,6 +21,7 @@ package controller_admin
 
 import (
 	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/middleware"
 	"github.com/apache/answer/internal/schema"
 	service "github.com/apache/answer/internal/service/role"
 	"github.com/gin-gonic/gin"
@@ -52,3 +53,154 @@ func (rc *RoleController) GetRoleList(ctx *gin.Context) {
 	resp, err := rc.roleService.GetRoleList(ctx)
 	handler.HandleResponse(ctx, err, resp)
 }
+
+// GetPowerList get the powers which could be assigned to the custom roles
+// @Summary get the powers which could be assigned to the custom roles
+// @Description get the powers which could be assigned to the custom roles
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Success 200 {object} handler.RespBody{data=[]schema.GetPowerResp}
+// @Router /answer/admin/api/powers [get]
+func (rc *RoleController) GetPowerList(ctx *gin.Context) {
+	resp, err := rc.roleService.GetPowerList(ctx)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetRolePowers get the power types of the role
+// @Summary get the power types of the role
+// @Description get the power types of the role
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Param role_id query int true "role id"
+// @Success 200 {object} handler.RespBody{data=[]string}
+// @Router /answer/admin/api/role/powers [get]
+func (rc *RoleController) GetRolePowers(ctx *gin.Context) {
+	req := &schema.GetRolePowersReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	resp, err := rc.roleService.GetRolePowers(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// AddRole add custom role
+// @Summary add custom role
+// @Description add custom role with the powers
+// @Security ApiKeyAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param data body schema.AddRoleReq true "AddRoleReq"
+// @Success 200 {object} handler.RespBody{data=schema.GetRoleResp}
+// @Router /answer/admin/api/role [post]
+func (rc *RoleController) AddRole(ctx *gin.Context) {
+	req := &schema.AddRoleReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	resp, err := rc.roleService.AddRole(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdateRole update custom role
+// @Summary update custom role
+// @Description update the name and description of the custom role
+// @Security ApiKeyAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param data body schema.UpdateRoleReq true "UpdateRoleReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/role [put]
+func (rc *RoleController) UpdateRole(ctx *gin.Context) {
+	req := &schema.UpdateRoleReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	err := rc.roleService.UpdateRole(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RemoveRole remove custom role
+// @Summary remove custom role
+// @Description remove the custom role which is not assigned to any user
+// @Security ApiKeyAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param data body schema.RemoveRoleReq true "RemoveRoleReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/role [delete]
+func (rc *RoleController) RemoveRole(ctx *gin.Context) {
+	req := &schema.RemoveRoleReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	err := rc.roleService.RemoveRole(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// UpdateRolePowers update the powers of the custom role
+// @Summary update the powers of the custom role
+// @Description replace the powers of the custom role, the users of the role get the new powers at once
+// @Security ApiKeyAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param data body schema.UpdateRolePowersReq true "UpdateRolePowersReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/role/powers [put]
+func (rc *RoleController) UpdateRolePowers(ctx *gin.Context) {
+	req := &schema.UpdateRolePowersReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	err := rc.roleService.UpdateRolePowers(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// UpdateRoleUsers assign the role to the users
+// @Summary assign the role to the users
+// @Description assign the role to the users, the users are logged out to refresh their role
+// @Security ApiKeyAuth
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param data body schema.UpdateRoleUsersReq true "UpdateRoleUsersReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/role/users [put]
+func (rc *RoleController) UpdateRoleUsers(ctx *gin.Context) {
+	req := &schema.UpdateRoleUsersReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	req.LoginUserID = middleware.GetLoginUserIDFromContext(ctx)
+	err := rc.roleService.UpdateRoleUsers(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// GetRoleAuditLogs list the role audit logs by page
+// @Summary list the role audit logs by page
+// @Description list the changes of the roles, their powers and their users by page, the latest first
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Param role_id query int false "role id"
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.RoleAuditLogResp}}
+// @Router /answer/admin/api/role/audit-logs [get]
+func (rc *RoleController) GetRoleAuditLogs(ctx *gin.Context) {
+	req := &schema.GetRoleAuditLogsReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	resp, err := rc.roleService.GetRoleAuditLogPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
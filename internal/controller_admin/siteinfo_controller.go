@@ -173,6 +173,32 @@ func (sc *SiteInfoController) GetSiteUsers(ctx *gin.Context) {
 	handler.HandleResponse(ctx, err, resp)
 }
 
+// GetSiteJob get site job posting config
+// @Summary get site job posting config
+// @Description get site job posting config
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Success 200 {object} handler.RespBody{data=schema.SiteJobResp}
+// @Router /answer/admin/api/siteinfo/job [get]
+func (sc *SiteInfoController) GetSiteJob(ctx *gin.Context) {
+	resp, err := sc.siteInfoService.GetSiteJob(ctx)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetSiteCurrency get site currency config
+// @Summary get site currency config
+// @Description get the base currency and the static exchange rates
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Success 200 {object} handler.RespBody{data=schema.SiteCurrencyResp}
+// @Router /answer/admin/api/siteinfo/currency [get]
+func (sc *SiteInfoController) GetSiteCurrency(ctx *gin.Context) {
+	resp, err := sc.siteInfoService.GetSiteCurrency(ctx)
+	handler.HandleResponse(ctx, err, resp)
+}
+
 // GetRobots get site robots information
 // @Summary get site robots information
 // @Description get site robots information
@@ -459,3 +485,39 @@ func (sc *SiteInfoController) UpdatePrivilegesConfig(ctx *gin.Context) {
 	err := sc.siteInfoService.UpdatePrivilegesConfig(ctx, req)
 	handler.HandleResponse(ctx, err, nil)
 }
+
+// UpdateSiteJob update site job posting config
+// @Summary update site job posting config
+// @Description update site job posting config
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Param data body schema.SiteJobReq true "job posting config"
+// @Success 200 {object} handler.RespBody{}
+// @Router /answer/admin/api/siteinfo/job [put]
+func (sc *SiteInfoController) UpdateSiteJob(ctx *gin.Context) {
+	req := &schema.SiteJobReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	err := sc.siteInfoService.SaveSiteJob(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// UpdateSiteCurrency update site currency config
+// @Summary update site currency config
+// @Description update the base currency and the static exchange rates used when no exchange rate plugin is enabled
+// @Security ApiKeyAuth
+// @Tags admin
+// @Produce json
+// @Param data body schema.SiteCurrencyReq true "currency config"
+// @Success 200 {object} handler.RespBody{}
+// @Router /answer/admin/api/siteinfo/currency [put]
+func (sc *SiteInfoController) UpdateSiteCurrency(ctx *gin.Context) {
+	req := &schema.SiteCurrencyReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+	err := sc.siteInfoService.SaveSiteCurrency(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
@@ -0,0 +1,184 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package controller_admin
+
+import (
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookController struct {
+	webhookService *webhook.WebhookService
+}
+
+func NewWebhookController(webhookService *webhook.WebhookService) *WebhookController {
+	return &WebhookController{
+		webhookService: webhookService,
+	}
+}
+
+// GetWebhookEvents get the events which could be subscribed
+// @Summary get the events which could be subscribed
+// @Description get the events which could be subscribed by the webhook
+// @Tags AdminWebhook
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} handler.RespBody{data=[]string}
+// @Router /answer/admin/api/webhook/events [get]
+func (wc *WebhookController) GetWebhookEvents(ctx *gin.Context) {
+	handler.HandleResponse(ctx, nil, wc.webhookService.GetEventTypes(ctx))
+}
+
+// GetWebhookList get all webhooks
+// @Summary get all webhooks
+// @Description get all webhooks, the secrets are not returned
+// @Tags AdminWebhook
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} handler.RespBody{data=[]schema.WebhookResp}
+// @Router /answer/admin/api/webhooks [get]
+func (wc *WebhookController) GetWebhookList(ctx *gin.Context) {
+	resp, err := wc.webhookService.GetWebhookList(ctx)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// AddWebhook add webhook
+// @Summary add webhook
+// @Description add webhook, the secret is generated and returned if it is empty
+// @Tags AdminWebhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.AddWebhookReq true "AddWebhookReq"
+// @Success 200 {object} handler.RespBody{data=schema.WebhookResp}
+// @Router /answer/admin/api/webhook [post]
+func (wc *WebhookController) AddWebhook(ctx *gin.Context) {
+	req := &schema.AddWebhookReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := wc.webhookService.AddWebhook(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// UpdateWebhook update webhook
+// @Summary update webhook
+// @Description update webhook, the secret is kept if it is empty
+// @Tags AdminWebhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.UpdateWebhookReq true "UpdateWebhookReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/webhook [put]
+func (wc *WebhookController) UpdateWebhook(ctx *gin.Context) {
+	req := &schema.UpdateWebhookReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	err := wc.webhookService.UpdateWebhook(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// RemoveWebhook remove webhook
+// @Summary remove webhook
+// @Description remove webhook
+// @Tags AdminWebhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.RemoveWebhookReq true "RemoveWebhookReq"
+// @Success 200 {object} handler.RespBody
+// @Router /answer/admin/api/webhook [delete]
+func (wc *WebhookController) RemoveWebhook(ctx *gin.Context) {
+	req := &schema.RemoveWebhookReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	err := wc.webhookService.RemoveWebhook(ctx, req)
+	handler.HandleResponse(ctx, err, nil)
+}
+
+// PingWebhook send the ping event to the webhook
+// @Summary send the ping event to the webhook
+// @Description send the ping event to the webhook right now and return the delivery result
+// @Tags AdminWebhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.PingWebhookReq true "PingWebhookReq"
+// @Success 200 {object} handler.RespBody{data=schema.WebhookDeliveryResp}
+// @Router /answer/admin/api/webhook/ping [post]
+func (wc *WebhookController) PingWebhook(ctx *gin.Context) {
+	req := &schema.PingWebhookReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := wc.webhookService.Ping(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// GetDeliveryList list the webhook deliveries by page
+// @Summary list the webhook deliveries by page
+// @Description list the webhook deliveries by page, the latest first
+// @Tags AdminWebhook
+// @Produce json
+// @Security ApiKeyAuth
+// @Param webhook_id query string false "webhook id"
+// @Param status query string false "delivery status" Enums(, pending, success, failed)
+// @Param page query int false "page"
+// @Param page_size query int false "page size"
+// @Success 200 {object} handler.RespBody{data=pager.PageModel{list=[]schema.WebhookDeliveryResp}}
+// @Router /answer/admin/api/webhook/deliveries [get]
+func (wc *WebhookController) GetDeliveryList(ctx *gin.Context) {
+	req := &schema.GetWebhookDeliveriesReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := wc.webhookService.GetDeliveryPage(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
+
+// RedeliverWebhook redeliver the webhook delivery
+// @Summary redeliver the webhook delivery
+// @Description deliver the payload of the delivery again as a new delivery
+// @Tags AdminWebhook
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param data body schema.RedeliverWebhookReq true "RedeliverWebhookReq"
+// @Success 200 {object} handler.RespBody{data=schema.WebhookDeliveryResp}
+// @Router /answer/admin/api/webhook/delivery/redeliver [post]
+func (wc *WebhookController) RedeliverWebhook(ctx *gin.Context) {
+	req := &schema.RedeliverWebhookReq{}
+	if handler.BindAndCheck(ctx, req) {
+		return
+	}
+
+	resp, err := wc.webhookService.Redeliver(ctx, req)
+	handler.HandleResponse(ctx, err, resp)
+}
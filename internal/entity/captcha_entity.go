@@ -32,6 +32,9 @@ const (
 	CaptchaActionReport           = "report"
 	CaptchaActionDelete           = "delete"
 	CaptchaActionVote             = "vote"
+	CaptchaActionJobPosting       = "job_posting"
+	CaptchaActionJobApplication   = "job_application"
+	CaptchaActionHire             = "hire"
 )
 
 type ActionRecordInfo struct {
@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	ContractStatusOffered   = "offered"
+	ContractStatusActive    = "active"
+	ContractStatusCompleted = "completed"
+	ContractStatusCancelled = "cancelled"
+)
+
+const (
+	MilestoneStatusDraft     = "draft"
+	MilestoneStatusFunding   = "funding"
+	MilestoneStatusFunded    = "funded"
+	MilestoneStatusSubmitted = "submitted"
+	MilestoneStatusApproved  = "approved"
+	MilestoneStatusDisputed  = "disputed"
+	MilestoneStatusReleasing = "releasing"
+	MilestoneStatusReleased  = "released"
+)
+
+// Contract contract between a client and a freelancer
+type Contract struct {
+	ID              string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt       time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt       time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ClientID        string    `xorm:"not null INDEX BIGINT(20) client_id"`
+	FreelancerID    string    `xorm:"not null INDEX BIGINT(20) freelancer_id"`
+	JobID           string    `xorm:"not null default 0 BIGINT(20) job_id"`
+	ApplicationID   string    `xorm:"not null default 0 BIGINT(20) application_id"`
+	Title           string    `xorm:"not null VARCHAR(255) title"`
+	Description     string    `xorm:"TEXT description"`
+	Currency        string    `xorm:"not null default 'USD' VARCHAR(10) currency"`
+	TotalAmount     float64   `xorm:"not null default 0 DECIMAL(10,2) total_amount"`
+	Status          string    `xorm:"not null default 'offered' VARCHAR(20) status"` // "offered", "active", "completed", "cancelled"
+	PaymentProvider string    `xorm:"not null default '' VARCHAR(100) payment_provider"`
+	WorkConfirmed   bool      `xorm:"not null default false BOOL work_confirmed"` // the freelancer has confirmed the work is done
+}
+
+// TableName contract table name
+func (Contract) TableName() string {
+	return "contract"
+}
+
+// Milestone milestone of the contract, the amount is held in escrow after funded until released
+type Milestone struct {
+	ID                   string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt            time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt            time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ContractID           string    `xorm:"not null INDEX BIGINT(20) contract_id"`
+	Title                string    `xorm:"not null VARCHAR(255) title"`
+	Description          string    `xorm:"TEXT description"`
+	Amount               float64   `xorm:"not null default 0 DECIMAL(10,2) amount"`
+	DueAt                time.Time `xorm:"TIMESTAMP due_at"`
+	Status               string    `xorm:"not null default 'draft' VARCHAR(20) status"`
+	Note                 string    `xorm:"TEXT note"` // the submission note or the dispute reason of the latest status change
+	FundTransactionID    string    `xorm:"not null default '' VARCHAR(255) fund_transaction_id"`
+	ReleaseTransactionID string    `xorm:"not null default '' VARCHAR(255) release_transaction_id"`
+}
+
+// TableName milestone table name
+func (Milestone) TableName() string {
+	return "milestone"
+}
@@ -0,0 +1,56 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	ContractReviewStatusAvailable = 1
+	ContractReviewStatusPending   = 2
+	ContractReviewStatusDeleted   = 10
+)
+
+const (
+	ContractReviewerRoleClient     = "client"
+	ContractReviewerRoleFreelancer = "freelancer"
+)
+
+// ContractReview review written by one party of a completed contract for the other party
+type ContractReview struct {
+	ID           string    `xorm:"not null pk BIGINT(20) id"`
+	CreatedAt    time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt    time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ContractID   string    `xorm:"not null UNIQUE(s) BIGINT(20) contract_id"`
+	ReviewerID   string    `xorm:"not null UNIQUE(s) BIGINT(20) reviewer_id"`
+	RevieweeID   string    `xorm:"not null INDEX BIGINT(20) reviewee_id"`
+	ReviewerRole string    `xorm:"not null default '' VARCHAR(20) reviewer_role"` // "client", "freelancer"
+	Rating       int       `xorm:"not null default 0 INT(11) rating"`             // 1 to 5
+	OriginalText string    `xorm:"not null MEDIUMTEXT original_text"`
+	ParsedText   string    `xorm:"not null MEDIUMTEXT parsed_text"`
+	ReplyText    string    `xorm:"MEDIUMTEXT reply_text"`
+	ReplyHTML    string    `xorm:"MEDIUMTEXT reply_html"`
+	RepliedAt    time.Time `xorm:"TIMESTAMP replied_at"`
+	Status       int       `xorm:"not null default 1 INT(11) status"`
+}
+
+// TableName contract review table name
+func (ContractReview) TableName() string {
+	return "contract_review"
+}
@@ -0,0 +1,82 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	MessageStatusAvailable = 1
+	MessageStatusDeleted   = 10
+)
+
+// Conversation private conversation between the client and the freelancer of a contract or a job application
+type Conversation struct {
+	ID            string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt     time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt     time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ClientID      string    `xorm:"not null UNIQUE(s) BIGINT(20) client_id"`
+	FreelancerID  string    `xorm:"not null UNIQUE(s) BIGINT(20) freelancer_id"`
+	ContractID    string    `xorm:"not null default 0 UNIQUE(s) BIGINT(20) contract_id"`
+	ApplicationID string    `xorm:"not null default 0 UNIQUE(s) BIGINT(20) application_id"`
+	JobID         string    `xorm:"not null default 0 BIGINT(20) job_id"`
+	Subject       string    `xorm:"not null default '' VARCHAR(255) subject"`
+	LastMessageID string    `xorm:"not null default 0 BIGINT(20) last_message_id"`
+	LastMessageAt time.Time `xorm:"TIMESTAMP last_message_at"`
+}
+
+// TableName conversation table name
+func (Conversation) TableName() string {
+	return "conversation"
+}
+
+// ConversationMember participant of the conversation, the last read message is the read receipt
+type ConversationMember struct {
+	ID                int       `xorm:"not null pk autoincr INT(11) id"`
+	CreatedAt         time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt         time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ConversationID    string    `xorm:"not null UNIQUE(s) BIGINT(20) conversation_id"`
+	UserID            string    `xorm:"not null UNIQUE(s) INDEX BIGINT(20) user_id"`
+	LastReadMessageID string    `xorm:"not null default 0 BIGINT(20) last_read_message_id"`
+	LastReadAt        time.Time `xorm:"TIMESTAMP last_read_at"`
+	UnreadCount       int       `xorm:"not null default 0 INT(11) unread_count"`
+}
+
+// TableName conversation member table name
+func (ConversationMember) TableName() string {
+	return "conversation_member"
+}
+
+// Message message of the conversation
+type Message struct {
+	ID             string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt      time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt      time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ConversationID string    `xorm:"not null INDEX BIGINT(20) conversation_id"`
+	SenderID       string    `xorm:"not null BIGINT(20) sender_id"`
+	OriginalText   string    `xorm:"not null MEDIUMTEXT original_text"`
+	ParsedText     string    `xorm:"not null MEDIUMTEXT parsed_text"`
+	Attachments    string    `xorm:"TEXT attachments"` // json array of the attachment urls
+	Status         int       `xorm:"not null default 1 INT(11) status"`
+}
+
+// TableName message table name
+func (Message) TableName() string {
+	return "message"
+}
@@ -0,0 +1,44 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+// FreelancerPortfolioItem the portfolio item of the freelancer
+type FreelancerPortfolioItem struct {
+	ID              string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt       time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt       time.Time `xorm:"updated TIMESTAMP updated_at"`
+	UserID          string    `xorm:"not null INDEX BIGINT(20) user_id"`
+	Title           string    `xorm:"not null default '' VARCHAR(150) title"`
+	Description     string    `xorm:"TEXT description"`
+	DescriptionHTML string    `xorm:"TEXT description_html"`
+	Images          string    `xorm:"TEXT images"` // JSON array of the image urls
+	Files           string    `xorm:"TEXT files"`  // JSON array of the file urls
+	ExternalURL     string    `xorm:"not null default '' VARCHAR(512) external_url"`
+	Tags            string    `xorm:"TEXT tags"`       // JSON array of the tag slug names
+	AnswerIDs       string    `xorm:"TEXT answer_ids"` // JSON array of the linked answer ids of the freelancer
+	Sort            int       `xorm:"not null default 0 INT(11) sort"`
+}
+
+// TableName freelancer portfolio item table name
+func (FreelancerPortfolioItem) TableName() string {
+	return "freelancer_portfolio_item"
+}
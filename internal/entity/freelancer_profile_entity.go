@@ -21,6 +21,21 @@ package entity
 
 import "time"
 
+const (
+	JobPostingStatusOpen   = "open"
+	JobPostingStatusClosed = "closed"
+	JobPostingStatusFilled = "filled"
+	JobPostingStatusPending = "pending" // waiting for the review
+	JobPostingStatusDeleted = "deleted" // rejected by the review
+)
+
+const (
+	JobApplicationStatusPending   = "pending"
+	JobApplicationStatusAccepted  = "accepted"
+	JobApplicationStatusRejected  = "rejected"
+	JobApplicationStatusWithdrawn = "withdrawn"
+)
+
 // FreelancerProfile freelancer profile
 type FreelancerProfile struct {
 	ID                string    `xorm:"not null pk autoincr BIGINT(20) id"`
@@ -30,9 +45,10 @@ type FreelancerProfile struct {
 	IsAvailable        bool      `xorm:"not null default true BOOL is_available"`
 	HourlyRate         float64   `xorm:"not null default 0 DECIMAL(10,2) hourly_rate"`
 	Currency           string    `xorm:"not null default 'USD' VARCHAR(10) currency"`
+	BaseHourlyRate     float64   `xorm:"not null default 0 DECIMAL(16,2) base_hourly_rate"` // the hourly rate in the base currency of the site
 	Skills             string    `xorm:"TEXT skills"` // JSON array of skills
 	Experience         string    `xorm:"TEXT experience"` // JSON object with experience details
-	Portfolio          string    `xorm:"TEXT portfolio"` // JSON array of portfolio items
+	Portfolio          string    `xorm:"TEXT portfolio"`             // Deprecated: moved to freelancer_portfolio_item
 	Availability       string    `xorm:"VARCHAR(100) availability"` // e.g., "Full-time", "Part-time", "Project-based"
 	PreferredProjects  string    `xorm:"TEXT preferred_projects"` // JSON array of preferred project types
 	ContactEmail       string    `xorm:"VARCHAR(100) contact_email"` // Optional different contact email
@@ -43,6 +59,10 @@ type FreelancerProfile struct {
 	BioHTML            string    `xorm:"TEXT bio_html"`
 	Languages          string    `xorm:"TEXT languages"` // JSON array of languages
 	TimeZone           string    `xorm:"VARCHAR(50) time_zone"`
+	UTCOffset          int       `xorm:"not null default 0 INT(11) utc_offset"`      // UTC offset of the time zone in minutes
+	HasUTCOffset       bool      `xorm:"not null default false BOOL has_utc_offset"` // the time zone is recognized
+	ExperienceLevel    string    `xorm:"VARCHAR(50) experience_level"`               // "entry", "intermediate", "senior", "expert"
+	LocationType       string    `xorm:"VARCHAR(20) location_type"`                  // "remote", "onsite", "hybrid"
 	ResponseTime       string    `xorm:"VARCHAR(50) response_time"` // e.g., "Within 24 hours"
 	CompletedProjects  int       `xorm:"not null default 0 INT(11) completed_projects"`
 	ClientSatisfaction float64   `xorm:"not null default 0 DECIMAL(3,2) client_satisfaction"` // 0.00 to 5.00
@@ -57,7 +77,7 @@ func (FreelancerProfile) TableName() string {
 
 // JobPosting job posting
 type JobPosting struct {
-	ID              string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	ID              string    `xorm:"not null pk BIGINT(20) id"`
 	CreatedAt       time.Time `xorm:"created TIMESTAMP created_at"`
 	UpdatedAt       time.Time `xorm:"updated TIMESTAMP updated_at"`
 	UserID          string    `xorm:"not null BIGINT(20) user_id"`
@@ -66,17 +86,20 @@ type JobPosting struct {
 	DescriptionHTML string    `xorm:"TEXT description_html"`
 	Budget          float64   `xorm:"not null default 0 DECIMAL(10,2) budget"`
 	Currency        string    `xorm:"not null default 'USD' VARCHAR(10) currency"`
+	BaseBudget       float64   `xorm:"not null default 0 DECIMAL(16,2) base_budget"`     // the budget in the base currency of the site
 	BudgetType      string    `xorm:"not null default 'fixed' VARCHAR(20) budget_type"` // "fixed", "hourly", "negotiable"
 	Skills          string    `xorm:"TEXT skills"` // JSON array of required skills
 	ExperienceLevel string    `xorm:"VARCHAR(50) experience_level"` // "entry", "intermediate", "senior", "expert"
 	Duration        string    `xorm:"VARCHAR(100) duration"` // e.g., "1-3 months", "3-6 months"
 	Location        string    `xorm:"VARCHAR(100) location"` // "remote", "onsite", "hybrid"
-	Status          string    `xorm:"not null default 'open' VARCHAR(20) status"` // "open", "closed", "filled"
+	Status           string    `xorm:"not null default 'open' VARCHAR(20) status"`       // "open", "closed", "filled", "pending", "deleted"
 	ContactEmail    string    `xorm:"VARCHAR(100) contact_email"`
 	ApplicationCount int      `xorm:"not null default 0 INT(11) application_count"`
 	ViewsCount      int       `xorm:"not null default 0 INT(11) views_count"`
 	IsActive        bool      `xorm:"not null default true BOOL is_active"`
 	ExpiresAt       time.Time `xorm:"TIMESTAMP expires_at"`
+	ExpiryNotified  bool      `xorm:"not null default false BOOL expiry_notified"` // the poster has been notified that it is about to expire
+	RevisionID      string    `xorm:"not null default 0 BIGINT(20) revision_id"`
 }
 
 // TableName job posting table name
@@ -84,6 +107,11 @@ func (JobPosting) TableName() string {
 	return "job_posting"
 }
 
+// IsPublished whether the job posting has passed the review and can be seen by others
+func (j *JobPosting) IsPublished() bool {
+	return j.Status != JobPostingStatusPending && j.Status != JobPostingStatusDeleted
+}
+
 // JobApplication job application
 type JobApplication struct {
 	ID           string    `xorm:"not null pk autoincr BIGINT(20) id"`
@@ -94,6 +122,7 @@ type JobApplication struct {
 	CoverLetter  string    `xorm:"TEXT cover_letter"`
 	ProposedRate float64   `xorm:"not null default 0 DECIMAL(10,2) proposed_rate"`
 	Currency     string    `xorm:"not null default 'USD' VARCHAR(10) currency"`
+	BaseProposedRate float64   `xorm:"not null default 0 DECIMAL(16,2) base_proposed_rate"` // the proposed rate in the base currency of the site
 	Status       string    `xorm:"not null default 'pending' VARCHAR(20) status"` // "pending", "accepted", "rejected", "withdrawn"
 	Message      string    `xorm:"TEXT message"` // Additional message from applicant
 }
@@ -0,0 +1,48 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	FreelancerVerificationStatusPending  = "pending"
+	FreelancerVerificationStatusApproved = "approved"
+	FreelancerVerificationStatusRejected = "rejected"
+)
+
+// FreelancerVerification the identity verification request of the freelancer
+type FreelancerVerification struct {
+	ID         string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt  time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt  time.Time `xorm:"updated TIMESTAMP updated_at"`
+	UserID     string    `xorm:"not null INDEX BIGINT(20) user_id"`
+	Evidence   string    `xorm:"not null TEXT evidence"` // JSON array of the evidence file urls
+	Note       string    `xorm:"TEXT note"`              // note from the freelancer to the reviewer
+	Status     string    `xorm:"not null default 'pending' INDEX VARCHAR(20) status"`
+	ReviewerID string    `xorm:"not null default 0 BIGINT(20) reviewer_id"`
+	ReviewedAt time.Time `xorm:"TIMESTAMP reviewed_at"`
+	ReasonType int       `xorm:"not null default 0 INT(11) reason_type"` // the config id of the rejection reason
+	ReasonMsg  string    `xorm:"TEXT reason_msg"`
+}
+
+// TableName freelancer verification table name
+func (FreelancerVerification) TableName() string {
+	return "freelancer_verification"
+}
@@ -0,0 +1,56 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+// QueueMessage the message waiting in the outbox to be delivered to the handler of the queue,
+// it is deleted after being handled successfully
+type QueueMessage struct {
+	ID        string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt time.Time `xorm:"updated TIMESTAMP updated_at"`
+	Queue     string    `xorm:"not null default '' INDEX(queue_run) VARCHAR(50) queue"`
+	Payload   string    `xorm:"not null MEDIUMTEXT payload"` // JSON of the message
+	Attempts  int       `xorm:"not null default 0 INT(11) attempts"`
+	NextRunAt time.Time `xorm:"not null INDEX(queue_run) TIMESTAMP next_run_at"`
+	LastError string    `xorm:"TEXT last_error"`
+}
+
+// TableName queue message table name
+func (QueueMessage) TableName() string {
+	return "queue_message"
+}
+
+// QueueDeadLetter the message failed to be handled after all the attempts
+type QueueDeadLetter struct {
+	ID        string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt time.Time `xorm:"created TIMESTAMP created_at"`
+	Queue     string    `xorm:"not null default '' INDEX VARCHAR(50) queue"`
+	MessageID string    `xorm:"not null default 0 BIGINT(20) message_id"`
+	Payload   string    `xorm:"not null MEDIUMTEXT payload"`
+	Attempts  int       `xorm:"not null default 0 INT(11) attempts"`
+	LastError string    `xorm:"TEXT last_error"`
+}
+
+// TableName queue dead letter table name
+func (QueueDeadLetter) TableName() string {
+	return "queue_dead_letter"
+}
@@ -0,0 +1,46 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	RoleAuditActionCreate       = "create"
+	RoleAuditActionUpdate       = "update"
+	RoleAuditActionDelete       = "delete"
+	RoleAuditActionUpdatePowers = "update_powers"
+	RoleAuditActionAssignUsers  = "assign_users"
+)
+
+// RoleAuditLog the log of the changes of the roles, their powers and their users
+type RoleAuditLog struct {
+	ID         string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt  time.Time `xorm:"created TIMESTAMP created_at"`
+	OperatorID string    `xorm:"not null default 0 INDEX BIGINT(20) operator_id"`
+	RoleID     int       `xorm:"not null default 0 INDEX INT(11) role_id"`
+	Action     string    `xorm:"not null default '' VARCHAR(32) action"`
+	// Content the JSON of the changes, see schema.RoleAuditContent
+	Content string `xorm:"not null TEXT content"`
+}
+
+// TableName role audit log table name
+func (RoleAuditLog) TableName() string {
+	return "role_audit_log"
+}
@@ -0,0 +1,58 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	SavedJobSearchAlertNone      = "none"
+	SavedJobSearchAlertImmediate = "immediate"
+	SavedJobSearchAlertDaily     = "daily"
+)
+
+// SavedJobSearch the job posting filter saved by the user to be alerted of new matching postings
+type SavedJobSearch struct {
+	ID             string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt      time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt      time.Time `xorm:"updated TIMESTAMP updated_at"`
+	UserID         string    `xorm:"not null INDEX BIGINT(20) user_id"`
+	Name           string    `xorm:"not null default '' VARCHAR(100) name"`
+	Filter         string    `xorm:"not null TEXT filter"` // JSON of the job postings filter
+	AlertFrequency string    `xorm:"not null default 'none' INDEX VARCHAR(20) alert_frequency"`
+	LastDigestAt   time.Time `xorm:"TIMESTAMP last_digest_at"`
+}
+
+// TableName saved job search table name
+func (SavedJobSearch) TableName() string {
+	return "saved_job_search"
+}
+
+// SavedJobSearchMatch the new job posting matched the saved search, waiting for the daily digest
+type SavedJobSearchMatch struct {
+	ID           string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt    time.Time `xorm:"created TIMESTAMP created_at"`
+	SearchID     string    `xorm:"not null UNIQUE(search_job) BIGINT(20) search_id"`
+	JobPostingID string    `xorm:"not null UNIQUE(search_job) BIGINT(20) job_posting_id"`
+}
+
+// TableName saved job search match table name
+func (SavedJobSearchMatch) TableName() string {
+	return "saved_job_search_match"
+}
@@ -0,0 +1,43 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	SkillRelObjectTypeFreelancer = "freelancer"
+	SkillRelObjectTypeJobPosting = "job_posting"
+)
+
+// SkillRel skill relation, binds a freelancer profile or a job posting to a tag
+type SkillRel struct {
+	ID         int64     `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt  time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt  time.Time `xorm:"updated TIMESTAMP updated_at"`
+	ObjectType string    `xorm:"not null default '' UNIQUE(s) VARCHAR(20) object_type"`
+	ObjectID   string    `xorm:"not null INDEX UNIQUE(s) BIGINT(20) object_id"`
+	TagID      string    `xorm:"not null INDEX UNIQUE(s) BIGINT(20) tag_id"`
+	Sort       int       `xorm:"not null default 0 INT(11) sort"`
+}
+
+// TableName skill rel table name
+func (SkillRel) TableName() string {
+	return "skill_rel"
+}
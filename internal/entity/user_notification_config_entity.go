@@ -23,13 +23,15 @@ import "time"
 
 // UserNotificationConfig user notification config
 type UserNotificationConfig struct {
-	ID        string    `xorm:"not null pk autoincr BIGINT(20) id"`
-	CreatedAt time.Time `xorm:"created TIMESTAMP created_at"`
-	UpdatedAt time.Time `xorm:"updated TIMESTAMP updated_at"`
-	UserID    string    `xorm:"not null default 0 INDEX UNIQUE(uk_us) BIGINT(20) INDEX user_id"`
-	Source    string    `xorm:"not null default '' INDEX UNIQUE(uk_us) VARCHAR(64) source"`
-	Channels  string    `xorm:"not null TEXT channels"`
-	Enabled   bool      `xorm:"not null default false BOOL enabled"`
+	ID           string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt    time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt    time.Time `xorm:"updated TIMESTAMP updated_at"`
+	UserID       string    `xorm:"not null default 0 INDEX UNIQUE(uk_us) BIGINT(20) INDEX user_id"`
+	Source       string    `xorm:"not null default '' INDEX UNIQUE(uk_us) VARCHAR(64) source"`
+	Channels     string    `xorm:"not null TEXT channels"`
+	Enabled      bool      `xorm:"not null default false BOOL enabled"`
+	Frequency    string    `xorm:"not null default 'immediate' INDEX VARCHAR(20) frequency"`
+	LastDigestAt time.Time `xorm:"TIMESTAMP last_digest_at"`
 }
 
 // TableName notification table name
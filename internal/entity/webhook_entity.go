@@ -0,0 +1,69 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package entity
+
+import "time"
+
+const (
+	WebhookDeliveryStatusPending = "pending"
+	WebhookDeliveryStatusSuccess = "success"
+	WebhookDeliveryStatusFailed  = "failed"
+)
+
+// Webhook the endpoint registered by the admin to receive the site events
+type Webhook struct {
+	ID        string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt time.Time `xorm:"updated TIMESTAMP updated_at"`
+	Name      string    `xorm:"not null default '' VARCHAR(100) name"`
+	URL       string    `xorm:"not null default '' VARCHAR(512) url"`
+	Secret    string    `xorm:"not null default '' VARCHAR(256) secret"` // the key of the HMAC-SHA256 signature
+	Events    string    `xorm:"not null TEXT events"`                    // JSON of the subscribed event types, empty means all
+	IsActive  bool      `xorm:"not null default true BOOL is_active"`
+}
+
+// TableName webhook table name
+func (Webhook) TableName() string {
+	return "webhook"
+}
+
+// WebhookDelivery the delivery log of the event to the webhook
+type WebhookDelivery struct {
+	ID           string    `xorm:"not null pk autoincr BIGINT(20) id"`
+	CreatedAt    time.Time `xorm:"created TIMESTAMP created_at"`
+	UpdatedAt    time.Time `xorm:"updated TIMESTAMP updated_at"`
+	WebhookID    string    `xorm:"not null INDEX BIGINT(20) webhook_id"`
+	EventID      string    `xorm:"not null default '' INDEX VARCHAR(64) event_id"` // the id of the event delivered
+	EventType    string    `xorm:"not null default '' VARCHAR(50) event_type"`
+	Payload      string    `xorm:"not null MEDIUMTEXT payload"`
+	Status       string    `xorm:"not null default 'pending' VARCHAR(20) status"`
+	Attempts     int       `xorm:"not null default 0 INT(11) attempts"`
+	ResponseCode int       `xorm:"not null default 0 INT(11) response_code"`
+	ResponseBody string    `xorm:"TEXT response_body"`
+	Error        string    `xorm:"TEXT error"`
+	DurationMs   int64     `xorm:"not null default 0 BIGINT(20) duration_ms"`
+	DeliveredAt  time.Time `xorm:"TIMESTAMP delivered_at"`
+	RedeliveryOf string    `xorm:"not null default 0 BIGINT(20) redelivery_of"` // the id of the delivery redelivered
+}
+
+// TableName webhook delivery table name
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
@@ -83,6 +83,7 @@ func (m *Mentor) InitDB() error {
 	m.do("init site info legal", m.initSiteInfoLegalConfig)
 	m.do("init default content", m.initDefaultContent)
 	m.do("init default badges", m.initDefaultBadges)
+	m.do("init full-text search index", m.initFullTextSearchIndex)
 	return m.err
 }
 
@@ -474,3 +475,7 @@ func (m *Mentor) initDefaultBadges() {
 		}
 	}
 }
+
+func (m *Mentor) initFullTextSearchIndex() {
+	m.err = addFullTextSearchIndex(m.ctx, m.engine)
+}
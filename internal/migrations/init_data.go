@@ -76,6 +76,25 @@ var (
 		&entity.BadgeAward{},
 		&entity.FileRecord{},
 		&entity.PluginKVStorage{},
+		&entity.FreelancerProfile{},
+		&entity.JobPosting{},
+		&entity.SkillRel{},
+		&entity.JobApplication{},
+		&entity.Contract{},
+		&entity.Milestone{},
+		&entity.ContractReview{},
+		&entity.Conversation{},
+		&entity.ConversationMember{},
+		&entity.Message{},
+		&entity.FreelancerVerification{},
+		&entity.SavedJobSearch{},
+		&entity.SavedJobSearchMatch{},
+		&entity.FreelancerPortfolioItem{},
+		&entity.QueueMessage{},
+		&entity.QueueDeadLetter{},
+		&entity.Webhook{},
+		&entity.WebhookDelivery{},
+		&entity.RoleAuditLog{},
 	}
 
 	roles = []*entity.Role{
@@ -126,6 +145,13 @@ var (
 		{ID: 39, Name: "recover answer", PowerType: permission.AnswerUnDelete, Description: "recover deleted answer"},
 		{ID: 40, Name: "recover question", PowerType: permission.QuestionUnDelete, Description: "recover deleted question"},
 		{ID: 41, Name: "recover tag", PowerType: permission.TagUnDelete, Description: "recover deleted tag"},
+		{ID: 42, Name: "job posting edit", PowerType: permission.JobPostingEdit, Description: "edit job posting"},
+		{ID: 43, Name: "job posting delete", PowerType: permission.JobPostingDelete, Description: "delete job posting"},
+		{ID: 44, Name: "job posting close", PowerType: permission.JobPostingClose, Description: "close job posting"},
+		{ID: 45, Name: "job posting reopen", PowerType: permission.JobPostingReopen, Description: "reopen job posting"},
+		{ID: 46, Name: "job posting add", PowerType: permission.JobPostingAdd, Description: "add job posting"},
+		{ID: 47, Name: "job application add", PowerType: permission.JobApplicationAdd, Description: "apply for job posting"},
+		{ID: 48, Name: "freelancer hire", PowerType: permission.FreelancerHire, Description: "hire freelancer"},
 	}
 
 	rolePowerRels = []*entity.RolePowerRel{
@@ -171,6 +197,13 @@ var (
 		{RoleID: 2, PowerType: permission.AnswerUnDelete},
 		{RoleID: 2, PowerType: permission.QuestionUnDelete},
 		{RoleID: 2, PowerType: permission.TagUnDelete},
+		{RoleID: 2, PowerType: permission.JobPostingEdit},
+		{RoleID: 2, PowerType: permission.JobPostingDelete},
+		{RoleID: 2, PowerType: permission.JobPostingClose},
+		{RoleID: 2, PowerType: permission.JobPostingReopen},
+		{RoleID: 2, PowerType: permission.JobPostingAdd},
+		{RoleID: 2, PowerType: permission.JobApplicationAdd},
+		{RoleID: 2, PowerType: permission.FreelancerHire},
 
 		{RoleID: 3, PowerType: permission.QuestionAdd},
 		{RoleID: 3, PowerType: permission.QuestionEdit},
@@ -213,6 +246,13 @@ var (
 		{RoleID: 3, PowerType: permission.AnswerUnDelete},
 		{RoleID: 3, PowerType: permission.QuestionUnDelete},
 		{RoleID: 3, PowerType: permission.TagUnDelete},
+		{RoleID: 3, PowerType: permission.JobPostingEdit},
+		{RoleID: 3, PowerType: permission.JobPostingDelete},
+		{RoleID: 3, PowerType: permission.JobPostingClose},
+		{RoleID: 3, PowerType: permission.JobPostingReopen},
+		{RoleID: 3, PowerType: permission.JobPostingAdd},
+		{RoleID: 3, PowerType: permission.JobApplicationAdd},
+		{RoleID: 3, PowerType: permission.FreelancerHire},
 	}
 
 	adminUserRoleRel = &entity.UserRoleRel{
@@ -350,6 +390,17 @@ var (
 		{ID: 128, Key: "rank.answer.undeleted", Value: `-1`},
 		{ID: 129, Key: "rank.question.undeleted", Value: `-1`},
 		{ID: 130, Key: "rank.tag.undeleted", Value: `-1`},
+		{ID: 131, Key: "rank.job_posting.edit", Value: `-1`},
+		{ID: 132, Key: "rank.job_posting.delete", Value: `-1`},
+		{ID: 133, Key: "rank.job_posting.close", Value: `-1`},
+		{ID: 134, Key: "rank.job_posting.reopen", Value: `-1`},
+		{ID: 135, Key: "reason.evidence_unclear", Value: `{"name":"evidence unclear","description":"The uploaded evidence is unreadable or does not show the required details."}`},
+		{ID: 136, Key: "reason.identity_mismatch", Value: `{"name":"identity mismatch","description":"The identity in the evidence does not match the profile."}`},
+		{ID: 137, Key: "reason.profile_incomplete", Value: `{"name":"profile incomplete","description":"The freelancer profile must be completed before it can be verified."}`},
+		{ID: 138, Key: "freelancer_verification.reject.reasons", Value: `["reason.evidence_unclear","reason.identity_mismatch","reason.profile_incomplete","reason.something"]`},
+		{ID: 139, Key: "rank.job_posting.add", Value: `50`},
+		{ID: 140, Key: "rank.job_application.add", Value: `10`},
+		{ID: 141, Key: "rank.freelancer.hire", Value: `50`},
 	}
 
 	defaultBadgeGroupTable = []*entity.BadgeGroup{
@@ -506,5 +557,15 @@ var (
 			Handler:      "ReachQuestionVote",
 			Param:        `{"amount":"50"}`,
 		},
+		{
+			Name:         "badge.default_badges.verified_freelancer.name",
+			Icon:         "patch-check-fill",
+			Description:  "badge.default_badges.verified_freelancer.desc",
+			Status:       entity.BadgeStatusActive,
+			BadgeGroupID: 2,
+			Level:        entity.BadgeLevelSilver,
+			Single:       entity.BadgeSingleAward,
+			Handler:      "FreelancerVerified",
+		},
 	}
 )
@@ -103,6 +103,23 @@ var migrations = []Migration{
 	NewMigration("v1.4.5", "add file record", addFileRecord, true),
 	NewMigration("v1.5.1", "add plugin kv storage", addPluginKVStorage, true),
 	NewMigration("v1.6.0", "move user config to interface", moveUserConfigToInterface, true),
+	NewMigration("v1.6.1", "add job posting permission and revision", addJobPostingPermission, true),
+	NewMigration("v1.6.2", "add job posting expiry", addJobPostingExpiry, true),
+	NewMigration("v1.6.3", "add skill relation", addSkillRel, true),
+	NewMigration("v1.6.4", "add contract and milestone", addContract, false),
+	NewMigration("v1.6.5", "add contract review", addContractReview, false),
+	NewMigration("v1.6.6", "add conversation and message", addConversation, false),
+	NewMigration("v1.6.7", "add freelancer listing filters", addFreelancerListingFilters, false),
+	NewMigration("v1.6.8", "add freelancer verification", addFreelancerVerification, true),
+	NewMigration("v1.6.9", "add saved job search", addSavedJobSearch, false),
+	NewMigration("v1.7.0", "add freelancer portfolio item", addFreelancerPortfolioItem, false),
+	NewMigration("v1.7.1", "add base currency amounts", addBaseCurrencyAmounts, true),
+	NewMigration("v1.7.2", "add job posting anti-spam permission", addJobPostingAntiSpamPermission, true),
+	NewMigration("v1.7.3", "add queue message and dead letter", addQueueMessage, false),
+	NewMigration("v1.7.4", "add webhook and delivery log", addWebhook, false),
+	NewMigration("v1.7.5", "add notification digest frequency", addNotificationDigest, false),
+	NewMigration("v1.7.6", "add full-text search index", addFullTextSearchIndex, false),
+	NewMigration("v1.7.7", "add role audit log", addRoleAuditLog, false),
 }
 
 func GetMigrations() []Migration {
@@ -0,0 +1,141 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+func addJobPostingPermission(ctx context.Context, x *xorm.Engine) error {
+	err := x.Context(ctx).Sync(new(entity.FreelancerProfile), new(entity.JobPosting), new(entity.JobApplication))
+	if err != nil {
+		return fmt.Errorf("sync freelancer tables failed: %w", err)
+	}
+	if err = renumberJobPostingID(ctx, x); err != nil {
+		return err
+	}
+
+	powers := []*entity.Power{
+		{ID: 42, Name: "job posting edit", PowerType: permission.JobPostingEdit, Description: "edit job posting"},
+		{ID: 43, Name: "job posting delete", PowerType: permission.JobPostingDelete, Description: "delete job posting"},
+		{ID: 44, Name: "job posting close", PowerType: permission.JobPostingClose, Description: "close job posting"},
+		{ID: 45, Name: "job posting reopen", PowerType: permission.JobPostingReopen, Description: "reopen job posting"},
+	}
+	for _, power := range powers {
+		exist, err := x.Context(ctx).Get(&entity.Power{ID: power.ID})
+		if err != nil {
+			return err
+		}
+		if exist {
+			_, err = x.Context(ctx).ID(power.ID).Update(power)
+		} else {
+			_, err = x.Context(ctx).Insert(power)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	rolePowerRels := []*entity.RolePowerRel{
+		{RoleID: 2, PowerType: permission.JobPostingEdit},
+		{RoleID: 2, PowerType: permission.JobPostingDelete},
+		{RoleID: 2, PowerType: permission.JobPostingClose},
+		{RoleID: 2, PowerType: permission.JobPostingReopen},
+
+		{RoleID: 3, PowerType: permission.JobPostingEdit},
+		{RoleID: 3, PowerType: permission.JobPostingDelete},
+		{RoleID: 3, PowerType: permission.JobPostingClose},
+		{RoleID: 3, PowerType: permission.JobPostingReopen},
+	}
+	for _, rel := range rolePowerRels {
+		exist, err := x.Context(ctx).Get(&entity.RolePowerRel{RoleID: rel.RoleID, PowerType: rel.PowerType})
+		if err != nil {
+			return err
+		}
+		if exist {
+			continue
+		}
+		_, err = x.Context(ctx).Insert(rel)
+		if err != nil {
+			return err
+		}
+	}
+
+	defaultConfigTable := []*entity.Config{
+		{ID: 131, Key: "rank.job_posting.edit", Value: `-1`},
+		{ID: 132, Key: "rank.job_posting.delete", Value: `-1`},
+		{ID: 133, Key: "rank.job_posting.close", Value: `-1`},
+		{ID: 134, Key: "rank.job_posting.reopen", Value: `-1`},
+	}
+	for _, c := range defaultConfigTable {
+		exist, err := x.Context(ctx).Get(&entity.Config{ID: c.ID})
+		if err != nil {
+			return fmt.Errorf("get config failed: %w", err)
+		}
+		if exist {
+			if _, err = x.Context(ctx).Update(c, &entity.Config{ID: c.ID}); err != nil {
+				log.Errorf("update %+v config failed: %s", c, err)
+				return fmt.Errorf("update config failed: %w", err)
+			}
+			continue
+		}
+		if _, err = x.Context(ctx).Insert(&entity.Config{ID: c.ID, Key: c.Key, Value: c.Value}); err != nil {
+			log.Errorf("insert %+v config failed: %s", c, err)
+			return fmt.Errorf("add config failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// renumberJobPostingID job posting used an auto increment id before, which can not be recognized by the revision.
+// Give each of them a unique id with the job posting object type.
+func renumberJobPostingID(ctx context.Context, x *xorm.Engine) error {
+	postings := make([]*entity.JobPosting, 0)
+	err := x.Context(ctx).Where("id < ?", 10000000000000000).Find(&postings)
+	if err != nil {
+		return fmt.Errorf("get job postings failed: %w", err)
+	}
+	objectType := constant.ObjectTypeStrMapping[constant.JobPostingObjectType]
+	for _, posting := range postings {
+		bean := &entity.Uniqid{UniqidType: objectType}
+		if _, err = x.Context(ctx).Insert(bean); err != nil {
+			return fmt.Errorf("add unique id failed: %w", err)
+		}
+		newID := fmt.Sprintf("1%03d%013d", objectType, bean.ID)
+		_, err = x.Context(ctx).Table(new(entity.JobPosting).TableName()).
+			Where("id = ?", posting.ID).Update(map[string]interface{}{"id": newID})
+		if err != nil {
+			return fmt.Errorf("update job posting id failed: %w", err)
+		}
+		_, err = x.Context(ctx).Table(new(entity.JobApplication).TableName()).
+			Where("job_id = ?", posting.ID).Update(map[string]interface{}{"job_id": newID})
+		if err != nil {
+			return fmt.Errorf("update job application job id failed: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"xorm.io/xorm"
+)
+
+func addJobPostingExpiry(ctx context.Context, x *xorm.Engine) error {
+	if err := x.Context(ctx).Sync(new(entity.JobPosting)); err != nil {
+		return fmt.Errorf("sync job posting table failed: %w", err)
+	}
+
+	// The job posting created without expiration time will expire after the default max lifetime since it was created.
+	postings := make([]*entity.JobPosting, 0)
+	if err := x.Context(ctx).Cols("id", "created_at", "expires_at").Find(&postings); err != nil {
+		return fmt.Errorf("get job postings failed: %w", err)
+	}
+	for _, posting := range postings {
+		if posting.ExpiresAt.After(posting.CreatedAt) {
+			continue
+		}
+		expiresAt := posting.CreatedAt.Add(constant.DefaultJobPostingMaxLifetimeDays * 24 * time.Hour)
+		_, err := x.Context(ctx).ID(posting.ID).Cols("expires_at").Update(&entity.JobPosting{ExpiresAt: expiresAt})
+		if err != nil {
+			return fmt.Errorf("update job posting expiration time failed: %w", err)
+		}
+	}
+	return nil
+}
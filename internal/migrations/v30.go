@@ -0,0 +1,178 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+func addSkillRel(ctx context.Context, x *xorm.Engine) error {
+	if err := x.Context(ctx).Sync(new(entity.SkillRel)); err != nil {
+		return fmt.Errorf("sync skill rel table failed: %w", err)
+	}
+	m := &skillMigrator{ctx: ctx, x: x, tags: make(map[string]*entity.Tag)}
+
+	profiles := make([]*entity.FreelancerProfile, 0)
+	if err := x.Context(ctx).Cols("id", "user_id", "skills").Find(&profiles); err != nil {
+		return fmt.Errorf("get freelancer profiles failed: %w", err)
+	}
+	for _, profile := range profiles {
+		skills, err := m.convert(entity.SkillRelObjectTypeFreelancer, profile.UserID, profile.UserID, profile.Skills)
+		if err != nil {
+			return err
+		}
+		_, err = x.Context(ctx).ID(profile.ID).Cols("skills").Update(&entity.FreelancerProfile{Skills: skills})
+		if err != nil {
+			return fmt.Errorf("update freelancer profile skills failed: %w", err)
+		}
+	}
+
+	postings := make([]*entity.JobPosting, 0)
+	if err := x.Context(ctx).Cols("id", "user_id", "skills").Find(&postings); err != nil {
+		return fmt.Errorf("get job postings failed: %w", err)
+	}
+	for _, posting := range postings {
+		skills, err := m.convert(entity.SkillRelObjectTypeJobPosting, posting.ID, posting.UserID, posting.Skills)
+		if err != nil {
+			return err
+		}
+		_, err = x.Context(ctx).ID(posting.ID).Cols("skills").Update(&entity.JobPosting{Skills: skills})
+		if err != nil {
+			return fmt.Errorf("update job posting skills failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// skillSlugNameMaxLength the max length of the tag slug name
+const skillSlugNameMaxLength = 35
+
+// truncateSkillSlugName cut the slug name to the max length of the tag slug name
+func truncateSkillSlugName(slugName string) string {
+	runes := []rune(slugName)
+	if len(runes) <= skillSlugNameMaxLength {
+		return slugName
+	}
+	return strings.TrimRight(string(runes[:skillSlugNameMaxLength]), "-")
+}
+
+// skillMigrator converts the skills stored as json array into skill relations of tags.
+type skillMigrator struct {
+	ctx  context.Context
+	x    *xorm.Engine
+	tags map[string]*entity.Tag
+}
+
+// convert add skill relations for the object and return the skills json with the main tag slug names
+func (m *skillMigrator) convert(objectType, objectID, userID, skillsJSON string) (string, error) {
+	skills := make([]string, 0)
+	_ = json.Unmarshal([]byte(skillsJSON), &skills)
+
+	slugNames := make([]string, 0, len(skills))
+	seen := make(map[string]bool)
+	for _, skill := range skills {
+		slugName := strings.Join(strings.Fields(strings.ToLower(skill)), "-")
+		if len(slugName) == 0 {
+			continue
+		}
+		if truncated := truncateSkillSlugName(slugName); truncated != slugName {
+			log.Warnf("skill [%s] of %s %s is longer than %d characters, it is saved as tag [%s]",
+				skill, objectType, objectID, skillSlugNameMaxLength, truncated)
+			slugName = truncated
+		}
+		tag, err := m.getOrAddTag(slugName, skill, userID)
+		if err != nil {
+			return "", err
+		}
+		if seen[tag.ID] {
+			continue
+		}
+		seen[tag.ID] = true
+
+		exist, err := m.x.Context(m.ctx).Exist(&entity.SkillRel{ObjectType: objectType, ObjectID: objectID, TagID: tag.ID})
+		if err != nil {
+			return "", fmt.Errorf("get skill rel failed: %w", err)
+		}
+		if !exist {
+			rel := &entity.SkillRel{ObjectType: objectType, ObjectID: objectID, TagID: tag.ID, Sort: len(slugNames)}
+			if _, err = m.x.Context(m.ctx).Insert(rel); err != nil {
+				return "", fmt.Errorf("add skill rel failed: %w", err)
+			}
+		}
+		slugNames = append(slugNames, tag.SlugName)
+	}
+	data, _ := json.Marshal(slugNames)
+	return string(data), nil
+}
+
+// getOrAddTag get the main tag of the slug name, the tag is created if not exist
+func (m *skillMigrator) getOrAddTag(slugName, displayName, userID string) (*entity.Tag, error) {
+	if tag, ok := m.tags[slugName]; ok {
+		return tag, nil
+	}
+	tag := &entity.Tag{}
+	exist, err := m.x.Context(m.ctx).Where("slug_name = ?", slugName).
+		And("status = ?", entity.TagStatusAvailable).Get(tag)
+	if err != nil {
+		return nil, fmt.Errorf("get tag failed: %w", err)
+	}
+	if exist && tag.MainTagID > 0 {
+		mainTag := &entity.Tag{}
+		mainExist, err := m.x.Context(m.ctx).ID(tag.MainTagID).Get(mainTag)
+		if err != nil {
+			return nil, fmt.Errorf("get main tag failed: %w", err)
+		}
+		if mainExist {
+			tag = mainTag
+		}
+	}
+	if !exist {
+		objectType := constant.ObjectTypeStrMapping[constant.TagObjectType]
+		bean := &entity.Uniqid{UniqidType: objectType}
+		if _, err = m.x.Context(m.ctx).Insert(bean); err != nil {
+			return nil, fmt.Errorf("add unique id failed: %w", err)
+		}
+		displayName = strings.TrimSpace(displayName)
+		if utf8.RuneCountInString(displayName) > skillSlugNameMaxLength {
+			displayName = slugName
+		}
+		tag = &entity.Tag{
+			ID:          fmt.Sprintf("1%03d%013d", objectType, bean.ID),
+			SlugName:    slugName,
+			DisplayName: displayName,
+			Status:      entity.TagStatusAvailable,
+			UserID:      userID,
+		}
+		if _, err = m.x.Context(m.ctx).Insert(tag); err != nil {
+			return nil, fmt.Errorf("add tag failed: %w", err)
+		}
+	}
+	m.tags[slugName] = tag
+	return tag, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/pkg/day"
+	"xorm.io/xorm"
+)
+
+func addFreelancerListingFilters(ctx context.Context, x *xorm.Engine) error {
+	err := x.Context(ctx).Sync(new(entity.FreelancerProfile))
+	if err != nil {
+		return fmt.Errorf("sync freelancer profile table failed: %w", err)
+	}
+
+	profiles := make([]*entity.FreelancerProfile, 0)
+	err = x.Context(ctx).Where("time_zone <> ?", "").Find(&profiles)
+	if err != nil {
+		return fmt.Errorf("get freelancer profiles failed: %w", err)
+	}
+	for _, profile := range profiles {
+		offset, ok := day.UTCOffset(profile.TimeZone)
+		if !ok {
+			continue
+		}
+		_, err = x.Context(ctx).ID(profile.ID).Cols("utc_offset", "has_utc_offset").
+			Update(&entity.FreelancerProfile{UTCOffset: offset, HasUTCOffset: true})
+		if err != nil {
+			return fmt.Errorf("update freelancer profile utc offset failed: %w", err)
+		}
+	}
+	return nil
+}
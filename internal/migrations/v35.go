@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/unique"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+func addFreelancerVerification(ctx context.Context, x *xorm.Engine) error {
+	err := x.Context(ctx).Sync(new(entity.FreelancerVerification))
+	if err != nil {
+		return fmt.Errorf("sync freelancer verification table failed: %w", err)
+	}
+
+	defaultConfigTable := []*entity.Config{
+		{ID: 135, Key: "reason.evidence_unclear", Value: `{"name":"evidence unclear","description":"The uploaded evidence is unreadable or does not show the required details."}`},
+		{ID: 136, Key: "reason.identity_mismatch", Value: `{"name":"identity mismatch","description":"The identity in the evidence does not match the profile."}`},
+		{ID: 137, Key: "reason.profile_incomplete", Value: `{"name":"profile incomplete","description":"The freelancer profile must be completed before it can be verified."}`},
+		{ID: 138, Key: "freelancer_verification.reject.reasons", Value: `["reason.evidence_unclear","reason.identity_mismatch","reason.profile_incomplete","reason.something"]`},
+	}
+	for _, c := range defaultConfigTable {
+		exist, err := x.Context(ctx).Get(&entity.Config{ID: c.ID})
+		if err != nil {
+			return fmt.Errorf("get config failed: %w", err)
+		}
+		if exist {
+			if _, err = x.Context(ctx).Update(c, &entity.Config{ID: c.ID}); err != nil {
+				log.Errorf("update %+v config failed: %s", c, err)
+				return fmt.Errorf("update config failed: %w", err)
+			}
+			continue
+		}
+		if _, err = x.Context(ctx).Insert(&entity.Config{ID: c.ID, Key: c.Key, Value: c.Value}); err != nil {
+			log.Errorf("insert %+v config failed: %s", c, err)
+			return fmt.Errorf("add config failed: %w", err)
+		}
+	}
+
+	badge := &entity.Badge{
+		Name:         "badge.default_badges.verified_freelancer.name",
+		Icon:         "patch-check-fill",
+		Description:  "badge.default_badges.verified_freelancer.desc",
+		Status:       entity.BadgeStatusActive,
+		BadgeGroupID: 2,
+		Level:        entity.BadgeLevelSilver,
+		Single:       entity.BadgeSingleAward,
+		Handler:      "FreelancerVerified",
+	}
+	exist, err := x.Context(ctx).Get(&entity.Badge{Name: badge.Name})
+	if err != nil {
+		return fmt.Errorf("get badge failed: %w", err)
+	}
+	if exist {
+		return nil
+	}
+	badge.ID, err = unique.NewUniqueIDRepo(&data.Data{DB: x}).GenUniqueIDStr(ctx, new(entity.Badge).TableName())
+	if err != nil {
+		return err
+	}
+	if _, err = x.Context(ctx).Insert(badge); err != nil {
+		return fmt.Errorf("add badge failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/apache/answer/internal/entity"
+	"xorm.io/xorm"
+)
+
+func addFreelancerPortfolioItem(ctx context.Context, x *xorm.Engine) error {
+	err := x.Context(ctx).Sync(new(entity.FreelancerPortfolioItem))
+	if err != nil {
+		return fmt.Errorf("sync freelancer portfolio item table failed: %w", err)
+	}
+
+	// the free-form portfolio of the profile becomes the portfolio items
+	profiles := make([]*entity.FreelancerProfile, 0)
+	err = x.Context(ctx).Where("portfolio IS NOT NULL AND portfolio <> ? AND portfolio <> ?", "", "[]").Find(&profiles)
+	if err != nil {
+		return fmt.Errorf("get freelancer profiles failed: %w", err)
+	}
+	for _, profile := range profiles {
+		var portfolio []string
+		if err := json.Unmarshal([]byte(profile.Portfolio), &portfolio); err != nil {
+			continue
+		}
+		exist, err := x.Context(ctx).Exist(&entity.FreelancerPortfolioItem{UserID: profile.UserID})
+		if err != nil {
+			return fmt.Errorf("get freelancer portfolio item failed: %w", err)
+		}
+		if exist {
+			continue
+		}
+		for i, content := range portfolio {
+			content = strings.TrimSpace(content)
+			if len(content) == 0 {
+				continue
+			}
+			item := &entity.FreelancerPortfolioItem{
+				UserID:    profile.UserID,
+				Title:     content,
+				Images:    "[]",
+				Files:     "[]",
+				Tags:      "[]",
+				AnswerIDs: "[]",
+				Sort:      i,
+			}
+			if u, err := url.Parse(content); err == nil && (u.Scheme == "http" || u.Scheme == "https") &&
+				len(content) <= 512 {
+				item.ExternalURL = content
+				item.Title = u.Host + u.Path
+			}
+			if title := []rune(item.Title); len(title) > 150 {
+				item.Title = string(title[:150])
+			}
+			if _, err = x.Context(ctx).Insert(item); err != nil {
+				return fmt.Errorf("add freelancer portfolio item failed: %w", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"xorm.io/xorm"
+)
+
+func addBaseCurrencyAmounts(ctx context.Context, x *xorm.Engine) error {
+	err := x.Context(ctx).Sync(new(entity.FreelancerProfile), new(entity.JobPosting), new(entity.JobApplication))
+	if err != nil {
+		return fmt.Errorf("sync freelancer tables failed: %w", err)
+	}
+
+	// the currency was free text, it becomes the upper case ISO 4217 code.
+	// The amounts in the default base currency are copied, the others are converted
+	// by the refresh task after the exchange rates are configured.
+	amounts := []struct {
+		table, amount, baseAmount string
+	}{
+		{entity.FreelancerProfile{}.TableName(), "hourly_rate", "base_hourly_rate"},
+		{entity.JobPosting{}.TableName(), "budget", "base_budget"},
+		{entity.JobApplication{}.TableName(), "proposed_rate", "base_proposed_rate"},
+	}
+	for _, a := range amounts {
+		_, err = x.Context(ctx).Exec(fmt.Sprintf("UPDATE %s SET currency = UPPER(TRIM(currency))", a.table))
+		if err != nil {
+			return fmt.Errorf("update %s currency failed: %w", a.table, err)
+		}
+		_, err = x.Context(ctx).Exec(fmt.Sprintf("UPDATE %s SET currency = ? WHERE currency = ?", a.table),
+			constant.DefaultBaseCurrency, "")
+		if err != nil {
+			return fmt.Errorf("update %s empty currency failed: %w", a.table, err)
+		}
+		_, err = x.Context(ctx).Exec(fmt.Sprintf("UPDATE %s SET %s = %s WHERE currency = ?",
+			a.table, a.baseAmount, a.amount), constant.DefaultBaseCurrency)
+		if err != nil {
+			return fmt.Errorf("update %s base amount failed: %w", a.table, err)
+		}
+	}
+	return nil
+}
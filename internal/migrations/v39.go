@@ -0,0 +1,135 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+func addJobPostingAntiSpamPermission(ctx context.Context, x *xorm.Engine) error {
+	powers := []*entity.Power{
+		{ID: 46, Name: "job posting add", PowerType: permission.JobPostingAdd, Description: "add job posting"},
+		{ID: 47, Name: "job application add", PowerType: permission.JobApplicationAdd, Description: "apply for job posting"},
+		{ID: 48, Name: "freelancer hire", PowerType: permission.FreelancerHire, Description: "hire freelancer"},
+	}
+	for _, power := range powers {
+		exist, err := x.Context(ctx).Get(&entity.Power{ID: power.ID})
+		if err != nil {
+			return err
+		}
+		if exist {
+			_, err = x.Context(ctx).ID(power.ID).Update(power)
+		} else {
+			_, err = x.Context(ctx).Insert(power)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	rolePowerRels := []*entity.RolePowerRel{
+		{RoleID: 2, PowerType: permission.JobPostingAdd},
+		{RoleID: 2, PowerType: permission.JobApplicationAdd},
+		{RoleID: 2, PowerType: permission.FreelancerHire},
+
+		{RoleID: 3, PowerType: permission.JobPostingAdd},
+		{RoleID: 3, PowerType: permission.JobApplicationAdd},
+		{RoleID: 3, PowerType: permission.FreelancerHire},
+	}
+	for _, rel := range rolePowerRels {
+		exist, err := x.Context(ctx).Get(&entity.RolePowerRel{RoleID: rel.RoleID, PowerType: rel.PowerType})
+		if err != nil {
+			return err
+		}
+		if exist {
+			continue
+		}
+		_, err = x.Context(ctx).Insert(rel)
+		if err != nil {
+			return err
+		}
+	}
+
+	rankValues, err := getSitePrivilegeRankValues(ctx, x)
+	if err != nil {
+		return err
+	}
+	defaultConfigTable := []*entity.Config{
+		{ID: 139, Key: constant.RankJobPostingAddKey},
+		{ID: 140, Key: constant.RankJobApplicationAddKey},
+		{ID: 141, Key: constant.RankFreelancerHireKey},
+	}
+	for _, c := range defaultConfigTable {
+		c.Value = fmt.Sprintf("%d", rankValues[c.Key])
+		exist, err := x.Context(ctx).Get(&entity.Config{ID: c.ID})
+		if err != nil {
+			return fmt.Errorf("get config failed: %w", err)
+		}
+		if exist {
+			if _, err = x.Context(ctx).Update(c, &entity.Config{ID: c.ID}); err != nil {
+				log.Errorf("update %+v config failed: %s", c, err)
+				return fmt.Errorf("update config failed: %w", err)
+			}
+			continue
+		}
+		if _, err = x.Context(ctx).Insert(&entity.Config{ID: c.ID, Key: c.Key, Value: c.Value}); err != nil {
+			log.Errorf("insert %+v config failed: %s", c, err)
+			return fmt.Errorf("add config failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// getSitePrivilegeRankValues the rank values of the privilege level which the site has chosen.
+// The site which has never chosen a level or uses the custom level gets the default level of a fresh install.
+func getSitePrivilegeRankValues(ctx context.Context, x *xorm.Engine) (rankValues map[string]int, err error) {
+	level := schema.PrivilegeLevel2
+	siteInfo := &entity.SiteInfo{}
+	exist, err := x.Context(ctx).Where("type = ?", constant.SiteTypePrivileges).Get(siteInfo)
+	if err != nil {
+		return nil, fmt.Errorf("get privileges config failed: %w", err)
+	}
+	if exist {
+		privileges := &schema.UpdatePrivilegesConfigReq{}
+		if err = json.Unmarshal([]byte(siteInfo.Content), privileges); err != nil {
+			log.Errorf("parse privileges config failed: %s", err)
+		} else if privileges.Level != schema.PrivilegeLevelCustom {
+			level = privileges.Level
+		}
+	}
+	option := schema.DefaultPrivilegeOptions.Choose(level)
+	if option == nil {
+		option = schema.DefaultPrivilegeOptions.Choose(schema.PrivilegeLevel2)
+	}
+	rankValues = make(map[string]int, len(option.Privileges))
+	for _, privilege := range option.Privileges {
+		rankValues[privilege.Key] = privilege.Value
+	}
+	return rankValues, nil
+}
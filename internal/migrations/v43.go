@@ -0,0 +1,123 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"xorm.io/xorm"
+	"xorm.io/xorm/schemas"
+)
+
+// The PostgreSQL index expressions must stay identical to the ones used by the search repo,
+// otherwise the planner will not use the GIN indexes.
+const (
+	postgresQuestionFullTextExpr = `to_tsvector('simple', coalesce("title", '') || ' ' || coalesce("original_text", ''))`
+	postgresAnswerFullTextExpr   = `to_tsvector('simple', coalesce("original_text", ''))`
+)
+
+func addFullTextSearchIndex(ctx context.Context, x *xorm.Engine) (err error) {
+	switch x.Dialect().URI().DBType {
+	case schemas.MYSQL:
+		err = addMySQLFullTextIndex(ctx, x)
+	case schemas.POSTGRES:
+		err = addPostgresFullTextIndex(ctx, x)
+	case schemas.SQLITE:
+		err = addSQLiteFullTextIndex(ctx, x)
+	}
+	return err
+}
+
+func addMySQLFullTextIndex(ctx context.Context, x *xorm.Engine) error {
+	indexes := []struct {
+		table, name, columns string
+	}{
+		{table: "question", name: "IDX_question_fulltext", columns: "`title`, `original_text`"},
+		{table: "answer", name: "IDX_answer_fulltext", columns: "`original_text`"},
+	}
+	for _, idx := range indexes {
+		res, err := x.Context(ctx).QueryString(
+			fmt.Sprintf("SHOW INDEX FROM `%s` WHERE `Key_name` = ?", idx.table), idx.name)
+		if err != nil {
+			return fmt.Errorf("check %s full-text index failed: %w", idx.table, err)
+		}
+		if len(res) > 0 {
+			continue
+		}
+		_, err = x.Context(ctx).Exec(
+			fmt.Sprintf("ALTER TABLE `%s` ADD FULLTEXT INDEX `%s` (%s)", idx.table, idx.name, idx.columns))
+		if err != nil {
+			return fmt.Errorf("create %s full-text index failed: %w", idx.table, err)
+		}
+	}
+	return nil
+}
+
+func addPostgresFullTextIndex(ctx context.Context, x *xorm.Engine) error {
+	_, err := x.Context(ctx).Exec(`CREATE INDEX IF NOT EXISTS "IDX_question_fulltext" ON "question" USING GIN (` +
+		postgresQuestionFullTextExpr + `)`)
+	if err != nil {
+		return fmt.Errorf("create question full-text index failed: %w", err)
+	}
+	_, err = x.Context(ctx).Exec(`CREATE INDEX IF NOT EXISTS "IDX_answer_fulltext" ON "answer" USING GIN (` +
+		postgresAnswerFullTextExpr + `)`)
+	if err != nil {
+		return fmt.Errorf("create answer full-text index failed: %w", err)
+	}
+	return nil
+}
+
+// addSQLiteFullTextIndex creates external content FTS5 tables that are kept in sync by triggers,
+// then rebuilds them from the existing rows.
+func addSQLiteFullTextIndex(ctx context.Context, x *xorm.Engine) error {
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS "question_fts" USING fts5("title", "original_text", content='question', content_rowid='id')`,
+		`CREATE TRIGGER IF NOT EXISTS "question_fts_ai" AFTER INSERT ON "question" BEGIN
+  INSERT INTO "question_fts" (rowid, "title", "original_text") VALUES (new."id", new."title", new."original_text");
+END`,
+		`CREATE TRIGGER IF NOT EXISTS "question_fts_ad" AFTER DELETE ON "question" BEGIN
+  INSERT INTO "question_fts" ("question_fts", rowid, "title", "original_text") VALUES ('delete', old."id", old."title", old."original_text");
+END`,
+		`CREATE TRIGGER IF NOT EXISTS "question_fts_au" AFTER UPDATE OF "title", "original_text" ON "question" BEGIN
+  INSERT INTO "question_fts" ("question_fts", rowid, "title", "original_text") VALUES ('delete', old."id", old."title", old."original_text");
+  INSERT INTO "question_fts" (rowid, "title", "original_text") VALUES (new."id", new."title", new."original_text");
+END`,
+		`INSERT INTO "question_fts" ("question_fts") VALUES ('rebuild')`,
+		`CREATE VIRTUAL TABLE IF NOT EXISTS "answer_fts" USING fts5("original_text", content='answer', content_rowid='id')`,
+		`CREATE TRIGGER IF NOT EXISTS "answer_fts_ai" AFTER INSERT ON "answer" BEGIN
+  INSERT INTO "answer_fts" (rowid, "original_text") VALUES (new."id", new."original_text");
+END`,
+		`CREATE TRIGGER IF NOT EXISTS "answer_fts_ad" AFTER DELETE ON "answer" BEGIN
+  INSERT INTO "answer_fts" ("answer_fts", rowid, "original_text") VALUES ('delete', old."id", old."original_text");
+END`,
+		`CREATE TRIGGER IF NOT EXISTS "answer_fts_au" AFTER UPDATE OF "original_text" ON "answer" BEGIN
+  INSERT INTO "answer_fts" ("answer_fts", rowid, "original_text") VALUES ('delete', old."id", old."original_text");
+  INSERT INTO "answer_fts" (rowid, "original_text") VALUES (new."id", new."original_text");
+END`,
+		`INSERT INTO "answer_fts" ("answer_fts") VALUES ('rebuild')`,
+	}
+	for _, statement := range statements {
+		if _, err := x.Context(ctx).Exec(statement); err != nil {
+			return fmt.Errorf("create sqlite full-text index failed: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxTermLength the longer words are not indexed, they are usually hashes or urls
+const maxTermLength = 64
+
+// token is an analyzed term and its rune offsets in the text
+type token struct {
+	term       string
+	start, end int
+}
+
+// analyzer splits the text to terms. The words are lower-cased, folded to remove the accents
+// and stemmed by the language. The CJK characters are indexed as overlapping bigrams because
+// there is no space between the words.
+type analyzer struct {
+	language string
+	stem     func(word string) string
+}
+
+func newAnalyzer(language string) *analyzer {
+	stem, ok := stemmers[language]
+	if !ok {
+		language, stem = LanguageNone, func(word string) string { return word }
+	}
+	return &analyzer{language: language, stem: stem}
+}
+
+// terms returns the terms of the text in order, they may be duplicated
+func (a *analyzer) terms(text string) []string {
+	tokens := a.tokens(text)
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		terms = append(terms, t.term)
+	}
+	return terms
+}
+
+func (a *analyzer) tokens(text string) (tokens []token) {
+	var (
+		word      []rune
+		cjk       []rune
+		wordStart int
+		cjkStart  int
+	)
+	flushWord := func(end int) {
+		if len(word) > 0 && len(word) <= maxTermLength {
+			tokens = append(tokens, token{term: a.stem(foldAccents(string(word))), start: wordStart, end: end})
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			tokens = append(tokens, token{term: string(cjk), start: cjkStart, end: cjkStart + 1})
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			tokens = append(tokens, token{term: string(cjk[i : i+2]), start: cjkStart + i, end: cjkStart + i + 2})
+		}
+		cjk = cjk[:0]
+	}
+
+	pos := 0
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord(pos)
+			if len(cjk) == 0 {
+				cjkStart = pos
+			}
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r):
+			flushCJK()
+			if len(word) == 0 {
+				wordStart = pos
+			}
+			word = append(word, unicode.ToLower(r))
+		default:
+			flushWord(pos)
+			flushCJK()
+		}
+		pos++
+	}
+	flushWord(pos)
+	flushCJK()
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// foldAccents removes the diacritics, e.g. "café" -> "cafe"
+func foldAccents(word string) string {
+	ascii := true
+	for i := 0; i < len(word); i++ {
+		if word[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return word
+	}
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, word)
+	if err != nil {
+		return word
+	}
+	return strings.ToLower(folded)
+}
@@ -0,0 +1,105 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemEnglish(t *testing.T) {
+	cases := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"digitizer":      "digit",
+		"vietnamization": "vietnam",
+		"hopefulness":    "hope",
+		"sensibiliti":    "sensibl",
+		"triplicate":     "triplic",
+		"electrical":     "electr",
+		"goodness":       "good",
+		"allowance":      "allow",
+		"adjustment":     "adjust",
+		"adoption":       "adopt",
+		"communism":      "commun",
+		"effective":      "effect",
+		"cease":          "ceas",
+		"controll":       "control",
+		"roll":           "roll",
+		"go":             "go",
+		"c++":            "c++",
+	}
+	for word, stem := range cases {
+		assert.Equal(t, stem, stemEnglish(word), word)
+	}
+}
+
+func TestStemOtherLanguages(t *testing.T) {
+	assert.Equal(t, "cheval", stemFrench("chevaux"))
+	assert.Equal(t, "chanteus", stemFrench("chanteuses"))
+	assert.Equal(t, "katz", stemGerman("katzen"))
+	assert.Equal(t, "luz", stemSpanish("luces"))
+	assert.Equal(t, "casa", stemSpanish("casas"))
+	assert.Equal(t, "cancao", stemPortuguese("cancoes"))
+	assert.Equal(t, "papel", stemPortuguese("papeis"))
+	assert.Equal(t, "amic", stemItalian("amiche"))
+}
+
+func TestAnalyzerTokens(t *testing.T) {
+	a := newAnalyzer(LanguageEnglish)
+	assert.Equal(t, []string{"run", "test", "cafe", "v2"}, a.terms("Running TESTS, café v2!"))
+
+	tokens := a.tokens("use 搜索引擎")
+	assert.Equal(t, []token{
+		{term: "us", start: 0, end: 3},
+		{term: "搜索", start: 4, end: 6},
+		{term: "索引", start: 5, end: 7},
+		{term: "引擎", start: 6, end: 8},
+	}, tokens)
+
+	assert.Equal(t, []string{"running"}, newAnalyzer(LanguageNone).terms("running"))
+	assert.Equal(t, LanguageNone, newAnalyzer("klingon").language)
+}
+
+func TestLanguageOfSite(t *testing.T) {
+	assert.Equal(t, LanguageEnglish, languageOfSite("en_US"))
+	assert.Equal(t, LanguagePortuguese, languageOfSite("pt_BR"))
+	assert.Equal(t, LanguageNone, languageOfSite("zh_CN"))
+	assert.Equal(t, LanguageNone, languageOfSite(""))
+}
+
+func TestEditDistance(t *testing.T) {
+	assert.Equal(t, 1, editDistance([]rune("golang"), []rune("golnag"), 2))
+	assert.Equal(t, 1, editDistance([]rune("search"), []rune("serch"), 2))
+	assert.Equal(t, 2, editDistance([]rune("search"), []rune("sarch!"), 2))
+	assert.Equal(t, 2, editDistance([]rune("search"), []rune("index"), 1))
+}
@@ -0,0 +1,296 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/cli"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/log"
+)
+
+// SlugName slug name of the built-in embedded search plugin
+const SlugName = "embedded_search"
+
+// rebuildPageSize the number of contents read from the syncer at a time when the index is rebuilt
+const rebuildPageSize = 200
+
+type Config struct {
+	// IndexDir the directory of the index, the search_index directory under the data directory if empty
+	IndexDir string `json:"index_dir"`
+	// Language the stemming language, auto follows the site language
+	Language      string `json:"language"`
+	TypoTolerance bool   `json:"typo_tolerance"`
+}
+
+// EmbeddedSearch the built-in search plugin. It keeps an inverted index on the local disk,
+// so no external search service is needed.
+type EmbeddedSearch struct {
+	lock   sync.Mutex
+	config *Config
+	index  *index
+	syncer plugin.SearchSyncer
+	// rebuiltLanguage the language of the last rebuild in this process, it avoids rebuilding an empty index again and again
+	rebuiltLanguage string
+	building        atomic.Bool
+}
+
+var embeddedSearch = &EmbeddedSearch{
+	config: &Config{Language: LanguageAuto, TypoTolerance: true},
+}
+
+func init() {
+	plugin.Register(embeddedSearch)
+}
+
+// SetDefaultSyncer gives the syncer to the plugin before it is configured, then the index is
+// built from the database when the plugin is used the first time.
+func SetDefaultSyncer(syncer plugin.SearchSyncer) {
+	embeddedSearch.lock.Lock()
+	defer embeddedSearch.lock.Unlock()
+	if embeddedSearch.syncer == nil {
+		embeddedSearch.syncer = syncer
+	}
+}
+
+func (e *EmbeddedSearch) Info() plugin.Info {
+	return plugin.Info{
+		Name:     plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Embedded search" }},
+		SlugName: SlugName,
+		Description: plugin.Translator{Fn: func(ctx *plugin.GinContext) string {
+			return "Full-text search with a local index, no external search service is needed"
+		}},
+		Version: "1.0.0",
+	}
+}
+
+func (e *EmbeddedSearch) Description() plugin.SearchDesc {
+	return plugin.SearchDesc{}
+}
+
+func (e *EmbeddedSearch) ConfigFields() []plugin.ConfigField {
+	e.lock.Lock()
+	config := *e.config
+	e.lock.Unlock()
+
+	languageOptions := make([]plugin.ConfigFieldOption, 0)
+	for _, language := range []struct{ value, label string }{
+		{LanguageAuto, "Same as the site language"},
+		{LanguageEnglish, "English"},
+		{LanguageFrench, "French"},
+		{LanguageGerman, "German"},
+		{LanguageSpanish, "Spanish"},
+		{LanguagePortuguese, "Portuguese"},
+		{LanguageItalian, "Italian"},
+		{LanguageNone, "No stemming"},
+	} {
+		label := language.label
+		languageOptions = append(languageOptions, plugin.ConfigFieldOption{
+			Label: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return label }},
+			Value: language.value,
+		})
+	}
+	return []plugin.ConfigField{
+		{
+			Name:  "index_dir",
+			Type:  plugin.ConfigTypeInput,
+			Title: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Index directory" }},
+			Description: plugin.Translator{Fn: func(ctx *plugin.GinContext) string {
+				return "Leave it empty to keep the index in the search_index directory under the data directory."
+			}},
+			Value: config.IndexDir,
+			UIOptions: plugin.ConfigFieldUIOptions{
+				InputType: plugin.InputTypeText,
+			},
+		},
+		{
+			Name:  "language",
+			Type:  plugin.ConfigTypeSelect,
+			Title: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Stemming language" }},
+			Description: plugin.Translator{Fn: func(ctx *plugin.GinContext) string {
+				return "Words are reduced to their stem, so that \"running\" matches \"run\". Saving the config rebuilds the index."
+			}},
+			Value:   config.Language,
+			Options: languageOptions,
+		},
+		{
+			Name:  "typo_tolerance",
+			Type:  plugin.ConfigTypeSwitch,
+			Title: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Typo tolerance" }},
+			Value: config.TypoTolerance,
+			UIOptions: plugin.ConfigFieldUIOptions{
+				Label: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Also match the words with one or two typos" }},
+			},
+		},
+	}
+}
+
+func (e *EmbeddedSearch) ConfigReceiver(config []byte) error {
+	c := &Config{}
+	if err := json.Unmarshal(config, c); err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.index != nil && c.IndexDir != e.config.IndexDir {
+		if err := e.index.close(); err != nil {
+			log.Errorf("close embedded search index failed: %v", err)
+		}
+		e.index = nil
+	}
+	e.config = c
+	return nil
+}
+
+// RegisterSyncer is called when the config is saved, the index is rebuilt with the new config.
+func (e *EmbeddedSearch) RegisterSyncer(ctx context.Context, syncer plugin.SearchSyncer) {
+	e.lock.Lock()
+	e.syncer = syncer
+	e.lock.Unlock()
+	go e.rebuild()
+}
+
+func (e *EmbeddedSearch) SearchContents(ctx context.Context, cond *plugin.SearchBasicCond) (
+	res []plugin.SearchResult, total int64, err error) {
+	return e.search(cond, constant.QuestionObjectType, constant.AnswerObjectType)
+}
+
+func (e *EmbeddedSearch) SearchQuestions(ctx context.Context, cond *plugin.SearchBasicCond) (
+	res []plugin.SearchResult, total int64, err error) {
+	return e.search(cond, constant.QuestionObjectType)
+}
+
+func (e *EmbeddedSearch) SearchAnswers(ctx context.Context, cond *plugin.SearchBasicCond) (
+	res []plugin.SearchResult, total int64, err error) {
+	return e.search(cond, constant.AnswerObjectType)
+}
+
+func (e *EmbeddedSearch) UpdateContent(ctx context.Context, content *plugin.SearchContent) (err error) {
+	idx, err := e.getIndex()
+	if err != nil {
+		return err
+	}
+	return idx.put(content)
+}
+
+func (e *EmbeddedSearch) DeleteContent(ctx context.Context, objectID string) (err error) {
+	idx, err := e.getIndex()
+	if err != nil {
+		return err
+	}
+	return idx.delete(objectID)
+}
+
+func (e *EmbeddedSearch) search(cond *plugin.SearchBasicCond, types ...string) (
+	res []plugin.SearchResult, total int64, err error) {
+	idx, err := e.getIndex()
+	if err != nil {
+		return nil, 0, err
+	}
+	e.lock.Lock()
+	typoTolerance := e.config.TypoTolerance
+	e.lock.Unlock()
+	return idx.search(cond, types, typoTolerance)
+}
+
+// getIndex opens the index at the first use. The index is rebuilt in the background
+// if it is empty or its language is changed.
+func (e *EmbeddedSearch) getIndex() (idx *index, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	language := e.language()
+	if e.index == nil {
+		e.index, err = openIndex(e.indexDir(), language)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if e.syncer != nil && e.rebuiltLanguage != language && e.index.outdated(language) {
+		go e.rebuild()
+	}
+	return e.index, nil
+}
+
+// rebuild removes all documents and indexes the questions and answers from the syncer
+func (e *EmbeddedSearch) rebuild() {
+	if !e.building.CompareAndSwap(false, true) {
+		return
+	}
+	defer e.building.Store(false)
+
+	e.lock.Lock()
+	syncer, language := e.syncer, e.language()
+	e.rebuiltLanguage = language
+	e.lock.Unlock()
+	idx, err := e.getIndex()
+	if err != nil {
+		log.Errorf("open embedded search index failed: %v", err)
+		return
+	}
+	if err = idx.reset(language); err != nil {
+		log.Errorf("reset embedded search index failed: %v", err)
+		return
+	}
+
+	ctx := context.Background()
+	pages := []func(ctx context.Context, page, pageSize int) ([]*plugin.SearchContent, error){
+		syncer.GetQuestionsPage,
+		syncer.GetAnswersPage,
+	}
+	for _, getPage := range pages {
+		for page := 1; ; page++ {
+			contents, err := getPage(ctx, page, rebuildPageSize)
+			if err != nil {
+				log.Errorf("get contents for embedded search index failed: %v", err)
+				return
+			}
+			for _, content := range contents {
+				if err = idx.put(content); err != nil {
+					log.Errorf("index content %s failed: %v", content.ObjectID, err)
+				}
+			}
+			if len(contents) < rebuildPageSize {
+				break
+			}
+		}
+	}
+	meta, _ := idx.state()
+	log.Infof("embedded search index is rebuilt, language: %s, documents: %d", meta.Language, meta.Docs)
+}
+
+func (e *EmbeddedSearch) language() string {
+	if len(e.config.Language) == 0 || e.config.Language == LanguageAuto {
+		return languageOfSite(plugin.SiteLanguage())
+	}
+	return e.config.Language
+}
+
+func (e *EmbeddedSearch) indexDir() string {
+	if len(e.config.IndexDir) > 0 {
+		return e.config.IndexDir
+	}
+	return filepath.Clean(cli.SearchIndexDir)
+}
@@ -0,0 +1,754 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/pkg/htmltext"
+	"github.com/apache/answer/pkg/uid"
+	"github.com/apache/answer/plugin"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// indexVersion is increased when the layout of the index is changed, the index is rebuilt then
+const indexVersion = 2
+
+// BM25 parameters
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+	// titleBoost the term frequency of the title counts as many times as this
+	titleBoost = 2
+)
+
+const (
+	// maxFuzzyTerms the max number of similar terms which one query term is expanded to
+	maxFuzzyTerms = 20
+	// snippetLength the number of runes in the highlight snippet
+	snippetLength = 200
+	// snippetLeading the number of runes before the first matched word in the snippet
+	snippetLeading = 40
+)
+
+// The keys of the index in the key-value store:
+//
+//	m                        -> index meta
+//	d:<object id>            -> document
+//	p:<term>\x00<object id>  -> term frequency and document length
+//	t:<term>                 -> document frequency
+var (
+	metaKey       = []byte("m")
+	docPrefix     = []byte("d:")
+	postingPrefix = []byte("p:")
+	termPrefix    = []byte("t:")
+)
+
+type indexMeta struct {
+	Version  int    `json:"version"`
+	Language string `json:"language"`
+	// Docs the number of documents
+	Docs int64 `json:"docs"`
+	// Length the total length of all documents, it is used to get the average length
+	Length int64 `json:"length"`
+}
+
+type document struct {
+	ObjectID    string         `json:"id"`
+	Type        string         `json:"type"`
+	QuestionID  string         `json:"question_id"`
+	UserID      string         `json:"user_id"`
+	Tags        []string       `json:"tags"`
+	Status      int            `json:"status"`
+	Answers     int64          `json:"answers"`
+	Views       int64          `json:"views"`
+	Created     int64          `json:"created"`
+	Active      int64          `json:"active"`
+	Score       int64          `json:"score"`
+	HasAccepted bool           `json:"has_accepted"`
+	Title       string         `json:"title"`
+	Text        string         `json:"text"`
+	Length      int            `json:"length"`
+	Terms       map[string]int `json:"terms"`
+}
+
+// index is an inverted index stored in a LevelDB database
+type index struct {
+	db *leveldb.DB
+	// lock serializes the writes, the document frequencies and the meta are read-modify-write
+	lock     sync.RWMutex
+	meta     indexMeta
+	analyzer *analyzer
+}
+
+func openIndex(dir, language string) (idx *index, err error) {
+	db, err := leveldb.OpenFile(dir, nil)
+	if err != nil {
+		return nil, err
+	}
+	idx = &index{db: db}
+	value, err := db.Get(metaKey, nil)
+	switch {
+	case errors.Is(err, leveldb.ErrNotFound):
+		idx.meta = indexMeta{Version: indexVersion, Language: language}
+	case err != nil:
+		_ = db.Close()
+		return nil, err
+	default:
+		if err = json.Unmarshal(value, &idx.meta); err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+	}
+	// the existing index is analyzed by its own language until it is rebuilt
+	idx.analyzer = newAnalyzer(idx.meta.Language)
+	return idx, nil
+}
+
+func (idx *index) close() error {
+	return idx.db.Close()
+}
+
+// state returns the current meta and the analyzer of the index
+func (idx *index) state() (indexMeta, *analyzer) {
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+	return idx.meta, idx.analyzer
+}
+
+// outdated returns whether the index should be rebuilt for the language
+func (idx *index) outdated(language string) bool {
+	meta, _ := idx.state()
+	return meta.Docs == 0 || meta.Version != indexVersion || meta.Language != newAnalyzer(language).language
+}
+
+// reset removes all documents and starts a new index of the language
+func (idx *index) reset(language string) error {
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	iter := idx.db.NewIterator(nil, nil)
+	defer iter.Release()
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		if batch.Len() >= 1000 {
+			if err := idx.db.Write(batch, nil); err != nil {
+				return err
+			}
+			batch.Reset()
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	analyzer := newAnalyzer(language)
+	meta := indexMeta{Version: indexVersion, Language: analyzer.language}
+	if err := putJSON(batch, metaKey, meta); err != nil {
+		return err
+	}
+	if err := idx.db.Write(batch, nil); err != nil {
+		return err
+	}
+	idx.meta, idx.analyzer = meta, analyzer
+	return nil
+}
+
+// put adds or replaces the document of the content, the deleted content is removed
+func (idx *index) put(content *plugin.SearchContent) error {
+	if content.Status >= plugin.SearchContentStatusDeleted {
+		return idx.delete(content.ObjectID)
+	}
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	doc := idx.newDocument(content)
+
+	batch := new(leveldb.Batch)
+	meta := idx.meta
+	dfDelta := make(map[string]int)
+	old, err := idx.getDocument(doc.ObjectID)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		for term := range old.Terms {
+			batch.Delete(postingKey(term, old.ObjectID))
+			dfDelta[term]--
+		}
+		meta.Docs--
+		meta.Length -= int64(old.Length)
+	}
+	for term, tf := range doc.Terms {
+		batch.Put(postingKey(term, doc.ObjectID), encodePosting(tf, doc.Length))
+		dfDelta[term]++
+	}
+	meta.Docs++
+	meta.Length += int64(doc.Length)
+
+	if err := idx.applyDocFreq(batch, dfDelta); err != nil {
+		return err
+	}
+	if err := putJSON(batch, docKey(doc.ObjectID), doc); err != nil {
+		return err
+	}
+	if err := putJSON(batch, metaKey, meta); err != nil {
+		return err
+	}
+	if err := idx.db.Write(batch, nil); err != nil {
+		return err
+	}
+	idx.meta = meta
+	return nil
+}
+
+func (idx *index) delete(objectID string) error {
+	objectID = uid.DeShortID(objectID)
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	old, err := idx.getDocument(objectID)
+	if err != nil || old == nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	meta := idx.meta
+	dfDelta := make(map[string]int)
+	for term := range old.Terms {
+		batch.Delete(postingKey(term, objectID))
+		dfDelta[term]--
+	}
+	meta.Docs--
+	meta.Length -= int64(old.Length)
+
+	if err := idx.applyDocFreq(batch, dfDelta); err != nil {
+		return err
+	}
+	batch.Delete(docKey(objectID))
+	if err := putJSON(batch, metaKey, meta); err != nil {
+		return err
+	}
+	if err := idx.db.Write(batch, nil); err != nil {
+		return err
+	}
+	idx.meta = meta
+	return nil
+}
+
+func (idx *index) newDocument(content *plugin.SearchContent) *document {
+	doc := &document{
+		ObjectID:    uid.DeShortID(content.ObjectID),
+		Type:        content.Type,
+		QuestionID:  uid.DeShortID(content.QuestionID),
+		UserID:      content.UserID,
+		Tags:        content.Tags,
+		Status:      int(content.Status),
+		Answers:     content.Answers,
+		Views:       content.Views,
+		Created:     content.Created,
+		Active:      content.Active,
+		Score:       content.Score,
+		HasAccepted: content.HasAccepted,
+		Title:       content.Title,
+		Text:        htmltext.ClearText(content.Content),
+		Terms:       make(map[string]int),
+	}
+	for _, term := range idx.analyzer.terms(content.Title) {
+		doc.Terms[term] += titleBoost
+		doc.Length++
+	}
+	for _, term := range idx.analyzer.terms(doc.Text) {
+		doc.Terms[term]++
+		doc.Length++
+	}
+	return doc
+}
+
+func (idx *index) applyDocFreq(batch *leveldb.Batch, dfDelta map[string]int) error {
+	for term, delta := range dfDelta {
+		if delta == 0 {
+			continue
+		}
+		df, err := idx.docFreq(term)
+		if err != nil {
+			return err
+		}
+		df += int64(delta)
+		if df <= 0 {
+			batch.Delete(termKey(term))
+		} else {
+			batch.Put(termKey(term), binary.AppendUvarint(nil, uint64(df)))
+		}
+	}
+	return nil
+}
+
+func (idx *index) docFreq(term string) (int64, error) {
+	value, err := idx.db.Get(termKey(term), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	df, _ := binary.Uvarint(value)
+	return int64(df), nil
+}
+
+func (idx *index) getDocument(objectID string) (*document, error) {
+	value, err := idx.db.Get(docKey(objectID), nil)
+	if errors.Is(err, leveldb.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	doc := &document{}
+	if err = json.Unmarshal(value, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// queryTerm is a term to search and its weight, the similar terms of the typo tolerance weigh less
+type queryTerm struct {
+	term   string
+	weight float64
+}
+
+// queryTerms analyzes the words to terms, and expands them to the similar terms in the index
+func (idx *index) queryTerms(analyzer *analyzer, words []string, typoTolerance bool) (terms []queryTerm, err error) {
+	seen := make(map[string]bool)
+	for _, word := range words {
+		for _, term := range analyzer.terms(word) {
+			if seen[term] {
+				continue
+			}
+			seen[term] = true
+			terms = append(terms, queryTerm{term: term, weight: 1})
+			if !typoTolerance {
+				continue
+			}
+			similar, err := idx.similarTerms(term)
+			if err != nil {
+				return nil, err
+			}
+			for _, t := range similar {
+				if !seen[t.term] {
+					seen[t.term] = true
+					terms = append(terms, t)
+				}
+			}
+		}
+	}
+	return terms, nil
+}
+
+// similarTerms returns the terms in the index within the edit distance allowed for the length of the term.
+// Typos in the first letter are rare, so only the terms starting with the same letter are compared.
+func (idx *index) similarTerms(term string) (similar []queryTerm, err error) {
+	maxDistance := allowedTypos(term)
+	if maxDistance == 0 {
+		return nil, nil
+	}
+	first, _ := utf8.DecodeRuneInString(term)
+	termRunes := []rune(term)
+
+	iter := idx.db.NewIterator(util.BytesPrefix(append(append([]byte(nil), termPrefix...), string(first)...)), nil)
+	defer iter.Release()
+	type candidate struct {
+		term     string
+		distance int
+		df       uint64
+	}
+	candidates := make([]candidate, 0)
+	for iter.Next() {
+		t := string(iter.Key()[len(termPrefix):])
+		if t == term {
+			continue
+		}
+		d := editDistance(termRunes, []rune(t), maxDistance)
+		if d > maxDistance {
+			continue
+		}
+		df, _ := binary.Uvarint(iter.Value())
+		candidates = append(candidates, candidate{term: t, distance: d, df: df})
+	}
+	if err = iter.Error(); err != nil {
+		return nil, err
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].df > candidates[j].df
+	})
+	for i, c := range candidates {
+		if i >= maxFuzzyTerms {
+			break
+		}
+		similar = append(similar, queryTerm{term: c.term, weight: 0.5 / float64(c.distance)})
+	}
+	return similar, nil
+}
+
+// allowedTypos returns the max edit distance of the term, the short terms must match exactly
+func allowedTypos(term string) int {
+	first, _ := utf8.DecodeRuneInString(term)
+	if isCJK(first) {
+		return 0
+	}
+	switch n := utf8.RuneCountInString(term); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// editDistance returns the optimal string alignment distance of a and b,
+// it stops early and returns max+1 if the distance is greater than max.
+func editDistance(a, b []rune, max int) int {
+	if diff := len(a) - len(b); diff > max || -diff > max {
+		return max + 1
+	}
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+	return prev[len(b)]
+}
+
+type hit struct {
+	doc   *document
+	score float64
+}
+
+// search returns the documents of the types matched by the condition
+func (idx *index) search(cond *plugin.SearchBasicCond, types []string, typoTolerance bool) (
+	res []plugin.SearchResult, total int64, err error) {
+	meta, analyzer := idx.state()
+	terms, err := idx.queryTerms(analyzer, cond.Words, typoTolerance)
+	if err != nil {
+		return nil, 0, err
+	}
+	matcher, err := idx.newKeywordMatcher(analyzer, cond, typoTolerance)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var hits []*hit
+	if len(terms) == 0 {
+		hits, err = idx.allDocuments(cond, types, matcher)
+	} else {
+		hits, err = idx.scoreDocuments(meta, terms, cond, types, matcher)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := cond.Order
+	if order == plugin.SearchRelevanceOrder && len(terms) == 0 {
+		order = plugin.SearchNewestOrder
+	}
+	sortHits(hits, order)
+
+	total = int64(len(hits))
+	page, pageSize := max(cond.Page, 1), cond.PageSize
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	start := min((page-1)*pageSize, len(hits))
+	end := min(start+pageSize, len(hits))
+
+	matched := make(map[string]bool, len(terms))
+	for _, t := range terms {
+		matched[t.term] = true
+	}
+	res = make([]plugin.SearchResult, 0, end-start)
+	for _, h := range hits[start:end] {
+		res = append(res, plugin.SearchResult{
+			ID:        h.doc.ObjectID,
+			Type:      h.doc.Type,
+			Highlight: snippet(analyzer, h.doc.Text, matched),
+		})
+	}
+	return res, total, nil
+}
+
+func (idx *index) scoreDocuments(meta indexMeta, terms []queryTerm, cond *plugin.SearchBasicCond, types []string,
+	matcher *keywordMatcher) (hits []*hit, err error) {
+	docs := float64(max(meta.Docs, 1))
+	avgLength := max(float64(meta.Length)/docs, 1)
+	scores := make(map[string]float64)
+	for _, qt := range terms {
+		df, err := idx.docFreq(qt.term)
+		if err != nil {
+			return nil, err
+		}
+		if df == 0 {
+			continue
+		}
+		idf := math.Log(1 + (docs-float64(df)+0.5)/(float64(df)+0.5))
+
+		prefix := postingKey(qt.term, "")
+		iter := idx.db.NewIterator(util.BytesPrefix(prefix), nil)
+		for iter.Next() {
+			objectID := string(iter.Key()[len(prefix):])
+			tf, length := decodePosting(iter.Value())
+			norm := bm25K1 * (1 - bm25B + bm25B*float64(length)/avgLength)
+			scores[objectID] += qt.weight * idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + norm)
+		}
+		iter.Release()
+		if err = iter.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	for objectID, score := range scores {
+		doc, err := idx.getDocument(objectID)
+		if err != nil {
+			return nil, err
+		}
+		if doc != nil && matchCond(doc, cond, types) && matcher.match(doc) {
+			hits = append(hits, &hit{doc: doc, score: score})
+		}
+	}
+	return hits, nil
+}
+
+func (idx *index) allDocuments(cond *plugin.SearchBasicCond, types []string, matcher *keywordMatcher) (
+	hits []*hit, err error) {
+	iter := idx.db.NewIterator(util.BytesPrefix(docPrefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		doc := &document{}
+		if err = json.Unmarshal(iter.Value(), doc); err != nil {
+			return nil, err
+		}
+		if matchCond(doc, cond, types) && matcher.match(doc) {
+			hits = append(hits, &hit{doc: doc})
+		}
+	}
+	return hits, iter.Error()
+}
+
+// matchCond checks the filters of the condition in the same way as the database search
+func matchCond(doc *document, cond *plugin.SearchBasicCond, types []string) bool {
+	if doc.Status >= plugin.SearchContentStatusDeleted {
+		return false
+	}
+	if !containsAny(types, doc.Type) {
+		return false
+	}
+	for _, tagIDs := range cond.TagIDs {
+		if len(tagIDs) > 0 && !containsAny(doc.Tags, tagIDs...) {
+			return false
+		}
+	}
+	if containsAny(doc.Tags, cond.ExcludedTagIDs...) {
+		return false
+	}
+	if cond.HasObjectIDs && !containsAny(cond.ObjectIDs, doc.ObjectID) {
+		return false
+	}
+	if !inTimeRange(doc.Created, cond.CreatedAfter, cond.CreatedBefore) ||
+		!inTimeRange(doc.Active, cond.ActiveAfter, cond.ActiveBefore) {
+		return false
+	}
+	if len(cond.UserID) > 0 && doc.UserID != cond.UserID {
+		return false
+	}
+	if len(cond.QuestionID) > 0 && doc.QuestionID != uid.DeShortID(cond.QuestionID) {
+		return false
+	}
+	if (cond.VoteAmount == 0 && doc.Score != 0) || (cond.VoteAmount > 0 && doc.Score < int64(cond.VoteAmount)) {
+		return false
+	}
+	if cond.ViewAmount > -1 && doc.Views < int64(cond.ViewAmount) {
+		return false
+	}
+
+	switch doc.Type {
+	case constant.QuestionObjectType:
+		if (cond.QuestionAccepted == plugin.AcceptedCondTrue && !doc.HasAccepted) ||
+			(cond.QuestionAccepted == plugin.AcceptedCondFalse && doc.HasAccepted) {
+			return false
+		}
+		if (cond.AnswerAmount == 0 && doc.Answers != 0) || (cond.AnswerAmount > 0 && doc.Answers < int64(cond.AnswerAmount)) {
+			return false
+		}
+		closed := doc.Status == plugin.SearchContentStatusClosed
+		if (cond.QuestionClosed == plugin.ClosedCondTrue && !closed) ||
+			(cond.QuestionClosed == plugin.ClosedCondFalse && closed) {
+			return false
+		}
+	case constant.AnswerObjectType:
+		if (cond.AnswerAccepted == plugin.AcceptedCondTrue && !doc.HasAccepted) ||
+			(cond.AnswerAccepted == plugin.AcceptedCondFalse && doc.HasAccepted) {
+			return false
+		}
+	}
+	return true
+}
+
+// inTimeRange checks the unix timestamp is in [after, before), zero means unlimited
+func inTimeRange(timestamp, after, before int64) bool {
+	return (after == 0 || timestamp >= after) && (before == 0 || timestamp < before)
+}
+
+func sortHits(hits []*hit, order plugin.SearchOrderCond) {
+	var key func(h *hit) float64
+	switch order {
+	case plugin.SearchRelevanceOrder:
+		key = func(h *hit) float64 { return h.score }
+	case plugin.SearchActiveOrder:
+		key = func(h *hit) float64 { return float64(h.doc.Active) }
+	case plugin.SearchScoreOrder:
+		key = func(h *hit) float64 { return float64(h.doc.Score) }
+	default:
+		key = func(h *hit) float64 { return float64(h.doc.Created) }
+	}
+	sort.SliceStable(hits, func(i, j int) bool {
+		ki, kj := key(hits[i]), key(hits[j])
+		if ki != kj {
+			return ki > kj
+		}
+		if hits[i].doc.Created != hits[j].doc.Created {
+			return hits[i].doc.Created > hits[j].doc.Created
+		}
+		return hits[i].doc.ObjectID > hits[j].doc.ObjectID
+	})
+}
+
+// snippet returns the part of the text which contains the most matched terms
+func snippet(analyzer *analyzer, text string, matched map[string]bool) string {
+	textRunes := []rune(text)
+	if len(textRunes) <= snippetLength {
+		return text
+	}
+	var positions []token
+	for _, t := range analyzer.tokens(text) {
+		if matched[t.term] {
+			positions = append(positions, t)
+		}
+	}
+
+	begin, best := 0, 0
+	for i, p := range positions {
+		distinct := make(map[string]bool)
+		for _, q := range positions[i:] {
+			if q.end-p.start > snippetLength-snippetLeading {
+				break
+			}
+			distinct[q.term] = true
+		}
+		if len(distinct) > best {
+			best = len(distinct)
+			begin = max(p.start-snippetLeading, 0)
+		}
+	}
+	begin = min(begin, len(textRunes)-snippetLength)
+	end := begin + snippetLength
+
+	snippet := string(textRunes[begin:end])
+	if begin > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(textRunes) {
+		snippet += "..."
+	}
+	return snippet
+}
+
+func containsAny(list []string, values ...string) bool {
+	for _, item := range list {
+		for _, value := range values {
+			if item == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func docKey(objectID string) []byte {
+	return append(append([]byte(nil), docPrefix...), objectID...)
+}
+
+func termKey(term string) []byte {
+	return append(append([]byte(nil), termPrefix...), term...)
+}
+
+func postingKey(term, objectID string) []byte {
+	key := append(append([]byte(nil), postingPrefix...), term...)
+	key = append(key, 0)
+	return append(key, objectID...)
+}
+
+func encodePosting(tf, length int) []byte {
+	return binary.AppendUvarint(binary.AppendUvarint(nil, uint64(tf)), uint64(length))
+}
+
+func decodePosting(value []byte) (tf, length int) {
+	v, n := binary.Uvarint(value)
+	l, _ := binary.Uvarint(value[n:])
+	return int(v), int(l)
+}
+
+func putJSON(batch *leveldb.Batch, key []byte, value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	batch.Put(key, content)
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apache/answer/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex(t *testing.T) *index {
+	idx, err := openIndex(t.TempDir(), LanguageEnglish)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = idx.close() })
+
+	contents := []*plugin.SearchContent{
+		{ObjectID: "10010000000000001", Type: "question", Title: "How to run tests in parallel",
+			Content: "<p>I am running my tests one by one.</p>", Status: plugin.SearchContentStatusAvailable,
+			Tags: []string{"1"}, QuestionID: "10010000000000001", UserID: "1", Created: 1, Score: 3},
+		{ObjectID: "10010000000000002", Type: "question", Title: "Deploy with docker",
+			Content: "<p>The container can not reach the database.</p>", Status: plugin.SearchContentStatusAvailable,
+			Tags: []string{"2"}, QuestionID: "10010000000000002", UserID: "2", Created: 2, HasAccepted: true},
+		{ObjectID: "10020000000000001", Type: "answer", Title: "How to run tests in parallel",
+			Content: "<p>Use the parallel flag, the runner executes the test functions concurrently.</p>",
+			Status:  plugin.SearchContentStatusAvailable, Tags: []string{"1"}, QuestionID: "10010000000000001",
+			UserID: "2", Created: 3, HasAccepted: true},
+	}
+	for _, content := range contents {
+		require.NoError(t, idx.put(content))
+	}
+	return idx
+}
+
+func searchIDs(t *testing.T, idx *index, cond *plugin.SearchBasicCond, types ...string) (ids []string, total int64) {
+	if cond.PageSize == 0 {
+		cond.Page, cond.PageSize = 1, 10
+	}
+	cond.VoteAmount, cond.ViewAmount, cond.AnswerAmount = -1, -1, -1
+	res, total, err := idx.search(cond, types, true)
+	require.NoError(t, err)
+	for _, r := range res {
+		ids = append(ids, r.ID)
+	}
+	return ids, total
+}
+
+func TestIndexSearch(t *testing.T) {
+	idx := newTestIndex(t)
+	all := []string{"question", "answer"}
+
+	// stemming, "running" and "run" have the same stem
+	ids, total := searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"running"}, Order: plugin.SearchRelevanceOrder}, all...)
+	assert.Equal(t, int64(2), total)
+	assert.Equal(t, "10010000000000001", ids[0])
+
+	// typo tolerance
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"dokcer"}}, all...)
+	assert.Equal(t, []string{"10010000000000002"}, ids)
+
+	// filters
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"parallel"}}, "answer")
+	assert.Equal(t, []string{"10020000000000001"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{TagIDs: [][]string{{"2", "3"}}}, all...)
+	assert.Equal(t, []string{"10010000000000002"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"test"}, UserID: "2"}, all...)
+	assert.Equal(t, []string{"10020000000000001"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{QuestionAccepted: plugin.AcceptedCondFalse}, "question")
+	assert.Equal(t, []string{"10010000000000001"}, ids)
+
+	// pagination, newest first
+	ids, total = searchIDs(t, idx, &plugin.SearchBasicCond{Page: 2, PageSize: 2}, all...)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, []string{"10010000000000001"}, ids)
+}
+
+func TestIndexSearchKeywords(t *testing.T) {
+	idx := newTestIndex(t)
+	all := []string{"question", "answer"}
+
+	// all groups must be matched, any keyword of a group matches
+	ids, _ := searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"tests", "docker", "parallel"},
+		KeywordGroups: [][]plugin.SearchKeyword{{{Text: "tests"}, {Text: "docker"}}, {{Text: "parallel"}}}}, all...)
+	assert.ElementsMatch(t, []string{"10010000000000001", "10020000000000001"}, ids)
+
+	// phrase, field and excluded keyword
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"one by one"},
+		KeywordGroups: [][]plugin.SearchKeyword{{{Text: "one by one", Phrase: true}}}}, all...)
+	assert.Equal(t, []string{"10010000000000001"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"flag"},
+		KeywordGroups: [][]plugin.SearchKeyword{{{Text: "flag", Field: plugin.SearchKeywordFieldTitle}}}}, all...)
+	assert.Empty(t, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"parallel"},
+		KeywordGroups:    [][]plugin.SearchKeyword{{{Text: "parallel"}}},
+		ExcludedKeywords: []plugin.SearchKeyword{{Text: "flag"}}}, all...)
+	assert.Equal(t, []string{"10010000000000001"}, ids)
+
+	// excluded tags, time range and object ids
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{ExcludedTagIDs: []string{"1"}}, all...)
+	assert.Equal(t, []string{"10010000000000002"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{CreatedAfter: 2, CreatedBefore: 3}, all...)
+	assert.Equal(t, []string{"10010000000000002"}, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{HasObjectIDs: true}, all...)
+	assert.Empty(t, ids)
+}
+
+func TestIndexUpdateAndDelete(t *testing.T) {
+	idx := newTestIndex(t)
+
+	require.NoError(t, idx.put(&plugin.SearchContent{ObjectID: "10010000000000002", Type: "question",
+		Title: "Deploy with kubernetes", Status: plugin.SearchContentStatusAvailable}))
+	ids, _ := searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"docker"}}, "question")
+	assert.Empty(t, ids)
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"kubernetes"}}, "question")
+	assert.Equal(t, []string{"10010000000000002"}, ids)
+
+	require.NoError(t, idx.delete("10010000000000002"))
+	ids, _ = searchIDs(t, idx, &plugin.SearchBasicCond{Words: []string{"kubernetes"}}, "question")
+	assert.Empty(t, ids)
+	df, err := idx.docFreq("kubernet")
+	require.NoError(t, err)
+	assert.Zero(t, df)
+	meta, _ := idx.state()
+	assert.Equal(t, int64(2), meta.Docs)
+
+	require.NoError(t, idx.reset(LanguageNone))
+	meta, analyzer := idx.state()
+	assert.Equal(t, int64(0), meta.Docs)
+	assert.Equal(t, LanguageNone, analyzer.language)
+}
+
+func TestSnippet(t *testing.T) {
+	text := strings.Repeat("lorem ipsum ", 30) + "the docker container is running " + strings.Repeat("dolor sit ", 30)
+	s := snippet(newAnalyzer(LanguageEnglish), text, map[string]bool{"docker": true, "run": true})
+	assert.True(t, strings.HasPrefix(s, "..."))
+	assert.True(t, strings.HasSuffix(s, "..."))
+	assert.Contains(t, s, "the docker container is running")
+	assert.Equal(t, "short text", snippet(newAnalyzer(LanguageEnglish), "short text", nil))
+}
@@ -0,0 +1,148 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"github.com/apache/answer/plugin"
+)
+
+// keywordMatcher checks the keyword groups and the excluded keywords of the condition.
+// The scores only tell whether any word is matched, so the documents are checked by it then.
+type keywordMatcher struct {
+	analyzer *analyzer
+	groups   [][]*keywordTerms
+	excluded []*keywordTerms
+}
+
+// keywordTerms is a keyword analyzed to terms
+type keywordTerms struct {
+	field  plugin.SearchKeywordField
+	phrase bool
+	// terms each element is a term and its similar terms, any of them matches the term
+	terms [][]string
+}
+
+// newKeywordMatcher analyzes the keywords, the keywords without any term are ignored.
+// The similar terms of the typo tolerance only apply to the keywords which are not excluded.
+func (idx *index) newKeywordMatcher(analyzer *analyzer, cond *plugin.SearchBasicCond, typoTolerance bool) (
+	m *keywordMatcher, err error) {
+	m = &keywordMatcher{analyzer: analyzer}
+	similar := make(map[string][]string)
+	for _, group := range cond.KeywordGroups {
+		keywords := make([]*keywordTerms, 0, len(group))
+		for _, keyword := range group {
+			k := &keywordTerms{field: keyword.Field, phrase: keyword.Phrase}
+			for _, term := range analyzer.terms(keyword.Text) {
+				alternatives := []string{term}
+				if typoTolerance && !keyword.Phrase {
+					if _, ok := similar[term]; !ok {
+						terms, err := idx.similarTerms(term)
+						if err != nil {
+							return nil, err
+						}
+						for _, t := range terms {
+							similar[term] = append(similar[term], t.term)
+						}
+					}
+					alternatives = append(alternatives, similar[term]...)
+				}
+				k.terms = append(k.terms, alternatives)
+			}
+			if len(k.terms) > 0 {
+				keywords = append(keywords, k)
+			}
+		}
+		if len(keywords) > 0 {
+			m.groups = append(m.groups, keywords)
+		}
+	}
+	for _, keyword := range cond.ExcludedKeywords {
+		k := &keywordTerms{field: keyword.Field, phrase: keyword.Phrase}
+		for _, term := range analyzer.terms(keyword.Text) {
+			k.terms = append(k.terms, []string{term})
+		}
+		if len(k.terms) > 0 {
+			m.excluded = append(m.excluded, k)
+		}
+	}
+	return m, nil
+}
+
+// match returns whether the document matches all groups and none of the excluded keywords
+func (m *keywordMatcher) match(doc *document) bool {
+	if len(m.groups) == 0 && len(m.excluded) == 0 {
+		return true
+	}
+	title, text := m.analyzer.terms(doc.Title), m.analyzer.terms(doc.Text)
+	for _, group := range m.groups {
+		matched := false
+		for _, k := range group {
+			if k.match(title, text) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, k := range m.excluded {
+		if k.match(title, text) {
+			return false
+		}
+	}
+	return true
+}
+
+func (k *keywordTerms) match(title, text []string) bool {
+	switch k.field {
+	case plugin.SearchKeywordFieldTitle:
+		return k.matchTerms(title)
+	case plugin.SearchKeywordFieldContent:
+		return k.matchTerms(text)
+	default:
+		return k.matchTerms(title) || k.matchTerms(text)
+	}
+}
+
+// matchTerms the terms of a phrase must be consecutive, the terms of a word can be anywhere
+func (k *keywordTerms) matchTerms(terms []string) bool {
+	if !k.phrase {
+		for _, alternatives := range k.terms {
+			if !containsAny(terms, alternatives...) {
+				return false
+			}
+		}
+		return true
+	}
+	for i := 0; i+len(k.terms) <= len(terms); i++ {
+		matched := true
+		for j, alternatives := range k.terms {
+			if !containsAny(alternatives, terms[i+j]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,429 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package embedded_search
+
+import (
+	"strings"
+)
+
+const (
+	LanguageAuto       = "auto"
+	LanguageNone       = "none"
+	LanguageEnglish    = "english"
+	LanguageFrench     = "french"
+	LanguageGerman     = "german"
+	LanguageSpanish    = "spanish"
+	LanguagePortuguese = "portuguese"
+	LanguageItalian    = "italian"
+)
+
+var stemmers = map[string]func(word string) string{
+	LanguageEnglish:    stemEnglish,
+	LanguageFrench:     stemFrench,
+	LanguageGerman:     stemGerman,
+	LanguageSpanish:    stemSpanish,
+	LanguagePortuguese: stemPortuguese,
+	LanguageItalian:    stemItalian,
+}
+
+// languageOfSite returns the stemming language of the site language, e.g. en_US -> english
+func languageOfSite(siteLanguage string) string {
+	prefix, _, _ := strings.Cut(strings.ToLower(siteLanguage), "_")
+	switch prefix {
+	case "en":
+		return LanguageEnglish
+	case "fr":
+		return LanguageFrench
+	case "de":
+		return LanguageGerman
+	case "es":
+		return LanguageSpanish
+	case "pt":
+		return LanguagePortuguese
+	case "it":
+		return LanguageItalian
+	default:
+		return LanguageNone
+	}
+}
+
+// stemEnglish is the Porter stemming algorithm, see https://tartarus.org/martin/PorterStemmer/
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+	p := &porter{b: []byte(word)}
+	p.step1ab()
+	if len(p.b) > 1 {
+		p.step1c()
+		p.step2()
+		p.step3()
+		p.step4()
+		p.step5()
+	}
+	return string(p.b)
+}
+
+// porter keeps the word in b, the stem is b[0:j+1] after a call of ends
+type porter struct {
+	b []byte
+	j int
+}
+
+func (p *porter) k() int {
+	return len(p.b) - 1
+}
+
+// cons returns whether b[i] is a consonant
+func (p *porter) cons(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !p.cons(i-1)
+	}
+	return true
+}
+
+// m measures the number of consonant sequences in b[0:j+1]
+func (p *porter) m() int {
+	n, i := 0, 0
+	for {
+		if i > p.j {
+			return n
+		}
+		if !p.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > p.j {
+				return n
+			}
+			if p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > p.j {
+				return n
+			}
+			if !p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem returns whether b[0:j+1] contains a vowel
+func (p *porter) vowelInStem() bool {
+	for i := 0; i <= p.j; i++ {
+		if !p.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC returns whether b[j-1:j+1] is a double consonant
+func (p *porter) doubleC(j int) bool {
+	if j < 1 || p.b[j] != p.b[j-1] {
+		return false
+	}
+	return p.cons(j)
+}
+
+// cvc returns whether b[i-2:i+1] is consonant-vowel-consonant and the last one is not w, x or y
+func (p *porter) cvc(i int) bool {
+	if i < 2 || !p.cons(i) || p.cons(i-1) || !p.cons(i-2) {
+		return false
+	}
+	switch p.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+func (p *porter) ends(s string) bool {
+	if len(s) > len(p.b) || string(p.b[len(p.b)-len(s):]) != s {
+		return false
+	}
+	p.j = len(p.b) - len(s) - 1
+	return true
+}
+
+func (p *porter) setTo(s string) {
+	p.b = append(p.b[:p.j+1], s...)
+}
+
+func (p *porter) r(s string) {
+	if p.m() > 0 {
+		p.setTo(s)
+	}
+}
+
+// step1ab removes the plurals and -ed or -ing
+func (p *porter) step1ab() {
+	if p.b[p.k()] == 's' {
+		switch {
+		case p.ends("sses"):
+			p.b = p.b[:len(p.b)-2]
+		case p.ends("ies"):
+			p.setTo("i")
+		case p.b[p.k()-1] != 's':
+			p.b = p.b[:len(p.b)-1]
+		}
+	}
+	if p.ends("eed") {
+		if p.m() > 0 {
+			p.b = p.b[:len(p.b)-1]
+		}
+		return
+	}
+	if (p.ends("ed") || p.ends("ing")) && p.vowelInStem() {
+		p.b = p.b[:p.j+1]
+		switch {
+		case p.ends("at"):
+			p.setTo("ate")
+		case p.ends("bl"):
+			p.setTo("ble")
+		case p.ends("iz"):
+			p.setTo("ize")
+		case p.doubleC(p.k()):
+			switch p.b[p.k()] {
+			case 'l', 's', 'z':
+			default:
+				p.b = p.b[:len(p.b)-1]
+			}
+		default:
+			p.j = p.k()
+			if p.m() == 1 && p.cvc(p.k()) {
+				p.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns terminal y to i when there is another vowel in the stem
+func (p *porter) step1c() {
+	if p.ends("y") && p.vowelInStem() {
+		p.b[p.k()] = 'i'
+	}
+}
+
+var porterStep2 = [][2]string{
+	{"ational", "ate"}, {"tional", "tion"}, {"enci", "ence"}, {"anci", "ance"}, {"izer", "ize"},
+	{"bli", "ble"}, {"alli", "al"}, {"entli", "ent"}, {"eli", "e"}, {"ousli", "ous"},
+	{"ization", "ize"}, {"ation", "ate"}, {"ator", "ate"}, {"alism", "al"}, {"iveness", "ive"},
+	{"fulness", "ful"}, {"ousness", "ous"}, {"aliti", "al"}, {"iviti", "ive"}, {"biliti", "ble"},
+	{"logi", "log"},
+}
+
+// step2 maps double suffices to single ones
+func (p *porter) step2() {
+	for _, rule := range porterStep2 {
+		if p.ends(rule[0]) {
+			p.r(rule[1])
+			return
+		}
+	}
+}
+
+var porterStep3 = [][2]string{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"}, {"ical", "ic"},
+	{"ful", ""}, {"ness", ""},
+}
+
+// step3 deals with -ic-, -full, -ness etc.
+func (p *porter) step3() {
+	for _, rule := range porterStep3 {
+		if p.ends(rule[0]) {
+			p.r(rule[1])
+			return
+		}
+	}
+}
+
+var porterStep4 = []string{
+	"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment", "ent",
+	"ion", "ou", "ism", "ate", "iti", "ous", "ive", "ize",
+}
+
+// step4 takes off -ant, -ence etc., in context <c>vcvc<v>
+func (p *porter) step4() {
+	for _, suffix := range porterStep4 {
+		if !p.ends(suffix) {
+			continue
+		}
+		if suffix == "ion" && (p.j < 0 || (p.b[p.j] != 's' && p.b[p.j] != 't')) {
+			return
+		}
+		if p.m() > 1 {
+			p.b = p.b[:p.j+1]
+		}
+		return
+	}
+}
+
+// step5 removes a final -e and changes -ll to -l if the measure is greater than 1
+func (p *porter) step5() {
+	p.j = p.k()
+	if p.b[p.k()] == 'e' {
+		a := p.m()
+		if a > 1 || (a == 1 && !p.cvc(p.k()-1)) {
+			p.b = p.b[:len(p.b)-1]
+		}
+	}
+	p.j = p.k()
+	if p.b[p.k()] == 'l' && p.doubleC(p.k()) && p.m() > 1 {
+		p.b = p.b[:len(p.b)-1]
+	}
+}
+
+// stemFrench removes the plural and feminine forms, it is the minimal stemmer of Jacques Savoy
+func stemFrench(word string) string {
+	s := []rune(word)
+	if len(s) < 6 {
+		return word
+	}
+	n := len(s)
+	if s[n-1] == 'x' {
+		if s[n-3] == 'a' && s[n-2] == 'u' {
+			s[n-2] = 'l'
+		}
+		return string(s[:n-1])
+	}
+	for _, c := range []rune{'s', 'r', 'e'} {
+		if s[n-1] == c {
+			n--
+		}
+	}
+	if s[n-1] == s[n-2] {
+		n--
+	}
+	return string(s[:n])
+}
+
+// stemGerman removes the common inflections, it is the minimal stemmer of Jacques Savoy
+func stemGerman(word string) string {
+	s := []rune(word)
+	n := len(s)
+	if n < 5 {
+		return word
+	}
+	if n > 6 && strings.HasSuffix(word, "nen") {
+		return string(s[:n-3])
+	}
+	if n > 5 && s[n-2] == 'e' {
+		switch s[n-1] {
+		case 'n', 's', 'r':
+			return string(s[:n-2])
+		}
+	}
+	if n > 5 && s[n-2] == 's' && s[n-1] == 'e' {
+		return string(s[:n-2])
+	}
+	switch s[n-1] {
+	case 'n', 'e', 's', 'r':
+		return string(s[:n-1])
+	}
+	return word
+}
+
+// stemSpanish removes the plural forms
+func stemSpanish(word string) string {
+	s := []rune(word)
+	n := len(s)
+	if n < 4 || s[n-1] != 's' {
+		return word
+	}
+	switch {
+	case strings.HasSuffix(word, "eses"):
+		return string(s[:n-2])
+	case strings.HasSuffix(word, "ces"):
+		return string(s[:n-3]) + "z"
+	}
+	switch s[n-2] {
+	case 'a', 'e', 'o':
+		return string(s[:n-1])
+	}
+	return word
+}
+
+var portuguesePlurals = [][2]string{
+	{"oes", "ao"}, {"aes", "ao"}, {"eis", "el"}, {"ois", "ol"}, {"ais", "al"},
+	{"ns", "m"}, {"les", "l"}, {"res", "r"}, {"zes", "z"},
+}
+
+// stemPortuguese removes the plural forms
+func stemPortuguese(word string) string {
+	s := []rune(word)
+	n := len(s)
+	if n < 4 || s[n-1] != 's' {
+		return word
+	}
+	for _, rule := range portuguesePlurals {
+		if strings.HasSuffix(word, rule[0]) {
+			return strings.TrimSuffix(word, rule[0]) + rule[1]
+		}
+	}
+	return string(s[:n-1])
+}
+
+// stemItalian removes the final vowel of the gender and number, it is the light stemmer of Jacques Savoy
+func stemItalian(word string) string {
+	s := []rune(word)
+	n := len(s)
+	if n < 6 {
+		return word
+	}
+	switch s[n-1] {
+	case 'e':
+		if s[n-2] == 'i' || s[n-2] == 'h' {
+			return string(s[:n-2])
+		}
+		return string(s[:n-1])
+	case 'i':
+		if s[n-2] == 'h' || s[n-2] == 'i' {
+			return string(s[:n-2])
+		}
+		return string(s[:n-1])
+	case 'a', 'o':
+		if s[n-2] == 'i' {
+			return string(s[:n-2])
+		}
+		return string(s[:n-1])
+	}
+	return word
+}
@@ -62,6 +62,16 @@ func (r *badgeRepo) GetByIDs(ctx context.Context, ids []string) (badges []*entit
 	return
 }
 
+// GetByHandler returns the badge awarded by the handler
+func (r *badgeRepo) GetByHandler(ctx context.Context, handler string) (badge *entity.Badge, exists bool, err error) {
+	badge = &entity.Badge{}
+	exists, err = r.data.DB.Context(ctx).Where("handler = ?", handler).Get(badge)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
 // ListPaged returns a list of activated badges
 func (r *badgeRepo) ListPaged(ctx context.Context, page int, pageSize int) (badges []*entity.Badge, total int64, err error) {
 	badges = make([]*entity.Badge, 0)
@@ -193,3 +193,19 @@ func (r *badgeAwardRepo) DeleteUserBadgeAward(ctx context.Context, userID string
 	}
 	return
 }
+
+// DeleteUserBadgeAwardByBadgeID delete the awards of the badge from the user and decrease the award count of the badge
+func (r *badgeAwardRepo) DeleteUserBadgeAwardByBadgeID(ctx context.Context, userID string, badgeID string) (err error) {
+	_, err = r.data.DB.Transaction(func(session *xorm.Session) (result any, err error) {
+		session = session.Context(ctx)
+		deleted, err := session.Where("user_id = ? AND badge_id = ?", userID, badgeID).Delete(&entity.BadgeAward{})
+		if err != nil || deleted == 0 {
+			return nil, err
+		}
+		return session.ID(badgeID).Decr("award_count", deleted).Update(&entity.Badge{})
+	})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
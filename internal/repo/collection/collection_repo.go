@@ -109,7 +109,9 @@ func (cr *collectionRepo) GetCollection(ctx context.Context, id int) (collection
 func (cr *collectionRepo) GetCollectionList(ctx context.Context, collection *entity.Collection) (collectionList []*entity.Collection, err error) {
 	collectionList = make([]*entity.Collection, 0)
 	err = cr.data.DB.Context(ctx).Find(&collectionList, collection)
-	err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
 	return
 }
 
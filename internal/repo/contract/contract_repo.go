@@ -0,0 +1,178 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/contract"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+	"xorm.io/xorm"
+)
+
+// contractRepo contract repository
+type contractRepo struct {
+	data *data.Data
+}
+
+// NewContractRepo new repository
+func NewContractRepo(data *data.Data) contract.ContractRepo {
+	return &contractRepo{
+		data: data,
+	}
+}
+
+// AddContract add contract with its milestones
+func (cr *contractRepo) AddContract(ctx context.Context, contract *entity.Contract, milestones []*entity.Milestone) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(contract); err != nil {
+			return nil, err
+		}
+		for _, milestone := range milestones {
+			milestone.ContractID = contract.ID
+			if _, err := session.Insert(milestone); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// GetContract get contract by id
+func (cr *contractRepo) GetContract(ctx context.Context, id string) (contract *entity.Contract, exist bool, err error) {
+	contract = &entity.Contract{}
+	exist, err = cr.data.DB.Context(ctx).ID(id).Get(contract)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetContractPage get the contracts of the user by page, role is the party of the user, both if empty
+func (cr *contractRepo) GetContractPage(ctx context.Context, page, pageSize int, userID, role, status string) (
+	contracts []*entity.Contract, total int64, err error) {
+	contracts = make([]*entity.Contract, 0)
+	cond := builder.NewCond()
+	switch role {
+	case "client":
+		cond = cond.And(builder.Eq{"client_id": userID})
+	case "freelancer":
+		cond = cond.And(builder.Eq{"freelancer_id": userID})
+	default:
+		cond = cond.And(builder.Or(builder.Eq{"client_id": userID}, builder.Eq{"freelancer_id": userID}))
+	}
+	if len(status) > 0 {
+		cond = cond.And(builder.Eq{"status": status})
+	}
+	session := cr.data.DB.Context(ctx).Where(cond).Desc("created_at")
+	total, err = pager.Help(page, pageSize, &contracts, &entity.Contract{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdateContractStatus update contract status
+func (cr *contractRepo) UpdateContractStatus(ctx context.Context, id, status string) (err error) {
+	_, err = cr.data.DB.Context(ctx).ID(id).Cols("status").Update(&entity.Contract{Status: status})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// ChangeContractStatus change the contract to the status only if it is still in the from status
+func (cr *contractRepo) ChangeContractStatus(ctx context.Context, id, fromStatus, toStatus string) (changed bool, err error) {
+	affected, err := cr.data.DB.Context(ctx).ID(id).Where("status = ?", fromStatus).
+		Cols("status").Update(&entity.Contract{Status: toStatus})
+	if err != nil {
+		return false, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return affected > 0, nil
+}
+
+// ConfirmContractWork record that the freelancer has confirmed the work of the contract is done
+func (cr *contractRepo) ConfirmContractWork(ctx context.Context, id string) (err error) {
+	_, err = cr.data.DB.Context(ctx).ID(id).Cols("work_confirmed").
+		Update(&entity.Contract{WorkConfirmed: true})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// AddMilestone add milestone and add its amount to the total amount of the contract
+func (cr *contractRepo) AddMilestone(ctx context.Context, milestone *entity.Milestone) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(milestone); err != nil {
+			return nil, err
+		}
+		_, err := session.ID(milestone.ContractID).Incr("total_amount", milestone.Amount).
+			Update(&entity.Contract{})
+		return nil, err
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// GetMilestone get milestone by id
+func (cr *contractRepo) GetMilestone(ctx context.Context, id string) (milestone *entity.Milestone, exist bool, err error) {
+	milestone = &entity.Milestone{}
+	exist, err = cr.data.DB.Context(ctx).ID(id).Get(milestone)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetMilestoneList get the milestones of the contract in the order of creation
+func (cr *contractRepo) GetMilestoneList(ctx context.Context, contractID string) (milestones []*entity.Milestone, err error) {
+	milestones = make([]*entity.Milestone, 0)
+	err = cr.data.DB.Context(ctx).Where("contract_id = ?", contractID).Asc("id").Find(&milestones)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// ChangeMilestoneStatus change the milestone to its status and update the columns only if it is still in the from status.
+// The change is claimed by a single conditional update, so only one of the concurrent requests can make it.
+func (cr *contractRepo) ChangeMilestoneStatus(ctx context.Context, milestone *entity.Milestone, fromStatus string,
+	cols ...string) (changed bool, err error) {
+	affected, err := cr.data.DB.Context(ctx).ID(milestone.ID).Where("status = ?", fromStatus).
+		Cols(append([]string{"status"}, cols...)...).Update(milestone)
+	if err != nil {
+		return false, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,208 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/service/contract_common"
+	"github.com/apache/answer/internal/service/unique"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+// contractReviewRepo contract review repository
+type contractReviewRepo struct {
+	data           *data.Data
+	uniqueIDRepo   unique.UniqueIDRepo
+	freelancerRepo freelancer.FreelancerRepo
+}
+
+// NewContractReviewRepo new repository
+func NewContractReviewRepo(
+	data *data.Data,
+	uniqueIDRepo unique.UniqueIDRepo,
+	freelancerRepo freelancer.FreelancerRepo,
+) contract_common.ContractReviewRepo {
+	return &contractReviewRepo{
+		data:           data,
+		uniqueIDRepo:   uniqueIDRepo,
+		freelancerRepo: freelancerRepo,
+	}
+}
+
+// AddContractReview add contract review
+func (cr *contractReviewRepo) AddContractReview(ctx context.Context, review *entity.ContractReview) (err error) {
+	review.ID, err = cr.uniqueIDRepo.GenUniqueIDStr(ctx, constant.ContractReviewObjectType)
+	if err != nil {
+		return err
+	}
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(review); err != nil {
+			return nil, err
+		}
+		return nil, cr.refreshRevieweeStats(session, review)
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	cr.updateListingSearch(ctx, review.RevieweeID)
+	return nil
+}
+
+// GetContractReview get contract review by id
+func (cr *contractReviewRepo) GetContractReview(ctx context.Context, id string) (
+	review *entity.ContractReview, exist bool, err error) {
+	review = &entity.ContractReview{}
+	exist, err = cr.data.DB.Context(ctx).ID(id).Get(review)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetContractReviewByReviewer get the review of the contract written by the reviewer
+func (cr *contractReviewRepo) GetContractReviewByReviewer(ctx context.Context, contractID, reviewerID string) (
+	review *entity.ContractReview, exist bool, err error) {
+	review = &entity.ContractReview{}
+	exist, err = cr.data.DB.Context(ctx).Where("contract_id = ?", contractID).
+		And("reviewer_id = ?", reviewerID).Get(review)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetContractReviewPage get the available reviews of the reviewee by page
+func (cr *contractReviewRepo) GetContractReviewPage(ctx context.Context, page, pageSize int, revieweeID, reviewerRole string) (
+	reviews []*entity.ContractReview, total int64, err error) {
+	reviews = make([]*entity.ContractReview, 0)
+	session := cr.data.DB.Context(ctx).Where("reviewee_id = ?", revieweeID).
+		And("status = ?", entity.ContractReviewStatusAvailable)
+	if len(reviewerRole) > 0 {
+		session = session.And("reviewer_role = ?", reviewerRole)
+	}
+	session = session.Desc("created_at")
+	total, err = pager.Help(page, pageSize, &reviews, &entity.ContractReview{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetRevieweeRating get the average rating and the count of the available reviews of the reviewee
+func (cr *contractReviewRepo) GetRevieweeRating(ctx context.Context, revieweeID, reviewerRole string) (
+	rating float64, count int64, err error) {
+	return revieweeRating(cr.data.DB.Context(ctx), revieweeID, reviewerRole)
+}
+
+// UpdateContractReviewStatus update contract review status
+func (cr *contractReviewRepo) UpdateContractReviewStatus(ctx context.Context, review *entity.ContractReview, status int) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		_, err := session.ID(review.ID).Cols("status").Update(&entity.ContractReview{Status: status})
+		if err != nil {
+			return nil, err
+		}
+		return nil, cr.refreshRevieweeStats(session, review)
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	review.Status = status
+	cr.updateListingSearch(ctx, review.RevieweeID)
+	return nil
+}
+
+// UpdateContractReviewReply update the reply of the reviewee
+func (cr *contractReviewRepo) UpdateContractReviewReply(ctx context.Context, review *entity.ContractReview) (err error) {
+	_, err = cr.data.DB.Context(ctx).ID(review.ID).Cols("reply_text", "reply_html", "replied_at").Update(review)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RefreshFreelancerStats recompute the client satisfaction and the completed projects of the freelancer
+func (cr *contractReviewRepo) RefreshFreelancerStats(ctx context.Context, userID string) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		return nil, refreshFreelancerStats(session.Context(ctx), userID)
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	cr.updateListingSearch(ctx, userID)
+	return nil
+}
+
+// updateListingSearch the rating of the reviewee is indexed with the profile and the job postings
+func (cr *contractReviewRepo) updateListingSearch(ctx context.Context, revieweeID string) {
+	if err := cr.freelancerRepo.UpdateUserListingSearch(ctx, revieweeID); err != nil {
+		log.Errorf("update user %s search listings failed: %v", revieweeID, err)
+	}
+}
+
+// refreshRevieweeStats only the reviews written by clients are counted into the freelancer profile
+func (cr *contractReviewRepo) refreshRevieweeStats(session *xorm.Session, review *entity.ContractReview) error {
+	if review.ReviewerRole != entity.ContractReviewerRoleClient {
+		return nil
+	}
+	return refreshFreelancerStats(session, review.RevieweeID)
+}
+
+func refreshFreelancerStats(session *xorm.Session, userID string) error {
+	rating, _, err := revieweeRating(session, userID, entity.ContractReviewerRoleClient)
+	if err != nil {
+		return err
+	}
+	completed, err := session.Where("freelancer_id = ?", userID).
+		And("status = ?", entity.ContractStatusCompleted).Count(&entity.Contract{})
+	if err != nil {
+		return err
+	}
+	_, err = session.Where("user_id = ?", userID).Cols("client_satisfaction", "completed_projects").
+		Update(&entity.FreelancerProfile{ClientSatisfaction: rating, CompletedProjects: int(completed)})
+	return err
+}
+
+func revieweeRating(session *xorm.Session, revieweeID, reviewerRole string) (rating float64, count int64, err error) {
+	session = session.Where("reviewee_id = ?", revieweeID).And("status = ?", entity.ContractReviewStatusAvailable)
+	if len(reviewerRole) > 0 {
+		session = session.And("reviewer_role = ?", reviewerRole)
+	}
+	result := &struct {
+		Rating float64 `xorm:"rating"`
+		Count  int64   `xorm:"count"`
+	}{}
+	_, err = session.Table(entity.ContractReview{}.TableName()).
+		Select("COALESCE(AVG(rating), 0) AS rating, COUNT(*) AS count").Get(result)
+	if err != nil {
+		return 0, 0, err
+	}
+	return result.Rating, result.Count, nil
+}
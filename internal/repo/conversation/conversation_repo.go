@@ -0,0 +1,196 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package conversation
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/conversation"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+	"xorm.io/xorm"
+)
+
+// conversationRepo conversation repository
+type conversationRepo struct {
+	data *data.Data
+}
+
+// NewConversationRepo new repository
+func NewConversationRepo(data *data.Data) conversation.ConversationRepo {
+	return &conversationRepo{
+		data: data,
+	}
+}
+
+// AddConversation add conversation with the client and the freelancer as members
+func (cr *conversationRepo) AddConversation(ctx context.Context, c *entity.Conversation) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(c); err != nil {
+			return nil, err
+		}
+		members := []*entity.ConversationMember{
+			{ConversationID: c.ID, UserID: c.ClientID, LastReadMessageID: "0"},
+			{ConversationID: c.ID, UserID: c.FreelancerID, LastReadMessageID: "0"},
+		}
+		_, err := session.Insert(members)
+		return nil, err
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// GetConversation get conversation by id
+func (cr *conversationRepo) GetConversation(ctx context.Context, id string) (
+	c *entity.Conversation, exist bool, err error) {
+	c = &entity.Conversation{}
+	exist, err = cr.data.DB.Context(ctx).ID(id).Get(c)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetConversationByContext get the conversation of the contract or the job application between the users
+func (cr *conversationRepo) GetConversationByContext(ctx context.Context, cond *entity.Conversation) (
+	c *entity.Conversation, exist bool, err error) {
+	c = &entity.Conversation{}
+	exist, err = cr.data.DB.Context(ctx).Where("client_id = ?", cond.ClientID).
+		And("freelancer_id = ?", cond.FreelancerID).
+		And("contract_id = ?", cond.ContractID).
+		And("application_id = ?", cond.ApplicationID).Get(c)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetConversationPage get the conversations of the user by page, the latest active first
+func (cr *conversationRepo) GetConversationPage(ctx context.Context, page, pageSize int, userID string) (
+	conversations []*entity.Conversation, total int64, err error) {
+	conversations = make([]*entity.Conversation, 0)
+	session := cr.data.DB.Context(ctx).In("id", builder.Select("conversation_id").
+		From(entity.ConversationMember{}.TableName()).Where(builder.Eq{"user_id": userID})).
+		Desc("last_message_at", "id")
+	total, err = pager.Help(page, pageSize, &conversations, &entity.Conversation{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetConversationMembers get the members of the conversation
+func (cr *conversationRepo) GetConversationMembers(ctx context.Context, conversationID string) (
+	members []*entity.ConversationMember, err error) {
+	members = make([]*entity.ConversationMember, 0)
+	err = cr.data.DB.Context(ctx).Where("conversation_id = ?", conversationID).Find(&members)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetConversationMembersByIDs get the members of the conversations
+func (cr *conversationRepo) GetConversationMembersByIDs(ctx context.Context, conversationIDs []string) (
+	members []*entity.ConversationMember, err error) {
+	members = make([]*entity.ConversationMember, 0)
+	if len(conversationIDs) == 0 {
+		return members, nil
+	}
+	err = cr.data.DB.Context(ctx).In("conversation_id", conversationIDs).Find(&members)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// AddMessage add message, the conversation and the unread count of the other members are updated in the same transaction
+func (cr *conversationRepo) AddMessage(ctx context.Context, message *entity.Message) (err error) {
+	_, err = cr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(message); err != nil {
+			return nil, err
+		}
+		_, err := session.ID(message.ConversationID).Cols("last_message_id", "last_message_at").
+			Update(&entity.Conversation{LastMessageID: message.ID, LastMessageAt: message.CreatedAt})
+		if err != nil {
+			return nil, err
+		}
+		_, err = session.Where("conversation_id = ?", message.ConversationID).And("user_id <> ?", message.SenderID).
+			Incr("unread_count").Update(&entity.ConversationMember{})
+		if err != nil {
+			return nil, err
+		}
+		// the sender has read all messages before their own message
+		_, err = session.Where("conversation_id = ?", message.ConversationID).And("user_id = ?", message.SenderID).
+			Cols("last_read_message_id", "last_read_at", "unread_count").
+			Update(&entity.ConversationMember{LastReadMessageID: message.ID, LastReadAt: message.CreatedAt})
+		return nil, err
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// GetMessagePage get the available messages of the conversation by page, the latest first
+func (cr *conversationRepo) GetMessagePage(ctx context.Context, page, pageSize int, conversationID string) (
+	messages []*entity.Message, total int64, err error) {
+	messages = make([]*entity.Message, 0)
+	session := cr.data.DB.Context(ctx).Where("conversation_id = ?", conversationID).
+		And("status = ?", entity.MessageStatusAvailable).Desc("id")
+	total, err = pager.Help(page, pageSize, &messages, &entity.Message{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetMessagesByIDs get messages by ids
+func (cr *conversationRepo) GetMessagesByIDs(ctx context.Context, ids []string) (messages []*entity.Message, err error) {
+	messages = make([]*entity.Message, 0)
+	if len(ids) == 0 {
+		return messages, nil
+	}
+	err = cr.data.DB.Context(ctx).In("id", ids).Find(&messages)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// ReadConversation mark the messages of the conversation until the last message as read by the user
+func (cr *conversationRepo) ReadConversation(ctx context.Context, conversationID, userID, lastMessageID string) (err error) {
+	_, err = cr.data.DB.Context(ctx).Where("conversation_id = ?", conversationID).And("user_id = ?", userID).
+		Cols("last_read_message_id", "last_read_at", "unread_count").
+		Update(&entity.ConversationMember{LastReadMessageID: lastMessageID, LastReadAt: time.Now()})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
@@ -97,3 +97,14 @@ func (fr *fileRecordRepo) GetFileRecordByURL(ctx context.Context, fileURL string
 	}
 	return record, nil
 }
+
+// GetFileRecordByPath gets a file record by its file path
+func (fr *fileRecordRepo) GetFileRecordByPath(ctx context.Context, filePath string) (record *entity.FileRecord, err error) {
+	record = &entity.FileRecord{}
+	session := fr.data.DB.Context(ctx)
+	_, err = session.Where("file_path = ? AND status = ?", filePath, entity.FileRecordStatusAvailable).Get(record)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
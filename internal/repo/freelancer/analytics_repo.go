@@ -0,0 +1,186 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+)
+
+// AnalyticsRepo marketplace analytics repository
+type AnalyticsRepo interface {
+	GetJobPostingsCreatedSince(ctx context.Context, since time.Time) (postings []*entity.JobPosting, err error)
+	GetJobPostingsExpiredBetween(ctx context.Context, start, end time.Time) (postings []*entity.JobPosting, err error)
+	CountOpenJobPostings(ctx context.Context) (count int64, err error)
+	GetJobApplicationsByJobIDs(ctx context.Context, jobIDs []string) (applications []*entity.JobApplication, err error)
+	GetAcceptedJobApplicationsSince(ctx context.Context, since time.Time) (applications []*entity.JobApplication, err error)
+	GetTopJobPostingSkills(ctx context.Context, since time.Time, limit int) (skills []*schema.MarketplaceSkillStat, err error)
+	GetFreelancerRatesBySkill(ctx context.Context, tagIDs []string) (rates map[string][]float64, err error)
+	CountActiveVerifiedFreelancers(ctx context.Context) (count int64, err error)
+}
+
+type analyticsRepo struct {
+	data *data.Data
+}
+
+// NewAnalyticsRepo new marketplace analytics repository
+func NewAnalyticsRepo(data *data.Data) AnalyticsRepo {
+	return &analyticsRepo{
+		data: data,
+	}
+}
+
+// publishedJobPostingCond the job postings which are not waiting for the review or rejected
+func publishedJobPostingCond() builder.Cond {
+	return builder.NotIn("status", entity.JobPostingStatusPending, entity.JobPostingStatusDeleted)
+}
+
+// GetJobPostingsCreatedSince get the published job postings created since the time
+func (ar *analyticsRepo) GetJobPostingsCreatedSince(ctx context.Context, since time.Time) (
+	postings []*entity.JobPosting, err error) {
+	postings = make([]*entity.JobPosting, 0)
+	err = ar.data.DB.Context(ctx).Where("created_at >= ?", since).And(publishedJobPostingCond()).
+		Omit("description", "description_html").OrderBy("created_at ASC").Find(&postings)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetJobPostingsExpiredBetween get the published job postings which expired in the time range without being filled
+func (ar *analyticsRepo) GetJobPostingsExpiredBetween(ctx context.Context, start, end time.Time) (
+	postings []*entity.JobPosting, err error) {
+	postings = make([]*entity.JobPosting, 0)
+	err = ar.data.DB.Context(ctx).Where("expires_at >= ?", start).And("expires_at < ?", end).
+		In("status", entity.JobPostingStatusOpen, entity.JobPostingStatusClosed).
+		Cols("id", "expires_at").Find(&postings)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// CountOpenJobPostings count the open job postings which have not expired
+func (ar *analyticsRepo) CountOpenJobPostings(ctx context.Context) (count int64, err error) {
+	count, err = ar.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("is_active = ?", true).And("expires_at > ?", time.Now()).Count(&entity.JobPosting{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetJobApplicationsByJobIDs get the applications of the job postings
+func (ar *analyticsRepo) GetJobApplicationsByJobIDs(ctx context.Context, jobIDs []string) (
+	applications []*entity.JobApplication, err error) {
+	applications = make([]*entity.JobApplication, 0)
+	if len(jobIDs) == 0 {
+		return applications, nil
+	}
+	err = ar.data.DB.Context(ctx).In("job_id", jobIDs).
+		Cols("id", "job_id", "status", "created_at", "updated_at").Find(&applications)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetAcceptedJobApplicationsSince get the applications accepted since the time, each of them fills a job posting
+func (ar *analyticsRepo) GetAcceptedJobApplicationsSince(ctx context.Context, since time.Time) (
+	applications []*entity.JobApplication, err error) {
+	applications = make([]*entity.JobApplication, 0)
+	err = ar.data.DB.Context(ctx).Where("status = ?", entity.JobApplicationStatusAccepted).
+		And("updated_at >= ?", since).Cols("id", "job_id", "updated_at").Find(&applications)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetTopJobPostingSkills get the skills most required by the published job postings created since the time
+func (ar *analyticsRepo) GetTopJobPostingSkills(ctx context.Context, since time.Time, limit int) (
+	skills []*schema.MarketplaceSkillStat, err error) {
+	rows := make([]*struct {
+		TagID  string `xorm:"tag_id"`
+		Demand int64  `xorm:"demand"`
+	}, 0)
+	postingIDs := builder.Select("id").From(entity.JobPosting{}.TableName()).
+		Where(builder.Gte{"created_at": since}.And(publishedJobPostingCond()))
+	err = ar.data.DB.Context(ctx).Table(entity.SkillRel{}.TableName()).
+		Select("tag_id, COUNT(*) AS demand").
+		Where("object_type = ?", entity.SkillRelObjectTypeJobPosting).
+		In("object_id", postingIDs).
+		GroupBy("tag_id").OrderBy("demand DESC, tag_id ASC").Limit(limit).Find(&rows)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	skills = make([]*schema.MarketplaceSkillStat, 0, len(rows))
+	for _, row := range rows {
+		skills = append(skills, &schema.MarketplaceSkillStat{TagID: row.TagID, Demand: row.Demand})
+	}
+	return skills, nil
+}
+
+// GetFreelancerRatesBySkill get the hourly rates in the base currency of the freelancers with the skills,
+// the result is tag id -> rates
+func (ar *analyticsRepo) GetFreelancerRatesBySkill(ctx context.Context, tagIDs []string) (
+	rates map[string][]float64, err error) {
+	rates = make(map[string][]float64, len(tagIDs))
+	if len(tagIDs) == 0 {
+		return rates, nil
+	}
+	rows := make([]*struct {
+		TagID string  `xorm:"tag_id"`
+		Rate  float64 `xorm:"base_hourly_rate"`
+	}, 0)
+	err = ar.data.DB.Context(ctx).Table(entity.SkillRel{}.TableName()).
+		Join("INNER", entity.FreelancerProfile{}.TableName(),
+			"skill_rel.object_id = freelancer_profile.user_id").
+		Select("skill_rel.tag_id, freelancer_profile.base_hourly_rate").
+		Where("skill_rel.object_type = ?", entity.SkillRelObjectTypeFreelancer).
+		In("skill_rel.tag_id", tagIDs).
+		And("freelancer_profile.base_hourly_rate > 0").Find(&rows)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, row := range rows {
+		rates[row.TagID] = append(rates[row.TagID], row.Rate)
+	}
+	return rates, nil
+}
+
+// CountActiveVerifiedFreelancers count the verified freelancers who are available for work
+func (ar *analyticsRepo) CountActiveVerifiedFreelancers(ctx context.Context) (count int64, err error) {
+	count, err = ar.data.DB.Context(ctx).Where("is_verified = ?", true).And("is_available = ?", true).
+		In("user_id", builder.Select("id").From(entity.User{}.TableName()).
+			Where(builder.Eq{"status": entity.UserStatusAvailable})).
+		Count(&entity.FreelancerProfile{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
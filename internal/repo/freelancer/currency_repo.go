@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// GetAmountCurrencies get the currencies used by the freelancer profiles, the job postings and the applications
+func (fr *freelancerRepo) GetAmountCurrencies(ctx context.Context) (currencies []string, err error) {
+	has := make(map[string]bool)
+	for _, table := range []string{
+		entity.FreelancerProfile{}.TableName(),
+		entity.JobPosting{}.TableName(),
+		entity.JobApplication{}.TableName(),
+	} {
+		codes := make([]string, 0)
+		err = fr.data.DB.Context(ctx).Table(table).Distinct("currency").Find(&codes)
+		if err != nil {
+			return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+		for _, code := range codes {
+			if !has[code] {
+				has[code] = true
+				currencies = append(currencies, code)
+			}
+		}
+	}
+	return currencies, nil
+}
+
+// UpdateBaseAmounts convert the amounts in the currency to the base currency with the rate,
+// only the changed profiles and job postings are updated and re-indexed in the search plugin
+func (fr *freelancerRepo) UpdateBaseAmounts(ctx context.Context, currency string, rate float64) (err error) {
+	profiles := make([]*entity.FreelancerProfile, 0)
+	err = fr.data.DB.Context(ctx).Where("currency = ?", currency).Cols("id", "user_id", "hourly_rate", "base_hourly_rate").
+		Find(&profiles)
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, profile := range profiles {
+		baseAmount := roundAmount(profile.HourlyRate * rate)
+		if baseAmount == profile.BaseHourlyRate {
+			continue
+		}
+		_, err = fr.data.DB.Context(ctx).ID(profile.ID).Cols("base_hourly_rate").NoAutoTime().
+			Update(&entity.FreelancerProfile{BaseHourlyRate: baseAmount})
+		if err != nil {
+			return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+		fr.updateListingSearch(ctx, plugin.SearchListingTypeFreelancer, profile.UserID)
+	}
+
+	postings := make([]*entity.JobPosting, 0)
+	err = fr.data.DB.Context(ctx).Where("currency = ?", currency).Cols("id", "budget", "base_budget").
+		Find(&postings)
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, posting := range postings {
+		baseAmount := roundAmount(posting.Budget * rate)
+		if baseAmount == posting.BaseBudget {
+			continue
+		}
+		_, err = fr.data.DB.Context(ctx).ID(posting.ID).Cols("base_budget").NoAutoTime().
+			Update(&entity.JobPosting{BaseBudget: baseAmount})
+		if err != nil {
+			return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+		fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, posting.ID)
+	}
+
+	// the applications are not indexed, so they are updated together
+	_, err = fr.data.DB.Context(ctx).Exec(fmt.Sprintf("UPDATE %s SET base_proposed_rate = ROUND(proposed_rate * ?, 2) "+
+		"WHERE currency = ?", entity.JobApplication{}.TableName()), rate, currency)
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// roundAmount round the amount to the cent
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -21,12 +21,20 @@ package freelancer
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
 	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/unique"
+	"github.com/apache/answer/plugin"
 	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+	"xorm.io/xorm"
 )
 
 // FreelancerRepo freelancer repository
@@ -35,33 +43,64 @@ type FreelancerRepo interface {
 	UpdateFreelancerProfile(ctx context.Context, profile *entity.FreelancerProfile) error
 	GetFreelancerProfileByUserID(ctx context.Context, userID string) (*entity.FreelancerProfile, bool, error)
 	GetFreelancerProfiles(ctx context.Context, req *schema.GetFreelancerProfilesReq) ([]*entity.FreelancerProfile, int64, error)
+	GetFreelancerProfilesByUserIDs(ctx context.Context, userIDs []string) ([]*entity.FreelancerProfile, error)
 	DeleteFreelancerProfile(ctx context.Context, userID string) error
+	GetAvailableFreelancerProfiles(ctx context.Context, limit int) ([]*entity.FreelancerProfile, error)
 
 	CreateJobPosting(ctx context.Context, posting *entity.JobPosting) error
-	UpdateJobPosting(ctx context.Context, posting *entity.JobPosting) error
+	UpdateJobPosting(ctx context.Context, posting *entity.JobPosting, cols []string) error
+	UpdateJobPostingStatus(ctx context.Context, id, status string) error
+	GetJobPostingsExpireBefore(ctx context.Context, deadline time.Time) ([]*entity.JobPosting, error)
+	UpdateJobPostingExpiryNotified(ctx context.Context, id string, notified bool) error
+	CloseExpiredJobPostings(ctx context.Context, now time.Time) (int64, error)
 	GetJobPostingByID(ctx context.Context, id string) (*entity.JobPosting, bool, error)
 	GetJobPostings(ctx context.Context, req *schema.GetJobPostingsReq) ([]*entity.JobPosting, int64, error)
+	GetJobPostingsByIDs(ctx context.Context, ids []string) ([]*entity.JobPosting, error)
 	GetJobPostingsByUserID(ctx context.Context, userID string) ([]*entity.JobPosting, error)
+	GetOpenJobPostings(ctx context.Context, limit int) ([]*entity.JobPosting, error)
 	DeleteJobPosting(ctx context.Context, id string) error
 	IncrementJobViews(ctx context.Context, id string) error
 
 	CreateJobApplication(ctx context.Context, application *entity.JobApplication) error
 	UpdateJobApplication(ctx context.Context, application *entity.JobApplication) error
+	UpdateJobApplicationStatus(ctx context.Context, application *entity.JobApplication, jobStatus string) error
 	GetJobApplicationByID(ctx context.Context, id string) (*entity.JobApplication, bool, error)
 	GetJobApplications(ctx context.Context, req *schema.GetJobApplicationsReq) ([]*entity.JobApplication, int64, error)
 	GetJobApplicationsByJobID(ctx context.Context, jobID string) ([]*entity.JobApplication, error)
 	GetJobApplicationsByApplicantID(ctx context.Context, applicantID string) ([]*entity.JobApplication, error)
 	DeleteJobApplication(ctx context.Context, id string) error
+
+	GetAcceptedAnswerCountByTags(ctx context.Context, userIDs, tagIDs []string) (map[string]map[string]int64, error)
+
+	UpdateSkillRels(ctx context.Context, objectType, objectID string, tagIDs []string) error
+	CountSkillObjects(ctx context.Context, tagIDs []string) (freelancerCount, jobPostingCount int64, err error)
+	GetFreelancerProfilesBySkill(ctx context.Context, tagIDs []string, limit int) ([]*entity.FreelancerProfile, error)
+	GetOpenJobPostingsBySkill(ctx context.Context, tagIDs []string, limit int) ([]*entity.JobPosting, error)
+
+	GetAmountCurrencies(ctx context.Context) (currencies []string, err error)
+	UpdateBaseAmounts(ctx context.Context, currency string, rate float64) (err error)
+
+	HasListingSearch() bool
+	SearchListings(ctx context.Context, cond *plugin.SearchListingCond) (objectIDs []string, total int64, err error)
+	UpdateListingSearch(ctx context.Context, listingType, objectID string) (err error)
+	UpdateUserListingSearch(ctx context.Context, userID string) (err error)
+
+	SitemapJobPostings(ctx context.Context, page, pageSize int) (jobPostings []*schema.SiteMapJobPostingInfo, err error)
+	GetSitemapJobPostingCount(ctx context.Context) (count int64, err error)
+	SitemapFreelancers(ctx context.Context, page, pageSize int) (freelancers []*schema.SiteMapFreelancerInfo, err error)
+	GetSitemapFreelancerCount(ctx context.Context) (count int64, err error)
 }
 
 type freelancerRepo struct {
-	data *data.Data
+	data         *data.Data
+	uniqueIDRepo unique.UniqueIDRepo
 }
 
 // NewFreelancerRepo new freelancer repository
-func NewFreelancerRepo(data *data.Data) FreelancerRepo {
+func NewFreelancerRepo(data *data.Data, uniqueIDRepo unique.UniqueIDRepo) FreelancerRepo {
 	return &freelancerRepo{
-		data: data,
+		data:         data,
+		uniqueIDRepo: uniqueIDRepo,
 	}
 }
 
@@ -71,15 +110,18 @@ func (fr *freelancerRepo) CreateFreelancerProfile(ctx context.Context, profile *
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeFreelancer, profile.UserID)
 	return nil
 }
 
 // UpdateFreelancerProfile update freelancer profile
 func (fr *freelancerRepo) UpdateFreelancerProfile(ctx context.Context, profile *entity.FreelancerProfile) error {
-	_, err := fr.data.DB.Context(ctx).Where("user_id = ?", profile.UserID).Update(profile)
+	_, err := fr.data.DB.Context(ctx).Where("user_id = ?", profile.UserID).
+		MustCols("is_available", "utc_offset", "has_utc_offset").Update(profile)
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeFreelancer, profile.UserID)
 	return nil
 }
 
@@ -98,35 +140,56 @@ func (fr *freelancerRepo) GetFreelancerProfiles(ctx context.Context, req *schema
 	session := fr.data.DB.Context(ctx).Where("is_available = ?", true)
 	
 	// Apply filters
-	if req.Skills != "" {
-		session = session.Where("skills LIKE ?", "%"+req.Skills+"%")
+	if len(req.SkillTagIDs) > 0 {
+		session = session.And(skillFilterCond("user_id", entity.SkillRelObjectTypeFreelancer,
+			req.SkillTagIDs, req.SkillMatch == schema.SkillMatchAll))
 	}
-	if req.Location != "" {
-		session = session.Where("location LIKE ?", "%"+req.Location+"%")
+	for _, word := range strings.Fields(req.Query) {
+		session = session.And(builder.Like{"bio", word}.Or(builder.Like{"skills", word}))
 	}
 	if req.MinRate > 0 {
-		session = session.Where("hourly_rate >= ?", req.MinRate)
+		session = session.Where("base_hourly_rate >= ?", req.MinBaseRate)
 	}
 	if req.MaxRate > 0 {
-		session = session.Where("hourly_rate <= ?", req.MaxRate)
+		session = session.Where("base_hourly_rate <= ?", req.MaxBaseRate)
 	}
 	if req.Currency != "" {
 		session = session.Where("currency = ?", req.Currency)
 	}
-
-	// Get total count
-	total, err := session.Count(&entity.FreelancerProfile{})
-	if err != nil {
-		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	if req.ExperienceLevel != "" {
+		session = session.Where("experience_level = ?", req.ExperienceLevel)
+	}
+	if req.LocationType != "" {
+		session = session.Where("location_type = ?", req.LocationType)
+	}
+	if req.Availability != "" {
+		session = session.Where("availability = ?", req.Availability)
+	}
+	if req.HasTimeZoneWindow() {
+		session = session.Where("has_utc_offset = ?", true)
+		if req.MinUTCOffset <= req.MaxUTCOffset {
+			session = session.And("utc_offset >= ? AND utc_offset <= ?", req.MinUTCOffset, req.MaxUTCOffset)
+		} else {
+			// the window crosses the date line, such as from UTC+10 to UTC-10
+			session = session.And("(utc_offset >= ? OR utc_offset <= ?)", req.MinUTCOffset, req.MaxUTCOffset)
+		}
+	}
+	if req.VerifiedOnly {
+		session = session.Where("is_verified = ?", true)
 	}
 
-	// Apply pagination
-	if req.Page > 0 {
-		session = session.Limit(req.PageSize, (req.Page-1)*req.PageSize)
+	// the database can not rank by relevance, so it is sorted by newest
+	switch req.Sort {
+	case schema.ListingSortBudget:
+		session = session.OrderBy("base_hourly_rate DESC, created_at DESC")
+	case schema.ListingSortRating:
+		session = session.OrderBy("client_satisfaction DESC, completed_projects DESC, created_at DESC")
+	default:
+		session = session.OrderBy("created_at DESC")
 	}
 
-	var profiles []*entity.FreelancerProfile
-	err = session.OrderBy("created_at DESC").Find(&profiles)
+	profiles := make([]*entity.FreelancerProfile, 0)
+	total, err := pager.Help(req.Page, req.PageSize, &profiles, &entity.FreelancerProfile{}, session)
 	if err != nil {
 		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
@@ -134,30 +197,71 @@ func (fr *freelancerRepo) GetFreelancerProfiles(ctx context.Context, req *schema
 	return profiles, total, nil
 }
 
+// GetFreelancerProfilesByUserIDs get the freelancer profiles of the users
+func (fr *freelancerRepo) GetFreelancerProfilesByUserIDs(ctx context.Context, userIDs []string) ([]*entity.FreelancerProfile, error) {
+	profiles := make([]*entity.FreelancerProfile, 0)
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+	err := fr.data.DB.Context(ctx).In("user_id", userIDs).Find(&profiles)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return profiles, nil
+}
+
 // DeleteFreelancerProfile delete freelancer profile
 func (fr *freelancerRepo) DeleteFreelancerProfile(ctx context.Context, userID string) error {
 	_, err := fr.data.DB.Context(ctx).Where("user_id = ?", userID).Delete(&entity.FreelancerProfile{})
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeFreelancer, userID)
 	return nil
 }
 
+// GetAvailableFreelancerProfiles get the latest updated profiles of available freelancers
+func (fr *freelancerRepo) GetAvailableFreelancerProfiles(ctx context.Context, limit int) ([]*entity.FreelancerProfile, error) {
+	profiles := make([]*entity.FreelancerProfile, 0)
+	err := fr.data.DB.Context(ctx).Where("is_available = ?", true).
+		OrderBy("updated_at DESC").Limit(limit).Find(&profiles)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return profiles, nil
+}
+
 // CreateJobPosting create job posting
-func (fr *freelancerRepo) CreateJobPosting(ctx context.Context, posting *entity.JobPosting) error {
-	_, err := fr.data.DB.Context(ctx).Insert(posting)
+func (fr *freelancerRepo) CreateJobPosting(ctx context.Context, posting *entity.JobPosting) (err error) {
+	posting.ID, err = fr.uniqueIDRepo.GenUniqueIDStr(ctx, posting.TableName())
+	if err != nil {
+		return err
+	}
+	_, err = fr.data.DB.Context(ctx).Insert(posting)
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, posting.ID)
 	return nil
 }
 
 // UpdateJobPosting update job posting
-func (fr *freelancerRepo) UpdateJobPosting(ctx context.Context, posting *entity.JobPosting) error {
-	_, err := fr.data.DB.Context(ctx).Where("id = ?", posting.ID).Update(posting)
+func (fr *freelancerRepo) UpdateJobPosting(ctx context.Context, posting *entity.JobPosting, cols []string) error {
+	_, err := fr.data.DB.Context(ctx).ID(posting.ID).Cols(cols...).Update(posting)
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, posting.ID)
+	return nil
+}
+
+// UpdateJobPostingStatus update job posting status
+func (fr *freelancerRepo) UpdateJobPostingStatus(ctx context.Context, id, status string) error {
+	_, err := fr.data.DB.Context(ctx).ID(id).Cols("status").Update(&entity.JobPosting{Status: status})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, id)
 	return nil
 }
 
@@ -174,19 +278,27 @@ func (fr *freelancerRepo) GetJobPostingByID(ctx context.Context, id string) (*en
 // GetJobPostings get job postings
 func (fr *freelancerRepo) GetJobPostings(ctx context.Context, req *schema.GetJobPostingsReq) ([]*entity.JobPosting, int64, error) {
 	session := fr.data.DB.Context(ctx).Where("is_active = ?", true)
+	// the postings waiting for the review or rejected are not listed
+	session = session.NotIn("status", entity.JobPostingStatusPending, entity.JobPostingStatusDeleted)
+	// open postings which have expired but not been closed by cron yet are not listed
+	session = session.And("(status <> ? OR expires_at > ?)", entity.JobPostingStatusOpen, time.Now())
 	
 	// Apply filters
-	if req.Skills != "" {
-		session = session.Where("skills LIKE ?", "%"+req.Skills+"%")
+	if len(req.SkillTagIDs) > 0 {
+		session = session.And(skillFilterCond("id", entity.SkillRelObjectTypeJobPosting,
+			req.SkillTagIDs, req.SkillMatch == schema.SkillMatchAll))
+	}
+	for _, word := range strings.Fields(req.Query) {
+		session = session.And(builder.Like{"title", word}.Or(builder.Like{"description", word}))
 	}
 	if req.Location != "" {
 		session = session.Where("location LIKE ?", "%"+req.Location+"%")
 	}
 	if req.MinBudget > 0 {
-		session = session.Where("budget >= ?", req.MinBudget)
+		session = session.Where("base_budget >= ?", req.MinBaseBudget)
 	}
 	if req.MaxBudget > 0 {
-		session = session.Where("budget <= ?", req.MaxBudget)
+		session = session.Where("base_budget <= ?", req.MaxBaseBudget)
 	}
 	if req.Currency != "" {
 		session = session.Where("currency = ?", req.Currency)
@@ -194,25 +306,105 @@ func (fr *freelancerRepo) GetJobPostings(ctx context.Context, req *schema.GetJob
 	if req.Status != "" {
 		session = session.Where("status = ?", req.Status)
 	}
+	if req.ExperienceLevel != "" {
+		session = session.Where("experience_level = ?", req.ExperienceLevel)
+	}
+	if req.LocationType != "" {
+		session = session.Where("location = ?", req.LocationType)
+	}
+	if req.VerifiedOnly {
+		session = session.In("user_id", builder.Select("user_id").From(entity.FreelancerProfile{}.TableName()).
+			Where(builder.Eq{"is_verified": true}))
+	}
+	if postedAfter := req.PostedAfter(); !postedAfter.IsZero() {
+		session = session.Where("created_at >= ?", postedAfter)
+	}
+
+	// the database can not rank by relevance, so it is sorted by newest
+	switch req.Sort {
+	case schema.ListingSortBudget:
+		session = session.OrderBy("base_budget DESC, created_at DESC")
+	case schema.ListingSortRating:
+		session = session.OrderBy(clientRatingOrder + ", created_at DESC")
+	default:
+		session = session.OrderBy("created_at DESC")
+	}
 
-	// Get total count
-	total, err := session.Count(&entity.JobPosting{})
+	postings := make([]*entity.JobPosting, 0)
+	total, err := pager.Help(req.Page, req.PageSize, &postings, &entity.JobPosting{}, session)
 	if err != nil {
 		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
 
-	// Apply pagination
-	if req.Page > 0 {
-		session = session.Limit(req.PageSize, (req.Page-1)*req.PageSize)
+	return postings, total, nil
+}
+
+// clientRatingOrder sort the job postings by the average rating given to the poster by freelancers
+var clientRatingOrder = fmt.Sprintf("(SELECT COALESCE(AVG(rating), 0) FROM %s WHERE %s.reviewee_id = %s.user_id "+
+	"AND %s.reviewer_role = '%s' AND %s.status = %d) DESC",
+	entity.ContractReview{}.TableName(), entity.ContractReview{}.TableName(), entity.JobPosting{}.TableName(),
+	entity.ContractReview{}.TableName(), entity.ContractReviewerRoleFreelancer,
+	entity.ContractReview{}.TableName(), entity.ContractReviewStatusAvailable)
+
+// GetJobPostingsByIDs get the job postings by ids
+func (fr *freelancerRepo) GetJobPostingsByIDs(ctx context.Context, ids []string) ([]*entity.JobPosting, error) {
+	postings := make([]*entity.JobPosting, 0)
+	if len(ids) == 0 {
+		return postings, nil
 	}
+	err := fr.data.DB.Context(ctx).In("id", ids).Find(&postings)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return postings, nil
+}
 
-	var postings []*entity.JobPosting
-	err = session.OrderBy("created_at DESC").Find(&postings)
+// GetJobPostingsExpireBefore get the open job postings which will expire before deadline
+// and whose poster has not been notified
+func (fr *freelancerRepo) GetJobPostingsExpireBefore(ctx context.Context, deadline time.Time) ([]*entity.JobPosting, error) {
+	postings := make([]*entity.JobPosting, 0)
+	err := fr.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("expiry_notified = ?", false).
+		And("expires_at > ?", time.Now()).
+		And("expires_at <= ?", deadline).
+		Find(&postings)
 	if err != nil {
-		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	return postings, nil
+}
 
-	return postings, total, nil
+// UpdateJobPostingExpiryNotified update whether the poster has been notified about the expiry
+func (fr *freelancerRepo) UpdateJobPostingExpiryNotified(ctx context.Context, id string, notified bool) error {
+	_, err := fr.data.DB.Context(ctx).ID(id).Cols("expiry_notified").
+		Update(&entity.JobPosting{ExpiryNotified: notified})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+// CloseExpiredJobPostings close all open job postings which expired before now
+func (fr *freelancerRepo) CloseExpiredJobPostings(ctx context.Context, now time.Time) (int64, error) {
+	expiredIDs := make([]string, 0)
+	if fr.HasListingSearch() {
+		err := fr.data.DB.Context(ctx).Table(entity.JobPosting{}.TableName()).
+			Where("status = ?", entity.JobPostingStatusOpen).And("expires_at <= ?", now).
+			Cols("id").Find(&expiredIDs)
+		if err != nil {
+			return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+	}
+	affected, err := fr.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("expires_at <= ?", now).
+		Cols("status").Update(&entity.JobPosting{Status: entity.JobPostingStatusClosed})
+	if err != nil {
+		return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, id := range expiredIDs {
+		fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, id)
+	}
+	return affected, nil
 }
 
 // GetJobPostingsByUserID get job postings by user ID
@@ -225,12 +417,38 @@ func (fr *freelancerRepo) GetJobPostingsByUserID(ctx context.Context, userID str
 	return postings, nil
 }
 
-// DeleteJobPosting delete job posting
+// GetOpenJobPostings get the latest open job postings which have not expired
+func (fr *freelancerRepo) GetOpenJobPostings(ctx context.Context, limit int) ([]*entity.JobPosting, error) {
+	postings := make([]*entity.JobPosting, 0)
+	err := fr.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("is_active = ?", true).
+		And("expires_at > ?", time.Now()).
+		OrderBy("created_at DESC").Limit(limit).Find(&postings)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return postings, nil
+}
+
+// DeleteJobPosting delete job posting and all applications of it
 func (fr *freelancerRepo) DeleteJobPosting(ctx context.Context, id string) error {
-	_, err := fr.data.DB.Context(ctx).Where("id = ?", id).Delete(&entity.JobPosting{})
+	_, err := fr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Where("job_id = ?", id).Delete(&entity.JobApplication{}); err != nil {
+			return nil, err
+		}
+		_, err := session.Where("object_type = ?", entity.SkillRelObjectTypeJobPosting).And("object_id = ?", id).
+			Delete(&entity.SkillRel{})
+		if err != nil {
+			return nil, err
+		}
+		_, err = session.ID(id).Delete(&entity.JobPosting{})
+		return nil, err
+	})
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, id)
 	return nil
 }
 
@@ -243,9 +461,15 @@ func (fr *freelancerRepo) IncrementJobViews(ctx context.Context, id string) erro
 	return nil
 }
 
-// CreateJobApplication create job application
+// CreateJobApplication create job application and sync the application count of the job
 func (fr *freelancerRepo) CreateJobApplication(ctx context.Context, application *entity.JobApplication) error {
-	_, err := fr.data.DB.Context(ctx).Insert(application)
+	_, err := fr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Insert(application); err != nil {
+			return nil, err
+		}
+		return nil, fr.syncJobApplicationCount(session, application.JobID)
+	})
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
@@ -261,6 +485,58 @@ func (fr *freelancerRepo) UpdateJobApplication(ctx context.Context, application
 	return nil
 }
 
+// UpdateJobApplicationStatus update the status of a pending job application and sync the application count of the job.
+// If jobStatus is not empty, the open job posting status will be changed in the same transaction.
+// Nothing is changed if the application is no longer pending or the job posting is no longer open.
+func (fr *freelancerRepo) UpdateJobApplicationStatus(ctx context.Context, application *entity.JobApplication, jobStatus string) error {
+	var statusErr error
+	_, err := fr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		affected, err := session.ID(application.ID).And("status = ?", entity.JobApplicationStatusPending).
+			Cols("status").Update(&entity.JobApplication{Status: application.Status})
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			statusErr = errors.BadRequest(reason.JobApplicationStatusInvalid)
+			return nil, statusErr
+		}
+		if len(jobStatus) > 0 {
+			affected, err = session.ID(application.JobID).And("status = ?", entity.JobPostingStatusOpen).
+				Cols("status").Update(&entity.JobPosting{Status: jobStatus})
+			if err != nil {
+				return nil, err
+			}
+			if affected == 0 {
+				statusErr = errors.BadRequest(reason.JobPostingNotOpen)
+				return nil, statusErr
+			}
+		}
+		return nil, fr.syncJobApplicationCount(session, application.JobID)
+	})
+	if statusErr != nil {
+		return statusErr
+	}
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	if len(jobStatus) > 0 {
+		fr.updateListingSearch(ctx, plugin.SearchListingTypeJobPosting, application.JobID)
+	}
+	return nil
+}
+
+// syncJobApplicationCount recount the applications of the job, withdrawn applications are not counted
+func (fr *freelancerRepo) syncJobApplicationCount(session *xorm.Session, jobID string) error {
+	count, err := session.Where("job_id = ?", jobID).
+		And("status <> ?", entity.JobApplicationStatusWithdrawn).Count(&entity.JobApplication{})
+	if err != nil {
+		return err
+	}
+	_, err = session.ID(jobID).Cols("application_count").Update(&entity.JobPosting{ApplicationCount: int(count)})
+	return err
+}
+
 // GetJobApplicationByID get job application by ID
 func (fr *freelancerRepo) GetJobApplicationByID(ctx context.Context, id string) (*entity.JobApplication, bool, error) {
 	application := &entity.JobApplication{}
@@ -271,38 +547,25 @@ func (fr *freelancerRepo) GetJobApplicationByID(ctx context.Context, id string)
 	return application, exist, nil
 }
 
-// GetJobApplications get job applications
+// GetJobApplications get job applications page
 func (fr *freelancerRepo) GetJobApplications(ctx context.Context, req *schema.GetJobApplicationsReq) ([]*entity.JobApplication, int64, error) {
 	session := fr.data.DB.Context(ctx)
-	
-	// Apply filters
 	if req.JobID != "" {
 		session = session.Where("job_id = ?", req.JobID)
 	}
 	if req.ApplicantID != "" {
-		session = session.Where("applicant_id = ?", req.ApplicantID)
+		session = session.And("applicant_id = ?", req.ApplicantID)
 	}
 	if req.Status != "" {
-		session = session.Where("status = ?", req.Status)
-	}
-
-	// Get total count
-	total, err := session.Count(&entity.JobApplication{})
-	if err != nil {
-		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
-	}
-
-	// Apply pagination
-	if req.Page > 0 {
-		session = session.Limit(req.PageSize, (req.Page-1)*req.PageSize)
+		session = session.And("status = ?", req.Status)
 	}
+	session = session.OrderBy("created_at DESC")
 
-	var applications []*entity.JobApplication
-	err = session.OrderBy("created_at DESC").Find(&applications)
+	applications := make([]*entity.JobApplication, 0)
+	total, err := pager.Help(req.Page, req.PageSize, &applications, &entity.JobApplication{}, session)
 	if err != nil {
 		return nil, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
-
 	return applications, total, nil
 }
 
@@ -334,3 +597,139 @@ func (fr *freelancerRepo) DeleteJobApplication(ctx context.Context, id string) e
 	}
 	return nil
 }
+
+// GetAcceptedAnswerCountByTags count the accepted answers of users in questions with these tags,
+// the result is user id -> tag id -> count
+func (fr *freelancerRepo) GetAcceptedAnswerCountByTags(ctx context.Context, userIDs, tagIDs []string) (
+	map[string]map[string]int64, error) {
+	result := make(map[string]map[string]int64)
+	if len(userIDs) == 0 || len(tagIDs) == 0 {
+		return result, nil
+	}
+	rows := make([]*struct {
+		UserID string `xorm:"user_id"`
+		TagID  string `xorm:"tag_id"`
+		Count  int64  `xorm:"count"`
+	}, 0)
+	err := fr.data.DB.Context(ctx).Table(entity.Answer{}.TableName()).
+		Select("answer.user_id, tag_rel.tag_id, COUNT(*) AS count").
+		Join("INNER", entity.TagRel{}.TableName(), "tag_rel.object_id = answer.question_id").
+		In("answer.user_id", userIDs).
+		In("tag_rel.tag_id", tagIDs).
+		And("answer.adopted = ?", schema.AnswerAcceptedEnable).
+		And("answer.status = ?", entity.AnswerStatusAvailable).
+		And("tag_rel.status = ?", entity.TagRelStatusAvailable).
+		GroupBy("answer.user_id, tag_rel.tag_id").
+		Find(&rows)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, row := range rows {
+		if result[row.UserID] == nil {
+			result[row.UserID] = make(map[string]int64)
+		}
+		result[row.UserID][row.TagID] = row.Count
+	}
+	return result, nil
+}
+
+// UpdateSkillRels replace the skill relations of the object, tagIDs are in the order of display
+func (fr *freelancerRepo) UpdateSkillRels(ctx context.Context, objectType, objectID string, tagIDs []string) error {
+	_, err := fr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		_, err := session.Where("object_type = ?", objectType).And("object_id = ?", objectID).
+			Delete(&entity.SkillRel{})
+		if err != nil {
+			return nil, err
+		}
+		rels := make([]*entity.SkillRel, 0, len(tagIDs))
+		for i, tagID := range tagIDs {
+			rels = append(rels, &entity.SkillRel{
+				ObjectType: objectType,
+				ObjectID:   objectID,
+				TagID:      tagID,
+				Sort:       i,
+			})
+		}
+		if len(rels) > 0 {
+			_, err = session.Insert(rels)
+		}
+		return nil, err
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	fr.updateListingSearch(ctx, objectType, objectID)
+	return nil
+}
+
+// CountSkillObjects count the available freelancers and the open job postings with any of the skills
+func (fr *freelancerRepo) CountSkillObjects(ctx context.Context, tagIDs []string) (
+	freelancerCount, jobPostingCount int64, err error) {
+	freelancerCount, err = fr.data.DB.Context(ctx).Where("is_available = ?", true).
+		In("user_id", skillRelObjectIDs(entity.SkillRelObjectTypeFreelancer, tagIDs)).
+		Count(&entity.FreelancerProfile{})
+	if err != nil {
+		return 0, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	jobPostingCount, err = fr.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("is_active = ?", true).
+		And("expires_at > ?", time.Now()).
+		In("id", skillRelObjectIDs(entity.SkillRelObjectTypeJobPosting, tagIDs)).
+		Count(&entity.JobPosting{})
+	if err != nil {
+		return 0, 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return freelancerCount, jobPostingCount, nil
+}
+
+// GetFreelancerProfilesBySkill get the available freelancer profiles with any of the skills
+func (fr *freelancerRepo) GetFreelancerProfilesBySkill(ctx context.Context, tagIDs []string, limit int) (
+	[]*entity.FreelancerProfile, error) {
+	profiles := make([]*entity.FreelancerProfile, 0)
+	err := fr.data.DB.Context(ctx).Where("is_available = ?", true).
+		In("user_id", skillRelObjectIDs(entity.SkillRelObjectTypeFreelancer, tagIDs)).
+		OrderBy("updated_at DESC").Limit(limit).Find(&profiles)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return profiles, nil
+}
+
+// GetOpenJobPostingsBySkill get the open job postings with any of the skills
+func (fr *freelancerRepo) GetOpenJobPostingsBySkill(ctx context.Context, tagIDs []string, limit int) (
+	[]*entity.JobPosting, error) {
+	postings := make([]*entity.JobPosting, 0)
+	err := fr.data.DB.Context(ctx).Where("status = ?", entity.JobPostingStatusOpen).
+		And("is_active = ?", true).
+		And("expires_at > ?", time.Now()).
+		In("id", skillRelObjectIDs(entity.SkillRelObjectTypeJobPosting, tagIDs)).
+		OrderBy("created_at DESC").Limit(limit).Find(&postings)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return postings, nil
+}
+
+// skillRelObjectIDs sub query of the object ids which have any of the skills
+func skillRelObjectIDs(objectType string, tagIDs []string) *builder.Builder {
+	return builder.Select("object_id").From(entity.SkillRel{}.TableName()).
+		Where(builder.Eq{"object_type": objectType}.And(builder.In("tag_id", tagIDs)))
+}
+
+// skillFilterCond the condition that the object has all or any of the skills,
+// each element of tagIDs is a skill tag and its synonyms
+func skillFilterCond(column, objectType string, tagIDs [][]string, matchAll bool) builder.Cond {
+	if matchAll {
+		cond := builder.NewCond()
+		for _, ids := range tagIDs {
+			cond = cond.And(builder.In(column, skillRelObjectIDs(objectType, ids)))
+		}
+		return cond
+	}
+	anyIDs := make([]string, 0)
+	for _, ids := range tagIDs {
+		anyIDs = append(anyIDs, ids...)
+	}
+	return builder.In(column, skillRelObjectIDs(objectType, anyIDs))
+}
@@ -0,0 +1,278 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/log"
+)
+
+// getListingSearch get the search plugin which supports listings, nil if not found
+func getListingSearch() plugin.ListingSearch {
+	var finder plugin.ListingSearch
+	_ = plugin.CallSearch(func(search plugin.Search) error {
+		if s, ok := search.(plugin.ListingSearch); ok {
+			finder = s
+		}
+		return nil
+	})
+	return finder
+}
+
+// HasListingSearch whether the listings are searched by the search plugin
+func (fr *freelancerRepo) HasListingSearch() bool {
+	return getListingSearch() != nil
+}
+
+// SearchListings search the freelancer profiles or job postings by the search plugin,
+// the object ids are returned in the order of the search result
+func (fr *freelancerRepo) SearchListings(ctx context.Context, cond *plugin.SearchListingCond) (
+	objectIDs []string, total int64, err error) {
+	finder := getListingSearch()
+	if finder == nil {
+		return nil, 0, nil
+	}
+	res, total, err := finder.SearchListings(ctx, cond)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, item := range res {
+		objectIDs = append(objectIDs, item.ID)
+	}
+	return objectIDs, total, nil
+}
+
+// UpdateListingSearch update the listing in the search plugin, if the plugin is not enabled, do nothing
+func (fr *freelancerRepo) UpdateListingSearch(ctx context.Context, listingType, objectID string) (err error) {
+	finder := getListingSearch()
+	if finder == nil {
+		return nil
+	}
+	var listings []*plugin.SearchListingContent
+	switch listingType {
+	case plugin.SearchListingTypeFreelancer:
+		profiles := make([]*entity.FreelancerProfile, 0)
+		if err = fr.data.DB.Context(ctx).Where("user_id = ?", objectID).Find(&profiles); err != nil {
+			return err
+		}
+		listings, err = ConvertProfilesToSearchListings(ctx, fr.data, profiles)
+	case plugin.SearchListingTypeJobPosting:
+		postings := make([]*entity.JobPosting, 0)
+		if err = fr.data.DB.Context(ctx).Where("id = ?", objectID).Find(&postings); err != nil {
+			return err
+		}
+		listings, err = ConvertJobPostingsToSearchListings(ctx, fr.data, postings)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(listings) == 0 {
+		return finder.DeleteListing(ctx, listingType, objectID)
+	}
+	return finder.UpdateListing(ctx, listings[0])
+}
+
+// UpdateUserListingSearch update the profile and all job postings of the user in the search plugin,
+// which is used when the rating or verification of the user is changed
+func (fr *freelancerRepo) UpdateUserListingSearch(ctx context.Context, userID string) (err error) {
+	if getListingSearch() == nil {
+		return nil
+	}
+	if err = fr.UpdateListingSearch(ctx, plugin.SearchListingTypeFreelancer, userID); err != nil {
+		return err
+	}
+	postingIDs := make([]string, 0)
+	err = fr.data.DB.Context(ctx).Table(entity.JobPosting{}.TableName()).Where("user_id = ?", userID).
+		Cols("id").Find(&postingIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range postingIDs {
+		if err = fr.UpdateListingSearch(ctx, plugin.SearchListingTypeJobPosting, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateListingSearch update the listing in the search plugin and only log the error,
+// the search index should not block the change of the listing
+func (fr *freelancerRepo) updateListingSearch(ctx context.Context, listingType, objectID string) {
+	if err := fr.UpdateListingSearch(ctx, listingType, objectID); err != nil {
+		log.Errorf("update %s %s search listing failed: %v", listingType, objectID, err)
+	}
+}
+
+// ConvertProfilesToSearchListings convert the freelancer profiles to the search plugin listings
+func ConvertProfilesToSearchListings(ctx context.Context, data *data.Data, profiles []*entity.FreelancerProfile) (
+	listings []*plugin.SearchListingContent, err error) {
+	if len(profiles) == 0 {
+		return nil, nil
+	}
+	userIDs := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		userIDs = append(userIDs, profile.UserID)
+	}
+	tagIDs, err := getSkillTagIDs(ctx, data, entity.SkillRelObjectTypeFreelancer, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*entity.User, 0)
+	if err = data.DB.Context(ctx).In("id", userIDs).Find(&users); err != nil {
+		return nil, err
+	}
+	displayNames := make(map[string]string, len(users))
+	for _, user := range users {
+		displayNames[user.ID] = user.DisplayName
+	}
+
+	for _, profile := range profiles {
+		var skills []string
+		_ = json.Unmarshal([]byte(profile.Skills), &skills)
+		listings = append(listings, &plugin.SearchListingContent{
+			ObjectID:        profile.UserID,
+			Type:            plugin.SearchListingTypeFreelancer,
+			Title:           displayNames[profile.UserID],
+			Content:         strings.TrimSpace(profile.Bio + "\n" + strings.Join(skills, " ")),
+			Tags:            tagIDs[profile.UserID],
+			UserID:          profile.UserID,
+			Amount:          profile.HourlyRate,
+			Currency:        profile.Currency,
+			BaseAmount:      profile.BaseHourlyRate,
+			ExperienceLevel: profile.ExperienceLevel,
+			LocationType:    profile.LocationType,
+			Availability:    profile.Availability,
+			UTCOffset:       profile.UTCOffset,
+			HasUTCOffset:    profile.HasUTCOffset,
+			Verified:        profile.IsVerified,
+			Rating:          profile.ClientSatisfaction,
+			Listed:          profile.IsAvailable,
+			Created:         profile.CreatedAt.Unix(),
+			Active:          profile.UpdatedAt.Unix(),
+		})
+	}
+	return listings, nil
+}
+
+// ConvertJobPostingsToSearchListings convert the job postings to the search plugin listings
+func ConvertJobPostingsToSearchListings(ctx context.Context, data *data.Data, postings []*entity.JobPosting) (
+	listings []*plugin.SearchListingContent, err error) {
+	if len(postings) == 0 {
+		return nil, nil
+	}
+	postingIDs := make([]string, 0, len(postings))
+	userIDs := make([]string, 0, len(postings))
+	for _, posting := range postings {
+		postingIDs = append(postingIDs, posting.ID)
+		userIDs = append(userIDs, posting.UserID)
+	}
+	tagIDs, err := getSkillTagIDs(ctx, data, entity.SkillRelObjectTypeJobPosting, postingIDs)
+	if err != nil {
+		return nil, err
+	}
+	verifiedUserIDs := make([]string, 0)
+	err = data.DB.Context(ctx).Table(entity.FreelancerProfile{}.TableName()).In("user_id", userIDs).
+		And("is_verified = ?", true).Cols("user_id").Find(&verifiedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	verified := make(map[string]bool, len(verifiedUserIDs))
+	for _, userID := range verifiedUserIDs {
+		verified[userID] = true
+	}
+	ratings, err := getClientRatings(ctx, data, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, posting := range postings {
+		listings = append(listings, &plugin.SearchListingContent{
+			ObjectID:        posting.ID,
+			Type:            plugin.SearchListingTypeJobPosting,
+			Title:           posting.Title,
+			Content:         posting.Description,
+			Tags:            tagIDs[posting.ID],
+			UserID:          posting.UserID,
+			Amount:          posting.Budget,
+			Currency:        posting.Currency,
+			BaseAmount:      posting.BaseBudget,
+			ExperienceLevel: posting.ExperienceLevel,
+			LocationType:    posting.Location,
+			Verified:        verified[posting.UserID],
+			Rating:          ratings[posting.UserID],
+			Listed: posting.IsActive && posting.IsPublished() &&
+				(posting.Status != entity.JobPostingStatusOpen || posting.ExpiresAt.After(now)),
+			Status:    posting.Status,
+			ExpiresAt: posting.ExpiresAt.Unix(),
+			Created:   posting.CreatedAt.Unix(),
+			Active:    posting.UpdatedAt.Unix(),
+		})
+	}
+	return listings, nil
+}
+
+// getSkillTagIDs get the skill tag ids of the objects, the result is object id -> tag ids
+func getSkillTagIDs(ctx context.Context, data *data.Data, objectType string, objectIDs []string) (
+	map[string][]string, error) {
+	rels := make([]*entity.SkillRel, 0)
+	err := data.DB.Context(ctx).Where("object_type = ?", objectType).In("object_id", objectIDs).
+		OrderBy("sort ASC").Find(&rels)
+	if err != nil {
+		return nil, err
+	}
+	tagIDs := make(map[string][]string, len(objectIDs))
+	for _, rel := range rels {
+		tagIDs[rel.ObjectID] = append(tagIDs[rel.ObjectID], rel.TagID)
+	}
+	return tagIDs, nil
+}
+
+// getClientRatings get the average rating of the clients given by freelancers, the result is user id -> rating
+func getClientRatings(ctx context.Context, data *data.Data, userIDs []string) (map[string]float64, error) {
+	rows := make([]*struct {
+		RevieweeID string  `xorm:"reviewee_id"`
+		Rating     float64 `xorm:"rating"`
+	}, 0)
+	err := data.DB.Context(ctx).Table(entity.ContractReview{}.TableName()).
+		Select("reviewee_id, AVG(rating) AS rating").
+		In("reviewee_id", userIDs).
+		And("reviewer_role = ?", entity.ContractReviewerRoleFreelancer).
+		And("status = ?", entity.ContractReviewStatusAvailable).
+		GroupBy("reviewee_id").
+		Find(&rows)
+	if err != nil {
+		return nil, err
+	}
+	ratings := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		ratings[row.RevieweeID] = row.Rating
+	}
+	return ratings, nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// PortfolioItemRepo freelancer portfolio item repository
+type PortfolioItemRepo interface {
+	AddPortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem) (err error)
+	UpdatePortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem) (err error)
+	GetPortfolioItem(ctx context.Context, id string) (item *entity.FreelancerPortfolioItem, exist bool, err error)
+	GetPortfolioItemsByUserID(ctx context.Context, userID string) (items []*entity.FreelancerPortfolioItem, err error)
+	CountPortfolioItems(ctx context.Context, userID string) (count int64, err error)
+	RemovePortfolioItem(ctx context.Context, id string) (err error)
+}
+
+type portfolioItemRepo struct {
+	data *data.Data
+}
+
+// NewPortfolioItemRepo new freelancer portfolio item repository
+func NewPortfolioItemRepo(data *data.Data) PortfolioItemRepo {
+	return &portfolioItemRepo{
+		data: data,
+	}
+}
+
+// AddPortfolioItem add portfolio item
+func (pr *portfolioItemRepo) AddPortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem) (err error) {
+	_, err = pr.data.DB.Context(ctx).Insert(item)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdatePortfolioItem update portfolio item
+func (pr *portfolioItemRepo) UpdatePortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem) (err error) {
+	_, err = pr.data.DB.Context(ctx).ID(item.ID).AllCols().Update(item)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetPortfolioItem get portfolio item by id
+func (pr *portfolioItemRepo) GetPortfolioItem(ctx context.Context, id string) (
+	item *entity.FreelancerPortfolioItem, exist bool, err error) {
+	item = &entity.FreelancerPortfolioItem{}
+	exist, err = pr.data.DB.Context(ctx).ID(id).Get(item)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetPortfolioItemsByUserID get the portfolio items of the user in the display order
+func (pr *portfolioItemRepo) GetPortfolioItemsByUserID(ctx context.Context, userID string) (
+	items []*entity.FreelancerPortfolioItem, err error) {
+	items = make([]*entity.FreelancerPortfolioItem, 0)
+	err = pr.data.DB.Context(ctx).Where("user_id = ?", userID).Asc("sort", "id").Find(&items)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// CountPortfolioItems count the portfolio items of the user
+func (pr *portfolioItemRepo) CountPortfolioItems(ctx context.Context, userID string) (count int64, err error) {
+	count, err = pr.data.DB.Context(ctx).Where("user_id = ?", userID).Count(&entity.FreelancerPortfolioItem{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RemovePortfolioItem remove portfolio item
+func (pr *portfolioItemRepo) RemovePortfolioItem(ctx context.Context, id string) (err error) {
+	_, err = pr.data.DB.Context(ctx).ID(id).Delete(&entity.FreelancerPortfolioItem{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
@@ -0,0 +1,205 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+	"xorm.io/xorm"
+)
+
+// SavedJobSearchRepo saved job search repository
+type SavedJobSearchRepo interface {
+	AddSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch) (err error)
+	UpdateSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch, cols []string) (err error)
+	GetSavedJobSearch(ctx context.Context, id string) (search *entity.SavedJobSearch, exist bool, err error)
+	GetSavedJobSearchesByUserID(ctx context.Context, userID string) (searches []*entity.SavedJobSearch, err error)
+	CountSavedJobSearches(ctx context.Context, userID string) (count int64, err error)
+	RemoveSavedJobSearch(ctx context.Context, id string) (err error)
+	GetAlertingSavedJobSearches(ctx context.Context, afterID string, limit int) (searches []*entity.SavedJobSearch, err error)
+	AddSavedJobSearchMatches(ctx context.Context, matches []*entity.SavedJobSearchMatch) (err error)
+	RemoveSavedJobSearchMatches(ctx context.Context, searchID string) (err error)
+	GetDueDigestSavedJobSearches(ctx context.Context, lastDigestBefore time.Time) (searches []*entity.SavedJobSearch, err error)
+	GetSavedJobSearchMatches(ctx context.Context, searchID string) (matches []*entity.SavedJobSearchMatch, err error)
+	FinishSavedJobSearchDigest(ctx context.Context, searchID string, matchIDs []string, digestAt time.Time) (err error)
+}
+
+type savedJobSearchRepo struct {
+	data *data.Data
+}
+
+// NewSavedJobSearchRepo new saved job search repository
+func NewSavedJobSearchRepo(data *data.Data) SavedJobSearchRepo {
+	return &savedJobSearchRepo{
+		data: data,
+	}
+}
+
+// AddSavedJobSearch add saved job search
+func (sr *savedJobSearchRepo) AddSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch) (err error) {
+	_, err = sr.data.DB.Context(ctx).Insert(search)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdateSavedJobSearch update the columns of the saved job search
+func (sr *savedJobSearchRepo) UpdateSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch, cols []string) (err error) {
+	_, err = sr.data.DB.Context(ctx).ID(search.ID).Cols(cols...).Update(search)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetSavedJobSearch get saved job search by id
+func (sr *savedJobSearchRepo) GetSavedJobSearch(ctx context.Context, id string) (
+	search *entity.SavedJobSearch, exist bool, err error) {
+	search = &entity.SavedJobSearch{}
+	exist, err = sr.data.DB.Context(ctx).ID(id).Get(search)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetSavedJobSearchesByUserID get the saved job searches of the user, the newest first
+func (sr *savedJobSearchRepo) GetSavedJobSearchesByUserID(ctx context.Context, userID string) (
+	searches []*entity.SavedJobSearch, err error) {
+	searches = make([]*entity.SavedJobSearch, 0)
+	err = sr.data.DB.Context(ctx).Where("user_id = ?", userID).Desc("id").Find(&searches)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// CountSavedJobSearches count the saved job searches of the user
+func (sr *savedJobSearchRepo) CountSavedJobSearches(ctx context.Context, userID string) (count int64, err error) {
+	count, err = sr.data.DB.Context(ctx).Where("user_id = ?", userID).Count(&entity.SavedJobSearch{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RemoveSavedJobSearch remove the saved job search and its pending matches
+func (sr *savedJobSearchRepo) RemoveSavedJobSearch(ctx context.Context, id string) (err error) {
+	_, err = sr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Where("search_id = ?", id).Delete(&entity.SavedJobSearchMatch{}); err != nil {
+			return nil, err
+		}
+		_, err := session.ID(id).Delete(&entity.SavedJobSearch{})
+		return nil, err
+	})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetAlertingSavedJobSearches get the saved job searches whose alert is turned on, ordered by id after the afterID
+func (sr *savedJobSearchRepo) GetAlertingSavedJobSearches(ctx context.Context, afterID string, limit int) (
+	searches []*entity.SavedJobSearch, err error) {
+	searches = make([]*entity.SavedJobSearch, 0)
+	session := sr.data.DB.Context(ctx).Where("alert_frequency <> ?", entity.SavedJobSearchAlertNone)
+	if len(afterID) > 0 {
+		session = session.And("id > ?", afterID)
+	}
+	err = session.Asc("id").Limit(limit).Find(&searches)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// AddSavedJobSearchMatches add the matches waiting for the daily digest
+func (sr *savedJobSearchRepo) AddSavedJobSearchMatches(ctx context.Context, matches []*entity.SavedJobSearchMatch) (err error) {
+	if len(matches) == 0 {
+		return nil
+	}
+	_, err = sr.data.DB.Context(ctx).Insert(matches)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RemoveSavedJobSearchMatches remove all pending matches of the saved job search
+func (sr *savedJobSearchRepo) RemoveSavedJobSearchMatches(ctx context.Context, searchID string) (err error) {
+	_, err = sr.data.DB.Context(ctx).Where("search_id = ?", searchID).Delete(&entity.SavedJobSearchMatch{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetDueDigestSavedJobSearches get the daily digest saved job searches which have pending matches
+// and have not been digested since lastDigestBefore
+func (sr *savedJobSearchRepo) GetDueDigestSavedJobSearches(ctx context.Context, lastDigestBefore time.Time) (
+	searches []*entity.SavedJobSearch, err error) {
+	searches = make([]*entity.SavedJobSearch, 0)
+	err = sr.data.DB.Context(ctx).Where("alert_frequency = ?", entity.SavedJobSearchAlertDaily).
+		And(builder.Lte{"last_digest_at": lastDigestBefore}.Or(builder.IsNull{"last_digest_at"})).
+		In("id", builder.Select("search_id").From(entity.SavedJobSearchMatch{}.TableName())).
+		Asc("id").Find(&searches)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetSavedJobSearchMatches get the pending matches of the saved job search
+func (sr *savedJobSearchRepo) GetSavedJobSearchMatches(ctx context.Context, searchID string) (
+	matches []*entity.SavedJobSearchMatch, err error) {
+	matches = make([]*entity.SavedJobSearchMatch, 0)
+	err = sr.data.DB.Context(ctx).Where("search_id = ?", searchID).Asc("id").Find(&matches)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// FinishSavedJobSearchDigest remove the digested matches and record the digest time
+func (sr *savedJobSearchRepo) FinishSavedJobSearchDigest(ctx context.Context, searchID string, matchIDs []string,
+	digestAt time.Time) (err error) {
+	_, err = sr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if len(matchIDs) > 0 {
+			if _, err := session.In("id", matchIDs).Delete(&entity.SavedJobSearchMatch{}); err != nil {
+				return nil, err
+			}
+		}
+		_, err := session.ID(searchID).Cols("last_digest_at").Update(&entity.SavedJobSearch{LastDigestAt: digestAt})
+		return nil, err
+	})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
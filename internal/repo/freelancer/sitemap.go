@@ -0,0 +1,136 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+// SitemapJobPostings get the open job postings which are not expired for the sitemap.
+// The cache time is short, so the closed or expired postings drop out of the sitemap soon.
+func (fr *freelancerRepo) SitemapJobPostings(ctx context.Context, page, pageSize int) (
+	jobPostings []*schema.SiteMapJobPostingInfo, err error) {
+	page = page - 1
+	jobPostings = make([]*schema.SiteMapJobPostingInfo, 0)
+
+	cacheKey := fmt.Sprintf(constant.SiteMapJobPostingCacheKeyPrefix, page)
+	cacheData, exist, err := fr.data.Cache.GetString(ctx, cacheKey)
+	if err == nil && exist {
+		_ = json.Unmarshal([]byte(cacheData), &jobPostings)
+		return jobPostings, nil
+	}
+
+	rows := make([]*entity.JobPosting, 0)
+	session := fr.sitemapJobPostingSession(ctx).Select("id,created_at,updated_at")
+	err = session.Limit(pageSize, page*pageSize).Asc("created_at").Find(&rows)
+	if err != nil {
+		return jobPostings, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, posting := range rows {
+		item := &schema.SiteMapJobPostingInfo{ID: posting.ID, UpdateTime: posting.CreatedAt.Format(time.RFC3339)}
+		if !posting.UpdatedAt.IsZero() {
+			item.UpdateTime = posting.UpdatedAt.Format(time.RFC3339)
+		}
+		jobPostings = append(jobPostings, item)
+	}
+
+	cacheDataByte, _ := json.Marshal(jobPostings)
+	if err := fr.data.Cache.SetString(ctx, cacheKey, string(cacheDataByte), constant.SiteMapJobPostingCacheTime); err != nil {
+		log.Error(err)
+	}
+	return jobPostings, nil
+}
+
+// GetSitemapJobPostingCount get the count of the job postings in the sitemap
+func (fr *freelancerRepo) GetSitemapJobPostingCount(ctx context.Context) (count int64, err error) {
+	count, err = fr.sitemapJobPostingSession(ctx).Count(&entity.JobPosting{})
+	if err != nil {
+		return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return count, nil
+}
+
+func (fr *freelancerRepo) sitemapJobPostingSession(ctx context.Context) *xorm.Session {
+	return fr.data.DB.Context(ctx).Where("is_active = ?", true).
+		And("status = ?", entity.JobPostingStatusOpen).And("expires_at > ?", time.Now())
+}
+
+// SitemapFreelancers get the freelancer profiles of the available users for the sitemap
+func (fr *freelancerRepo) SitemapFreelancers(ctx context.Context, page, pageSize int) (
+	freelancers []*schema.SiteMapFreelancerInfo, err error) {
+	page = page - 1
+	freelancers = make([]*schema.SiteMapFreelancerInfo, 0)
+
+	cacheKey := fmt.Sprintf(constant.SiteMapFreelancerCacheKeyPrefix, page)
+	cacheData, exist, err := fr.data.Cache.GetString(ctx, cacheKey)
+	if err == nil && exist {
+		_ = json.Unmarshal([]byte(cacheData), &freelancers)
+		return freelancers, nil
+	}
+
+	rows := make([]*struct {
+		Username  string    `xorm:"username"`
+		UpdatedAt time.Time `xorm:"updated_at"`
+	}, 0)
+	session := fr.sitemapFreelancerSession(ctx).
+		Select("`user`.username, `freelancer_profile`.updated_at")
+	err = session.Limit(pageSize, page*pageSize).Asc("freelancer_profile.id").Find(&rows)
+	if err != nil {
+		return freelancers, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, row := range rows {
+		freelancers = append(freelancers, &schema.SiteMapFreelancerInfo{
+			Username:   row.Username,
+			UpdateTime: row.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	cacheDataByte, _ := json.Marshal(freelancers)
+	if err := fr.data.Cache.SetString(ctx, cacheKey, string(cacheDataByte), constant.SiteMapFreelancerCacheTime); err != nil {
+		log.Error(err)
+	}
+	return freelancers, nil
+}
+
+// GetSitemapFreelancerCount get the count of the freelancer profiles in the sitemap
+func (fr *freelancerRepo) GetSitemapFreelancerCount(ctx context.Context) (count int64, err error) {
+	count, err = fr.sitemapFreelancerSession(ctx).Count()
+	if err != nil {
+		return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return count, nil
+}
+
+func (fr *freelancerRepo) sitemapFreelancerSession(ctx context.Context) *xorm.Session {
+	return fr.data.DB.Context(ctx).Table(entity.FreelancerProfile{}.TableName()).
+		Join("INNER", "user", "`user`.id = `freelancer_profile`.user_id").
+		Where("`user`.status = ?", entity.UserStatusAvailable)
+}
@@ -0,0 +1,116 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"xorm.io/xorm/schemas"
+)
+
+// newTestSitemapRepo new freelancer repo with a sqlite database which has the job postings,
+// the freelancer profiles and the users tables
+func newTestSitemapRepo(t *testing.T) *freelancerRepo {
+	engine, err := data.NewDB(false, &data.Database{
+		Driver:     string(schemas.SQLITE),
+		Connection: filepath.Join(t.TempDir(), "answer-test-data.db"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, engine.Sync(new(entity.JobPosting), new(entity.FreelancerProfile), new(entity.User)))
+	cache, _, err := data.NewCache(&data.CacheConf{})
+	require.NoError(t, err)
+	dataSource, cleanup, err := data.NewData(engine, cache)
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+	return &freelancerRepo{data: dataSource}
+}
+
+func TestSitemapJobPostings(t *testing.T) {
+	ctx := context.Background()
+	fr := newTestSitemapRepo(t)
+	now := time.Now()
+	postings := []*entity.JobPosting{
+		{ID: "1", Status: entity.JobPostingStatusOpen, IsActive: true, ExpiresAt: now.Add(time.Hour)},
+		{ID: "2", Status: entity.JobPostingStatusClosed, IsActive: true, ExpiresAt: now.Add(time.Hour)},
+		{ID: "3", Status: entity.JobPostingStatusFilled, IsActive: true, ExpiresAt: now.Add(time.Hour)},
+		{ID: "4", Status: entity.JobPostingStatusOpen, IsActive: false, ExpiresAt: now.Add(time.Hour)},
+		{ID: "5", Status: entity.JobPostingStatusOpen, IsActive: true, ExpiresAt: now.Add(-time.Hour)},
+		{ID: "6", Status: entity.JobPostingStatusOpen, IsActive: true, ExpiresAt: now.AddDate(0, 0, 30)},
+	}
+	for _, posting := range postings {
+		posting.UserID, posting.Title = "1", "job "+posting.ID
+		_, err := fr.data.DB.Context(ctx).Cols("id", "user_id", "title", "status", "is_active", "expires_at").
+			Insert(posting)
+		require.NoError(t, err)
+	}
+
+	count, err := fr.GetSitemapJobPostingCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	list, err := fr.SitemapJobPostings(ctx, 1, 10)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(list))
+	for _, item := range list {
+		ids = append(ids, item.ID)
+		assert.NotEmpty(t, item.UpdateTime)
+	}
+	assert.ElementsMatch(t, []string{"1", "6"}, ids)
+
+	list, err = fr.SitemapJobPostings(ctx, 2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestSitemapFreelancers(t *testing.T) {
+	ctx := context.Background()
+	fr := newTestSitemapRepo(t)
+	users := []*entity.User{
+		{ID: "1", Username: "available", Status: entity.UserStatusAvailable},
+		{ID: "2", Username: "suspended", Status: entity.UserStatusSuspended},
+		{ID: "3", Username: "deleted", Status: entity.UserStatusDeleted},
+		{ID: "4", Username: "no-profile", Status: entity.UserStatusAvailable},
+	}
+	for _, user := range users {
+		_, err := fr.data.DB.Context(ctx).Insert(user)
+		require.NoError(t, err)
+	}
+	for _, userID := range []string{"1", "2", "3"} {
+		_, err := fr.data.DB.Context(ctx).Cols("user_id").Insert(&entity.FreelancerProfile{UserID: userID})
+		require.NoError(t, err)
+	}
+
+	count, err := fr.GetSitemapFreelancerCount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	list, err := fr.SitemapFreelancers(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "available", list[0].Username)
+	assert.NotEmpty(t, list[0].UpdateTime)
+}
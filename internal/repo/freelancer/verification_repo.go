@@ -0,0 +1,168 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/xorm"
+)
+
+// FreelancerVerificationRepo freelancer verification repository
+type FreelancerVerificationRepo interface {
+	AddVerification(ctx context.Context, verification *entity.FreelancerVerification) (err error)
+	GetVerification(ctx context.Context, id string) (verification *entity.FreelancerVerification, exist bool, err error)
+	GetLatestVerification(ctx context.Context, userID string) (verification *entity.FreelancerVerification, exist bool, err error)
+	GetVerificationPage(ctx context.Context, page, pageSize int, status string) (
+		verifications []*entity.FreelancerVerification, total int64, err error)
+	ReviewVerification(ctx context.Context, verification *entity.FreelancerVerification) (reviewed bool, err error)
+	LapseVerifications(ctx context.Context, verifiedBefore time.Time) (userIDs []string, err error)
+}
+
+type freelancerVerificationRepo struct {
+	data           *data.Data
+	freelancerRepo FreelancerRepo
+}
+
+// NewFreelancerVerificationRepo new freelancer verification repository
+func NewFreelancerVerificationRepo(data *data.Data, freelancerRepo FreelancerRepo) FreelancerVerificationRepo {
+	return &freelancerVerificationRepo{
+		data:           data,
+		freelancerRepo: freelancerRepo,
+	}
+}
+
+// AddVerification add verification request
+func (vr *freelancerVerificationRepo) AddVerification(ctx context.Context, verification *entity.FreelancerVerification) (err error) {
+	_, err = vr.data.DB.Context(ctx).Insert(verification)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetVerification get verification request by id
+func (vr *freelancerVerificationRepo) GetVerification(ctx context.Context, id string) (
+	verification *entity.FreelancerVerification, exist bool, err error) {
+	verification = &entity.FreelancerVerification{}
+	exist, err = vr.data.DB.Context(ctx).ID(id).Get(verification)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetLatestVerification get the latest verification request of the user
+func (vr *freelancerVerificationRepo) GetLatestVerification(ctx context.Context, userID string) (
+	verification *entity.FreelancerVerification, exist bool, err error) {
+	verification = &entity.FreelancerVerification{}
+	exist, err = vr.data.DB.Context(ctx).Where("user_id = ?", userID).Desc("id").Get(verification)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetVerificationPage get verification requests page, the pending requests are listed from the oldest
+func (vr *freelancerVerificationRepo) GetVerificationPage(ctx context.Context, page, pageSize int, status string) (
+	verifications []*entity.FreelancerVerification, total int64, err error) {
+	verifications = make([]*entity.FreelancerVerification, 0)
+	session := vr.data.DB.Context(ctx)
+	if len(status) > 0 {
+		session = session.Where("status = ?", status)
+	}
+	if status == entity.FreelancerVerificationStatusPending {
+		session = session.Asc("id")
+	} else {
+		session = session.Desc("id")
+	}
+	total, err = pager.Help(page, pageSize, &verifications, &entity.FreelancerVerification{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// ReviewVerification update the review result of the pending verification request,
+// the freelancer profile is verified in the same transaction if it is approved.
+// If the request has been reviewed by others, nothing is changed and reviewed is false.
+func (vr *freelancerVerificationRepo) ReviewVerification(ctx context.Context, verification *entity.FreelancerVerification) (
+	reviewed bool, err error) {
+	_, err = vr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		affected, err := session.ID(verification.ID).And("status = ?", entity.FreelancerVerificationStatusPending).
+			Cols("status", "reviewer_id", "reviewed_at", "reason_type", "reason_msg").Update(verification)
+		if err != nil || affected == 0 {
+			return nil, err
+		}
+		reviewed = true
+		if verification.Status != entity.FreelancerVerificationStatusApproved {
+			return nil, nil
+		}
+		_, err = session.Where("user_id = ?", verification.UserID).Cols("is_verified", "verification_date").
+			Update(&entity.FreelancerProfile{IsVerified: true, VerificationDate: verification.ReviewedAt})
+		return nil, err
+	})
+	if err != nil {
+		return false, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	if reviewed && verification.Status == entity.FreelancerVerificationStatusApproved {
+		vr.updateListingSearch(ctx, verification.UserID)
+	}
+	return reviewed, nil
+}
+
+// LapseVerifications revoke the verification of the freelancers who were verified before the time
+func (vr *freelancerVerificationRepo) LapseVerifications(ctx context.Context, verifiedBefore time.Time) (
+	userIDs []string, err error) {
+	userIDs = make([]string, 0)
+	err = vr.data.DB.Context(ctx).Table(entity.FreelancerProfile{}.TableName()).
+		Where("is_verified = ?", true).And("verification_date <= ?", verifiedBefore).
+		Cols("user_id").Find(&userIDs)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	if len(userIDs) == 0 {
+		return userIDs, nil
+	}
+	_, err = vr.data.DB.Context(ctx).In("user_id", userIDs).Cols("is_verified").
+		Update(&entity.FreelancerProfile{IsVerified: false})
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, userID := range userIDs {
+		vr.updateListingSearch(ctx, userID)
+	}
+	return userIDs, nil
+}
+
+// updateListingSearch the verification is indexed with the profile and the job postings of the user
+func (vr *freelancerVerificationRepo) updateListingSearch(ctx context.Context, userID string) {
+	if err := vr.freelancerRepo.UpdateUserListingSearch(ctx, userID); err != nil {
+		log.Errorf("update user %s search listings failed: %v", userID, err)
+	}
+}
@@ -58,6 +58,21 @@ func (lr *LimitRepo) CheckAndRecord(ctx context.Context, key string) (limit bool
 	return false, nil
 }
 
+// CountAndRecord records the request in the current time window and returns the count of the requests in it
+func (lr *LimitRepo) CountAndRecord(ctx context.Context, key string, window time.Duration) (count int64, err error) {
+	windowKey := fmt.Sprintf("%s%s:%d", constant.RateLimitCacheKeyPrefix, key, time.Now().Unix()/int64(window.Seconds()))
+	count, _, err = lr.data.Cache.GetInt64(ctx, windowKey)
+	if err != nil {
+		return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	count++
+	err = lr.data.Cache.SetInt64(ctx, windowKey, count, window)
+	if err != nil {
+		return 0, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return count, nil
+}
+
 // ClearRecord clear
 func (lr *LimitRepo) ClearRecord(ctx context.Context, key string) error {
 	return lr.data.Cache.Del(ctx, constant.RateLimitCacheKeyPrefix+key)
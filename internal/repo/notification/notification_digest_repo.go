@@ -0,0 +1,80 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/notification"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+)
+
+// notificationDigestRepo notification digest repository
+type notificationDigestRepo struct {
+	data *data.Data
+}
+
+// NewNotificationDigestRepo new repository
+func NewNotificationDigestRepo(data *data.Data) notification.NotificationDigestRepo {
+	return &notificationDigestRepo{
+		data: data,
+	}
+}
+
+// GetUserNotificationsSince get the latest inbox and achievement notifications of the user created after the time
+func (nr *notificationDigestRepo) GetUserNotificationsSince(ctx context.Context, userID string, since time.Time,
+	limit int) (notifications []*entity.Notification, err error) {
+	notifications = make([]*entity.Notification, 0)
+	err = nr.data.DB.Context(ctx).Where("user_id = ?", userID).
+		And("status = ?", schema.NotificationStatusNormal).
+		And("created_at > ?", since).
+		In("type", []int{schema.NotificationTypeInbox, schema.NotificationTypeAchievement}).
+		Desc("created_at").Limit(limit).Find(&notifications)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetTagsQuestionsSince get the latest available questions in the tags created after the time
+func (nr *notificationDigestRepo) GetTagsQuestionsSince(ctx context.Context, tagIDs []string, since time.Time,
+	limit int) (questions []*entity.Question, err error) {
+	questions = make([]*entity.Question, 0)
+	if len(tagIDs) == 0 {
+		return questions, nil
+	}
+	err = nr.data.DB.Context(ctx).
+		Where("status = ?", entity.QuestionStatusAvailable).
+		And("`show` = ?", entity.QuestionShow).
+		And("created_at > ?", since).
+		In("id", builder.Select("object_id").From(entity.TagRel{}.TableName()).
+			Where(builder.In("tag_id", tagIDs).And(builder.Eq{"status": entity.TagRelStatusAvailable}))).
+		Desc("created_at").Limit(limit).Find(&questions)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/apache/answer/internal/repo/collection"
 	"github.com/apache/answer/internal/repo/comment"
 	"github.com/apache/answer/internal/repo/config"
+	"github.com/apache/answer/internal/repo/contract"
+	"github.com/apache/answer/internal/repo/conversation"
 	"github.com/apache/answer/internal/repo/export"
 	"github.com/apache/answer/internal/repo/file_record"
 	"github.com/apache/answer/internal/repo/freelancer"
@@ -40,6 +42,7 @@ import (
 	"github.com/apache/answer/internal/repo/notification"
 	"github.com/apache/answer/internal/repo/plugin_config"
 	"github.com/apache/answer/internal/repo/question"
+	"github.com/apache/answer/internal/repo/queue"
 	"github.com/apache/answer/internal/repo/rank"
 	"github.com/apache/answer/internal/repo/reason"
 	"github.com/apache/answer/internal/repo/report"
@@ -54,6 +57,7 @@ import (
 	"github.com/apache/answer/internal/repo/user"
 	"github.com/apache/answer/internal/repo/user_external_login"
 	"github.com/apache/answer/internal/repo/user_notification_config"
+	"github.com/apache/answer/internal/repo/webhook"
 	"github.com/google/wire"
 )
 
@@ -74,6 +78,7 @@ var ProviderSetRepo = wire.NewSet(
 	user.NewUserAdminRepo,
 	rank.NewUserRankRepo,
 	question.NewQuestionRepo,
+	question.NewRelatedQuestionRepo,
 	answer.NewAnswerRepo,
 	activity_common.NewActivityRepo,
 	activity.NewVoteRepo,
@@ -90,15 +95,18 @@ var ProviderSetRepo = wire.NewSet(
 	auth.NewAuthRepo,
 	revision.NewRevisionRepo,
 	search_common.NewSearchRepo,
+	search_common.NewSearchSuggestRepo,
 	meta.NewMetaRepo,
 	export.NewEmailRepo,
 	reason.NewReasonRepo,
 	site_info.NewSiteInfo,
 	notification.NewNotificationRepo,
+	notification.NewNotificationDigestRepo,
 	role.NewRoleRepo,
 	role.NewUserRoleRelRepo,
 	role.NewRolePowerRelRepo,
 	role.NewPowerRepo,
+	role.NewRoleAuditLogRepo,
 	user_external_login.NewUserExternalLoginRepo,
 	plugin_config.NewPluginConfigRepo,
 	user_notification_config.NewUserNotificationConfigRepo,
@@ -111,4 +119,13 @@ var ProviderSetRepo = wire.NewSet(
 	badge_award.NewBadgeAwardRepo,
 	file_record.NewFileRecordRepo,
 	freelancer.NewFreelancerRepo,
+	freelancer.NewFreelancerVerificationRepo,
+	freelancer.NewSavedJobSearchRepo,
+	freelancer.NewPortfolioItemRepo,
+	freelancer.NewAnalyticsRepo,
+	queue.NewQueueRepo,
+	webhook.NewWebhookRepo,
+	contract.NewContractRepo,
+	contract.NewContractReviewRepo,
+	conversation.NewConversationRepo,
 )
@@ -0,0 +1,157 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package question
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/related_question"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+)
+
+// relatedQuestionRepo related question repository
+type relatedQuestionRepo struct {
+	data *data.Data
+}
+
+// NewRelatedQuestionRepo new repository
+func NewRelatedQuestionRepo(data *data.Data) related_question.RelatedQuestionRepo {
+	return &relatedQuestionRepo{
+		data: data,
+	}
+}
+
+// GetQuestionTagIDs get the tag ids of the question
+func (rr *relatedQuestionRepo) GetQuestionTagIDs(ctx context.Context, questionID string) (tagIDs []string, err error) {
+	tagIDs = make([]string, 0)
+	err = rr.data.DB.Context(ctx).Table(entity.TagRel{}.TableName()).
+		Where(builder.Eq{"object_id": questionID, "status": entity.TagRelStatusAvailable}).
+		Cols("tag_id").Find(&tagIDs)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return tagIDs, nil
+}
+
+// GetSharedTagCounts get the questions which have the tags, and how many tags they have
+func (rr *relatedQuestionRepo) GetSharedTagCounts(ctx context.Context, questionID string, tagIDs []string, limit int) (
+	sharedCount map[string]int, err error) {
+	sharedCount = make(map[string]int)
+	rows := make([]*struct {
+		ObjectID string `xorm:"object_id"`
+		Count    int    `xorm:"shared"`
+	}, 0)
+	err = rr.data.DB.Context(ctx).Table(entity.TagRel{}.TableName()).
+		Select("object_id, COUNT(*) AS shared").
+		Where(builder.In("tag_id", tagIDs)).
+		And(builder.Eq{"status": entity.TagRelStatusAvailable}).
+		And(builder.Neq{"object_id": questionID}).
+		GroupBy("object_id").
+		OrderBy("shared DESC").
+		Limit(limit).
+		Find(&rows)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	for _, row := range rows {
+		sharedCount[row.ObjectID] = row.Count
+	}
+	return sharedCount, nil
+}
+
+// GetLinkedQuestionIDs get the questions linked to or from each question, regardless of the direction
+func (rr *relatedQuestionRepo) GetLinkedQuestionIDs(ctx context.Context, questionIDs []string) (
+	linked map[string][]string, err error) {
+	linked = make(map[string][]string)
+	links := make([]*entity.QuestionLink, 0)
+	err = rr.data.DB.Context(ctx).
+		Where(builder.Or(builder.In("from_question_id", questionIDs), builder.In("to_question_id", questionIDs))).
+		And(builder.Eq{"status": entity.QuestionLinkStatusAvailable}).
+		Find(&links)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	ids := make(map[string]bool, len(questionIDs))
+	for _, id := range questionIDs {
+		ids[id] = true
+	}
+	for _, link := range links {
+		if link.FromQuestionID == link.ToQuestionID {
+			continue
+		}
+		if ids[link.FromQuestionID] {
+			linked[link.FromQuestionID] = appendUnique(linked[link.FromQuestionID], link.ToQuestionID)
+		}
+		if ids[link.ToQuestionID] {
+			linked[link.ToQuestionID] = appendUnique(linked[link.ToQuestionID], link.FromQuestionID)
+		}
+	}
+	return linked, nil
+}
+
+// GetQuestionIDsByTitleTerms get the popular questions whose title contains any of the terms
+func (rr *relatedQuestionRepo) GetQuestionIDsByTitleTerms(ctx context.Context, questionID string, terms []string,
+	limit int) (questionIDs []string, err error) {
+	questionIDs = make([]string, 0)
+	cond := builder.NewCond()
+	for _, term := range terms {
+		cond = cond.Or(builder.Like{"LOWER(`title`)", term})
+	}
+	err = rr.data.DB.Context(ctx).Table(entity.Question{}.TableName()).
+		Where(cond).
+		And(builder.Neq{"id": questionID}).
+		In("status", []int{entity.QuestionStatusAvailable, entity.QuestionStatusClosed}).
+		And(builder.Eq{"`show`": entity.QuestionShow}).
+		OrderBy("view_count DESC").
+		Limit(limit).
+		Cols("id").Find(&questionIDs)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return questionIDs, nil
+}
+
+// GetQuestionsByIDs get the available or closed questions shown in the list
+func (rr *relatedQuestionRepo) GetQuestionsByIDs(ctx context.Context, questionIDs []string) (
+	questions []*entity.Question, err error) {
+	questions = make([]*entity.Question, 0)
+	err = rr.data.DB.Context(ctx).
+		In("id", questionIDs).
+		In("status", []int{entity.QuestionStatusAvailable, entity.QuestionStatusClosed}).
+		And(builder.Eq{"`show`": entity.QuestionShow}).
+		Find(&questions)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return questions, nil
+}
+
+func appendUnique(ids []string, id string) []string {
+	for _, existing := range ids {
+		if existing == id {
+			return ids
+		}
+	}
+	return append(ids, id)
+}
@@ -0,0 +1,203 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/segmentfault/pacman/errors"
+)
+
+const (
+	queueCacheKeyPrefix = "answer:queue:"
+	// queueCacheDoneMark the handled message is marked as done until the head moves over it
+	queueCacheDoneMark = "done"
+	// queueCacheScanWindow the max number of the messages to be scanned in one fetch
+	queueCacheScanWindow = 1000
+	// queueCacheGapTimeout the sequence without the message is skipped after the timeout,
+	// the push of the message must have failed
+	queueCacheGapTimeout = time.Minute
+)
+
+// queueCacheRepo the queue messages are stored in the cache, each message is kept with a sequence number,
+// and the head is the sequence before which all the messages have been handled.
+// The messages may be handled more than once when several instances share the cache.
+type queueCacheRepo struct {
+	data *data.Data
+	lock sync.Mutex
+	// the time when the sequence without the message was first found
+	gaps map[string]time.Time
+}
+
+func (qr *queueCacheRepo) gapExpired(key string, now time.Time) bool {
+	foundAt, ok := qr.gaps[key]
+	if !ok {
+		qr.gaps[key] = now
+		return false
+	}
+	if now.Sub(foundAt) < queueCacheGapTimeout {
+		return false
+	}
+	delete(qr.gaps, key)
+	return true
+}
+
+func (qr *queueCacheRepo) seqKey(queueName string) string {
+	return queueCacheKeyPrefix + queueName + ":seq"
+}
+
+func (qr *queueCacheRepo) headKey(queueName string) string {
+	return queueCacheKeyPrefix + queueName + ":head"
+}
+
+func (qr *queueCacheRepo) msgKey(queueName string, seq int64) string {
+	return fmt.Sprintf("%s%s:msg:%d", queueCacheKeyPrefix, queueName, seq)
+}
+
+// Push add the message to the cache
+func (qr *queueCacheRepo) Push(ctx context.Context, queueName, payload string) (err error) {
+	seq, err := qr.data.Cache.Increase(ctx, qr.seqKey(queueName), 1)
+	if err != nil {
+		return errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	now := time.Now()
+	return qr.setMessage(ctx, &entity.QueueMessage{
+		ID:        strconv.FormatInt(seq, 10),
+		CreatedAt: now,
+		Queue:     queueName,
+		Payload:   payload,
+		NextRunAt: now,
+	})
+}
+
+// Fetch claim the due messages after the head
+func (qr *queueCacheRepo) Fetch(ctx context.Context, queueName string, limit int, lease time.Duration) (
+	msgList []*entity.QueueMessage, err error) {
+	qr.lock.Lock()
+	defer qr.lock.Unlock()
+
+	head, _, err := qr.data.Cache.GetInt64(ctx, qr.headKey(queueName))
+	if err != nil {
+		return nil, errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	seq, _, err := qr.data.Cache.GetInt64(ctx, qr.seqKey(queueName))
+	if err != nil {
+		return nil, errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+
+	now := time.Now()
+	newHead := head
+	for i := head + 1; i <= seq && i <= head+queueCacheScanWindow && len(msgList) < limit; i++ {
+		key := qr.msgKey(queueName, i)
+		value, exist, err := qr.data.Cache.GetString(ctx, key)
+		if err != nil {
+			return nil, errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+		}
+		if !exist {
+			if newHead == i-1 && qr.gapExpired(key, now) {
+				newHead = i
+			}
+			continue
+		}
+		if value == queueCacheDoneMark {
+			if newHead == i-1 {
+				_ = qr.data.Cache.Del(ctx, key)
+				newHead = i
+			}
+			continue
+		}
+		msg := &entity.QueueMessage{}
+		if err = json.Unmarshal([]byte(value), msg); err != nil {
+			return nil, errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+		}
+		if msg.NextRunAt.After(now) {
+			continue
+		}
+		msg.Attempts++
+		msg.NextRunAt = now.Add(lease)
+		if err = qr.setMessage(ctx, msg); err != nil {
+			return nil, err
+		}
+		msgList = append(msgList, msg)
+	}
+	if newHead != head {
+		if err = qr.data.Cache.SetInt64(ctx, qr.headKey(queueName), newHead, 0); err != nil {
+			return nil, errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+		}
+	}
+	return msgList, nil
+}
+
+// Ack mark the message as done
+func (qr *queueCacheRepo) Ack(ctx context.Context, msg *entity.QueueMessage) (err error) {
+	return qr.markDone(ctx, msg)
+}
+
+// Retry reschedule the message
+func (qr *queueCacheRepo) Retry(ctx context.Context, msg *entity.QueueMessage, nextRunAt time.Time, lastError string) (err error) {
+	qr.lock.Lock()
+	defer qr.lock.Unlock()
+	msg.NextRunAt = nextRunAt
+	msg.LastError = lastError
+	return qr.setMessage(ctx, msg)
+}
+
+// DeadLetter the dead letters are always kept in the database for the admin to inspect
+func (qr *queueCacheRepo) DeadLetter(ctx context.Context, msg *entity.QueueMessage, lastError string) (err error) {
+	_, err = qr.data.DB.Context(ctx).Insert(newQueueDeadLetter(msg, lastError))
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return qr.markDone(ctx, msg)
+}
+
+func (qr *queueCacheRepo) markDone(ctx context.Context, msg *entity.QueueMessage) (err error) {
+	qr.lock.Lock()
+	defer qr.lock.Unlock()
+	seq, err := strconv.ParseInt(msg.ID, 10, 64)
+	if err != nil {
+		return errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	err = qr.data.Cache.SetString(ctx, qr.msgKey(msg.Queue, seq), queueCacheDoneMark, 0)
+	if err != nil {
+		return errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	return nil
+}
+
+func (qr *queueCacheRepo) setMessage(ctx context.Context, msg *entity.QueueMessage) (err error) {
+	seq, err := strconv.ParseInt(msg.ID, 10, 64)
+	if err != nil {
+		return errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	value, _ := json.Marshal(msg)
+	if err = qr.data.Cache.SetString(ctx, qr.msgKey(msg.Queue, seq), string(value), 0); err != nil {
+		return errors.InternalServer(reason.UnknownError).WithError(err).WithStack()
+	}
+	return nil
+}
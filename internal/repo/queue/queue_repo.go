@@ -0,0 +1,133 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package queue
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/queue"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/xorm"
+)
+
+// queueRepo the queue messages are stored in the outbox table of the database
+type queueRepo struct {
+	data *data.Data
+}
+
+// NewQueueRepo new queue repository, the messages are stored in the database or the cache as configured
+func NewQueueRepo(data *data.Data, serviceConfig *service_config.ServiceConfig) queue.Store {
+	if serviceConfig.Queue.WithDefault().Backend == queue.BackendCache {
+		return &queueCacheRepo{data: data, gaps: make(map[string]time.Time)}
+	}
+	return &queueRepo{data: data}
+}
+
+// Push add the message to the outbox
+func (qr *queueRepo) Push(ctx context.Context, queueName, payload string) (err error) {
+	msg := &entity.QueueMessage{
+		Queue:     queueName,
+		Payload:   payload,
+		NextRunAt: time.Now(),
+	}
+	_, err = qr.data.DB.Context(ctx).Insert(msg)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// Fetch claim the due messages, a message is claimed by the worker which increases its attempts first
+func (qr *queueRepo) Fetch(ctx context.Context, queueName string, limit int, lease time.Duration) (
+	msgList []*entity.QueueMessage, err error) {
+	now := time.Now()
+	candidates := make([]*entity.QueueMessage, 0)
+	err = qr.data.DB.Context(ctx).Where("queue = ?", queueName).And("next_run_at <= ?", now).
+		OrderBy("next_run_at ASC, id ASC").Limit(limit).Find(&candidates)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	msgList = make([]*entity.QueueMessage, 0, len(candidates))
+	for _, msg := range candidates {
+		claimed := &entity.QueueMessage{Attempts: msg.Attempts + 1, NextRunAt: now.Add(lease)}
+		affected, err := qr.data.DB.Context(ctx).Where("id = ?", msg.ID).And("attempts = ?", msg.Attempts).
+			Cols("attempts", "next_run_at").Update(claimed)
+		if err != nil {
+			return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+		if affected == 0 {
+			continue
+		}
+		msg.Attempts = claimed.Attempts
+		msg.NextRunAt = claimed.NextRunAt
+		msgList = append(msgList, msg)
+	}
+	return msgList, nil
+}
+
+// Ack remove the message from the outbox
+func (qr *queueRepo) Ack(ctx context.Context, msg *entity.QueueMessage) (err error) {
+	_, err = qr.data.DB.Context(ctx).ID(msg.ID).Delete(&entity.QueueMessage{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// Retry reschedule the message, it is skipped if the message has been claimed again after the lease expired
+func (qr *queueRepo) Retry(ctx context.Context, msg *entity.QueueMessage, nextRunAt time.Time, lastError string) (err error) {
+	_, err = qr.data.DB.Context(ctx).Where("id = ?", msg.ID).And("attempts = ?", msg.Attempts).
+		Cols("next_run_at", "last_error").Update(&entity.QueueMessage{NextRunAt: nextRunAt, LastError: lastError})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// DeadLetter move the message from the outbox to the dead letters
+func (qr *queueRepo) DeadLetter(ctx context.Context, msg *entity.QueueMessage, lastError string) (err error) {
+	_, err = qr.data.DB.Transaction(func(session *xorm.Session) (result any, err error) {
+		session = session.Context(ctx)
+		if _, err = session.Insert(newQueueDeadLetter(msg, lastError)); err != nil {
+			return nil, err
+		}
+		_, err = session.ID(msg.ID).Delete(&entity.QueueMessage{})
+		return nil, err
+	})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+func newQueueDeadLetter(msg *entity.QueueMessage, lastError string) *entity.QueueDeadLetter {
+	return &entity.QueueDeadLetter{
+		Queue:     msg.Queue,
+		MessageID: msg.ID,
+		Payload:   msg.Payload,
+		Attempts:  msg.Attempts,
+		LastError: lastError,
+	}
+}
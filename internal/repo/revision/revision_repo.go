@@ -193,6 +193,8 @@ func (rr *revisionRepo) allowRecord(objectType int) (ok bool) {
 		return true
 	case constant.ObjectTypeStrMapping["tag"]:
 		return true
+	case constant.ObjectTypeStrMapping[constant.JobPostingObjectType]:
+		return true
 	default:
 		return false
 	}
@@ -0,0 +1,68 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/role"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// roleAuditLogRepo role audit log repository
+type roleAuditLogRepo struct {
+	data *data.Data
+}
+
+// NewRoleAuditLogRepo new repository
+func NewRoleAuditLogRepo(data *data.Data) role.RoleAuditLogRepo {
+	return &roleAuditLogRepo{
+		data: data,
+	}
+}
+
+// AddRoleAuditLog add role audit log
+func (rr *roleAuditLogRepo) AddRoleAuditLog(ctx context.Context, auditLog *entity.RoleAuditLog) (err error) {
+	_, err = rr.data.DB.Context(ctx).Insert(auditLog)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetRoleAuditLogPage get role audit logs by page, the latest first
+func (rr *roleAuditLogRepo) GetRoleAuditLogPage(ctx context.Context, page, pageSize int, roleID int) (
+	auditLogs []*entity.RoleAuditLog, total int64, err error) {
+	auditLogs = make([]*entity.RoleAuditLog, 0)
+	session := rr.data.DB.Context(ctx)
+	if roleID > 0 {
+		session = session.Where("role_id = ?", roleID)
+	}
+	session = session.Desc("id")
+	total, err = pager.Help(page, pageSize, &auditLogs, &entity.RoleAuditLog{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
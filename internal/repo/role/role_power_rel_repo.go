@@ -21,12 +21,18 @@ package role
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/data"
 	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/service/role"
 	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
 	"xorm.io/builder"
+	"xorm.io/xorm"
 )
 
 // rolePowerRelRepo rolePowerRel repository
@@ -41,13 +47,61 @@ func NewRolePowerRelRepo(data *data.Data) role.RolePowerRelRepo {
 	}
 }
 
-// GetRolePowerTypeList get role power type list
+// GetRolePowerTypeList get role power type list, only the powers of the custom roles are cached,
+// because the powers of the built-in roles are changed by the migrations which do not clear the cache
 func (rr *rolePowerRelRepo) GetRolePowerTypeList(ctx context.Context, roleID int) (powers []string, err error) {
+	cacheable := !role.IsBuiltInRole(roleID)
+	cacheKey := fmt.Sprintf("%s%d", constant.RolePowerCacheKeyPrefix, roleID)
+	if cacheable {
+		cacheData, exist, err := rr.data.Cache.GetString(ctx, cacheKey)
+		if err == nil && exist && json.Unmarshal([]byte(cacheData), &powers) == nil {
+			return powers, nil
+		}
+	}
+
 	powers = make([]string, 0)
 	err = rr.data.DB.Context(ctx).Table("role_power_rel").
 		Cols("power_type").Where(builder.Eq{"role_id": roleID}).Find(&powers)
 	if err != nil {
 		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		return
+	}
+
+	if !cacheable {
+		return powers, nil
+	}
+	cacheVal, _ := json.Marshal(powers)
+	if err := rr.data.Cache.SetString(ctx, cacheKey, string(cacheVal), constant.RolePowerCacheTime); err != nil {
+		log.Error(err)
+	}
+	return powers, nil
+}
+
+// SaveRolePowerTypes replace the powers of the role, and remove the cached powers of the role
+func (rr *rolePowerRelRepo) SaveRolePowerTypes(ctx context.Context, roleID int, powerTypes []string) (err error) {
+	_, err = rr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if _, err := session.Where(builder.Eq{"role_id": roleID}).Delete(&entity.RolePowerRel{}); err != nil {
+			return nil, err
+		}
+		rels := make([]*entity.RolePowerRel, 0, len(powerTypes))
+		for _, powerType := range powerTypes {
+			rels = append(rels, &entity.RolePowerRel{RoleID: roleID, PowerType: powerType})
+		}
+		if len(rels) > 0 {
+			if _, err := session.Insert(rels); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+
+	cacheKey := fmt.Sprintf("%s%d", constant.RolePowerCacheKeyPrefix, roleID)
+	if err := rr.data.Cache.Del(ctx, cacheKey); err != nil {
+		log.Error(err)
 	}
-	return
+	return nil
 }
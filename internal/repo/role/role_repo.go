@@ -21,12 +21,17 @@ package role
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/data"
 	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
 	service "github.com/apache/answer/internal/service/role"
 	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+	"xorm.io/builder"
+	"xorm.io/xorm"
 )
 
 // roleRepo role repository
@@ -63,3 +68,72 @@ func (rr *roleRepo) GetRoleAllMapping(ctx context.Context) (roleMapping map[int]
 	}
 	return roleMapping, nil
 }
+
+// GetRole get role by id
+func (rr *roleRepo) GetRole(ctx context.Context, roleID int) (role *entity.Role, exist bool, err error) {
+	role = &entity.Role{}
+	exist, err = rr.data.DB.Context(ctx).ID(roleID).Get(role)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// AddRole add role
+func (rr *roleRepo) AddRole(ctx context.Context, role *entity.Role) (err error) {
+	_, err = rr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		// the built-in roles are inserted with their ids, which does not move the sequence of postgres,
+		// so the id is set explicitly.
+		last := &entity.Role{}
+		if _, err := session.Desc("id").Limit(1).Get(last); err != nil {
+			return nil, err
+		}
+		role.ID = last.ID + 1
+		return session.Insert(role)
+	})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdateRole update the name and description of the role
+func (rr *roleRepo) UpdateRole(ctx context.Context, role *entity.Role) (err error) {
+	_, err = rr.data.DB.Context(ctx).ID(role.ID).Cols("name", "description").Update(role)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RemoveRole remove the role which is not assigned to any user with its powers
+func (rr *roleRepo) RemoveRole(ctx context.Context, roleID int) (err error) {
+	_, err = rr.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if err := lockRole(session, roleID); err != nil {
+			return nil, err
+		}
+		count, err := session.Where(builder.Eq{"role_id": roleID}).Count(&entity.UserRoleRel{})
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, errors.BadRequest(reason.RoleInUse)
+		}
+		if _, err = session.ID(roleID).Delete(&entity.Role{}); err != nil {
+			return nil, err
+		}
+		_, err = session.Where(builder.Eq{"role_id": roleID}).Delete(&entity.RolePowerRel{})
+		return nil, err
+	})
+	if err != nil {
+		return wrapRoleError(err)
+	}
+
+	cacheKey := fmt.Sprintf("%s%d", constant.RolePowerCacheKeyPrefix, roleID)
+	if err := rr.data.Cache.Del(ctx, cacheKey); err != nil {
+		log.Error(err)
+	}
+	return nil
+}
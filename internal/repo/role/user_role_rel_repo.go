@@ -21,6 +21,7 @@ package role
 
 import (
 	"context"
+	goerrors "errors"
 
 	"github.com/apache/answer/internal/base/data"
 	"github.com/apache/answer/internal/base/reason"
@@ -47,26 +48,75 @@ func NewUserRoleRelRepo(data *data.Data) role.UserRoleRelRepo {
 func (ur *userRoleRelRepo) SaveUserRoleRel(ctx context.Context, userID string, roleID int) (err error) {
 	_, err = ur.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
 		session = session.Context(ctx)
-		item := &entity.UserRoleRel{UserID: userID}
-		exist, err := session.Get(item)
-		if err != nil {
+		if err := lockRole(session, roleID); err != nil {
 			return nil, err
 		}
-		if exist {
-			item.RoleID = roleID
-			_, err = session.ID(item.ID).Update(item)
-		} else {
-			_, err = session.Insert(&entity.UserRoleRel{UserID: userID, RoleID: roleID})
+		return nil, saveUserRoleRel(session, userID, roleID)
+	})
+	return wrapRoleError(err)
+}
+
+// SaveUsersRoleRel assign the role to the users in one transaction, all the users must exist
+func (ur *userRoleRelRepo) SaveUsersRoleRel(ctx context.Context, userIDs []string, roleID int) (err error) {
+	_, err = ur.data.DB.Transaction(func(session *xorm.Session) (interface{}, error) {
+		session = session.Context(ctx)
+		if err := lockRole(session, roleID); err != nil {
+			return nil, err
 		}
+		count, err := session.In("id", userIDs).And("status <> ?", entity.UserStatusDeleted).Count(&entity.User{})
 		if err != nil {
 			return nil, err
 		}
+		if int(count) != len(userIDs) {
+			return nil, errors.BadRequest(reason.UserNotFound)
+		}
+		for _, userID := range userIDs {
+			if err := saveUserRoleRel(session, userID, roleID); err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	})
+	return wrapRoleError(err)
+}
+
+// lockRole lock the role until the transaction ends, so that the role can not be removed while it is being assigned
+func lockRole(session *xorm.Session, roleID int) error {
+	exist, err := session.ID(roleID).ForUpdate().Get(&entity.Role{})
 	if err != nil {
-		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		return err
 	}
-	return
+	if !exist {
+		return errors.BadRequest(reason.RoleNotFound)
+	}
+	return nil
+}
+
+func saveUserRoleRel(session *xorm.Session, userID string, roleID int) (err error) {
+	item := &entity.UserRoleRel{UserID: userID}
+	exist, err := session.Get(item)
+	if err != nil {
+		return err
+	}
+	if exist {
+		item.RoleID = roleID
+		_, err = session.ID(item.ID).Update(item)
+	} else {
+		_, err = session.Insert(&entity.UserRoleRel{UserID: userID, RoleID: roleID})
+	}
+	return err
+}
+
+// wrapRoleError keep the reason errors returned in the transaction, the others are database errors
+func wrapRoleError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var e *errors.Error
+	if goerrors.As(err, &e) {
+		return err
+	}
+	return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 }
 
 // GetUserRoleRelList get user role all
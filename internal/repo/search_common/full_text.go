@@ -0,0 +1,209 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_common
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"xorm.io/builder"
+	"xorm.io/xorm/schemas"
+)
+
+const (
+	// mysqlMinTokenSize is the default innodb_ft_min_token_size, shorter words are not indexed
+	mysqlMinTokenSize = 3
+	// mysqlBooleanOperators are the characters with special meaning in boolean mode
+	mysqlBooleanOperators = `+-<>()~*"@`
+)
+
+// The PostgreSQL expressions must stay identical to the index expressions created by the migration.
+const (
+	postgresQuestionVector = "to_tsvector('simple', coalesce(`question`.`title`, '') || ' ' || coalesce(`question`.`original_text`, ''))"
+	postgresAnswerVector   = "to_tsvector('simple', coalesce(`answer`.`original_text`, ''))"
+	// postgresQuestionRankVector weights the title above the body when ranking
+	postgresQuestionRankVector = "setweight(to_tsvector('simple', coalesce(`question`.`title`, '')), 'A') || " +
+		"setweight(to_tsvector('simple', coalesce(`question`.`original_text`, '')), 'B')"
+)
+
+// fullText builds the native full-text conditions and relevance fields for the configured database
+type fullText struct {
+	dbType schemas.DBType
+}
+
+func newFullText(dbType schemas.DBType) *fullText {
+	return &fullText{dbType: dbType}
+}
+
+// supported returns whether the words can be searched by the full-text index,
+// otherwise the caller should fall back to LIKE.
+func (ft *fullText) supported(words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	for _, word := range words {
+		if containsCJK(word) {
+			return false
+		}
+	}
+	switch ft.dbType {
+	case schemas.MYSQL:
+		for _, word := range words {
+			tokens := strings.Fields(stripMySQLOperators(word))
+			if len(tokens) == 0 {
+				return false
+			}
+			for _, token := range tokens {
+				if utf8.RuneCountInString(token) < mysqlMinTokenSize {
+					return false
+				}
+			}
+		}
+		return true
+	case schemas.POSTGRES, schemas.SQLITE:
+		for _, word := range words {
+			if len(splitWordTokens(word)) == 0 {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// query returns the full-text query bound to the match condition and relevance field
+func (ft *fullText) query(words []string) string {
+	switch ft.dbType {
+	case schemas.MYSQL:
+		terms := make([]string, 0, len(words))
+		for _, word := range words {
+			tokens := strings.Fields(stripMySQLOperators(word))
+			if len(tokens) == 1 {
+				terms = append(terms, tokens[0]+"*")
+			} else {
+				terms = append(terms, `"`+strings.Join(tokens, " ")+`"`)
+			}
+		}
+		return strings.Join(terms, " ")
+	case schemas.POSTGRES:
+		terms := make([]string, 0, len(words))
+		for _, word := range words {
+			tokens := splitWordTokens(word)
+			for i, token := range tokens {
+				tokens[i] = token + ":*"
+			}
+			terms = append(terms, "("+strings.Join(tokens, " & ")+")")
+		}
+		return strings.Join(terms, " | ")
+	case schemas.SQLITE:
+		terms := make([]string, 0, len(words))
+		for _, word := range words {
+			terms = append(terms, `"`+strings.Join(splitWordTokens(word), " ")+`"*`)
+		}
+		return strings.Join(terms, " OR ")
+	}
+	return ""
+}
+
+// questionCond returns the condition matching the words against question title and content
+func (ft *fullText) questionCond(words []string) (cond builder.Cond, args []interface{}) {
+	q := ft.query(words)
+	switch ft.dbType {
+	case schemas.MYSQL:
+		cond = builder.Expr("MATCH(`question`.`title`, `question`.`original_text`) AGAINST (? IN BOOLEAN MODE)", q)
+	case schemas.POSTGRES:
+		cond = builder.Expr(postgresQuestionVector+" @@ to_tsquery('simple', ?)", q)
+	case schemas.SQLITE:
+		cond = builder.Expr("`question`.`id` IN (SELECT rowid FROM `question_fts` WHERE `question_fts` MATCH ?)", q)
+	}
+	return cond, []interface{}{q}
+}
+
+// answerCond returns the condition matching the words against answer content
+func (ft *fullText) answerCond(words []string) (cond builder.Cond, args []interface{}) {
+	q := ft.query(words)
+	switch ft.dbType {
+	case schemas.MYSQL:
+		cond = builder.Expr("MATCH(`answer`.`original_text`) AGAINST (? IN BOOLEAN MODE)", q)
+	case schemas.POSTGRES:
+		cond = builder.Expr(postgresAnswerVector+" @@ to_tsquery('simple', ?)", q)
+	case schemas.SQLITE:
+		cond = builder.Expr("`answer`.`id` IN (SELECT rowid FROM `answer_fts` WHERE `answer_fts` MATCH ?)", q)
+	}
+	return cond, []interface{}{q}
+}
+
+// questionRelevance returns the relevance field of question, higher is more relevant
+func (ft *fullText) questionRelevance(words []string) (field string, args []interface{}) {
+	q := ft.query(words)
+	switch ft.dbType {
+	case schemas.MYSQL:
+		field = "MATCH(`question`.`title`, `question`.`original_text`) AGAINST (? IN BOOLEAN MODE)"
+	case schemas.POSTGRES:
+		field = "ts_rank(" + postgresQuestionRankVector + ", to_tsquery('simple', ?))"
+	case schemas.SQLITE:
+		// bm25 returns lower values for better matches, the title column counts twice
+		field = "(SELECT -bm25(`question_fts`, 2.0, 1.0) FROM `question_fts` WHERE `question_fts` MATCH ? AND rowid = `question`.`id`)"
+	}
+	return "(" + field + ") as relevance", []interface{}{q}
+}
+
+// answerRelevance returns the relevance field of answer, higher is more relevant
+func (ft *fullText) answerRelevance(words []string) (field string, args []interface{}) {
+	q := ft.query(words)
+	switch ft.dbType {
+	case schemas.MYSQL:
+		field = "MATCH(`answer`.`original_text`) AGAINST (? IN BOOLEAN MODE)"
+	case schemas.POSTGRES:
+		field = "ts_rank(" + postgresAnswerVector + ", to_tsquery('simple', ?))"
+	case schemas.SQLITE:
+		field = "(SELECT -bm25(`answer_fts`) FROM `answer_fts` WHERE `answer_fts` MATCH ? AND rowid = `answer`.`id`)"
+	}
+	return "(" + field + ") as relevance", []interface{}{q}
+}
+
+func stripMySQLOperators(word string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(mysqlBooleanOperators, r) {
+			return ' '
+		}
+		return r
+	}, word)
+}
+
+// containsCJK returns whether the word has Chinese, Japanese or Korean characters. They are written without spaces,
+// so none of the full-text parsers splits the text into the words which are searched for.
+func containsCJK(word string) bool {
+	for _, r := range word {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWordTokens keeps only letters and digits, so the full-text query can never be malformed
+func splitWordTokens(word string) []string {
+	return strings.FieldsFunc(word, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"xorm.io/builder"
+	"xorm.io/xorm/schemas"
+)
+
+func TestFullTextSupported(t *testing.T) {
+	assert.False(t, newFullText(schemas.MYSQL).supported(nil))
+	assert.True(t, newFullText(schemas.MYSQL).supported([]string{"golang", "channel"}))
+	// words shorter than the innodb token size are not indexed
+	assert.False(t, newFullText(schemas.MYSQL).supported([]string{"golang", "go"}))
+	assert.False(t, newFullText(schemas.MYSQL).supported([]string{"+-"}))
+	assert.True(t, newFullText(schemas.POSTGRES).supported([]string{"go"}))
+	assert.False(t, newFullText(schemas.POSTGRES).supported([]string{"&|"}))
+	assert.True(t, newFullText(schemas.SQLITE).supported([]string{"go"}))
+	assert.False(t, newFullText(schemas.MSSQL).supported([]string{"golang"}))
+}
+
+func TestFullTextSupportedCJK(t *testing.T) {
+	for _, dbType := range []schemas.DBType{schemas.MYSQL, schemas.POSTGRES, schemas.SQLITE} {
+		ft := newFullText(dbType)
+		// the text without spaces between words is searched by LIKE
+		assert.False(t, ft.supported([]string{"并发编程"}), dbType)
+		assert.False(t, ft.supported([]string{"golang", "教程"}), dbType)
+		assert.False(t, ft.supported([]string{"プログラミング"}), dbType)
+		assert.False(t, ft.supported([]string{"프로그래밍"}), dbType)
+		assert.False(t, ft.supported([]string{"golang并发"}), dbType)
+		assert.True(t, ft.supported([]string{"golang", "café"}), dbType)
+	}
+}
+
+func TestFullTextQuery(t *testing.T) {
+	words := []string{"golang", `"go-routine"`}
+	assert.Equal(t, `golang* "go routine"`, newFullText(schemas.MYSQL).query(words))
+	assert.Equal(t, "(golang:*) | (go:* & routine:*)", newFullText(schemas.POSTGRES).query(words))
+	assert.Equal(t, `"golang"* OR "go routine"*`, newFullText(schemas.SQLITE).query(words))
+}
+
+func TestFullTextCond(t *testing.T) {
+	cond, args := newFullText(schemas.MYSQL).questionCond([]string{"golang"})
+	sql, _, err := builder.ToSQL(cond)
+	assert.NoError(t, err)
+	assert.Equal(t, "MATCH(`question`.`title`, `question`.`original_text`) AGAINST (? IN BOOLEAN MODE)", sql)
+	assert.Equal(t, []interface{}{"golang*"}, args)
+
+	field, args := newFullText(schemas.SQLITE).answerRelevance([]string{"golang"})
+	assert.Contains(t, field, "bm25(`answer_fts`)")
+	assert.Equal(t, []interface{}{`"golang"*`}, args)
+}
@@ -22,7 +22,6 @@ package search_common
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
@@ -81,6 +80,7 @@ type searchRepo struct {
 	userCommon   *usercommon.UserCommon
 	uniqueIDRepo unique.UniqueIDRepo
 	tagCommon    *tagcommon.TagCommonService
+	fullText     *fullText
 }
 
 // NewSearchRepo new repository
@@ -95,16 +95,15 @@ func NewSearchRepo(
 		uniqueIDRepo: uniqueIDRepo,
 		userCommon:   userCommon,
 		tagCommon:    tagCommon,
+		fullText:     newFullText(data.DB.Dialect().URI().DBType),
 	}
 }
 
 // SearchContents search question and answer data
-func (sr *searchRepo) SearchContents(ctx context.Context, words []string, tagIDs [][]string, userID string, votes int, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
-	words = filterWords(words)
+func (sr *searchRepo) SearchContents(ctx context.Context, cond *schema.SearchCondition, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
+	words := filterWords(cond.Words)
 
 	var (
-		b     *builder.Builder
-		ub    *builder.Builder
 		qfs   = qFields
 		afs   = aFields
 		argsQ = []interface{}{}
@@ -113,93 +112,29 @@ func (sr *searchRepo) SearchContents(ctx context.Context, words []string, tagIDs
 
 	if order == "relevance" {
 		if len(words) > 0 {
-			qfs, argsQ = addRelevanceField([]string{"title", "original_text"}, words, qfs)
-			afs, argsA = addRelevanceField([]string{"`answer`.`original_text`"}, words, afs)
+			qfs, argsQ = sr.questionRelevanceFields(words, qfs)
+			afs, argsA = sr.answerRelevanceFields(words, afs)
 		} else {
 			order = "newest"
 		}
 	}
 
-	b = builder.MySQL().Select(qfs...).From("`question`")
-	ub = builder.MySQL().Select(afs...).From("`answer`").
-		LeftJoin("`question`", "`question`.id = `answer`.question_id")
-
-	b.Where(builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}).
-		And(builder.Eq{"`question`.`show`": entity.QuestionShow})
-	ub.Where(builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}).
-		And(builder.Lt{"`answer`.`status`": entity.AnswerStatusDeleted}).
-		And(builder.Eq{"`question`.`show`": entity.QuestionShow})
-
-	argsQ = append(argsQ, entity.QuestionStatusDeleted, entity.QuestionShow)
-	argsA = append(argsA, entity.QuestionStatusDeleted, entity.AnswerStatusDeleted, entity.QuestionShow)
-
-	likeConQ := builder.NewCond()
-	likeConA := builder.NewCond()
-	for _, word := range words {
-		likeConQ = likeConQ.Or(builder.Like{"title", word}).
-			Or(builder.Like{"original_text", word})
-		argsQ = append(argsQ, "%"+word+"%")
-		argsQ = append(argsQ, "%"+word+"%")
-
-		likeConA = likeConA.Or(builder.Like{"`answer`.original_text", word})
-		argsA = append(argsA, "%"+word+"%")
-	}
-
-	b.Where(likeConQ)
-	ub.Where(likeConA)
-
-	// check tag
-	for ti, tagID := range tagIDs {
-		ast := "tag_rel" + strconv.Itoa(ti)
-		b.Join("INNER", "tag_rel as "+ast, "question.id = "+ast+".object_id").
-			And(builder.Eq{
-				ast + ".status": entity.TagRelStatusAvailable,
-			}).
-			And(builder.In(ast+".tag_id", tagID))
-		ub.Join("INNER", "tag_rel as "+ast, "question_id = "+ast+".object_id").
-			And(builder.Eq{
-				ast + ".status": entity.TagRelStatusAvailable,
-			}).
-			And(builder.In(ast+".tag_id", tagID))
-		argsQ = append(argsQ, entity.TagRelStatusAvailable)
-		argsA = append(argsA, entity.TagRelStatusAvailable)
-		for _, t := range tagID {
-			argsQ = append(argsQ, t)
-			argsA = append(argsA, t)
-		}
-	}
-
-	// check user
-	if userID != "" {
-		b.Where(builder.Eq{"question.user_id": userID})
-		ub.Where(builder.Eq{"answer.user_id": userID})
-		argsQ = append(argsQ, userID)
-		argsA = append(argsA, userID)
-	}
-
-	// check vote
-	if votes == 0 {
-		b.Where(builder.Eq{"question.vote_count": votes})
-		ub.Where(builder.Eq{"answer.vote_count": votes})
-		argsQ = append(argsQ, votes)
-		argsA = append(argsA, votes)
-	} else if votes > 0 {
-		b.Where(builder.Gte{"question.vote_count": votes})
-		ub.Where(builder.Gte{"answer.vote_count": votes})
-		argsQ = append(argsQ, votes)
-		argsA = append(argsA, votes)
-	}
+	b := builder.MySQL().Select(qfs...).From("`question`").Where(sr.questionCond(cond))
+	ub := builder.MySQL().Select(afs...).From("`answer`").
+		LeftJoin("`question`", "`question`.id = `answer`.question_id").Where(sr.answerCond(cond))
 
 	//b = b.Union("all", ub)
-	ubSQL, _, err := ub.ToSQL()
+	ubSQL, ubArgs, err := ub.ToSQL()
 	if err != nil {
 		return
 	}
-	bSQL, _, err := b.ToSQL()
+	bSQL, bArgs, err := b.ToSQL()
 	if err != nil {
 		return
 	}
 	sql := fmt.Sprintf("(%s UNION ALL %s)", bSQL, ubSQL)
+	// the arguments of the relevance fields are in front of the arguments of the conditions
+	args := append(append(argsQ, bArgs...), append(argsA, ubArgs...)...)
 
 	countSQL, _, err := builder.MySQL().Select("count(*) total").From(sql, "c").ToSQL()
 	if err != nil {
@@ -212,16 +147,8 @@ func (sr *searchRepo) SearchContents(ctx context.Context, words []string, tagIDs
 		return
 	}
 
-	queryArgs := []interface{}{}
-	countArgs := []interface{}{}
-
-	queryArgs = append(queryArgs, querySQL)
-	queryArgs = append(queryArgs, argsQ...)
-	queryArgs = append(queryArgs, argsA...)
-
-	countArgs = append(countArgs, countSQL)
-	countArgs = append(countArgs, argsQ...)
-	countArgs = append(countArgs, argsA...)
+	queryArgs := append([]interface{}{querySQL}, args...)
+	countArgs := append([]interface{}{countSQL}, args...)
 
 	res, err := sr.data.DB.Context(ctx).Query(queryArgs...)
 	if err != nil {
@@ -242,102 +169,104 @@ func (sr *searchRepo) SearchContents(ctx context.Context, words []string, tagIDs
 }
 
 // SearchQuestions search question data
-func (sr *searchRepo) SearchQuestions(ctx context.Context, words []string, tagIDs [][]string, notAccepted bool, views, answers int, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
-	words = filterWords(words)
+func (sr *searchRepo) SearchQuestions(ctx context.Context, cond *schema.SearchCondition, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
+	words := filterWords(cond.Words)
 	var (
 		qfs  = qFields
 		args = []interface{}{}
 	)
 	if order == "relevance" {
 		if len(words) > 0 {
-			qfs, args = addRelevanceField([]string{"title", "original_text"}, words, qfs)
+			qfs, args = sr.questionRelevanceFields(words, qfs)
 		} else {
 			order = "newest"
 		}
 	}
 
-	b := builder.MySQL().Select(qfs...).From("question")
+	b := builder.MySQL().Select(qfs...).From("question").Where(sr.questionCond(cond))
 
-	b.Where(builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}).And(builder.Eq{"`question`.`show`": entity.QuestionShow})
-	args = append(args, entity.QuestionStatusDeleted, entity.QuestionShow)
+	// check need filter has not accepted or has accepted
+	if cond.NotAccepted {
+		b.And(builder.Eq{"accepted_answer_id": 0})
+	} else if cond.HasAccepted {
+		b.And(builder.Gt{"accepted_answer_id": 0})
+	}
 
-	likeConQ := builder.NewCond()
-	for _, word := range words {
-		likeConQ = likeConQ.Or(builder.Like{"title", word}).
-			Or(builder.Like{"original_text", word})
-		args = append(args, "%"+word+"%")
-		args = append(args, "%"+word+"%")
+	// check closed
+	if cond.Closed {
+		b.And(builder.Eq{"`question`.`status`": entity.QuestionStatusClosed})
+	} else if cond.NotClosed {
+		b.And(builder.Neq{"`question`.`status`": entity.QuestionStatusClosed})
 	}
-	b.Where(likeConQ)
 
-	// check tag
-	for ti, tagID := range tagIDs {
-		ast := "tag_rel" + strconv.Itoa(ti)
-		b.Join("INNER", "tag_rel as "+ast, "question.id = "+ast+".object_id").
-			And(builder.Eq{
-				ast + ".status": entity.TagRelStatusAvailable,
-			}).
-			And(builder.In(ast+".tag_id", tagID))
-		args = append(args, entity.TagRelStatusAvailable)
-		for _, t := range tagID {
-			args = append(args, t)
-		}
+	// check views
+	if cond.Views > -1 {
+		b.And(builder.Gte{"view_count": cond.Views})
 	}
 
-	// check need filter has not accepted
-	if notAccepted {
-		b.And(builder.Eq{"accepted_answer_id": 0})
-		args = append(args, 0)
+	// check answers
+	if cond.AnswerAmount == 0 {
+		b.And(builder.Eq{"answer_count": 0})
+	} else if cond.AnswerAmount > 0 {
+		b.And(builder.Gte{"answer_count": cond.AnswerAmount})
 	}
 
-	// check views
-	if views > -1 {
-		b.And(builder.Gte{"view_count": views})
-		args = append(args, views)
+	return sr.searchObjects(ctx, b, args, words, page, pageSize, order)
+}
+
+// SearchAnswers search answer data
+func (sr *searchRepo) SearchAnswers(ctx context.Context, cond *schema.SearchCondition, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
+	words := filterWords(cond.Words)
+
+	var (
+		afs  = aFields
+		args = []interface{}{}
+	)
+	if order == "relevance" {
+		if len(words) > 0 {
+			afs, args = sr.answerRelevanceFields(words, afs)
+		} else {
+			order = "newest"
+		}
 	}
 
-	// check answers
-	if answers == 0 {
-		b.And(builder.Eq{"answer_count": answers})
-		args = append(args, answers)
-	} else if answers > 0 {
-		b.And(builder.Gte{"answer_count": answers})
-		args = append(args, answers)
+	b := builder.MySQL().Select(afs...).From("`answer`").
+		LeftJoin("`question`", "`question`.id = `answer`.question_id").Where(sr.answerCond(cond))
+
+	// check limit accepted
+	if cond.Accepted {
+		b.And(builder.Eq{"adopted": schema.AnswerAcceptedEnable})
 	}
 
-	if answers == 0 {
-		b.And(builder.Eq{"answer_count": 0})
-		args = append(args, 0)
-	} else if answers > 0 {
-		b.And(builder.Gte{"answer_count": answers})
-		args = append(args, answers)
+	// check question id
+	if cond.QuestionID != "" {
+		b.And(builder.Eq{"question_id": cond.QuestionID})
 	}
 
-	queryArgs := []interface{}{}
-	countArgs := []interface{}{}
+	return sr.searchObjects(ctx, b, args, words, page, pageSize, order)
+}
 
-	countSQL, _, err := builder.MySQL().Select("count(*) total").From(b, "c").ToSQL()
+// searchObjects queries a page of the questions or answers and the total,
+// fieldArgs are the arguments of the relevance field selected by the builder
+func (sr *searchRepo) searchObjects(ctx context.Context, b *builder.Builder, fieldArgs []interface{}, words []string,
+	page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
+	countSQL, countArgs, err := builder.MySQL().Select("count(*) total").From(b, "c").ToSQL()
 	if err != nil {
 		return
 	}
 
 	startNum := (page - 1) * pageSize
-	querySQL, _, err := b.OrderBy(sr.parseOrder(ctx, order)).Limit(pageSize, startNum).ToSQL()
+	querySQL, queryArgs, err := b.OrderBy(sr.parseOrder(ctx, order)).Limit(pageSize, startNum).ToSQL()
 	if err != nil {
 		return
 	}
-	queryArgs = append(queryArgs, querySQL)
-	queryArgs = append(queryArgs, args...)
-
-	countArgs = append(countArgs, countSQL)
-	countArgs = append(countArgs, args...)
 
-	res, err := sr.data.DB.Context(ctx).Query(queryArgs...)
+	res, err := sr.data.DB.Context(ctx).Query(append(append([]interface{}{querySQL}, fieldArgs...), queryArgs...)...)
 	if err != nil {
 		return
 	}
 
-	tr, err := sr.data.DB.Context(ctx).Query(countArgs...)
+	tr, err := sr.data.DB.Context(ctx).Query(append(append([]interface{}{countSQL}, fieldArgs...), countArgs...)...)
 	if err != nil {
 		return
 	}
@@ -352,99 +281,95 @@ func (sr *searchRepo) SearchQuestions(ctx context.Context, words []string, tagID
 	return
 }
 
-// SearchAnswers search answer data
-func (sr *searchRepo) SearchAnswers(ctx context.Context, words []string, tagIDs [][]string, accepted bool, questionID string, page, pageSize int, order string) (resp []*schema.SearchResult, total int64, err error) {
-	words = filterWords(words)
+// questionCond returns the conditions of the question shared by searching all contents and questions
+func (sr *searchRepo) questionCond(cond *schema.SearchCondition) builder.Cond {
+	c := builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}.
+		And(builder.Eq{"`question`.`show`": entity.QuestionShow}).
+		And(sr.keywordsCond(cond, sr.fullText.questionCond, questionKeywordColumns))
 
-	var (
-		afs  = aFields
-		args = []interface{}{}
-	)
-	if order == "relevance" {
-		if len(words) > 0 {
-			afs, args = addRelevanceField([]string{"`answer`.`original_text`"}, words, afs)
-		} else {
-			order = "newest"
-		}
+	// check tag
+	for _, tagIDs := range cond.Tags {
+		c = c.And(builder.In("`question`.`id`", tagRelObjectIDs(tagIDs)))
 	}
-
-	b := builder.MySQL().Select(afs...).From("`answer`").
-		LeftJoin("`question`", "`question`.id = `answer`.question_id")
-
-	b.Where(builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}).
-		And(builder.Lt{"`answer`.`status`": entity.AnswerStatusDeleted}).And(builder.Eq{"`question`.`show`": entity.QuestionShow})
-	args = append(args, entity.QuestionStatusDeleted, entity.AnswerStatusDeleted, entity.QuestionShow)
-
-	likeConA := builder.NewCond()
-	for _, word := range words {
-		likeConA = likeConA.Or(builder.Like{"`answer`.original_text", word})
-		args = append(args, "%"+word+"%")
+	if len(cond.ExcludedTags) > 0 {
+		c = c.And(builder.NotIn("`question`.`id`", tagRelObjectIDs(cond.ExcludedTags)))
 	}
 
-	b.Where(likeConA)
-
-	// check tag
-	for ti, tagID := range tagIDs {
-		ast := "tag_rel" + strconv.Itoa(ti)
-		b.Join("INNER", "tag_rel as "+ast, "question_id = "+ast+".object_id").
-			And(builder.Eq{
-				ast + ".status": entity.TagRelStatusAvailable,
-			}).
-			And(builder.In(ast+".tag_id", tagID))
-		args = append(args, entity.TagRelStatusAvailable)
-		for _, t := range tagID {
-			args = append(args, t)
-		}
+	// check user
+	if cond.UserID != "" {
+		c = c.And(builder.Eq{"`question`.`user_id`": cond.UserID})
 	}
 
-	// check limit accepted
-	if accepted {
-		b.Where(builder.Eq{"adopted": schema.AnswerAcceptedEnable})
-		args = append(args, schema.AnswerAcceptedEnable)
+	// check vote
+	if cond.VoteAmount == 0 {
+		c = c.And(builder.Eq{"`question`.`vote_count`": 0})
+	} else if cond.VoteAmount > 0 {
+		c = c.And(builder.Gte{"`question`.`vote_count`": cond.VoteAmount})
 	}
 
-	// check question id
-	if questionID != "" {
-		b.Where(builder.Eq{"question_id": questionID})
-		args = append(args, questionID)
+	c = c.And(timeRangeCond("`question`.`created_at`", cond.CreatedAfter, cond.CreatedBefore)).
+		And(timeRangeCond("`question`.`post_update_time`", cond.ActiveAfter, cond.ActiveBefore))
+
+	if cond.HasObjectIDs {
+		c = c.And(builder.In("`question`.`id`", cond.ObjectIDs))
 	}
+	return c
+}
 
-	queryArgs := []interface{}{}
-	countArgs := []interface{}{}
+// answerCond returns the conditions of the answer shared by searching all contents and answers
+func (sr *searchRepo) answerCond(cond *schema.SearchCondition) builder.Cond {
+	c := builder.Lt{"`question`.`status`": entity.QuestionStatusDeleted}.
+		And(builder.Lt{"`answer`.`status`": entity.AnswerStatusDeleted}).
+		And(builder.Eq{"`question`.`show`": entity.QuestionShow}).
+		And(sr.keywordsCond(cond, sr.fullText.answerCond, answerKeywordColumns))
 
-	countSQL, _, err := builder.MySQL().Select("count(*) total").From(b, "c").ToSQL()
-	if err != nil {
-		return
+	// check tag
+	for _, tagIDs := range cond.Tags {
+		c = c.And(builder.In("`answer`.`question_id`", tagRelObjectIDs(tagIDs)))
+	}
+	if len(cond.ExcludedTags) > 0 {
+		c = c.And(builder.NotIn("`answer`.`question_id`", tagRelObjectIDs(cond.ExcludedTags)))
 	}
 
-	startNum := (page - 1) * pageSize
-	querySQL, _, err := b.OrderBy(sr.parseOrder(ctx, order)).Limit(pageSize, startNum).ToSQL()
-	if err != nil {
-		return
+	// check user
+	if cond.UserID != "" {
+		c = c.And(builder.Eq{"`answer`.`user_id`": cond.UserID})
 	}
 
-	queryArgs = append(queryArgs, querySQL)
-	queryArgs = append(queryArgs, args...)
+	// check vote
+	if cond.VoteAmount == 0 {
+		c = c.And(builder.Eq{"`answer`.`vote_count`": 0})
+	} else if cond.VoteAmount > 0 {
+		c = c.And(builder.Gte{"`answer`.`vote_count`": cond.VoteAmount})
+	}
 
-	countArgs = append(countArgs, countSQL)
-	countArgs = append(countArgs, args...)
+	// the active time of answer is its created time, the same as the post_update_time field of answer
+	c = c.And(timeRangeCond("`answer`.`created_at`", cond.CreatedAfter, cond.CreatedBefore)).
+		And(timeRangeCond("`answer`.`created_at`", cond.ActiveAfter, cond.ActiveBefore))
 
-	res, err := sr.data.DB.Context(ctx).Query(queryArgs...)
-	if err != nil {
-		return
+	if cond.HasObjectIDs {
+		c = c.And(builder.In("`answer`.`id`", cond.ObjectIDs))
 	}
+	return c
+}
 
-	tr, err := sr.data.DB.Context(ctx).Query(countArgs...)
-	if err != nil {
-		return
-	}
+// tagRelObjectIDs selects the ids of the questions which have any of the tags
+func tagRelObjectIDs(tagIDs []string) *builder.Builder {
+	return builder.Select("`object_id`").From("`tag_rel`").
+		Where(builder.Eq{"`status`": entity.TagRelStatusAvailable}).
+		And(builder.In("`tag_id`", tagIDs))
+}
 
-	total = converter.StringToInt64(string(tr[0]["total"]))
-	resp, err = sr.parseResult(ctx, res, words)
-	if err != nil {
-		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+// timeRangeCond the time of the column must be in [after, before), the zero time means unlimited
+func timeRangeCond(column string, after, before time.Time) builder.Cond {
+	c := builder.NewCond()
+	if !after.IsZero() {
+		c = c.And(builder.Gte{column: after})
 	}
-	return
+	if !before.IsZero() {
+		c = c.And(builder.Lt{column: before})
+	}
+	return c
 }
 
 func (sr *searchRepo) parseOrder(ctx context.Context, order string) (res string) {
@@ -469,8 +394,13 @@ func (sr *searchRepo) ParseSearchPluginResult(ctx context.Context, sres []plugin
 		qres []map[string][]byte
 		res  = make([]map[string][]byte, 0)
 		b    *builder.Builder
+		// object id -> highlight snippet given by the plugin
+		highlights = make(map[string]string)
 	)
 	for _, r := range sres {
+		if len(r.Highlight) > 0 {
+			highlights[r.ID] = r.Highlight
+		}
 		switch r.Type {
 		case "question":
 			b = builder.MySQL().Select(qFields...).From("question").Where(builder.Eq{"id": r.ID}).
@@ -487,7 +417,16 @@ func (sr *searchRepo) ParseSearchPluginResult(ctx context.Context, sres []plugin
 		}
 		res = append(res, qres[0])
 	}
-	return sr.parseResult(ctx, res, words)
+	resp, err = sr.parseResult(ctx, res, words)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range resp {
+		if highlight, ok := highlights[uid.DeShortID(item.Object.ID)]; ok {
+			item.Object.Excerpt = highlight
+		}
+	}
+	return resp, nil
 }
 
 // parseResult parse search result, return the data structure
@@ -575,6 +514,79 @@ func (sr *searchRepo) parseResult(ctx context.Context, res []map[string][]byte,
 	return resultList, nil
 }
 
+// The columns matched by the keywords of each field, the empty field matches both the title and the content.
+var (
+	questionKeywordColumns = map[plugin.SearchKeywordField][]string{
+		"":                               {"`question`.`title`", "`question`.`original_text`"},
+		plugin.SearchKeywordFieldTitle:   {"`question`.`title`"},
+		plugin.SearchKeywordFieldContent: {"`question`.`original_text`"},
+	}
+	answerKeywordColumns = map[plugin.SearchKeywordField][]string{
+		"":                               {"`answer`.`original_text`"},
+		plugin.SearchKeywordFieldTitle:   {"`question`.`title`"},
+		plugin.SearchKeywordFieldContent: {"`answer`.`original_text`"},
+	}
+)
+
+// keywordsCond matches all keyword groups and none of the excluded keywords. The plain words of a group
+// are matched by the native full-text index if it can handle them, the phrases, the keywords of a field
+// and the others are matched by LIKE.
+func (sr *searchRepo) keywordsCond(cond *schema.SearchCondition,
+	fullTextCond func(words []string) (builder.Cond, []interface{}),
+	columns map[plugin.SearchKeywordField][]string) builder.Cond {
+	res := builder.NewCond()
+	for _, group := range cond.KeywordGroups {
+		groupCond := builder.NewCond()
+		words := make([]string, 0)
+		for _, keyword := range group {
+			if !keyword.Phrase && len(keyword.Field) == 0 {
+				words = append(words, keyword.Text)
+			}
+		}
+		fullTextSupported := sr.fullText.supported(words)
+		if fullTextSupported {
+			c, _ := fullTextCond(words)
+			groupCond = groupCond.Or(c)
+		}
+		for _, keyword := range group {
+			if fullTextSupported && !keyword.Phrase && len(keyword.Field) == 0 {
+				continue
+			}
+			groupCond = groupCond.Or(likeCond(columns[keyword.Field], keyword.Text))
+		}
+		res = res.And(groupCond)
+	}
+	for _, keyword := range cond.ExcludedKeywords {
+		res = res.And(builder.Not{likeCond(columns[keyword.Field], keyword.Text)})
+	}
+	return res
+}
+
+// likeCond matches the text by any of the columns
+func likeCond(columns []string, text string) builder.Cond {
+	c := builder.NewCond()
+	for _, column := range columns {
+		c = c.Or(builder.Like{column, text})
+	}
+	return c
+}
+
+func (sr *searchRepo) questionRelevanceFields(words, fields []string) (res []string, args []interface{}) {
+	if sr.fullText.supported(words) {
+		field, fieldArgs := sr.fullText.questionRelevance(words)
+		return append(fields, field), fieldArgs
+	}
+	return addRelevanceField([]string{"title", "original_text"}, words, fields)
+}
+
+func (sr *searchRepo) answerRelevanceFields(words, fields []string) (res []string, args []interface{}) {
+	if sr.fullText.supported(words) {
+		field, fieldArgs := sr.fullText.answerRelevance(words)
+		return append(fields, field), fieldArgs
+	}
+	return addRelevanceField([]string{"`answer`.`original_text`"}, words, fields)
+}
+
 func addRelevanceField(searchFields, words, fields []string) (res []string, args []interface{}) {
 	relevanceRes := []string{}
 	args = []interface{}{}
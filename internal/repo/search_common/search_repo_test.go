@@ -0,0 +1,57 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_common
+
+import (
+	"testing"
+
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/plugin"
+	"github.com/stretchr/testify/assert"
+	"xorm.io/builder"
+	"xorm.io/xorm/schemas"
+)
+
+func TestKeywordsCond(t *testing.T) {
+	cond := &schema.SearchCondition{
+		KeywordGroups: [][]plugin.SearchKeyword{
+			{{Text: "golang"}, {Text: "rust"}},
+			{{Text: "exact phrase", Phrase: true}, {Text: "channel", Field: plugin.SearchKeywordFieldTitle}},
+		},
+		ExcludedKeywords: []plugin.SearchKeyword{{Text: "python"}},
+	}
+
+	sr := &searchRepo{fullText: newFullText(schemas.MYSQL)}
+	sql, args, err := builder.ToSQL(sr.keywordsCond(cond, sr.fullText.questionCond, questionKeywordColumns))
+	assert.NoError(t, err)
+	assert.Equal(t, "((MATCH(`question`.`title`, `question`.`original_text`) AGAINST (? IN BOOLEAN MODE))) AND "+
+		"(`question`.`title` LIKE ? OR `question`.`original_text` LIKE ? OR `question`.`title` LIKE ?) AND "+
+		"NOT (`question`.`title` LIKE ? OR `question`.`original_text` LIKE ?)", sql)
+	assert.Equal(t, []interface{}{"golang* rust*", "%exact phrase%", "%exact phrase%", "%channel%", "%python%", "%python%"}, args)
+
+	// the words are matched by LIKE if the full-text index can not handle them
+	sr = &searchRepo{fullText: newFullText(schemas.MSSQL)}
+	sql, args, err = builder.ToSQL(sr.keywordsCond(&schema.SearchCondition{
+		KeywordGroups: [][]plugin.SearchKeyword{{{Text: "go"}}},
+	}, sr.fullText.answerCond, answerKeywordColumns))
+	assert.NoError(t, err)
+	assert.Equal(t, "(`answer`.`original_text` LIKE ?)", sql)
+	assert.Equal(t, []interface{}{"%go%"}, args)
+}
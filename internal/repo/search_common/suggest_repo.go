@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_common
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/search_common"
+	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
+)
+
+// searchSuggestRepo search suggest repository
+type searchSuggestRepo struct {
+	data *data.Data
+}
+
+// NewSearchSuggestRepo new repository
+func NewSearchSuggestRepo(data *data.Data) search_common.SearchSuggestRepo {
+	return &searchSuggestRepo{
+		data: data,
+	}
+}
+
+// SuggestQuestions get the questions whose title or a word of the title starts with the query
+func (sr *searchSuggestRepo) SuggestQuestions(ctx context.Context, query string, limit int) (
+	questions []*entity.Question, err error) {
+	questions = make([]*entity.Question, 0)
+	err = sr.data.DB.Context(ctx).
+		Where(prefixCond("LOWER(`title`)", query, true)).
+		In("status", []int{entity.QuestionStatusAvailable, entity.QuestionStatusClosed}).
+		And(builder.Eq{"`show`": entity.QuestionShow}).
+		OrderBy("view_count DESC, answer_count DESC").
+		Limit(limit).
+		Find(&questions)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return questions, nil
+}
+
+// SuggestTags get the main tags whose slug name or display name starts with the query
+func (sr *searchSuggestRepo) SuggestTags(ctx context.Context, query string, limit int) (tags []*entity.Tag, err error) {
+	tags = make([]*entity.Tag, 0)
+	err = sr.data.DB.Context(ctx).
+		Where(prefixCond("LOWER(`slug_name`)", query, false).Or(prefixCond("LOWER(`display_name`)", query, false))).
+		And(builder.Eq{"`status`": entity.TagStatusAvailable}).
+		And(builder.Eq{"`main_tag_id`": 0}).
+		OrderBy("question_count DESC").
+		Limit(limit).
+		Find(&tags)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return tags, nil
+}
+
+// SuggestUsers get the available users whose username or display name starts with the query
+func (sr *searchSuggestRepo) SuggestUsers(ctx context.Context, query string, limit int) (users []*entity.User, err error) {
+	users = make([]*entity.User, 0)
+	err = sr.data.DB.Context(ctx).
+		Where(prefixCond("LOWER(`username`)", query, false).Or(prefixCond("LOWER(`display_name`)", query, true))).
+		And(builder.Eq{"`status`": entity.UserStatusAvailable}).
+		OrderBy("`rank` DESC").
+		Limit(limit).
+		Find(&users)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return users, nil
+}
+
+// prefixCond matches the column which starts with the query, or has a word starts with the query if wordPrefix is true.
+// The wildcards of LIKE in the query are removed.
+func prefixCond(column, query string, wordPrefix bool) builder.Cond {
+	query = strings.NewReplacer("%", "", "_", "", "\\", "").Replace(strings.ToLower(query))
+	cond := builder.Like{column, query + "%"}
+	if !wordPrefix {
+		return cond
+	}
+	return builder.Or(cond, builder.Like{column, "% " + query + "%"})
+}
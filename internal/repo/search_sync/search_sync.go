@@ -24,6 +24,7 @@ import (
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/data"
 	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/pkg/uid"
 	"github.com/apache/answer/plugin"
@@ -60,6 +61,28 @@ func (p *PluginSyncer) GetQuestionsPage(ctx context.Context, page, pageSize int)
 	return p.convertQuestions(ctx, questions)
 }
 
+func (p *PluginSyncer) GetListingsPage(ctx context.Context, listingType string, page, pageSize int) (
+	listingList []*plugin.SearchListingContent, err error) {
+	startNum := (page - 1) * pageSize
+	switch listingType {
+	case plugin.SearchListingTypeFreelancer:
+		profiles := make([]*entity.FreelancerProfile, 0)
+		err = p.data.DB.Context(ctx).Limit(pageSize, startNum).Find(&profiles)
+		if err != nil {
+			return nil, err
+		}
+		return freelancer.ConvertProfilesToSearchListings(ctx, p.data, profiles)
+	case plugin.SearchListingTypeJobPosting:
+		postings := make([]*entity.JobPosting, 0)
+		err = p.data.DB.Context(ctx).Limit(pageSize, startNum).Find(&postings)
+		if err != nil {
+			return nil, err
+		}
+		return freelancer.ConvertJobPostingsToSearchListings(ctx, p.data, postings)
+	}
+	return nil, nil
+}
+
 func (p *PluginSyncer) convertAnswers(ctx context.Context, answers []*entity.Answer) (
 	answerList []*plugin.SearchContent, err error) {
 	for _, answer := range answers {
@@ -253,6 +253,28 @@ func (tr *tagRelRepo) MigrateTagObjects(ctx context.Context, sourceTagId, target
 			return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 		}
 
+		// 5. Move skill relations of freelancers and job postings to target tag
+		var sourceSkills []*entity.SkillRel
+		err = session.Where("tag_id = ?", sourceTagId).Find(&sourceSkills)
+		if err != nil {
+			return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		}
+		for _, skill := range sourceSkills {
+			exist, err := session.Exist(&entity.SkillRel{
+				ObjectType: skill.ObjectType, ObjectID: skill.ObjectID, TagID: targetTagId})
+			if err != nil {
+				return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+			}
+			if exist {
+				_, err = session.ID(skill.ID).Delete(&entity.SkillRel{})
+			} else {
+				_, err = session.ID(skill.ID).Cols("tag_id").Update(&entity.SkillRel{TagID: targetTagId})
+			}
+			if err != nil {
+				return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+			}
+		}
+
 		return nil, nil
 	})
 
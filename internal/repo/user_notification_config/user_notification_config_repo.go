@@ -21,12 +21,15 @@ package user_notification_config
 
 import (
 	"context"
+	"time"
+
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/data"
 	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/service/user_notification_config"
 	"github.com/segmentfault/pacman/errors"
+	"xorm.io/builder"
 )
 
 // userNotificationConfigRepo notification repository
@@ -46,10 +49,11 @@ func (ur *userNotificationConfigRepo) Add(ctx context.Context, userIDs []string,
 	var configs []*entity.UserNotificationConfig
 	for _, userID := range userIDs {
 		configs = append(configs, &entity.UserNotificationConfig{
-			UserID:   userID,
-			Source:   source,
-			Channels: channels,
-			Enabled:  true,
+			UserID:    userID,
+			Source:    source,
+			Channels:  channels,
+			Enabled:   true,
+			Frequency: string(constant.NotificationFrequencyImmediate),
 		})
 	}
 	_, err = ur.data.DB.Context(ctx).Insert(configs)
@@ -69,7 +73,8 @@ func (ur *userNotificationConfigRepo) Save(ctx context.Context, uc *entity.UserN
 	if exist {
 		old.Channels = uc.Channels
 		old.Enabled = uc.Enabled
-		_, err = ur.data.DB.Context(ctx).ID(old.ID).UseBool("enabled").Cols("channels", "enabled").Update(old)
+		old.Frequency = uc.Frequency
+		_, err = ur.data.DB.Context(ctx).ID(old.ID).UseBool("enabled").Cols("channels", "enabled", "frequency").Update(old)
 	} else {
 		_, err = ur.data.DB.Context(ctx).Insert(uc)
 	}
@@ -125,3 +130,30 @@ func (ur *userNotificationConfigRepo) GetByUsersAndSource(
 	}
 	return configs, nil
 }
+
+// GetDueDigestConfigs get the enabled notification configs with the frequency which are not digested since the time
+func (ur *userNotificationConfigRepo) GetDueDigestConfigs(ctx context.Context,
+	frequency constant.NotificationFrequency, lastDigestBefore time.Time) (
+	[]*entity.UserNotificationConfig, error) {
+	var configs []*entity.UserNotificationConfig
+	err := ur.data.DB.Context(ctx).Where("enabled = ?", true).And("frequency = ?", frequency).
+		And(builder.Lte{"last_digest_at": lastDigestBefore}.Or(builder.IsNull{"last_digest_at"})).
+		Asc("id").Find(&configs)
+	if err != nil {
+		return nil, errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return configs, nil
+}
+
+// SetLastDigestAt record the digest time of the notification configs
+func (ur *userNotificationConfigRepo) SetLastDigestAt(ctx context.Context, ids []string, digestAt time.Time) (err error) {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err = ur.data.DB.Context(ctx).In("id", ids).Cols("last_digest_at").
+		Update(&entity.UserNotificationConfig{LastDigestAt: digestAt})
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return nil
+}
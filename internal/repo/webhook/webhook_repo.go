@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/service/webhook"
+	"github.com/segmentfault/pacman/errors"
+)
+
+type webhookRepo struct {
+	data *data.Data
+}
+
+// NewWebhookRepo new webhook repository
+func NewWebhookRepo(data *data.Data) webhook.WebhookRepo {
+	return &webhookRepo{
+		data: data,
+	}
+}
+
+// AddWebhook add webhook
+func (wr *webhookRepo) AddWebhook(ctx context.Context, webhook *entity.Webhook) (err error) {
+	_, err = wr.data.DB.Context(ctx).Insert(webhook)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdateWebhook update webhook
+func (wr *webhookRepo) UpdateWebhook(ctx context.Context, webhook *entity.Webhook, cols []string) (err error) {
+	_, err = wr.data.DB.Context(ctx).ID(webhook.ID).Cols(cols...).Update(webhook)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// RemoveWebhook remove webhook
+func (wr *webhookRepo) RemoveWebhook(ctx context.Context, id string) (err error) {
+	_, err = wr.data.DB.Context(ctx).ID(id).Delete(&entity.Webhook{})
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetWebhook get webhook by id
+func (wr *webhookRepo) GetWebhook(ctx context.Context, id string) (webhook *entity.Webhook, exist bool, err error) {
+	webhook = &entity.Webhook{}
+	exist, err = wr.data.DB.Context(ctx).ID(id).Get(webhook)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetWebhookList get all webhooks or only the active ones
+func (wr *webhookRepo) GetWebhookList(ctx context.Context, onlyActive bool) (webhooks []*entity.Webhook, err error) {
+	webhooks = make([]*entity.Webhook, 0)
+	session := wr.data.DB.Context(ctx)
+	if onlyActive {
+		session = session.Where("is_active = ?", true)
+	}
+	err = session.Asc("id").Find(&webhooks)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// AddWebhookDelivery add webhook delivery
+func (wr *webhookRepo) AddWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (err error) {
+	_, err = wr.data.DB.Context(ctx).Insert(delivery)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// UpdateWebhookDelivery update webhook delivery
+func (wr *webhookRepo) UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery, cols []string) (err error) {
+	_, err = wr.data.DB.Context(ctx).ID(delivery.ID).Cols(cols...).Update(delivery)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetWebhookDelivery get webhook delivery by id
+func (wr *webhookRepo) GetWebhookDelivery(ctx context.Context, id string) (
+	delivery *entity.WebhookDelivery, exist bool, err error) {
+	delivery = &entity.WebhookDelivery{}
+	exist, err = wr.data.DB.Context(ctx).ID(id).Get(delivery)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetWebhookDeliveryByEvent get the delivery of the event to the webhook, the redeliveries are excluded
+func (wr *webhookRepo) GetWebhookDeliveryByEvent(ctx context.Context, webhookID, eventID string) (
+	delivery *entity.WebhookDelivery, exist bool, err error) {
+	delivery = &entity.WebhookDelivery{}
+	exist, err = wr.data.DB.Context(ctx).Where("webhook_id = ? AND event_id = ? AND redelivery_of = 0", webhookID, eventID).
+		Get(delivery)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
+
+// GetWebhookDeliveryPage get webhook deliveries by page, the latest first
+func (wr *webhookRepo) GetWebhookDeliveryPage(ctx context.Context, page, pageSize int, webhookID, status string) (
+	deliveries []*entity.WebhookDelivery, total int64, err error) {
+	deliveries = make([]*entity.WebhookDelivery, 0)
+	session := wr.data.DB.Context(ctx)
+	if len(webhookID) > 0 {
+		session = session.Where("webhook_id = ?", webhookID)
+	}
+	if len(status) > 0 {
+		session = session.And("status = ?", status)
+	}
+	session = session.Desc("id")
+	total, err = pager.Help(page, pageSize, &deliveries, &entity.WebhookDelivery{}, session)
+	if err != nil {
+		err = errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	return
+}
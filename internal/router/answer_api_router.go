@@ -58,6 +58,12 @@ type AnswerAPIRouter struct {
 	badgeController         *controller.BadgeController
 	adminBadgeController    *controller_admin.BadgeController
 	freelancerController    *controller.FreelancerController
+	contractController      *controller.ContractController
+	conversationController  *controller.ConversationController
+	emailReplyController    *controller.EmailReplyController
+
+	adminFreelancerVerificationController *controller_admin.FreelancerVerificationController
+	adminWebhookController                *controller_admin.WebhookController
 }
 
 func NewAnswerAPIRouter(
@@ -92,6 +98,11 @@ func NewAnswerAPIRouter(
 	badgeController *controller.BadgeController,
 	adminBadgeController *controller_admin.BadgeController,
 	freelancerController *controller.FreelancerController,
+	contractController *controller.ContractController,
+	conversationController *controller.ConversationController,
+	emailReplyController *controller.EmailReplyController,
+	adminFreelancerVerificationController *controller_admin.FreelancerVerificationController,
+	adminWebhookController *controller_admin.WebhookController,
 ) *AnswerAPIRouter {
 	return &AnswerAPIRouter{
 		langController:          langController,
@@ -125,6 +136,12 @@ func NewAnswerAPIRouter(
 		badgeController:         badgeController,
 		adminBadgeController:    adminBadgeController,
 		freelancerController:    freelancerController,
+		contractController:      contractController,
+		conversationController:  conversationController,
+		emailReplyController:    emailReplyController,
+
+		adminFreelancerVerificationController: adminFreelancerVerificationController,
+		adminWebhookController:                adminWebhookController,
 	}
 }
 
@@ -149,19 +166,23 @@ func (a *AnswerAPIRouter) RegisterMustUnAuthAnswerAPIRouter(authUserMiddleware *
 	routerGroup.POST("/user/password/replacement", a.userController.UseRePassWord)
 	routerGroup.PUT("/user/notification/unsubscribe", a.userController.UserUnsubscribeNotification)
 
+	// the replies to the notification emails forwarded by the mail server
+	r.POST("/email/reply", a.emailReplyController.IngestEmailReply)
+
 	// plugins
 	r.GET("/plugin/status", a.pluginController.GetAllPluginStatus)
 
 	// freelancer routes
 	r.GET("/freelancer/profile", a.freelancerController.GetFreelancerProfile)
 	r.GET("/freelancer/profiles", a.freelancerController.GetFreelancerProfiles)
+	r.GET("/freelancer/portfolio", a.freelancerController.GetPortfolioItems)
 	r.GET("/job/postings", a.freelancerController.GetJobPostings)
-	r.GET("/job/posting/:id", a.freelancerController.GetJobPosting)
+	r.GET("/job/posting/:id", authUserMiddleware.Auth(), a.freelancerController.GetJobPosting)
+	r.GET("/job/posting/:id/recommended-freelancers", a.freelancerController.GetRecommendedFreelancers)
 	
 	// freelancer authenticated routes
 	r.POST("/freelancer/profile", authUserMiddleware.Auth(), a.freelancerController.CreateFreelancerProfile)
 	r.PUT("/freelancer/profile", authUserMiddleware.Auth(), a.freelancerController.UpdateFreelancerProfile)
-	r.POST("/job/posting", authUserMiddleware.Auth(), a.freelancerController.CreateJobPosting)
 	r.POST("/job/application", authUserMiddleware.Auth(), a.freelancerController.CreateJobApplication)
 	r.POST("/freelancer/hire", authUserMiddleware.Auth(), a.freelancerController.HireFreelancer)
 }
@@ -183,6 +204,7 @@ func (a *AnswerAPIRouter) RegisterUnAuthAnswerAPIRouter(r *gin.RouterGroup) {
 	r.GET("/question/page", a.questionController.QuestionPage)
 	r.GET("/question/recommend/page", a.questionController.QuestionRecommendPage)
 	r.GET("/question/similar/tag", a.questionController.SimilarQuestion)
+	r.GET("/question/related", a.questionController.GetRelatedQuestions)
 	r.GET("/personal/qa/top", a.questionController.UserTop)
 	r.GET("/personal/question/page", a.questionController.PersonalQuestionPage)
 	r.GET("/question/link", a.questionController.GetQuestionLink)
@@ -201,10 +223,13 @@ func (a *AnswerAPIRouter) RegisterUnAuthAnswerAPIRouter(r *gin.RouterGroup) {
 	r.GET("/tag", a.tagController.GetTagInfo)
 	r.GET("/tags", a.tagController.GetTagsBySlugName)
 	r.GET("/tag/synonyms", a.tagController.GetTagSynonyms)
+	r.GET("/tag/skill", a.freelancerController.GetSkillTag)
+	r.GET("/contract/reviews", a.contractController.GetContractReviewPage)
 
 	// search
 	r.GET("/search", a.searchController.Search)
 	r.GET("/search/desc", a.searchController.SearchDesc)
+	r.GET("/search/suggest", a.searchController.Suggest)
 
 	// rank
 	r.GET("/personal/rank/page", a.rankController.GetRankPersonalWithPage)
@@ -326,6 +351,49 @@ func (a *AnswerAPIRouter) RegisterAnswerAPIRouter(r *gin.RouterGroup) {
 
 	// meta
 	r.PUT("/meta/reaction", a.metaController.AddOrUpdateReaction)
+
+	// job
+	r.GET("/freelancer/recommended-jobs", a.freelancerController.GetRecommendedJobs)
+	r.POST("/job/posting", a.freelancerController.CreateJobPosting)
+	r.PUT("/job/posting/:id", a.freelancerController.UpdateJobPosting)
+	r.GET("/job/posting/:id/analytics", a.freelancerController.GetJobPostingAnalytics)
+	r.PUT("/job/posting/:id/status", a.freelancerController.UpdateJobPostingStatus)
+	r.PUT("/job/posting/:id/renew", a.freelancerController.RenewJobPosting)
+	r.DELETE("/job/posting/:id", a.freelancerController.RemoveJobPosting)
+	r.GET("/job/applications", a.freelancerController.GetJobApplications)
+	r.PUT("/job/application/status", a.freelancerController.UpdateJobApplicationStatus)
+	r.GET("/job/saved-searches", a.freelancerController.GetSavedJobSearches)
+	r.POST("/job/saved-search", a.freelancerController.SaveJobSearch)
+	r.PUT("/job/saved-search/:id", a.freelancerController.UpdateSavedJobSearch)
+	r.DELETE("/job/saved-search/:id", a.freelancerController.RemoveSavedJobSearch)
+	r.POST("/freelancer/portfolio/item", a.freelancerController.AddPortfolioItem)
+	r.PUT("/freelancer/portfolio/item/:id", a.freelancerController.UpdatePortfolioItem)
+	r.DELETE("/freelancer/portfolio/item/:id", a.freelancerController.RemovePortfolioItem)
+
+	// contract
+	r.POST("/contract", a.contractController.CreateContract)
+	r.GET("/contracts", a.contractController.GetContractPage)
+	r.GET("/contract/:id", a.contractController.GetContract)
+	r.PUT("/contract/:id/accept", a.contractController.AcceptContract)
+	r.PUT("/contract/:id/cancel", a.contractController.CancelContract)
+	r.PUT("/contract/:id/complete", a.contractController.CompleteContract)
+	r.POST("/contract/:id/milestone", a.contractController.AddMilestone)
+	r.PUT("/contract/milestone/:id/status", a.contractController.UpdateMilestoneStatus)
+	r.POST("/contract/:id/review", a.contractController.AddContractReview)
+	r.PUT("/contract/review/:id/reply", a.contractController.ReplyContractReview)
+	r.DELETE("/contract/review", a.contractController.RemoveContractReview)
+
+	// conversation
+	r.POST("/conversation", a.conversationController.StartConversation)
+	r.GET("/conversations", a.conversationController.GetConversationPage)
+	r.GET("/conversation/:id/messages", a.conversationController.GetMessagePage)
+	r.POST("/conversation/:id/message", a.conversationController.SendMessage)
+	r.PUT("/conversation/:id/read", a.conversationController.ReadConversation)
+	r.GET("/conversation/attachment/*filepath", a.conversationController.GetMessageAttachment)
+
+	// freelancer verification
+	r.POST("/freelancer/verification", a.freelancerController.RequestVerification)
+	r.GET("/freelancer/verification", a.freelancerController.GetMyVerification)
 }
 
 func (a *AnswerAPIRouter) RegisterAnswerAdminAPIRouter(r *gin.RouterGroup) {
@@ -377,6 +445,10 @@ func (a *AnswerAPIRouter) RegisterAnswerAdminAPIRouter(r *gin.RouterGroup) {
 	r.PUT("/siteinfo/theme", a.adminSiteInfoController.SaveSiteTheme)
 	r.GET("/siteinfo/users", a.adminSiteInfoController.GetSiteUsers)
 	r.PUT("/siteinfo/users", a.adminSiteInfoController.UpdateSiteUsers)
+	r.GET("/siteinfo/job", a.adminSiteInfoController.GetSiteJob)
+	r.PUT("/siteinfo/job", a.adminSiteInfoController.UpdateSiteJob)
+	r.GET("/siteinfo/currency", a.adminSiteInfoController.GetSiteCurrency)
+	r.PUT("/siteinfo/currency", a.adminSiteInfoController.UpdateSiteCurrency)
 	r.GET("/setting/smtp", a.adminSiteInfoController.GetSMTPConfig)
 	r.PUT("/setting/smtp", a.adminSiteInfoController.UpdateSMTPConfig)
 	r.GET("/setting/privileges", a.adminSiteInfoController.GetPrivilegesConfig)
@@ -384,9 +456,19 @@ func (a *AnswerAPIRouter) RegisterAnswerAdminAPIRouter(r *gin.RouterGroup) {
 
 	// dashboard
 	r.GET("/dashboard", a.dashboardController.DashboardInfo)
+	r.GET("/dashboard/marketplace", a.dashboardController.MarketplaceStatistical)
+	r.GET("/dashboard/marketplace/export", a.dashboardController.ExportMarketplaceStatistical)
 
 	// roles
 	r.GET("/roles", a.roleController.GetRoleList)
+	r.GET("/powers", a.roleController.GetPowerList)
+	r.POST("/role", a.roleController.AddRole)
+	r.PUT("/role", a.roleController.UpdateRole)
+	r.DELETE("/role", a.roleController.RemoveRole)
+	r.GET("/role/powers", a.roleController.GetRolePowers)
+	r.PUT("/role/powers", a.roleController.UpdateRolePowers)
+	r.PUT("/role/users", a.roleController.UpdateRoleUsers)
+	r.GET("/role/audit-logs", a.roleController.GetRoleAuditLogs)
 
 	// plugin
 	r.GET("/plugins", a.pluginController.GetPluginList)
@@ -397,4 +479,19 @@ func (a *AnswerAPIRouter) RegisterAnswerAdminAPIRouter(r *gin.RouterGroup) {
 	// badge
 	r.GET("/badges", a.adminBadgeController.GetBadgeList)
 	r.PUT("/badge/status", a.adminBadgeController.UpdateBadgeStatus)
+
+	// freelancer verification
+	r.GET("/freelancer/verifications", a.adminFreelancerVerificationController.GetVerificationList)
+	r.PUT("/freelancer/verification/status", a.adminFreelancerVerificationController.ReviewVerification)
+	r.GET("/freelancer/verification/evidence/*filepath", a.adminFreelancerVerificationController.GetVerificationEvidence)
+
+	// webhook
+	r.GET("/webhook/events", a.adminWebhookController.GetWebhookEvents)
+	r.GET("/webhooks", a.adminWebhookController.GetWebhookList)
+	r.POST("/webhook", a.adminWebhookController.AddWebhook)
+	r.PUT("/webhook", a.adminWebhookController.UpdateWebhook)
+	r.DELETE("/webhook", a.adminWebhookController.RemoveWebhook)
+	r.POST("/webhook/ping", a.adminWebhookController.PingWebhook)
+	r.GET("/webhook/deliveries", a.adminWebhookController.GetDeliveryList)
+	r.POST("/webhook/delivery/redeliver", a.adminWebhookController.RedeliverWebhook)
 }
@@ -64,4 +64,20 @@ func (a *StaticRouter) RegisterStaticRouter(r *gin.RouterGroup) {
 		}
 		c.FileAttachment(fileLocalPath, originalFilename)
 	})
+	r.GET("/uploads/"+constant.FilesPortfolioSubPath+"/*filepath", func(c *gin.Context) {
+		// The filepath such as hash/123.png
+		filePath := c.Param("filepath")
+		// The original filename is 123.png
+		originalFilename := filepath.Base(filePath)
+		// The real filename is hash.png
+		realFilename := strings.TrimSuffix(filePath, "/"+originalFilename) + filepath.Ext(originalFilename)
+		// The file local path is /uploads/files/portfolio/hash.png
+		fileLocalPath := filepath.Join(a.serviceConfig.UploadPath, constant.FilesPortfolioSubPath, realFilename)
+		// If the file is not exist, return 404
+		if !dir.CheckFileExist(fileLocalPath) {
+			c.Redirect(http.StatusFound, "/404")
+			return
+		}
+		c.FileAttachment(fileLocalPath, originalFilename)
+	})
 }
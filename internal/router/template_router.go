@@ -72,4 +72,7 @@ func (a *TemplateRouter) RegisterTemplateRouter(r *gin.RouterGroup, baseURLPath
 	seo.GET("/tags", a.templateController.TagList)
 	seo.GET("/tags/:tag", a.templateController.TagInfo)
 	seo.GET("/users/:username", a.templateController.UserInfo)
+	seo.GET("/jobs", a.templateController.JobList)
+	seo.GET("/jobs/:id", a.templateController.JobInfo)
+	seo.GET("/freelancers/:username", a.templateController.FreelancerInfo)
 }
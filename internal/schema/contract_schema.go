@@ -0,0 +1,273 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"time"
+
+	"github.com/apache/answer/internal/base/validator"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/pkg/converter"
+)
+
+// CreateContractReq create contract request
+type CreateContractReq struct {
+	FreelancerUserID string                `validate:"required" json:"freelancer_user_id"`
+	JobID            string                `validate:"omitempty" json:"job_id"`
+	ApplicationID    string                `validate:"omitempty" json:"application_id"`
+	Title            string                `validate:"required,notblank,lte=255" json:"title"`
+	Description      string                `validate:"omitempty,lte=65535" json:"description"`
+	Currency         string                `validate:"omitempty,iso4217" json:"currency"`
+	Milestones       []*CreateMilestoneReq `validate:"omitempty,dive" json:"milestones"`
+	LoginUserID      string                `json:"-"`
+}
+
+// CreateContractResp create contract response
+type CreateContractResp struct {
+	ID string `json:"id"`
+}
+
+// CreateMilestoneReq create milestone request
+type CreateMilestoneReq struct {
+	ContractID  string  `json:"-"`
+	Title       string  `validate:"required,notblank,lte=255" json:"title"`
+	Description string  `validate:"omitempty,lte=65535" json:"description"`
+	Amount      float64 `validate:"required,gt=0" json:"amount"`
+	// due time of the milestone, unix timestamp in seconds, optional
+	DueAt       int64  `validate:"omitempty,min=0" json:"due_at"`
+	LoginUserID string `json:"-"`
+}
+
+// GetContractReq get contract request
+type GetContractReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+	IsAdmin     bool   `json:"-"`
+}
+
+// GetContractsReq get the contracts of login user request
+type GetContractsReq struct {
+	// the role of login user in the contracts, client or freelancer, both if empty
+	Role        string `validate:"omitempty,oneof=client freelancer" form:"role"`
+	Status      string `validate:"omitempty,oneof=offered active completed cancelled" form:"status"`
+	Page        int    `validate:"omitempty,min=1" form:"page"`
+	PageSize    int    `validate:"omitempty,min=1" form:"page_size"`
+	LoginUserID string `json:"-"`
+}
+
+// UpdateMilestoneStatusReq update milestone status request
+type UpdateMilestoneStatusReq struct {
+	ID     string `json:"-"`
+	Status string `validate:"required,oneof=funded submitted approved disputed released" json:"status"`
+	// the submission note or the dispute reason
+	Note        string `validate:"omitempty,lte=5000" json:"note"`
+	LoginUserID string `json:"-"`
+}
+
+// AcceptContractReq accept contract request
+type AcceptContractReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// CancelContractReq cancel contract request
+type CancelContractReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// CompleteContractReq complete contract request
+type CompleteContractReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// ContractResp contract response
+type ContractResp struct {
+	ID              string           `json:"id"`
+	ClientID        string           `json:"client_id"`
+	FreelancerID    string           `json:"freelancer_id"`
+	JobID           string           `json:"job_id"`
+	ApplicationID   string           `json:"application_id"`
+	Title           string           `json:"title"`
+	Description     string           `json:"description"`
+	Currency        string           `json:"currency"`
+	TotalAmount     float64          `json:"total_amount"`
+	Status          string           `json:"status"`
+	PaymentProvider string           `json:"payment_provider"`
+	WorkConfirmed   bool             `json:"work_confirmed"`
+	Milestones      []*MilestoneResp `json:"milestones"`
+	CreatedAt       int64            `json:"created_at"`
+	UpdatedAt       int64            `json:"updated_at"`
+}
+
+// ConvertFromContractEntity convert from contract entity
+func (r *ContractResp) ConvertFromContractEntity(contract *entity.Contract) {
+	r.ID = contract.ID
+	r.ClientID = contract.ClientID
+	r.FreelancerID = contract.FreelancerID
+	if contract.JobID != "0" {
+		r.JobID = contract.JobID
+	}
+	if contract.ApplicationID != "0" {
+		r.ApplicationID = contract.ApplicationID
+	}
+	r.Title = contract.Title
+	r.Description = contract.Description
+	r.Currency = contract.Currency
+	r.TotalAmount = contract.TotalAmount
+	r.Status = contract.Status
+	r.PaymentProvider = contract.PaymentProvider
+	r.WorkConfirmed = contract.WorkConfirmed
+	r.Milestones = make([]*MilestoneResp, 0)
+	r.CreatedAt = contract.CreatedAt.Unix()
+	r.UpdatedAt = contract.UpdatedAt.Unix()
+}
+
+// MilestoneResp milestone response
+type MilestoneResp struct {
+	ID                   string  `json:"id"`
+	ContractID           string  `json:"contract_id"`
+	Title                string  `json:"title"`
+	Description          string  `json:"description"`
+	Amount               float64 `json:"amount"`
+	DueAt                int64   `json:"due_at"`
+	Status               string  `json:"status"`
+	Note                 string  `json:"note"`
+	FundTransactionID    string  `json:"fund_transaction_id"`
+	ReleaseTransactionID string  `json:"release_transaction_id"`
+	CreatedAt            int64   `json:"created_at"`
+	UpdatedAt            int64   `json:"updated_at"`
+}
+
+// ConvertFromMilestoneEntity convert from milestone entity
+func (r *MilestoneResp) ConvertFromMilestoneEntity(milestone *entity.Milestone) {
+	r.ID = milestone.ID
+	r.ContractID = milestone.ContractID
+	r.Title = milestone.Title
+	r.Description = milestone.Description
+	r.Amount = milestone.Amount
+	if !milestone.DueAt.IsZero() {
+		r.DueAt = milestone.DueAt.Unix()
+	}
+	r.Status = milestone.Status
+	r.Note = milestone.Note
+	r.FundTransactionID = milestone.FundTransactionID
+	r.ReleaseTransactionID = milestone.ReleaseTransactionID
+	r.CreatedAt = milestone.CreatedAt.Unix()
+	r.UpdatedAt = milestone.UpdatedAt.Unix()
+}
+
+// DueTime the due time of the milestone, zero if not set
+func (req *CreateMilestoneReq) DueTime() time.Time {
+	if req.DueAt <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(req.DueAt, 0)
+}
+
+// AddContractReviewReq add contract review request
+type AddContractReviewReq struct {
+	ContractID string `json:"-"`
+	// rating from 1 to 5
+	Rating      int    `validate:"required,min=1,max=5" json:"rating"`
+	Content     string `validate:"required,notblank,lte=5000" json:"content"`
+	HTML        string `json:"-"`
+	LoginUserID string `json:"-"`
+	IP          string `json:"-"`
+	UserAgent   string `json:"-"`
+}
+
+func (req *AddContractReviewReq) Check() (errFields []*validator.FormErrorField, err error) {
+	req.HTML = converter.Markdown2HTML(req.Content)
+	return nil, nil
+}
+
+// ReplyContractReviewReq reply contract review request
+type ReplyContractReviewReq struct {
+	ID          string `json:"-"`
+	Content     string `validate:"required,notblank,lte=5000" json:"content"`
+	HTML        string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+func (req *ReplyContractReviewReq) Check() (errFields []*validator.FormErrorField, err error) {
+	req.HTML = converter.Markdown2HTML(req.Content)
+	return nil, nil
+}
+
+// RemoveContractReviewReq remove contract review request
+type RemoveContractReviewReq struct {
+	ID          string `validate:"required" json:"id"`
+	LoginUserID string `json:"-"`
+	IsAdmin     bool   `json:"-"`
+}
+
+// GetContractReviewsReq get the reviews of the user request
+type GetContractReviewsReq struct {
+	UserID string `validate:"required" form:"user_id"`
+	// the role of the reviewer, client or freelancer, both if empty
+	Role     string `validate:"omitempty,oneof=client freelancer" form:"role"`
+	Page     int    `validate:"omitempty,min=1" form:"page"`
+	PageSize int    `validate:"omitempty,min=1" form:"page_size"`
+}
+
+// GetContractReviewsResp get the reviews of the user response
+type GetContractReviewsResp struct {
+	// average rating of all available reviews
+	Rating float64               `json:"rating"`
+	Count  int64                 `json:"count"`
+	List   []*ContractReviewResp `json:"list"`
+}
+
+// ContractReviewResp contract review response
+type ContractReviewResp struct {
+	ID           string         `json:"id"`
+	ContractID   string         `json:"contract_id"`
+	RevieweeID   string         `json:"reviewee_id"`
+	ReviewerRole string         `json:"reviewer_role"`
+	Reviewer     *UserBasicInfo `json:"reviewer"`
+	Rating       int            `json:"rating"`
+	Content      string         `json:"content"`
+	HTML         string         `json:"html"`
+	ReplyContent string         `json:"reply_content"`
+	ReplyHTML    string         `json:"reply_html"`
+	RepliedAt    int64          `json:"replied_at"`
+	Status       int            `json:"status"`
+	CreatedAt    int64          `json:"created_at"`
+}
+
+// ConvertFromContractReviewEntity convert from contract review entity
+func (r *ContractReviewResp) ConvertFromContractReviewEntity(review *entity.ContractReview) {
+	r.ID = review.ID
+	r.ContractID = review.ContractID
+	r.RevieweeID = review.RevieweeID
+	r.ReviewerRole = review.ReviewerRole
+	r.Rating = review.Rating
+	r.Content = review.OriginalText
+	r.HTML = review.ParsedText
+	r.ReplyContent = review.ReplyText
+	r.ReplyHTML = review.ReplyHTML
+	if !review.RepliedAt.IsZero() {
+		r.RepliedAt = review.RepliedAt.Unix()
+	}
+	r.Status = review.Status
+	r.CreatedAt = review.CreatedAt.Unix()
+}
@@ -0,0 +1,156 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"github.com/apache/answer/internal/base/validator"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/pkg/converter"
+	"github.com/goccy/go-json"
+)
+
+// StartConversationReq start conversation request
+type StartConversationReq struct {
+	// the contract or the job application which the conversation is about, one of them is required
+	ContractID    string `validate:"required_without=ApplicationID" json:"contract_id"`
+	ApplicationID string `validate:"required_without=ContractID" json:"application_id"`
+	Subject       string `validate:"omitempty,lte=255" json:"subject"`
+	Content       string `validate:"required,notblank,lte=65535" json:"content"`
+	// the urls of the files uploaded as message attachment
+	Attachments []string `validate:"omitempty,max=10,dive,url" json:"attachments"`
+	HTML        string   `json:"-"`
+	LoginUserID string   `json:"-"`
+}
+
+func (req *StartConversationReq) Check() (errFields []*validator.FormErrorField, err error) {
+	req.HTML = converter.Markdown2HTML(req.Content)
+	return nil, nil
+}
+
+// StartConversationResp start conversation response
+type StartConversationResp struct {
+	ConversationID string       `json:"conversation_id"`
+	Message        *MessageResp `json:"message"`
+}
+
+// SendMessageReq send message request
+type SendMessageReq struct {
+	ConversationID string `json:"-"`
+	Content        string `validate:"required,notblank,lte=65535" json:"content"`
+	// the urls of the files uploaded as message attachment
+	Attachments []string `validate:"omitempty,max=10,dive,url" json:"attachments"`
+	HTML        string   `json:"-"`
+	LoginUserID string   `json:"-"`
+}
+
+func (req *SendMessageReq) Check() (errFields []*validator.FormErrorField, err error) {
+	req.HTML = converter.Markdown2HTML(req.Content)
+	return nil, nil
+}
+
+// GetConversationsReq get the conversations of login user request
+type GetConversationsReq struct {
+	Page        int    `validate:"omitempty,min=1" form:"page"`
+	PageSize    int    `validate:"omitempty,min=1" form:"page_size"`
+	LoginUserID string `json:"-"`
+}
+
+// GetMessagesReq get the messages of the conversation request
+type GetMessagesReq struct {
+	ConversationID string `json:"-"`
+	Page           int    `validate:"omitempty,min=1" form:"page"`
+	PageSize       int    `validate:"omitempty,min=1" form:"page_size"`
+	LoginUserID    string `json:"-"`
+}
+
+// ReadConversationReq mark all messages of the conversation as read request
+type ReadConversationReq struct {
+	ConversationID string `json:"-"`
+	LoginUserID    string `json:"-"`
+}
+
+// GetMessageAttachmentReq download the message attachment request
+type GetMessageAttachmentReq struct {
+	// the download path such as hash/123.pdf
+	FilePath    string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// ConversationResp conversation response
+type ConversationResp struct {
+	ID            string `json:"id"`
+	ContractID    string `json:"contract_id"`
+	ApplicationID string `json:"application_id"`
+	JobID         string `json:"job_id"`
+	Subject       string `json:"subject"`
+	// the role of login user in the conversation, client or freelancer
+	Role          string         `json:"role"`
+	Participant   *UserBasicInfo `json:"participant"`
+	LastMessage   *MessageResp   `json:"last_message"`
+	UnreadCount   int            `json:"unread_count"`
+	LastMessageAt int64          `json:"last_message_at"`
+	CreatedAt     int64          `json:"created_at"`
+}
+
+// ConvertFromConversationEntity convert from conversation entity
+func (r *ConversationResp) ConvertFromConversationEntity(conversation *entity.Conversation) {
+	r.ID = conversation.ID
+	if conversation.ContractID != "0" {
+		r.ContractID = conversation.ContractID
+	}
+	if conversation.ApplicationID != "0" {
+		r.ApplicationID = conversation.ApplicationID
+	}
+	if conversation.JobID != "0" {
+		r.JobID = conversation.JobID
+	}
+	r.Subject = conversation.Subject
+	if !conversation.LastMessageAt.IsZero() {
+		r.LastMessageAt = conversation.LastMessageAt.Unix()
+	}
+	r.CreatedAt = conversation.CreatedAt.Unix()
+}
+
+// MessageResp message response
+type MessageResp struct {
+	ID             string   `json:"id"`
+	ConversationID string   `json:"conversation_id"`
+	SenderID       string   `json:"sender_id"`
+	Content        string   `json:"content"`
+	HTML           string   `json:"html"`
+	Attachments    []string `json:"attachments"`
+	// read receipt, whether the message has been read by the receiver
+	IsRead    bool  `json:"is_read"`
+	CreatedAt int64 `json:"created_at"`
+}
+
+// ConvertFromMessageEntity convert from message entity
+func (r *MessageResp) ConvertFromMessageEntity(message *entity.Message) {
+	r.ID = message.ID
+	r.ConversationID = message.ConversationID
+	r.SenderID = message.SenderID
+	r.Content = message.OriginalText
+	r.HTML = message.ParsedText
+	r.Attachments = make([]string, 0)
+	if len(message.Attachments) > 0 {
+		_ = json.Unmarshal([]byte(message.Attachments), &r.Attachments)
+	}
+	r.CreatedAt = message.CreatedAt.Unix()
+}
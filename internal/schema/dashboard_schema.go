@@ -26,6 +26,9 @@ var AppStartTime time.Time
 const (
 	DashboardCacheKey  = "answer:dashboard"
 	DashboardCacheTime = 60 * time.Minute
+
+	MarketplaceStatisticalCacheKey  = "answer:dashboard:marketplace"
+	MarketplaceStatisticalCacheTime = 10 * time.Minute
 )
 
 type DashboardInfo struct {
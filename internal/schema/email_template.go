@@ -142,3 +142,71 @@ type NewQuestionTemplateData struct {
 	Tags           string
 	UnsubscribeUrl string
 }
+
+type NewMessageTemplateRawData struct {
+	SenderDisplayName string
+	ConversationID    string
+	Subject           string
+	MessageSummary    string
+	UnsubscribeCode   string
+}
+
+type NewMessageTemplateData struct {
+	SiteName        string
+	DisplayName     string
+	Subject         string
+	ConversationUrl string
+	MessageSummary  string
+	UnsubscribeUrl  string
+}
+
+type NewJobMatchTemplateRawData struct {
+	SearchName      string
+	JobPostings     []*NewJobMatchItem
+	UnsubscribeCode string
+}
+
+type NewJobMatchItem struct {
+	ID    string
+	Title string
+}
+
+type NewJobMatchTemplateData struct {
+	SiteName       string
+	SearchName     string
+	JobList        string
+	UnsubscribeUrl string
+}
+
+type NotificationDigestTemplateRawData struct {
+	Frequency       constant.NotificationFrequency
+	Answers         []*NotificationDigestItem
+	Comments        []*NotificationDigestItem
+	Invites         []*NotificationDigestItem
+	Badges          []*NotificationDigestItem
+	Questions       []*NotificationDigestItem
+	UnsubscribeCode string
+}
+
+// NotificationDigestItem the question, answer or comment links to the question, the badge links to the badge
+type NotificationDigestItem struct {
+	Title       string
+	DisplayName string
+	QuestionID  string
+	AnswerID    string
+	CommentID   string
+	BadgeID     string
+}
+
+// IsEmpty whether there is nothing to digest
+func (r *NotificationDigestTemplateRawData) IsEmpty() bool {
+	return len(r.Answers) == 0 && len(r.Comments) == 0 && len(r.Invites) == 0 &&
+		len(r.Badges) == 0 && len(r.Questions) == 0
+}
+
+type NotificationDigestTemplateData struct {
+	SiteName       string
+	Sections       string
+	SettingsUrl    string
+	UnsubscribeUrl string
+}
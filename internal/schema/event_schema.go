@@ -26,6 +26,8 @@ import (
 
 // EventMsg event message
 type EventMsg struct {
+	// ID the unique id of the event, it is kept when the event is retried
+	ID        string
 	EventType constant.EventType
 	UserID    string
 
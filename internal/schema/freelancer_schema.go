@@ -19,16 +19,54 @@
 
 package schema
 
-// FreelancerProfileResp freelancer profile response
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/plugin"
+)
+
+const (
+	SkillMatchAny = "any"
+	SkillMatchAll = "all"
+)
+
+const (
+	ListingSortNewest    = "newest"
+	ListingSortBudget    = "budget"
+	ListingSortRating    = "rating"
+	ListingSortRelevance = "relevance"
+)
+
+// listingOrder the search plugin order of the listing sort, default newest
+func listingOrder(sort string) plugin.SearchListingOrderCond {
+	switch sort {
+	case ListingSortBudget:
+		return plugin.SearchListingBudgetOrder
+	case ListingSortRating:
+		return plugin.SearchListingRatingOrder
+	case ListingSortRelevance:
+		return plugin.SearchListingRelevanceOrder
+	default:
+		return plugin.SearchListingNewestOrder
+	}
+}
+
+// FreelancerProfileResp freelancer profile response, the base amount is in the base currency of the site,
+// the display amount is in the currency chosen by the viewer of the list
 type FreelancerProfileResp struct {
 	ID                 string   `json:"id"`
 	UserID             string   `json:"user_id"`
 	IsAvailable        bool     `json:"is_available"`
 	HourlyRate         float64  `json:"hourly_rate"`
 	Currency           string   `json:"currency"`
+	BaseHourlyRate     float64  `json:"base_hourly_rate"`
+	DisplayHourlyRate  float64  `json:"display_hourly_rate,omitempty"`
+	DisplayCurrency    string   `json:"display_currency,omitempty"`
 	Skills             []string `json:"skills"`
 	Experience         string   `json:"experience"`
-	Portfolio          []string `json:"portfolio"`
 	Availability       string   `json:"availability"`
 	PreferredProjects  []string `json:"preferred_projects"`
 	ContactEmail       string   `json:"contact_email"`
@@ -39,6 +77,8 @@ type FreelancerProfileResp struct {
 	BioHTML            string   `json:"bio_html"`
 	Languages          []string `json:"languages"`
 	TimeZone           string   `json:"time_zone"`
+	ExperienceLevel    string   `json:"experience_level"`
+	LocationType       string   `json:"location_type"`
 	ResponseTime       string   `json:"response_time"`
 	CompletedProjects  int      `json:"completed_projects"`
 	ClientSatisfaction float64  `json:"client_satisfaction"`
@@ -46,16 +86,49 @@ type FreelancerProfileResp struct {
 	VerificationDate   int64    `json:"verification_date"`
 	CreatedAt          int64    `json:"created_at"`
 	UpdatedAt          int64    `json:"updated_at"`
+	// Portfolio the portfolio items, only returned with the single profile
+	Portfolio []*PortfolioItemResp `json:"portfolio,omitempty"`
+}
+
+// ConvertFromFreelancerProfileEntity convert freelancer profile entity to response
+func (r *FreelancerProfileResp) ConvertFromFreelancerProfileEntity(profile *entity.FreelancerProfile) {
+	_ = json.Unmarshal([]byte(profile.Skills), &r.Skills)
+	_ = json.Unmarshal([]byte(profile.PreferredProjects), &r.PreferredProjects)
+	_ = json.Unmarshal([]byte(profile.Languages), &r.Languages)
+
+	r.ID = profile.ID
+	r.UserID = profile.UserID
+	r.IsAvailable = profile.IsAvailable
+	r.HourlyRate = profile.HourlyRate
+	r.Currency = profile.Currency
+	r.BaseHourlyRate = profile.BaseHourlyRate
+	r.Experience = profile.Experience
+	r.Availability = profile.Availability
+	r.ContactEmail = profile.ContactEmail
+	r.LinkedInProfile = profile.LinkedInProfile
+	r.GitHubProfile = profile.GitHubProfile
+	r.Website = profile.Website
+	r.Bio = profile.Bio
+	r.BioHTML = profile.BioHTML
+	r.TimeZone = profile.TimeZone
+	r.ExperienceLevel = profile.ExperienceLevel
+	r.LocationType = profile.LocationType
+	r.ResponseTime = profile.ResponseTime
+	r.CompletedProjects = profile.CompletedProjects
+	r.ClientSatisfaction = profile.ClientSatisfaction
+	r.IsVerified = profile.IsVerified
+	r.VerificationDate = profile.VerificationDate.Unix()
+	r.CreatedAt = profile.CreatedAt.Unix()
+	r.UpdatedAt = profile.UpdatedAt.Unix()
 }
 
 // CreateFreelancerProfileReq create freelancer profile request
 type CreateFreelancerProfileReq struct {
 	IsAvailable       bool     `json:"is_available"`
-	HourlyRate        float64  `json:"hourly_rate"`
-	Currency          string   `json:"currency"`
-	Skills            []string `json:"skills"`
+	HourlyRate        float64  `validate:"omitempty,min=0" json:"hourly_rate"`
+	Currency          string   `validate:"omitempty,iso4217" json:"currency"`
+	Skills            []string `validate:"omitempty,dive,gt=0,lte=35" json:"skills"`
 	Experience        string   `json:"experience"`
-	Portfolio         []string `json:"portfolio"`
 	Availability      string   `json:"availability"`
 	PreferredProjects []string `json:"preferred_projects"`
 	ContactEmail      string   `json:"contact_email"`
@@ -65,19 +138,21 @@ type CreateFreelancerProfileReq struct {
 	Bio               string   `json:"bio"`
 	Languages         []string `json:"languages"`
 	TimeZone          string   `json:"time_zone"`
+	ExperienceLevel   string   `validate:"omitempty,oneof=entry intermediate senior expert" json:"experience_level"`
+	LocationType      string   `validate:"omitempty,oneof=remote onsite hybrid" json:"location_type"`
 	ResponseTime      string   `json:"response_time"`
 	LoginUserID       string   `json:"-"`
+	CanAddTag         bool     `json:"-"`
 }
 
 // UpdateFreelancerProfileReq update freelancer profile request
 type UpdateFreelancerProfileReq struct {
 	ID                string   `validate:"required" json:"id"`
 	IsAvailable       bool     `json:"is_available"`
-	HourlyRate        float64  `json:"hourly_rate"`
-	Currency          string   `json:"currency"`
-	Skills            []string `json:"skills"`
+	HourlyRate        float64  `validate:"omitempty,min=0" json:"hourly_rate"`
+	Currency          string   `validate:"omitempty,iso4217" json:"currency"`
+	Skills            []string `validate:"omitempty,dive,gt=0,lte=35" json:"skills"`
 	Experience        string   `json:"experience"`
-	Portfolio         []string `json:"portfolio"`
 	Availability      string   `json:"availability"`
 	PreferredProjects []string `json:"preferred_projects"`
 	ContactEmail      string   `json:"contact_email"`
@@ -87,8 +162,11 @@ type UpdateFreelancerProfileReq struct {
 	Bio               string   `json:"bio"`
 	Languages         []string `json:"languages"`
 	TimeZone          string   `json:"time_zone"`
+	ExperienceLevel   string   `validate:"omitempty,oneof=entry intermediate senior expert" json:"experience_level"`
+	LocationType      string   `validate:"omitempty,oneof=remote onsite hybrid" json:"location_type"`
 	ResponseTime      string   `json:"response_time"`
 	LoginUserID       string   `json:"-"`
+	CanAddTag         bool     `json:"-"`
 }
 
 // GetFreelancerProfileReq get freelancer profile request
@@ -98,13 +176,64 @@ type GetFreelancerProfileReq struct {
 
 // GetFreelancerProfilesReq get freelancer profiles request
 type GetFreelancerProfilesReq struct {
-	Page     int     `json:"page" form:"page"`
-	PageSize int     `json:"page_size" form:"page_size"`
-	Skills   string  `json:"skills" form:"skills"`
-	Location string  `json:"location" form:"location"`
-	MinRate  float64 `json:"min_rate" form:"min_rate"`
-	MaxRate  float64 `json:"max_rate" form:"max_rate"`
-	Currency string  `json:"currency" form:"currency"`
+	Page     int `validate:"omitempty,min=1" json:"page" form:"page"`
+	PageSize int `validate:"omitempty,min=1" json:"page_size" form:"page_size"`
+	// keywords searched in the bio and skills
+	Query string `validate:"omitempty,lte=100" json:"q" form:"q"`
+	// comma separated skill names
+	Skills string `json:"skills" form:"skills"`
+	// the profile must have all or any of the skills, default any
+	SkillMatch      string  `validate:"omitempty,oneof=any all" json:"skill_match" form:"skill_match"`
+	MinRate         float64 `validate:"omitempty,min=0" json:"min_rate" form:"min_rate"`
+	MaxRate         float64 `validate:"omitempty,min=0" json:"max_rate" form:"max_rate"`
+	Currency        string  `validate:"omitempty,iso4217" json:"currency" form:"currency"`
+	ExperienceLevel string  `validate:"omitempty,oneof=entry intermediate senior expert" json:"experience_level" form:"experience_level"`
+	LocationType    string  `validate:"omitempty,oneof=remote onsite hybrid" json:"location_type" form:"location_type"`
+	Availability    string  `json:"availability" form:"availability"`
+	// the time zone window, like UTC-3 and UTC+2, both or neither must be set
+	TimeZoneFrom string `validate:"required_with=TimeZoneTo" json:"time_zone_from" form:"time_zone_from"`
+	TimeZoneTo   string `validate:"required_with=TimeZoneFrom" json:"time_zone_to" form:"time_zone_to"`
+	VerifiedOnly bool   `json:"verified_only" form:"verified_only"`
+	// budget sorts by the hourly rate from high to low
+	Sort string `validate:"omitempty,oneof=newest budget rating relevance" json:"sort" form:"sort"`
+	// the currency of min_rate and max_rate and of the displayed rates, default the base currency of the site
+	DisplayCurrency string `validate:"omitempty,iso4217" json:"display_currency" form:"display_currency"`
+	// SkillTagIDs each element is the tag and its synonyms of a skill filter
+	SkillTagIDs [][]string `json:"-"`
+	// MinBaseRate and MaxBaseRate the rate filter in the base currency
+	MinBaseRate float64 `json:"-"`
+	MaxBaseRate float64 `json:"-"`
+	// MinUTCOffset and MaxUTCOffset the time zone window in minutes
+	MinUTCOffset int `json:"-"`
+	MaxUTCOffset int `json:"-"`
+}
+
+// HasTimeZoneWindow whether the time zone window is set
+func (req *GetFreelancerProfilesReq) HasTimeZoneWindow() bool {
+	return len(req.TimeZoneFrom) > 0 && len(req.TimeZoneTo) > 0
+}
+
+// Convert2PluginSearchListingCond convert to the search plugin condition
+func (req *GetFreelancerProfilesReq) Convert2PluginSearchListingCond() *plugin.SearchListingCond {
+	return &plugin.SearchListingCond{
+		Page:               req.Page,
+		PageSize:           req.PageSize,
+		Type:               plugin.SearchListingTypeFreelancer,
+		Words:              strings.Fields(req.Query),
+		TagIDs:             req.SkillTagIDs,
+		MatchAllTags:       req.SkillMatch == SkillMatchAll,
+		MinAmount:          req.MinBaseRate,
+		MaxAmount:          req.MaxBaseRate,
+		Currency:           req.Currency,
+		ExperienceLevel:    req.ExperienceLevel,
+		LocationType:       req.LocationType,
+		Availability:       req.Availability,
+		MinUTCOffset:       req.MinUTCOffset,
+		MaxUTCOffset:       req.MaxUTCOffset,
+		HasUTCOffsetWindow: req.HasTimeZoneWindow(),
+		VerifiedOnly:       req.VerifiedOnly,
+		Order:              listingOrder(req.Sort),
+	}
 }
 
 // GetFreelancerProfilesResp get freelancer profiles response
@@ -113,7 +242,8 @@ type GetFreelancerProfilesResp struct {
 	List  []*FreelancerProfileResp `json:"list"`
 }
 
-// JobPostingResp job posting response
+// JobPostingResp job posting response, the base amount is in the base currency of the site,
+// the display amount is in the currency chosen by the viewer of the list
 type JobPostingResp struct {
 	ID               string   `json:"id"`
 	UserID           string   `json:"user_id"`
@@ -122,6 +252,9 @@ type JobPostingResp struct {
 	DescriptionHTML  string   `json:"description_html"`
 	Budget           float64  `json:"budget"`
 	Currency         string   `json:"currency"`
+	BaseBudget       float64  `json:"base_budget"`
+	DisplayBudget    float64  `json:"display_budget,omitempty"`
+	DisplayCurrency  string   `json:"display_currency,omitempty"`
 	BudgetType       string   `json:"budget_type"`
 	Skills           []string `json:"skills"`
 	ExperienceLevel  string   `json:"experience_level"`
@@ -135,52 +268,249 @@ type JobPostingResp struct {
 	ExpiresAt        int64    `json:"expires_at"`
 	CreatedAt        int64    `json:"created_at"`
 	UpdatedAt        int64    `json:"updated_at"`
+	// operation the login user can do with this job posting
+	Operation []*PermissionMemberAction `json:"operation,omitempty"`
+}
+
+// ConvertFromJobPostingEntity convert job posting entity to response
+func (r *JobPostingResp) ConvertFromJobPostingEntity(posting *entity.JobPosting) {
+	var skills []string
+	_ = json.Unmarshal([]byte(posting.Skills), &skills)
+
+	r.ID = posting.ID
+	r.UserID = posting.UserID
+	r.Title = posting.Title
+	r.Description = posting.Description
+	r.DescriptionHTML = posting.DescriptionHTML
+	r.Budget = posting.Budget
+	r.Currency = posting.Currency
+	r.BaseBudget = posting.BaseBudget
+	r.BudgetType = posting.BudgetType
+	r.Skills = skills
+	r.ExperienceLevel = posting.ExperienceLevel
+	r.Duration = posting.Duration
+	r.Location = posting.Location
+	r.Status = posting.Status
+	r.ContactEmail = posting.ContactEmail
+	r.ApplicationCount = posting.ApplicationCount
+	r.ViewsCount = posting.ViewsCount
+	r.IsActive = posting.IsActive
+	r.ExpiresAt = posting.ExpiresAt.Unix()
+	r.CreatedAt = posting.CreatedAt.Unix()
+	r.UpdatedAt = posting.UpdatedAt.Unix()
 }
 
 // CreateJobPostingReq create job posting request
 type CreateJobPostingReq struct {
 	Title           string   `validate:"required" json:"title"`
 	Description     string   `validate:"required" json:"description"`
-	Budget          float64  `json:"budget"`
-	Currency        string   `json:"currency"`
+	Budget          float64  `validate:"omitempty,min=0" json:"budget"`
+	Currency        string   `validate:"omitempty,iso4217" json:"currency"`
 	BudgetType      string   `json:"budget_type"`
-	Skills          []string `json:"skills"`
+	Skills          []string `validate:"omitempty,dive,gt=0,lte=35" json:"skills"`
 	ExperienceLevel string   `json:"experience_level"`
 	Duration        string   `json:"duration"`
 	Location        string   `json:"location"`
 	ContactEmail    string   `json:"contact_email"`
 	ExpiresAt       int64    `json:"expires_at"`
+	CaptchaID       string   `json:"captcha_id"`
+	CaptchaCode     string   `json:"captcha_code"`
 	LoginUserID     string   `json:"-"`
+	CanAddTag       bool     `json:"-"`
+	IP              string   `json:"-"`
+	UserAgent       string   `json:"-"`
+}
+
+// GetJobPostingReq get job posting request
+type GetJobPostingReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+	IsAdmin     bool   `json:"-"`
+	CanEdit     bool   `json:"-"`
+	CanDelete   bool   `json:"-"`
+	CanClose    bool   `json:"-"`
+	CanReopen   bool   `json:"-"`
 }
 
 // UpdateJobPostingReq update job posting request
 type UpdateJobPostingReq struct {
-	ID              string   `validate:"required" json:"id"`
-	Title           string   `json:"title"`
-	Description     string   `json:"description"`
-	Budget          float64  `json:"budget"`
-	Currency        string   `json:"currency"`
+	ID              string   `json:"-"`
+	Title           string   `validate:"required,notblank,lte=255" json:"title"`
+	Description     string   `validate:"required,notblank" json:"description"`
+	Budget          float64  `validate:"omitempty,min=0" json:"budget"`
+	Currency        string   `validate:"omitempty,iso4217" json:"currency"`
 	BudgetType      string   `json:"budget_type"`
-	Skills          []string `json:"skills"`
+	Skills          []string `validate:"omitempty,dive,gt=0,lte=35" json:"skills"`
 	ExperienceLevel string   `json:"experience_level"`
 	Duration        string   `json:"duration"`
 	Location        string   `json:"location"`
 	ContactEmail    string   `json:"contact_email"`
-	Status          string   `json:"status"`
 	ExpiresAt       int64    `json:"expires_at"`
-	LoginUserID     string   `json:"-"`
+	// edit summary
+	EditSummary string `validate:"omitempty" json:"edit_summary"`
+	LoginUserID string `json:"-"`
+	CanEdit     bool   `json:"-"`
+	CanAddTag   bool   `json:"-"`
+	IP          string `json:"-"`
+	UserAgent   string `json:"-"`
+}
+
+// UpdateJobPostingStatusReq update job posting status request
+type UpdateJobPostingStatusReq struct {
+	ID          string `json:"-"`
+	Status      string `validate:"required,oneof=open closed filled" json:"status"`
+	LoginUserID string `json:"-"`
+	CanClose    bool   `json:"-"`
+	CanReopen   bool   `json:"-"`
+}
+
+// RenewJobPostingReq renew job posting request
+type RenewJobPostingReq struct {
+	ID string `json:"-"`
+	// new expiration timestamp, if it is 0, the max lifetime is used
+	ExpiresAt   int64  `validate:"omitempty,gte=0" json:"expires_at"`
+	LoginUserID string `json:"-"`
+}
+
+// RemoveJobPostingReq remove job posting request
+type RemoveJobPostingReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+	CanDelete   bool   `json:"-"`
 }
 
 // GetJobPostingsReq get job postings request
 type GetJobPostingsReq struct {
-	Page      int     `json:"page" form:"page"`
-	PageSize  int     `json:"page_size" form:"page_size"`
-	Skills    string  `json:"skills" form:"skills"`
-	Location  string  `json:"location" form:"location"`
-	MinBudget float64 `json:"min_budget" form:"min_budget"`
-	MaxBudget float64 `json:"max_budget" form:"max_budget"`
-	Currency  string  `json:"currency" form:"currency"`
-	Status    string  `json:"status" form:"status"`
+	Page     int `validate:"omitempty,min=1" json:"page" form:"page"`
+	PageSize int `validate:"omitempty,min=1" json:"page_size" form:"page_size"`
+	// keywords searched in the title and description
+	Query string `validate:"omitempty,lte=100" json:"q" form:"q"`
+	// comma separated skill names
+	Skills string `json:"skills" form:"skills"`
+	// the posting must require all or any of the skills, default any
+	SkillMatch      string  `validate:"omitempty,oneof=any all" json:"skill_match" form:"skill_match"`
+	Location        string  `json:"location" form:"location"`
+	MinBudget       float64 `validate:"omitempty,min=0" json:"min_budget" form:"min_budget"`
+	MaxBudget       float64 `validate:"omitempty,min=0" json:"max_budget" form:"max_budget"`
+	Currency        string  `validate:"omitempty,iso4217" json:"currency" form:"currency"`
+	Status          string  `json:"status" form:"status"`
+	ExperienceLevel string  `validate:"omitempty,oneof=entry intermediate senior expert" json:"experience_level" form:"experience_level"`
+	LocationType    string  `validate:"omitempty,oneof=remote onsite hybrid" json:"location_type" form:"location_type"`
+	// only the postings of verified freelancers and clients
+	VerifiedOnly bool `json:"verified_only" form:"verified_only"`
+	// only the postings created in the last days
+	PostedWithin int `validate:"omitempty,min=1,max=365" json:"posted_within" form:"posted_within"`
+	// budget sorts by the budget from high to low, rating by the rating of the poster
+	Sort string `validate:"omitempty,oneof=newest budget rating relevance" json:"sort" form:"sort"`
+	// the currency of min_budget and max_budget and of the displayed budgets, default the base currency of the site
+	DisplayCurrency string `validate:"omitempty,iso4217" json:"display_currency" form:"display_currency"`
+	// SkillTagIDs each element is the tag and its synonyms of a skill filter
+	SkillTagIDs [][]string `json:"-"`
+	// MinBaseBudget and MaxBaseBudget the budget filter in the base currency
+	MinBaseBudget float64 `json:"-"`
+	MaxBaseBudget float64 `json:"-"`
+}
+
+// PostedAfter the earliest creation time of the postings, zero if not limited
+func (req *GetJobPostingsReq) PostedAfter() time.Time {
+	if req.PostedWithin <= 0 {
+		return time.Time{}
+	}
+	return time.Now().AddDate(0, 0, -req.PostedWithin)
+}
+
+// Convert2PluginSearchListingCond convert to the search plugin condition
+func (req *GetJobPostingsReq) Convert2PluginSearchListingCond() *plugin.SearchListingCond {
+	cond := &plugin.SearchListingCond{
+		Page:            req.Page,
+		PageSize:        req.PageSize,
+		Type:            plugin.SearchListingTypeJobPosting,
+		Words:           strings.Fields(req.Query),
+		TagIDs:          req.SkillTagIDs,
+		MatchAllTags:    req.SkillMatch == SkillMatchAll,
+		MinAmount:       req.MinBaseBudget,
+		MaxAmount:       req.MaxBaseBudget,
+		Currency:        req.Currency,
+		ExperienceLevel: req.ExperienceLevel,
+		LocationType:    req.LocationType,
+		VerifiedOnly:    req.VerifiedOnly,
+		Status:          req.Status,
+		Order:           listingOrder(req.Sort),
+	}
+	if postedAfter := req.PostedAfter(); !postedAfter.IsZero() {
+		cond.CreatedAfter = postedAfter.Unix()
+	}
+	return cond
+}
+
+// MatchJobPosting check the job posting matches the filter in the same way as the job postings list.
+// The SkillTagIDs and the base budgets must be resolved before, tagIDs are the skill tag ids of the posting.
+func (req *GetJobPostingsReq) MatchJobPosting(posting *entity.JobPosting, tagIDs []string, posterVerified bool) bool {
+	if !posting.IsActive {
+		return false
+	}
+	if len(req.SkillTagIDs) > 0 && !matchSkillTagIDs(req.SkillTagIDs, tagIDs, req.SkillMatch == SkillMatchAll) {
+		return false
+	}
+	title, description := strings.ToLower(posting.Title), strings.ToLower(posting.Description)
+	for _, word := range strings.Fields(strings.ToLower(req.Query)) {
+		if !strings.Contains(title, word) && !strings.Contains(description, word) {
+			return false
+		}
+	}
+	if req.Location != "" && !strings.Contains(strings.ToLower(posting.Location), strings.ToLower(req.Location)) {
+		return false
+	}
+	if req.MinBudget > 0 && posting.BaseBudget < req.MinBaseBudget {
+		return false
+	}
+	if req.MaxBudget > 0 && posting.BaseBudget > req.MaxBaseBudget {
+		return false
+	}
+	if req.Currency != "" && posting.Currency != req.Currency {
+		return false
+	}
+	if req.Status != "" && posting.Status != req.Status {
+		return false
+	}
+	if req.ExperienceLevel != "" && posting.ExperienceLevel != req.ExperienceLevel {
+		return false
+	}
+	if req.LocationType != "" && posting.Location != req.LocationType {
+		return false
+	}
+	if req.VerifiedOnly && !posterVerified {
+		return false
+	}
+	if postedAfter := req.PostedAfter(); !postedAfter.IsZero() && posting.CreatedAt.Before(postedAfter) {
+		return false
+	}
+	return true
+}
+
+// matchSkillTagIDs each group is the tag and its synonyms of a skill filter,
+// the group matches if the tag ids contain any of it
+func matchSkillTagIDs(groups [][]string, tagIDs []string, matchAll bool) bool {
+	has := make(map[string]bool, len(tagIDs))
+	for _, tagID := range tagIDs {
+		has[tagID] = true
+	}
+	for _, group := range groups {
+		matched := false
+		for _, tagID := range group {
+			if has[tagID] {
+				matched = true
+				break
+			}
+		}
+		if matched && !matchAll {
+			return true
+		}
+		if !matched && matchAll {
+			return false
+		}
+	}
+	return matchAll
 }
 
 // GetJobPostingsResp get job postings response
@@ -189,27 +519,33 @@ type GetJobPostingsResp struct {
 	List  []*JobPostingResp `json:"list"`
 }
 
-// JobApplicationResp job application response
+// JobApplicationResp job application response, the base amount is in the base currency of the site,
+// the display amount is in the currency chosen by the viewer of the list
 type JobApplicationResp struct {
-	ID           string  `json:"id"`
-	JobID        string  `json:"job_id"`
-	ApplicantID  string  `json:"applicant_id"`
-	CoverLetter  string  `json:"cover_letter"`
-	ProposedRate float64 `json:"proposed_rate"`
-	Currency     string  `json:"currency"`
-	Status       string  `json:"status"`
-	Message      string  `json:"message"`
-	CreatedAt    int64   `json:"created_at"`
-	UpdatedAt    int64   `json:"updated_at"`
+	ID                  string  `json:"id"`
+	JobID               string  `json:"job_id"`
+	ApplicantID         string  `json:"applicant_id"`
+	CoverLetter         string  `json:"cover_letter"`
+	ProposedRate        float64 `json:"proposed_rate"`
+	Currency            string  `json:"currency"`
+	BaseProposedRate    float64 `json:"base_proposed_rate"`
+	DisplayProposedRate float64 `json:"display_proposed_rate,omitempty"`
+	DisplayCurrency     string  `json:"display_currency,omitempty"`
+	Status              string  `json:"status"`
+	Message             string  `json:"message"`
+	CreatedAt           int64   `json:"created_at"`
+	UpdatedAt           int64   `json:"updated_at"`
 }
 
 // CreateJobApplicationReq create job application request
 type CreateJobApplicationReq struct {
 	JobID        string  `validate:"required" json:"job_id"`
 	CoverLetter  string  `json:"cover_letter"`
-	ProposedRate float64 `json:"proposed_rate"`
-	Currency     string  `json:"currency"`
+	ProposedRate float64 `validate:"omitempty,min=0" json:"proposed_rate"`
+	Currency     string  `validate:"omitempty,iso4217" json:"currency"`
 	Message      string  `json:"message"`
+	CaptchaID    string  `json:"captcha_id"`
+	CaptchaCode  string  `json:"captcha_code"`
 	LoginUserID  string  `json:"-"`
 }
 
@@ -217,9 +553,13 @@ type CreateJobApplicationReq struct {
 type GetJobApplicationsReq struct {
 	JobID       string `json:"job_id" form:"job_id"`
 	ApplicantID string `json:"applicant_id" form:"applicant_id"`
-	Status      string `json:"status" form:"status"`
-	Page        int    `json:"page" form:"page"`
-	PageSize    int    `json:"page_size" form:"page_size"`
+	Status      string `validate:"omitempty,oneof=pending accepted rejected withdrawn" json:"status" form:"status"`
+	Page        int    `validate:"omitempty,min=1" json:"page" form:"page"`
+	PageSize    int    `validate:"omitempty,min=1" json:"page_size" form:"page_size"`
+	// the currency of the displayed proposed rates, default the base currency of the site
+	DisplayCurrency string `validate:"omitempty,iso4217" json:"display_currency" form:"display_currency"`
+	LoginUserID     string `json:"-"`
+	IsAdmin         bool   `json:"-"`
 }
 
 // GetJobApplicationsResp get job applications response
@@ -228,16 +568,283 @@ type GetJobApplicationsResp struct {
 	List  []*JobApplicationResp `json:"list"`
 }
 
+// UpdateJobApplicationStatusReq update job application status request
+type UpdateJobApplicationStatusReq struct {
+	ID          string `validate:"required" json:"id"`
+	Status      string `validate:"required,oneof=accepted rejected withdrawn" json:"status"`
+	LoginUserID string `json:"-"`
+	IsAdmin     bool   `json:"-"`
+}
+
 // HireFreelancerReq hire freelancer request
 type HireFreelancerReq struct {
 	FreelancerUserID string `validate:"required" json:"freelancer_user_id"`
 	Subject          string `validate:"required" json:"subject"`
 	Message          string `validate:"required" json:"message"`
-	LoginUserID      string `json:"-"`
+	// the job posting and the accepted application which the freelancer is hired for, optional
+	JobID         string `validate:"omitempty" json:"job_id"`
+	ApplicationID string `validate:"omitempty" json:"application_id"`
+	CaptchaID     string `json:"captcha_id"`
+	CaptchaCode   string `json:"captcha_code"`
+	LoginUserID   string `json:"-"`
 }
 
 // HireFreelancerResp hire freelancer response
 type HireFreelancerResp struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success        bool   `json:"success"`
+	Message        string `json:"message"`
+	ContractID     string `json:"contract_id"`
+	ConversationID string `json:"conversation_id"`
+}
+
+// GetRecommendedFreelancersReq get recommended freelancers of job posting request
+type GetRecommendedFreelancersReq struct {
+	JobID string `json:"-"`
+	Limit int    `validate:"omitempty,min=1,max=50" form:"limit"`
+}
+
+// RecommendedFreelancerResp recommended freelancer response
+type RecommendedFreelancerResp struct {
+	// match score from 0 to 100
+	Score float64 `json:"score"`
+	// the skills of the job posting which the freelancer has
+	MatchedSkills []string               `json:"matched_skills"`
+	Profile       *FreelancerProfileResp `json:"profile"`
+}
+
+// GetRecommendedJobsReq get recommended job postings of login user request
+type GetRecommendedJobsReq struct {
+	Limit       int    `validate:"omitempty,min=1,max=50" form:"limit"`
+	LoginUserID string `json:"-"`
+}
+
+// RecommendedJobResp recommended job posting response
+type RecommendedJobResp struct {
+	// match score from 0 to 100
+	Score float64 `json:"score"`
+	// the skills of the job posting which the freelancer has
+	MatchedSkills []string        `json:"matched_skills"`
+	Job           *JobPostingResp `json:"job"`
+}
+
+// GetSkillTagReq get freelancers and open jobs of the skill tag request
+type GetSkillTagReq struct {
+	TagName string `validate:"required,gt=0,lte=35" form:"tag_name"`
+	Limit   int    `validate:"omitempty,min=1,max=50" form:"limit"`
+}
+
+// GetSkillTagResp get freelancers and open jobs of the skill tag response
+type GetSkillTagResp struct {
+	TagID           string                   `json:"tag_id"`
+	SlugName        string                   `json:"slug_name"`
+	DisplayName     string                   `json:"display_name"`
+	FreelancerCount int64                    `json:"freelancer_count"`
+	JobPostingCount int64                    `json:"job_posting_count"`
+	Freelancers     []*FreelancerProfileResp `json:"freelancers"`
+	JobPostings     []*JobPostingResp        `json:"job_postings"`
+}
+
+// RequestFreelancerVerificationReq request freelancer verification request
+type RequestFreelancerVerificationReq struct {
+	// the urls of the evidence files uploaded with the verification_evidence source
+	Evidence    []string `validate:"required,min=1,max=10,dive,url" json:"evidence"`
+	Note        string   `validate:"omitempty,lte=2000" json:"note"`
+	LoginUserID string   `json:"-"`
+}
+
+// GetFreelancerVerificationsReq get freelancer verification requests request
+type GetFreelancerVerificationsReq struct {
+	Status   string `validate:"omitempty,oneof=pending approved rejected" form:"status"`
+	Page     int    `validate:"omitempty,min=1" form:"page"`
+	PageSize int    `validate:"omitempty,min=1" form:"page_size"`
+}
+
+// ReviewFreelancerVerificationReq approve or reject the freelancer verification request
+type ReviewFreelancerVerificationReq struct {
+	ID     string `validate:"required" json:"id"`
+	Status string `validate:"required,oneof=approved rejected" json:"status"`
+	// the reason of the rejection, from the freelancer_verification reject reasons
+	ReasonType  int    `validate:"required_if=Status rejected" json:"reason_type"`
+	ReasonMsg   string `validate:"omitempty,lte=2000" json:"reason_msg"`
+	LoginUserID string `json:"-"`
+}
+
+// FreelancerVerificationResp freelancer verification request response
+type FreelancerVerificationResp struct {
+	ID         string         `json:"id"`
+	CreatedAt  int64          `json:"created_at"`
+	User       *UserBasicInfo `json:"user"`
+	Evidence   []string       `json:"evidence"`
+	Note       string         `json:"note"`
+	Status     string         `json:"status"`
+	ReviewedAt int64          `json:"reviewed_at"`
+	Reason     *ReasonItem    `json:"reason"`
+	ReasonMsg  string         `json:"reason_msg"`
+	// the time the verification lapses, 0 if it does not lapse or is not approved
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// ConvertFromFreelancerVerificationEntity convert from freelancer verification entity
+func (r *FreelancerVerificationResp) ConvertFromFreelancerVerificationEntity(verification *entity.FreelancerVerification) {
+	r.ID = verification.ID
+	r.CreatedAt = verification.CreatedAt.Unix()
+	r.Note = verification.Note
+	r.Status = verification.Status
+	r.ReasonMsg = verification.ReasonMsg
+	r.Evidence = make([]string, 0)
+	_ = json.Unmarshal([]byte(verification.Evidence), &r.Evidence)
+	if !verification.ReviewedAt.IsZero() {
+		r.ReviewedAt = verification.ReviewedAt.Unix()
+	}
+}
+
+// SavedJobSearchMaxPerUser the max number of the saved job searches of a user
+const SavedJobSearchMaxPerUser = 20
+
+// SaveJobSearchReq save the job postings filter to be alerted of new matching postings
+type SaveJobSearchReq struct {
+	Name   string             `validate:"required,notblank,lte=100" json:"name"`
+	Filter *GetJobPostingsReq `validate:"required" json:"filter"`
+	// none only saves the filter, immediate alerts for each new posting, daily sends a digest
+	AlertFrequency string `validate:"required,oneof=none immediate daily" json:"alert_frequency"`
+	LoginUserID    string `json:"-"`
+}
+
+// UpdateSavedJobSearchReq update saved job search request
+type UpdateSavedJobSearchReq struct {
+	ID string `json:"-"`
+	SaveJobSearchReq
+}
+
+// RemoveSavedJobSearchReq remove saved job search request
+type RemoveSavedJobSearchReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// SavedJobSearchResp saved job search response
+type SavedJobSearchResp struct {
+	ID             string             `json:"id"`
+	CreatedAt      int64              `json:"created_at"`
+	Name           string             `json:"name"`
+	Filter         *GetJobPostingsReq `json:"filter"`
+	AlertFrequency string             `json:"alert_frequency"`
+}
+
+// ConvertFromSavedJobSearchEntity convert from saved job search entity
+func (r *SavedJobSearchResp) ConvertFromSavedJobSearchEntity(search *entity.SavedJobSearch) {
+	r.ID = search.ID
+	r.CreatedAt = search.CreatedAt.Unix()
+	r.Name = search.Name
+	r.AlertFrequency = search.AlertFrequency
+	r.Filter = NewSavedJobSearchFilter(search.Filter)
+}
+
+// NewSavedJobSearchFilter parse the saved job postings filter
+func NewSavedJobSearchFilter(filter string) *GetJobPostingsReq {
+	req := &GetJobPostingsReq{}
+	_ = json.Unmarshal([]byte(filter), req)
+	return req
+}
+
+// SavedJobSearchFilterToJSON the saved filter does not keep the paging
+func SavedJobSearchFilterToJSON(req *GetJobPostingsReq) string {
+	filter := *req
+	filter.Page, filter.PageSize = 0, 0
+	data, _ := json.Marshal(filter)
+	return string(data)
+}
+
+// PortfolioItemMaxPerUser the max number of the portfolio items of a freelancer
+const PortfolioItemMaxPerUser = 30
+
+// AddPortfolioItemReq add portfolio item request
+type AddPortfolioItemReq struct {
+	Title string `validate:"required,notblank,lte=150" json:"title"`
+	// the description in markdown
+	Description string `validate:"omitempty,lte=65535" json:"description"`
+	// the urls of the images and the files uploaded with the portfolio_file source
+	Images      []string `validate:"omitempty,max=10,dive,url" json:"images"`
+	Files       []string `validate:"omitempty,max=10,dive,url" json:"files"`
+	ExternalURL string   `validate:"omitempty,url,lte=512" json:"external_url"`
+	Tags        []string `validate:"omitempty,max=5,dive,gt=0,lte=35" json:"tags"`
+	// the ids of the own answers which have enough votes
+	AnswerIDs   []string `validate:"omitempty,max=10" json:"answer_ids"`
+	Sort        int      `json:"sort"`
+	LoginUserID string   `json:"-"`
+	CanAddTag   bool     `json:"-"`
+}
+
+// UpdatePortfolioItemReq update portfolio item request
+type UpdatePortfolioItemReq struct {
+	ID string `json:"-"`
+	AddPortfolioItemReq
+}
+
+// RemovePortfolioItemReq remove portfolio item request
+type RemovePortfolioItemReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+}
+
+// GetPortfolioItemsReq get portfolio items request
+type GetPortfolioItemsReq struct {
+	UserID string `validate:"required" form:"user_id"`
+}
+
+// PortfolioItemResp portfolio item response
+type PortfolioItemResp struct {
+	ID              string                 `json:"id"`
+	Title           string                 `json:"title"`
+	Description     string                 `json:"description"`
+	DescriptionHTML string                 `json:"description_html"`
+	Images          []string               `json:"images"`
+	Files           []string               `json:"files"`
+	ExternalURL     string                 `json:"external_url"`
+	Tags            []string               `json:"tags"`
+	Answers         []*PortfolioAnswerItem `json:"answers"`
+	Sort            int                    `json:"sort"`
+	CreatedAt       int64                  `json:"created_at"`
+	UpdatedAt       int64                  `json:"updated_at"`
+}
+
+// PortfolioAnswerItem the answer linked to the portfolio item
+type PortfolioAnswerItem struct {
+	ID            string `json:"id"`
+	QuestionID    string `json:"question_id"`
+	QuestionTitle string `json:"question_title"`
+	VoteCount     int    `json:"vote_count"`
+	Accepted      bool   `json:"accepted"`
+	URL           string `json:"url"`
+}
+
+// ConvertFromPortfolioItemEntity convert from portfolio item entity, the answers are filled by the caller
+func (r *PortfolioItemResp) ConvertFromPortfolioItemEntity(item *entity.FreelancerPortfolioItem) {
+	r.ID = item.ID
+	r.Title = item.Title
+	r.Description = item.Description
+	r.DescriptionHTML = item.DescriptionHTML
+	r.ExternalURL = item.ExternalURL
+	r.Sort = item.Sort
+	r.CreatedAt = item.CreatedAt.Unix()
+	r.UpdatedAt = item.UpdatedAt.Unix()
+	r.Images, r.Files = PortfolioItemFileURLs(item)
+	r.Tags = make([]string, 0)
+	_ = json.Unmarshal([]byte(item.Tags), &r.Tags)
+	r.Answers = make([]*PortfolioAnswerItem, 0)
+}
+
+// PortfolioItemFileURLs the urls of the images and the files of the portfolio item
+func PortfolioItemFileURLs(item *entity.FreelancerPortfolioItem) (images, files []string) {
+	images, files = make([]string, 0), make([]string, 0)
+	_ = json.Unmarshal([]byte(item.Images), &images)
+	_ = json.Unmarshal([]byte(item.Files), &files)
+	return images, files
+}
+
+// PortfolioItemAnswerIDs the linked answer ids of the portfolio item
+func PortfolioItemAnswerIDs(item *entity.FreelancerPortfolioItem) (answerIDs []string) {
+	answerIDs = make([]string, 0)
+	_ = json.Unmarshal([]byte(item.AnswerIDs), &answerIDs)
+	return answerIDs
 }
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetJobPostingsReq_MatchJobPosting(t *testing.T) {
+	posting := &entity.JobPosting{
+		CreatedAt:       time.Now(),
+		Title:           "Go backend developer",
+		Description:     "Build services on Kubernetes",
+		Budget:          6640,
+		Currency:        "INR",
+		BaseBudget:      80,
+		ExperienceLevel: "senior",
+		Location:        "remote",
+		Status:          entity.JobPostingStatusOpen,
+		IsActive:        true,
+	}
+	tagIDs := []string{"1", "2"}
+
+	req := &GetJobPostingsReq{
+		Query:         "kubernetes",
+		SkillTagIDs:   [][]string{{"1", "10"}, {"2"}},
+		SkillMatch:    SkillMatchAll,
+		LocationType:  "remote",
+		MinBudget:     60,
+		MinBaseBudget: 60,
+		Currency:      "INR",
+	}
+	assert.True(t, req.MatchJobPosting(posting, tagIDs, false))
+
+	req.SkillTagIDs = [][]string{{"1"}, {"3"}}
+	assert.False(t, req.MatchJobPosting(posting, tagIDs, false))
+	req.SkillMatch = SkillMatchAny
+	assert.True(t, req.MatchJobPosting(posting, tagIDs, false))
+
+	// the budget is compared in the base currency, not in the currency of the posting
+	req.MinBudget, req.MinBaseBudget = 100, 100
+	assert.False(t, req.MatchJobPosting(posting, tagIDs, false))
+	req.MinBudget, req.MinBaseBudget = 0, 0
+
+	req.Query = "kubernetes rust"
+	assert.False(t, req.MatchJobPosting(posting, tagIDs, false))
+	req.Query = ""
+
+	req.VerifiedOnly = true
+	assert.False(t, req.MatchJobPosting(posting, tagIDs, false))
+	assert.True(t, req.MatchJobPosting(posting, tagIDs, true))
+}
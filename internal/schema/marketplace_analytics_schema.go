@@ -0,0 +1,199 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+)
+
+const (
+	MarketplaceStatisticalDefaultDays = 30
+	MarketplaceStatisticalTopSkills   = 10
+
+	MarketplaceExportTypeSummary = "summary"
+	MarketplaceExportTypeJobs    = "jobs"
+)
+
+// GetMarketplaceStatisticalReq get marketplace statistical request
+type GetMarketplaceStatisticalReq struct {
+	// the statistics cover the job postings created in the last days, default 30
+	Days int `validate:"omitempty,min=1,max=365" form:"days"`
+}
+
+// GetDays get the days of the statistical period
+func (r *GetMarketplaceStatisticalReq) GetDays() int {
+	if r.Days <= 0 {
+		return MarketplaceStatisticalDefaultDays
+	}
+	return r.Days
+}
+
+// ExportMarketplaceStatisticalReq export marketplace statistical request
+type ExportMarketplaceStatisticalReq struct {
+	GetMarketplaceStatisticalReq
+	// summary: the metrics and the daily trend, jobs: one row for each job posting
+	Type string `validate:"omitempty,oneof=summary jobs" form:"type"`
+}
+
+// MarketplaceStatisticalResp marketplace statistical response
+type MarketplaceStatisticalResp struct {
+	Days         int    `json:"days"`
+	BaseCurrency string `json:"base_currency"`
+	// the number of the currently open job postings
+	PostingsOpen int64 `json:"postings_open"`
+	// the number of the job postings created, filled and expired in the period
+	PostingsCreated int64 `json:"postings_created"`
+	PostingsFilled  int64 `json:"postings_filled"`
+	PostingsExpired int64 `json:"postings_expired"`
+	// the average applications of the job postings created in the period
+	ApplicationsPerJob float64 `json:"applications_per_job"`
+	// the hours from posting to the first application, only the job postings with applications are counted
+	AvgHoursToFirstApplication    float64 `json:"avg_hours_to_first_application"`
+	MedianHoursToFirstApplication float64 `json:"median_hours_to_first_application"`
+	// the percentage of the job postings created in the period which have hired a freelancer
+	HireConversionRate        float64                    `json:"hire_conversion_rate"`
+	ActiveVerifiedFreelancers int64                      `json:"active_verified_freelancers"`
+	Trend                     []*MarketplaceTrendItem    `json:"trend"`
+	TopSkills                 []*MarketplaceSkillStat    `json:"top_skills"`
+	Jobs                      []*JobPostingAnalyticsResp `json:"-"`
+}
+
+// MarketplaceTrendItem the number of the job postings created, filled and expired in a day
+type MarketplaceTrendItem struct {
+	Date    string `json:"date"`
+	Created int64  `json:"created"`
+	Filled  int64  `json:"filled"`
+	Expired int64  `json:"expired"`
+}
+
+// MarketplaceSkillStat the demand of the skill and the median hourly rate of the freelancers with it
+type MarketplaceSkillStat struct {
+	TagID       string `json:"-"`
+	SlugName    string `json:"slug_name"`
+	DisplayName string `json:"display_name"`
+	// the number of the job postings which require the skill in the period
+	Demand int64 `json:"demand"`
+	// the median hourly rate in the base currency, 0 if no freelancer with the skill has set the rate
+	MedianRate  float64 `json:"median_rate"`
+	Freelancers int     `json:"freelancers"`
+}
+
+// GetJobPostingAnalyticsReq get job posting analytics request
+type GetJobPostingAnalyticsReq struct {
+	ID          string `json:"-"`
+	LoginUserID string `json:"-"`
+	IsAdmin     bool   `json:"-"`
+}
+
+// JobPostingAnalyticsResp job posting analytics response
+type JobPostingAnalyticsResp struct {
+	JobID        string `json:"job_id"`
+	Title        string `json:"title"`
+	Status       string `json:"status"`
+	CreatedAt    int64  `json:"created_at"`
+	Views        int    `json:"views"`
+	Applications int    `json:"applications"`
+	Pending      int    `json:"pending"`
+	Accepted     int    `json:"accepted"`
+	Rejected     int    `json:"rejected"`
+	Withdrawn    int    `json:"withdrawn"`
+	// the time of the first application, 0 if there is no application
+	FirstApplicationAt      int64                   `json:"first_application_at"`
+	HoursToFirstApplication float64                 `json:"hours_to_first_application"`
+	Funnel                  []*JobPostingFunnelStep `json:"funnel"`
+}
+
+// JobPostingFunnelStep the step of the job posting funnel,
+// rate is the percentage of the count of the previous step
+type JobPostingFunnelStep struct {
+	Step  string  `json:"step"`
+	Count int     `json:"count"`
+	Rate  float64 `json:"rate"`
+}
+
+// ConvertFromJobPosting count the analytics of the job posting by its applications
+func (r *JobPostingAnalyticsResp) ConvertFromJobPosting(posting *entity.JobPosting,
+	applications []*entity.JobApplication) {
+	r.JobID = posting.ID
+	r.Title = posting.Title
+	r.Status = posting.Status
+	r.CreatedAt = posting.CreatedAt.Unix()
+	r.Views = posting.ViewsCount
+	var first time.Time
+	for _, application := range applications {
+		if application.JobID != posting.ID {
+			continue
+		}
+		r.Applications++
+		switch application.Status {
+		case entity.JobApplicationStatusPending:
+			r.Pending++
+		case entity.JobApplicationStatusAccepted:
+			r.Accepted++
+		case entity.JobApplicationStatusRejected:
+			r.Rejected++
+		case entity.JobApplicationStatusWithdrawn:
+			r.Withdrawn++
+		}
+		if first.IsZero() || application.CreatedAt.Before(first) {
+			first = application.CreatedAt
+		}
+	}
+	if !first.IsZero() {
+		r.FirstApplicationAt = first.Unix()
+		r.HoursToFirstApplication = RoundAnalytics(math.Max(first.Sub(posting.CreatedAt).Hours(), 0))
+	}
+	r.Funnel = []*JobPostingFunnelStep{
+		{Step: "views", Count: r.Views, Rate: 100},
+		{Step: "applications", Count: r.Applications, Rate: Percentage(int64(r.Applications), int64(r.Views))},
+		{Step: "hired", Count: r.Accepted, Rate: Percentage(int64(r.Accepted), int64(r.Applications))},
+	}
+}
+
+// Percentage the percentage of part in total rounded to 2 decimals, 0 if total is 0
+func Percentage(part, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return RoundAnalytics(float64(part) / float64(total) * 100)
+}
+
+// Median the median of the values, 0 if it is empty
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return RoundAnalytics((sorted[mid-1] + sorted[mid]) / 2)
+	}
+	return RoundAnalytics(sorted[mid])
+}
+
+// RoundAnalytics round the analytics value to 2 decimals
+func RoundAnalytics(value float64) float64 {
+	return math.Round(value*100) / 100
+}
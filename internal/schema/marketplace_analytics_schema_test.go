@@ -0,0 +1,72 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobPostingAnalyticsResp_ConvertFromJobPosting(t *testing.T) {
+	createdAt := time.Now().Add(-48 * time.Hour)
+	posting := &entity.JobPosting{
+		ID:         "1",
+		Title:      "Go backend developer",
+		Status:     entity.JobPostingStatusFilled,
+		ViewsCount: 40,
+		CreatedAt:  createdAt,
+	}
+	applications := []*entity.JobApplication{
+		{JobID: "1", Status: entity.JobApplicationStatusRejected, CreatedAt: createdAt.Add(6 * time.Hour)},
+		{JobID: "1", Status: entity.JobApplicationStatusAccepted, CreatedAt: createdAt.Add(3 * time.Hour)},
+		{JobID: "1", Status: entity.JobApplicationStatusPending, CreatedAt: createdAt.Add(10 * time.Hour)},
+		{JobID: "1", Status: entity.JobApplicationStatusWithdrawn, CreatedAt: createdAt.Add(12 * time.Hour)},
+		{JobID: "2", Status: entity.JobApplicationStatusAccepted, CreatedAt: createdAt.Add(time.Hour)},
+	}
+
+	resp := &JobPostingAnalyticsResp{}
+	resp.ConvertFromJobPosting(posting, applications)
+	assert.Equal(t, 4, resp.Applications)
+	assert.Equal(t, 1, resp.Pending)
+	assert.Equal(t, 1, resp.Accepted)
+	assert.Equal(t, 1, resp.Rejected)
+	assert.Equal(t, 1, resp.Withdrawn)
+	assert.Equal(t, float64(3), resp.HoursToFirstApplication)
+	assert.Equal(t, createdAt.Add(3*time.Hour).Unix(), resp.FirstApplicationAt)
+	assert.Len(t, resp.Funnel, 3)
+	assert.Equal(t, float64(10), resp.Funnel[1].Rate)
+	assert.Equal(t, float64(25), resp.Funnel[2].Rate)
+
+	resp = &JobPostingAnalyticsResp{}
+	resp.ConvertFromJobPosting(&entity.JobPosting{ID: "3", CreatedAt: createdAt}, nil)
+	assert.Equal(t, int64(0), resp.FirstApplicationAt)
+	assert.Equal(t, float64(0), resp.Funnel[1].Rate)
+}
+
+func TestMedian(t *testing.T) {
+	assert.Equal(t, float64(0), Median(nil))
+	assert.Equal(t, float64(30), Median([]float64{50, 10, 30}))
+	assert.Equal(t, float64(25), Median([]float64{40, 10, 30, 20}))
+	assert.Equal(t, float64(33.33), Percentage(1, 3))
+	assert.Equal(t, float64(0), Percentage(1, 0))
+}
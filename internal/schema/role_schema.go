@@ -19,9 +19,107 @@
 
 package schema
 
+import (
+	"encoding/json"
+
+	"github.com/apache/answer/internal/entity"
+)
+
 // GetRoleResp get role  response
 type GetRoleResp struct {
 	ID          int    `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	// the built-in roles can not be changed or removed
+	BuiltIn bool `json:"built_in"`
+}
+
+// GetPowerResp get power response
+type GetPowerResp struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PowerType   string `json:"power_type"`
+	Description string `json:"description"`
+}
+
+// AddRoleReq add role request
+type AddRoleReq struct {
+	Name        string   `validate:"required,notblank,lte=50" json:"name"`
+	Description string   `validate:"omitempty,lte=200" json:"description"`
+	PowerTypes  []string `validate:"omitempty,dive,required,lte=100" json:"power_types"`
+	LoginUserID string   `json:"-"`
+}
+
+// UpdateRoleReq update role request
+type UpdateRoleReq struct {
+	ID          int    `validate:"required" json:"id"`
+	Name        string `validate:"required,notblank,lte=50" json:"name"`
+	Description string `validate:"omitempty,lte=200" json:"description"`
+	LoginUserID string `json:"-"`
+}
+
+// RemoveRoleReq remove role request
+type RemoveRoleReq struct {
+	ID          int    `validate:"required" json:"id"`
+	LoginUserID string `json:"-"`
+}
+
+// GetRolePowersReq get role powers request
+type GetRolePowersReq struct {
+	RoleID int `validate:"required" form:"role_id"`
+}
+
+// UpdateRolePowersReq update role powers request
+type UpdateRolePowersReq struct {
+	RoleID      int      `validate:"required" json:"role_id"`
+	PowerTypes  []string `validate:"omitempty,dive,required,lte=100" json:"power_types"`
+	LoginUserID string   `json:"-"`
+}
+
+// UpdateRoleUsersReq assign the role to the users request
+type UpdateRoleUsersReq struct {
+	RoleID      int      `validate:"required" json:"role_id"`
+	UserIDs     []string `validate:"required,gte=1,lte=100,dive,required" json:"user_ids"`
+	LoginUserID string   `json:"-"`
+}
+
+// GetRoleAuditLogsReq get role audit logs request
+type GetRoleAuditLogsReq struct {
+	RoleID   int `validate:"omitempty" form:"role_id"`
+	Page     int `validate:"omitempty,min=1" form:"page"`
+	PageSize int `validate:"omitempty,min=1" form:"page_size"`
+}
+
+// RoleAuditContent the changes recorded in the role audit log
+type RoleAuditContent struct {
+	Name           string   `json:"name,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	OldName        string   `json:"old_name,omitempty"`
+	OldDescription string   `json:"old_description,omitempty"`
+	AddedPowers    []string `json:"added_powers,omitempty"`
+	RemovedPowers  []string `json:"removed_powers,omitempty"`
+	UserIDs        []string `json:"user_ids,omitempty"`
+	// PreviousRoles the role id of each user before the role is assigned
+	PreviousRoles map[string]int `json:"previous_roles,omitempty"`
+}
+
+// RoleAuditLogResp role audit log response
+type RoleAuditLogResp struct {
+	ID         string            `json:"id"`
+	OperatorID string            `json:"operator_id"`
+	RoleID     int               `json:"role_id"`
+	Action     string            `json:"action"`
+	Content    *RoleAuditContent `json:"content"`
+	CreatedAt  int64             `json:"created_at"`
+}
+
+// ConvertFromRoleAuditLog convert from role audit log entity
+func (r *RoleAuditLogResp) ConvertFromRoleAuditLog(log *entity.RoleAuditLog) {
+	r.ID = log.ID
+	r.OperatorID = log.OperatorID
+	r.RoleID = log.RoleID
+	r.Action = log.Action
+	r.Content = &RoleAuditContent{}
+	_ = json.Unmarshal([]byte(log.Content), r.Content)
+	r.CreatedAt = log.CreatedAt.Unix()
 }
@@ -22,6 +22,7 @@ package schema
 import (
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/validator"
@@ -39,34 +40,19 @@ type SearchDTO struct {
 }
 
 func (s *SearchDTO) Check() (errField []*validator.FormErrorField, err error) {
-	// Replace special characters.
-	// Special characters will cause the search abnormal, such as search for "#" will get nearly all the content that Markdown format.
-	replacedContent, patterns := ReplaceSearchContent(s.Query)
-	s.Query = strings.Join(append(patterns, replacedContent), " ")
-
+	// The query is kept as it is, the operators such as "-" and quotes are parsed by the search parser,
+	// and the special characters of the keywords are removed by SplitSearchWord.
+	s.Query = strings.TrimSpace(s.Query)
 	return nil, nil
 }
 
-func ReplaceSearchContent(content string) (string, []string) {
-	// Define the regular expressions for key:value pairs and [tag]
-	keyValueRegex := regexp.MustCompile(`\w+:\S+`)
-	tagRegex := regexp.MustCompile(`\[\w+\]`)
-	// Define the pattern for characters to replace
-	replaceCharsPattern := regexp.MustCompile(`[+#.<>\-_()*]`)
-
-	// Extract key:value pairs
-	keyValues := keyValueRegex.FindAllString(content, -1)
-	// Extract [tag]
-	tags := tagRegex.FindAllString(content, -1)
-
-	// Replace key:value pairs and [tag] with empty string
-	contentWithoutPatterns := keyValueRegex.ReplaceAllString(content, "")
-	contentWithoutPatterns = tagRegex.ReplaceAllString(contentWithoutPatterns, "")
-
-	// Replace characters with pattern [+#.<>_()*] with space
-	replacedContent := replaceCharsPattern.ReplaceAllString(contentWithoutPatterns, " ")
+// searchSpecialCharsPattern the characters to be removed from the keywords
+var searchSpecialCharsPattern = regexp.MustCompile(`[+#.<>\-_()*]`)
 
-	return strings.TrimSpace(replacedContent), append(keyValues, tags...)
+// SplitSearchWord replaces the special characters of the keyword with space and splits it.
+// Special characters will cause the search abnormal, such as search for "#" will get nearly all the content that Markdown format.
+func SplitSearchWord(word string) []string {
+	return strings.Fields(searchSpecialCharsPattern.ReplaceAllString(word, " "))
 }
 
 type SearchCondition struct {
@@ -78,6 +64,12 @@ type SearchCondition struct {
 	VoteAmount int
 	// only show not accepted answer's question
 	NotAccepted bool
+	// only show accepted answer's question
+	HasAccepted bool
+	// only show closed question
+	Closed bool
+	// only show not closed question
+	NotClosed bool
 	// view amount
 	Views int
 	// answer count
@@ -88,8 +80,22 @@ type SearchCondition struct {
 	QuestionID string
 	// search query tags
 	Tags [][]string
-	// search query keywords
+	// the content must not have any of these tags
+	ExcludedTags []string
+	// search query keywords, flattened from the keyword groups
 	Words []string
+	// all groups must be matched, and the content matches a group if it matches any keyword of the group
+	KeywordGroups [][]plugin.SearchKeyword
+	// the content must not match any of these keywords
+	ExcludedKeywords []plugin.SearchKeyword
+	// the time range of created time and last active time, zero means unlimited
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	ActiveAfter   time.Time
+	ActiveBefore  time.Time
+	// only show these objects if HasObjectIDs is true, such as the collections of user
+	ObjectIDs    []string
+	HasObjectIDs bool
 }
 
 // SearchAll check if search all
@@ -110,16 +116,25 @@ func (s *SearchCondition) SearchAnswer() bool {
 // Convert2PluginSearchCond convert to plugin search condition
 func (s *SearchCondition) Convert2PluginSearchCond(page, pageSize int, order string) *plugin.SearchBasicCond {
 	basic := &plugin.SearchBasicCond{
-		Page:         page,
-		PageSize:     pageSize,
-		Words:        s.Words,
-		TagIDs:       s.Tags,
-		UserID:       s.UserID,
-		Order:        plugin.SearchOrderCond(order),
-		QuestionID:   s.QuestionID,
-		VoteAmount:   s.VoteAmount,
-		ViewAmount:   s.Views,
-		AnswerAmount: s.AnswerAmount,
+		Page:             page,
+		PageSize:         pageSize,
+		Words:            s.Words,
+		KeywordGroups:    s.KeywordGroups,
+		ExcludedKeywords: s.ExcludedKeywords,
+		TagIDs:           s.Tags,
+		ExcludedTagIDs:   s.ExcludedTags,
+		UserID:           s.UserID,
+		Order:            plugin.SearchOrderCond(order),
+		CreatedAfter:     unixTimestamp(s.CreatedAfter),
+		CreatedBefore:    unixTimestamp(s.CreatedBefore),
+		ActiveAfter:      unixTimestamp(s.ActiveAfter),
+		ActiveBefore:     unixTimestamp(s.ActiveBefore),
+		ObjectIDs:        s.ObjectIDs,
+		HasObjectIDs:     s.HasObjectIDs,
+		QuestionID:       s.QuestionID,
+		VoteAmount:       s.VoteAmount,
+		ViewAmount:       s.Views,
+		AnswerAmount:     s.AnswerAmount,
 	}
 	if s.Accepted {
 		basic.AnswerAccepted = plugin.AcceptedCondTrue
@@ -128,12 +143,29 @@ func (s *SearchCondition) Convert2PluginSearchCond(page, pageSize int, order str
 	}
 	if s.NotAccepted {
 		basic.QuestionAccepted = plugin.AcceptedCondFalse
+	} else if s.HasAccepted {
+		basic.QuestionAccepted = plugin.AcceptedCondTrue
 	} else {
 		basic.QuestionAccepted = plugin.AcceptedCondAll
 	}
+	if s.Closed {
+		basic.QuestionClosed = plugin.ClosedCondTrue
+	} else if s.NotClosed {
+		basic.QuestionClosed = plugin.ClosedCondFalse
+	} else {
+		basic.QuestionClosed = plugin.ClosedCondAll
+	}
 	return basic
 }
 
+// unixTimestamp returns zero for the zero time, which means unlimited for the plugin
+func unixTimestamp(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
 type SearchObject struct {
 	ID              string `json:"id"`
 	QuestionID      string `json:"question_id"`
@@ -183,6 +215,27 @@ type SearchResp struct {
 	SearchResults []*SearchResult `json:"list"`
 }
 
+// SearchSuggestReq search-as-you-type request
+type SearchSuggestReq struct {
+	Query string `validate:"required,gte=1,lte=60" form:"q"`
+	Size  int    `validate:"omitempty,min=1,max=10" form:"size,default=10"`
+}
+
+// SearchSuggestResp is a question, tag or user suggested for the query
+type SearchSuggestResp struct {
+	// object type: question, tag or user
+	ObjectType string `json:"object_type"`
+	ID         string `json:"id"`
+	// question title, tag display name or user display name
+	Title string `json:"title"`
+	// question url title, tag slug name or username
+	Slug string `json:"slug"`
+	// only for user
+	Avatar string `json:"avatar,omitempty"`
+	// question view count, tag question count or user rank
+	Popularity int `json:"popularity"`
+}
+
 type SearchDescResp struct {
 	Name string `json:"name"`
 	Icon string `json:"icon"`
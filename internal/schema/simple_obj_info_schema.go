@@ -26,18 +26,20 @@ import (
 
 // SimpleObjectInfo simple object info
 type SimpleObjectInfo struct {
-	ObjectID            string `json:"object_id"`
-	ObjectCreatorUserID string `json:"object_creator_user_id"`
-	QuestionID          string `json:"question_id"`
-	QuestionStatus      int    `json:"question_status"`
-	AnswerID            string `json:"answer_id"`
-	AnswerStatus        int    `json:"answer_status"`
-	CommentID           string `json:"comment_id"`
-	CommentStatus       int    `json:"comment_status"`
-	TagID               string `json:"tag_id"`
-	ObjectType          string `json:"object_type"`
-	Title               string `json:"title"`
-	Content             string `json:"content"`
+	ObjectID             string `json:"object_id"`
+	ObjectCreatorUserID  string `json:"object_creator_user_id"`
+	QuestionID           string `json:"question_id"`
+	QuestionStatus       int    `json:"question_status"`
+	AnswerID             string `json:"answer_id"`
+	AnswerStatus         int    `json:"answer_status"`
+	CommentID            string `json:"comment_id"`
+	CommentStatus        int    `json:"comment_status"`
+	TagID                string `json:"tag_id"`
+	ContractReviewID     string `json:"contract_review_id"`
+	ContractReviewStatus int    `json:"contract_review_status"`
+	ObjectType           string `json:"object_type"`
+	Title                string `json:"title"`
+	Content              string `json:"content"`
 }
 
 // IsDeleted is deleted
@@ -49,6 +51,8 @@ func (s *SimpleObjectInfo) IsDeleted() bool {
 		return s.AnswerStatus == entity.AnswerStatusDeleted
 	case constant.CommentObjectType:
 		return s.CommentStatus == entity.CommentStatusDeleted
+	case constant.ContractReviewObjectType:
+		return s.ContractReviewStatus == entity.ContractReviewStatusDeleted
 	}
 	return false
 }
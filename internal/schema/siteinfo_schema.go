@@ -156,6 +156,27 @@ type SiteUsersReq struct {
 	AllowUpdateLocation    bool   `json:"allow_update_location"`
 }
 
+// SiteJobReq site job posting config request
+type SiteJobReq struct {
+	// notify the poster this many days before the job posting expires, 0 means no notice
+	ExpiryNoticeDays int `validate:"omitempty,gte=0,lte=365" json:"expiry_notice_days"`
+	// the longest days a job posting can stay open after it is created or renewed
+	MaxLifetimeDays int `validate:"required,gte=1,lte=3650" json:"max_lifetime_days"`
+	// the freelancer verification lapses this many days after it is approved, 0 means it never lapses
+	VerificationValidDays int `validate:"omitempty,gte=0,lte=3650" json:"verification_valid_days"`
+	// the least votes of the answer which the freelancer links to the portfolio
+	PortfolioAnswerMinVotes int `validate:"omitempty,gte=0,lte=10000" json:"portfolio_answer_min_votes"`
+}
+
+// SiteCurrencyReq site currency config request
+type SiteCurrencyReq struct {
+	// the ISO 4217 code of the currency which all amounts are normalized to
+	BaseCurrency string `validate:"required,iso4217" json:"base_currency"`
+	// the static exchange rates, currency code -> how many units of it one unit of the base currency is worth,
+	// they are used when no exchange rate plugin is enabled
+	Rates map[string]float64 `validate:"omitempty,max=200,dive,keys,iso4217,endkeys,gt=0" json:"rates"`
+}
+
 // SiteLoginReq site login request
 type SiteLoginReq struct {
 	AllowNewRegistrations   bool     `json:"allow_new_registrations"`
@@ -209,6 +230,12 @@ type SiteCustomCssHTMLResp SiteCustomCssHTMLReq
 // SiteUsersResp site users response
 type SiteUsersResp SiteUsersReq
 
+// SiteJobResp site job posting config response
+type SiteJobResp SiteJobReq
+
+// SiteCurrencyResp site currency config response
+type SiteCurrencyResp SiteCurrencyReq
+
 // SiteThemeResp site theme response
 type SiteThemeResp struct {
 	ThemeOptions []*ThemeOption         `json:"theme_options"`
@@ -433,6 +460,9 @@ var (
 		constant.RankTagAuditKey:                  {1, 2500, 5000},
 		constant.RankTagEditWithoutReviewKey:      {1, 10000, 20000},
 		constant.RankTagSynonymKey:                {1, 10000, 20000},
+		constant.RankJobPostingAddKey:             {1, 50, 100},
+		constant.RankJobApplicationAddKey:         {1, 10, 20},
+		constant.RankFreelancerHireKey:            {1, 50, 100},
 	}
 )
 
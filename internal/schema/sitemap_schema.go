@@ -33,3 +33,13 @@ type SiteMapQuestionInfo struct {
 	Title      string `json:"title"`
 	UpdateTime string `json:"time"`
 }
+
+type SiteMapJobPostingInfo struct {
+	ID         string `json:"id"`
+	UpdateTime string `json:"time"`
+}
+
+type SiteMapFreelancerInfo struct {
+	Username   string `json:"username"`
+	UpdateTime string `json:"time"`
+}
@@ -74,3 +74,54 @@ type SuggestedAnswerItem struct {
 		Name string `json:"name"`
 	} `json:"author"`
 }
+
+type JobPostingJsonLD struct {
+	Context            string     `json:"@context"`
+	Type               string     `json:"@type"`
+	Title              string     `json:"title"`
+	Description        string     `json:"description"`
+	DatePosted         time.Time  `json:"datePosted"`
+	ValidThrough       *time.Time `json:"validThrough,omitempty"`
+	URL                string     `json:"url"`
+	HiringOrganization struct {
+		Type   string `json:"@type"`
+		Name   string `json:"name"`
+		SameAs string `json:"sameAs"`
+	} `json:"hiringOrganization"`
+	JobLocationType        string              `json:"jobLocationType,omitempty"`
+	JobLocation            *JobLocationItem    `json:"jobLocation,omitempty"`
+	BaseSalary             *MonetaryAmountItem `json:"baseSalary,omitempty"`
+	Skills                 string              `json:"skills,omitempty"`
+	ExperienceRequirements string              `json:"experienceRequirements,omitempty"`
+}
+
+type JobLocationItem struct {
+	Type    string `json:"@type"`
+	Address struct {
+		Type            string `json:"@type"`
+		AddressLocality string `json:"addressLocality"`
+	} `json:"address"`
+}
+
+type MonetaryAmountItem struct {
+	Type     string `json:"@type"`
+	Currency string `json:"currency"`
+	Value    struct {
+		Type     string  `json:"@type"`
+		Value    float64 `json:"value"`
+		UnitText string  `json:"unitText,omitempty"`
+	} `json:"value"`
+}
+
+type PersonJsonLD struct {
+	Context       string   `json:"@context"`
+	Type          string   `json:"@type"`
+	Name          string   `json:"name"`
+	AlternateName string   `json:"alternateName"`
+	URL           string   `json:"url"`
+	Image         string   `json:"image,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	KnowsAbout    []string `json:"knowsAbout,omitempty"`
+	KnowsLanguage []string `json:"knowsLanguage,omitempty"`
+	SameAs        []string `json:"sameAs,omitempty"`
+}
@@ -28,6 +28,23 @@ import (
 type NotificationChannelConfig struct {
 	Key    constant.NotificationChannelKey `json:"key"`
 	Enable bool                            `json:"enable"`
+	// immediate, daily or weekly, only the email of the inbox and the following tags questions can be digested
+	Frequency constant.NotificationFrequency `validate:"omitempty,oneof=immediate daily weekly" json:"frequency,omitempty"`
+}
+
+// IsDigest whether the channel is collected into the daily or weekly digest instead of being sent immediately
+func (n *NotificationChannelConfig) IsDigest() bool {
+	return n.Frequency == constant.NotificationFrequencyDaily || n.Frequency == constant.NotificationFrequencyWeekly
+}
+
+func (n *NotificationChannelConfig) formatFrequency(digestible bool) {
+	if !digestible {
+		n.Frequency = ""
+		return
+	}
+	if !n.IsDigest() {
+		n.Frequency = constant.NotificationFrequencyImmediate
+	}
 }
 
 type NotificationChannels []*NotificationChannelConfig
@@ -56,6 +73,7 @@ type NotificationConfig struct {
 	Inbox                          NotificationChannelConfig `json:"inbox"`
 	AllNewQuestion                 NotificationChannelConfig `json:"all_new_question"`
 	AllNewQuestionForFollowingTags NotificationChannelConfig `json:"all_new_question_for_following_tags"`
+	SavedJobSearch                 NotificationChannelConfig `json:"saved_job_search"`
 }
 
 func NewNotificationConfig(configs []*entity.UserNotificationConfig) NotificationConfig {
@@ -68,6 +86,8 @@ func NewNotificationConfig(configs []*entity.UserNotificationConfig) Notificatio
 			nc.AllNewQuestion = NewNotificationChannelConfigFormJson(item.Channels)
 		case string(constant.AllNewQuestionForFollowingTagsSource):
 			nc.AllNewQuestionForFollowingTags = NewNotificationChannelConfigFormJson(item.Channels)
+		case string(constant.SavedJobSearchSource):
+			nc.SavedJobSearch = NewNotificationChannelConfigFormJson(item.Channels)
 		}
 	}
 	return nc
@@ -86,6 +106,14 @@ func (n *NotificationConfig) Format() {
 		n.AllNewQuestionForFollowingTags.Key = constant.EmailChannel
 		n.AllNewQuestionForFollowingTags.Enable = false
 	}
+	if n.SavedJobSearch.Key == "" {
+		n.SavedJobSearch.Key = constant.EmailChannel
+		n.SavedJobSearch.Enable = false
+	}
+	n.Inbox.formatFrequency(true)
+	n.AllNewQuestion.formatFrequency(false)
+	n.AllNewQuestionForFollowingTags.formatFrequency(true)
+	n.SavedJobSearch.formatFrequency(false)
 }
 
 // UpdateUserNotificationConfigReq update user notification config request
@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationConfig_FormatFrequency(t *testing.T) {
+	nc := NotificationConfig{
+		Inbox:          NotificationChannelConfig{Key: constant.EmailChannel, Enable: true, Frequency: constant.NotificationFrequencyWeekly},
+		AllNewQuestion: NotificationChannelConfig{Key: constant.EmailChannel, Enable: true, Frequency: constant.NotificationFrequencyDaily},
+	}
+	nc.Format()
+
+	assert.True(t, nc.Inbox.IsDigest())
+	assert.Equal(t, constant.NotificationFrequencyWeekly, nc.Inbox.Frequency)
+	// only the inbox and the following tags questions can be digested
+	assert.False(t, nc.AllNewQuestion.IsDigest())
+	assert.Empty(t, nc.AllNewQuestion.Frequency)
+	assert.Equal(t, constant.NotificationFrequencyImmediate, nc.AllNewQuestionForFollowingTags.Frequency)
+	assert.False(t, nc.AllNewQuestionForFollowingTags.Enable)
+}
+
+func TestNewNotificationConfig_Frequency(t *testing.T) {
+	nc := NewNotificationConfig([]*entity.UserNotificationConfig{
+		{Source: string(constant.InboxSource), Channels: `[{"key":"email","enable":true}]`},
+		{Source: string(constant.AllNewQuestionForFollowingTagsSource), Channels: `[{"key":"email","enable":true,"frequency":"daily"}]`},
+	})
+	nc.Format()
+
+	assert.False(t, nc.Inbox.IsDigest())
+	assert.Equal(t, constant.NotificationFrequencyImmediate, nc.Inbox.Frequency)
+	assert.True(t, nc.AllNewQuestionForFollowingTags.IsDigest())
+}
+
+func TestNotificationDigestTemplateRawData_IsEmpty(t *testing.T) {
+	raw := &NotificationDigestTemplateRawData{Frequency: constant.NotificationFrequencyDaily}
+	assert.True(t, raw.IsEmpty())
+	raw.Badges = append(raw.Badges, &NotificationDigestItem{Title: "Nice Question", BadgeID: "1"})
+	assert.False(t, raw.IsEmpty())
+}
@@ -0,0 +1,180 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package schema
+
+import (
+	"encoding/json"
+
+	"github.com/apache/answer/internal/entity"
+)
+
+const (
+	// WebhookEventPing the event sent by the test-ping of the webhook
+	WebhookEventPing = "ping"
+)
+
+// AddWebhookReq add webhook request
+type AddWebhookReq struct {
+	Name string `validate:"required,notblank,lte=100" json:"name"`
+	URL  string `validate:"required,url,lte=512" json:"url"`
+	// the secret is generated if it is empty
+	Secret string `validate:"omitempty,lte=256" json:"secret"`
+	// the subscribed event types, empty means all the events
+	Events   []string `validate:"omitempty,dive,required,lte=50" json:"events"`
+	IsActive bool     `json:"is_active"`
+}
+
+// UpdateWebhookReq update webhook request
+type UpdateWebhookReq struct {
+	ID   string `validate:"required" json:"id"`
+	Name string `validate:"required,notblank,lte=100" json:"name"`
+	URL  string `validate:"required,url,lte=512" json:"url"`
+	// the secret is kept if it is empty
+	Secret   string   `validate:"omitempty,lte=256" json:"secret"`
+	Events   []string `validate:"omitempty,dive,required,lte=50" json:"events"`
+	IsActive bool     `json:"is_active"`
+}
+
+// RemoveWebhookReq remove webhook request
+type RemoveWebhookReq struct {
+	ID string `validate:"required" json:"id"`
+}
+
+// PingWebhookReq send the ping event to the webhook request
+type PingWebhookReq struct {
+	ID string `validate:"required" json:"id"`
+}
+
+// WebhookResp webhook response
+type WebhookResp struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// the secret is only returned when it is generated
+	Secret    string `json:"secret,omitempty"`
+	IsActive  bool   `json:"is_active"`
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+}
+
+// ConvertFromWebhook convert from webhook entity
+func (r *WebhookResp) ConvertFromWebhook(webhook *entity.Webhook) {
+	r.ID = webhook.ID
+	r.Name = webhook.Name
+	r.URL = webhook.URL
+	r.Events = make([]string, 0)
+	if len(webhook.Events) > 0 {
+		_ = json.Unmarshal([]byte(webhook.Events), &r.Events)
+	}
+	r.IsActive = webhook.IsActive
+	r.CreatedAt = webhook.CreatedAt.Unix()
+	r.UpdatedAt = webhook.UpdatedAt.Unix()
+}
+
+// GetWebhookDeliveriesReq get webhook deliveries request
+type GetWebhookDeliveriesReq struct {
+	WebhookID string `validate:"omitempty" form:"webhook_id"`
+	Status    string `validate:"omitempty,oneof=pending success failed" form:"status"`
+	Page      int    `validate:"omitempty,min=1" form:"page"`
+	PageSize  int    `validate:"omitempty,min=1" form:"page_size"`
+}
+
+// RedeliverWebhookReq redeliver webhook delivery request
+type RedeliverWebhookReq struct {
+	ID string `validate:"required" json:"id"`
+}
+
+// WebhookDeliveryResp webhook delivery response
+type WebhookDeliveryResp struct {
+	ID           string `json:"id"`
+	WebhookID    string `json:"webhook_id"`
+	EventType    string `json:"event_type"`
+	Payload      string `json:"payload"`
+	Status       string `json:"status"`
+	Attempts     int    `json:"attempts"`
+	ResponseCode int    `json:"response_code"`
+	ResponseBody string `json:"response_body"`
+	Error        string `json:"error"`
+	DurationMs   int64  `json:"duration_ms"`
+	RedeliveryOf string `json:"redelivery_of"`
+	CreatedAt    int64  `json:"created_at"`
+	DeliveredAt  int64  `json:"delivered_at"`
+}
+
+// ConvertFromWebhookDelivery convert from webhook delivery entity
+func (r *WebhookDeliveryResp) ConvertFromWebhookDelivery(delivery *entity.WebhookDelivery) {
+	r.ID = delivery.ID
+	r.WebhookID = delivery.WebhookID
+	r.EventType = delivery.EventType
+	r.Payload = delivery.Payload
+	r.Status = delivery.Status
+	r.Attempts = delivery.Attempts
+	r.ResponseCode = delivery.ResponseCode
+	r.ResponseBody = delivery.ResponseBody
+	r.Error = delivery.Error
+	r.DurationMs = delivery.DurationMs
+	if delivery.RedeliveryOf != "0" {
+		r.RedeliveryOf = delivery.RedeliveryOf
+	}
+	r.CreatedAt = delivery.CreatedAt.Unix()
+	if !delivery.DeliveredAt.IsZero() {
+		r.DeliveredAt = delivery.DeliveredAt.Unix()
+	}
+}
+
+// WebhookDeliveryMsg the message of the webhook delivery queue
+type WebhookDeliveryMsg struct {
+	DeliveryID string `json:"delivery_id"`
+}
+
+// WebhookPayload the JSON body posted to the webhook
+type WebhookPayload struct {
+	Event     string            `json:"event"`
+	Timestamp int64             `json:"timestamp"`
+	Data      *WebhookEventData `json:"data"`
+}
+
+// WebhookEventData the objects of the event
+type WebhookEventData struct {
+	WebhookID       string            `json:"webhook_id,omitempty"`
+	UserID          string            `json:"user_id,omitempty"`
+	TriggerObjectID string            `json:"trigger_object_id,omitempty"`
+	QuestionID      string            `json:"question_id,omitempty"`
+	QuestionUserID  string            `json:"question_user_id,omitempty"`
+	AnswerID        string            `json:"answer_id,omitempty"`
+	AnswerUserID    string            `json:"answer_user_id,omitempty"`
+	CommentID       string            `json:"comment_id,omitempty"`
+	CommentUserID   string            `json:"comment_user_id,omitempty"`
+	ExtraInfo       map[string]string `json:"extra_info,omitempty"`
+}
+
+// ConvertFromEventMsg convert from the event message
+func (d *WebhookEventData) ConvertFromEventMsg(msg *EventMsg) {
+	d.UserID = msg.UserID
+	d.TriggerObjectID = msg.TriggerObjectID
+	d.QuestionID = msg.QuestionID
+	d.QuestionUserID = msg.QuestionUserID
+	d.AnswerID = msg.AnswerID
+	d.AnswerUserID = msg.AnswerUserID
+	d.CommentID = msg.CommentID
+	d.CommentUserID = msg.CommentUserID
+	d.ExtraInfo = msg.ExtraInfo
+}
@@ -78,6 +78,12 @@ func (cs *CaptchaService) ActionRecord(ctx context.Context, req *schema.ActionRe
 		unit = req.UserID
 	case entity.CaptchaActionVote:
 		unit = req.UserID
+	case entity.CaptchaActionJobPosting:
+		unit = req.UserID
+	case entity.CaptchaActionJobApplication:
+		unit = req.UserID
+	case entity.CaptchaActionHire:
+		unit = req.UserID
 	}
 	verificationResult := cs.ValidationStrategy(ctx, unit, req.Action)
 	if !verificationResult {
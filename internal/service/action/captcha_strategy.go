@@ -67,6 +67,12 @@ func (cs *CaptchaService) ValidationStrategy(ctx context.Context, unit, actionTy
 		return cs.CaptchaActionDelete(ctx, unit, info)
 	case entity.CaptchaActionVote:
 		return cs.CaptchaActionVote(ctx, unit, info)
+	case entity.CaptchaActionJobPosting:
+		return cs.CaptchaActionJobPosting(ctx, unit, info)
+	case entity.CaptchaActionJobApplication:
+		return cs.CaptchaActionJobApplication(ctx, unit, info)
+	case entity.CaptchaActionHire:
+		return cs.CaptchaActionHire(ctx, unit, info)
 
 	}
 	//actionType not found
@@ -223,3 +229,42 @@ func (cs *CaptchaService) CaptchaActionVote(ctx context.Context, unit string, ac
 	}
 	return true
 }
+
+func (cs *CaptchaService) CaptchaActionJobPosting(ctx context.Context, unit string, actionInfo *entity.ActionRecordInfo) bool {
+	if actionInfo == nil {
+		return true
+	}
+	setNum := 3
+	setTime := int64(60) //seconds
+	now := time.Now().Unix()
+	if now-actionInfo.LastTime <= setTime || actionInfo.Num >= setNum {
+		return false
+	}
+	return true
+}
+
+func (cs *CaptchaService) CaptchaActionJobApplication(ctx context.Context, unit string, actionInfo *entity.ActionRecordInfo) bool {
+	if actionInfo == nil {
+		return true
+	}
+	setNum := 10
+	setTime := int64(10) //seconds
+	now := time.Now().Unix()
+	if now-actionInfo.LastTime <= setTime || actionInfo.Num >= setNum {
+		return false
+	}
+	return true
+}
+
+func (cs *CaptchaService) CaptchaActionHire(ctx context.Context, unit string, actionInfo *entity.ActionRecordInfo) bool {
+	if actionInfo == nil {
+		return true
+	}
+	setNum := 3
+	setTime := int64(60) //seconds
+	now := time.Now().Unix()
+	if now-actionInfo.LastTime <= setTime || actionInfo.Num >= setNum {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,159 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCaptchaRepo struct {
+	mock.Mock
+}
+
+func (m *MockCaptchaRepo) SetCaptcha(ctx context.Context, key string, captcha string) error {
+	args := m.Called(ctx, key, captcha)
+	return args.Error(0)
+}
+
+func (m *MockCaptchaRepo) GetCaptcha(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCaptchaRepo) DelCaptcha(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockCaptchaRepo) SetActionType(ctx context.Context, unit string, actionType string, config string, amount int) error {
+	args := m.Called(ctx, unit, actionType, config, amount)
+	return args.Error(0)
+}
+
+func (m *MockCaptchaRepo) GetActionType(ctx context.Context, unit string, actionType string) (*entity.ActionRecordInfo, error) {
+	args := m.Called(ctx, unit, actionType)
+	return args.Get(0).(*entity.ActionRecordInfo), args.Error(1)
+}
+
+func (m *MockCaptchaRepo) DelActionType(ctx context.Context, unit string, actionType string) error {
+	args := m.Called(ctx, unit, actionType)
+	return args.Error(0)
+}
+
+// testCaptcha the captcha whose code is always "1234", it is registered but only enabled by the tests which use it
+type testCaptcha struct{}
+
+const testCaptchaSlugName = "test_captcha"
+
+func (c *testCaptcha) Info() plugin.Info {
+	return plugin.Info{SlugName: testCaptchaSlugName}
+}
+
+func (c *testCaptcha) GetConfig() (configJsonStr string) {
+	return ""
+}
+
+func (c *testCaptcha) Create() (captcha, code string) {
+	return "", "1234"
+}
+
+func (c *testCaptcha) Verify(captchaCode, userInput string) (pass bool) {
+	return userInput == "1234"
+}
+
+func init() {
+	plugin.Register(&testCaptcha{})
+}
+
+func enableTestCaptcha(t *testing.T) {
+	plugin.StatusManager.Enable(testCaptchaSlugName, true)
+	t.Cleanup(func() {
+		plugin.StatusManager.Enable(testCaptchaSlugName, false)
+	})
+}
+
+func TestValidationStrategyFreelancerActions(t *testing.T) {
+	ctx := context.Background()
+	enableTestCaptcha(t)
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name       string
+		actionType string
+		info       *entity.ActionRecordInfo
+		pass       bool
+	}{
+		{"first_job_posting", entity.CaptchaActionJobPosting, nil, true},
+		{"job_posting_within_a_minute", entity.CaptchaActionJobPosting, &entity.ActionRecordInfo{LastTime: now - 30, Num: 1}, false},
+		{"job_posting_after_a_minute", entity.CaptchaActionJobPosting, &entity.ActionRecordInfo{LastTime: now - 120, Num: 2}, true},
+		{"too_many_job_postings", entity.CaptchaActionJobPosting, &entity.ActionRecordInfo{LastTime: now - 120, Num: 3}, false},
+		{"first_job_application", entity.CaptchaActionJobApplication, nil, true},
+		{"job_application_within_seconds", entity.CaptchaActionJobApplication, &entity.ActionRecordInfo{LastTime: now - 5, Num: 1}, false},
+		{"job_application_after_seconds", entity.CaptchaActionJobApplication, &entity.ActionRecordInfo{LastTime: now - 20, Num: 9}, true},
+		{"too_many_job_applications", entity.CaptchaActionJobApplication, &entity.ActionRecordInfo{LastTime: now - 20, Num: 10}, false},
+		{"first_hire", entity.CaptchaActionHire, nil, true},
+		{"hire_within_a_minute", entity.CaptchaActionHire, &entity.ActionRecordInfo{LastTime: now - 30, Num: 1}, false},
+		{"too_many_hires", entity.CaptchaActionHire, &entity.ActionRecordInfo{LastTime: now - 120, Num: 3}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCaptchaRepo := new(MockCaptchaRepo)
+			mockCaptchaRepo.On("GetActionType", ctx, "user", tt.actionType).Return(tt.info, nil)
+			cs := NewCaptchaService(mockCaptchaRepo)
+			assert.Equal(t, tt.pass, cs.ValidationStrategy(ctx, "user", tt.actionType))
+		})
+	}
+}
+
+func TestActionRecordVerifyCaptchaJobPosting(t *testing.T) {
+	ctx := context.Background()
+	newService := func() *CaptchaService {
+		mockCaptchaRepo := new(MockCaptchaRepo)
+		mockCaptchaRepo.On("GetActionType", ctx, "user", entity.CaptchaActionJobPosting).Return(
+			&entity.ActionRecordInfo{LastTime: time.Now().Unix(), Num: 1}, nil)
+		mockCaptchaRepo.On("GetCaptcha", ctx, mock.Anything).Return("1234", nil)
+		mockCaptchaRepo.On("DelCaptcha", ctx, mock.Anything).Return(nil)
+		return NewCaptchaService(mockCaptchaRepo)
+	}
+
+	t.Run("captcha_is_not_required_without_plugin", func(t *testing.T) {
+		assert.True(t, newService().ActionRecordVerifyCaptcha(ctx, entity.CaptchaActionJobPosting, "user", "", ""))
+	})
+
+	enableTestCaptcha(t)
+	t.Run("missing_captcha", func(t *testing.T) {
+		assert.False(t, newService().ActionRecordVerifyCaptcha(ctx, entity.CaptchaActionJobPosting, "user", "", ""))
+	})
+	t.Run("wrong_captcha", func(t *testing.T) {
+		assert.False(t, newService().ActionRecordVerifyCaptcha(ctx, entity.CaptchaActionJobPosting, "user",
+			"captcha-id", "0000"))
+	})
+	t.Run("correct_captcha", func(t *testing.T) {
+		assert.True(t, newService().ActionRecordVerifyCaptcha(ctx, entity.CaptchaActionJobPosting, "user",
+			"captcha-id", "1234"))
+	})
+}
@@ -54,6 +54,7 @@ type BadgeAwardRepo interface {
 	GetByUserIdAndBadgeIdAndAwardKey(ctx context.Context, userID string, badgeID string, awardKey string) (badgeAward *entity.BadgeAward, exists bool, err error)
 
 	DeleteUserBadgeAward(ctx context.Context, userID string) (err error)
+	DeleteUserBadgeAwardByBadgeID(ctx context.Context, userID string, badgeID string) (err error)
 }
 
 type BadgeAwardService struct {
@@ -189,6 +190,30 @@ func (bs *BadgeAwardService) Award(ctx context.Context, badgeID string, userID s
 	return nil
 }
 
+// AwardByHandler award the badge with the handler, nothing happens if the badge is not available
+func (bs *BadgeAwardService) AwardByHandler(ctx context.Context, handler string, userID string, awardKey string) (err error) {
+	badgeData, exists, err := bs.badgeRepo.GetByHandler(ctx, handler)
+	if err != nil {
+		return err
+	}
+	if !exists || badgeData.Status != entity.BadgeStatusActive {
+		return nil
+	}
+	return bs.Award(ctx, badgeData.ID, userID, awardKey)
+}
+
+// RevokeByHandler revoke the badge with the handler from the user, so that it can be awarded again later
+func (bs *BadgeAwardService) RevokeByHandler(ctx context.Context, handler string, userID string) (err error) {
+	badgeData, exists, err := bs.badgeRepo.GetByHandler(ctx, handler)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	return bs.badgeAwardRepo.DeleteUserBadgeAwardByBadgeID(ctx, userID, badgeData.ID)
+}
+
 // GetUserBadgeAwardList get user badge award list
 func (bs *BadgeAwardService) GetUserBadgeAwardList(
 	ctx *gin.Context,
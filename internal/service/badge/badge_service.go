@@ -38,6 +38,7 @@ import (
 type BadgeRepo interface {
 	GetByID(ctx context.Context, id string) (badge *entity.Badge, exists bool, err error)
 	GetByIDs(ctx context.Context, ids []string) (badges []*entity.Badge, err error)
+	GetByHandler(ctx context.Context, handler string) (badge *entity.Badge, exists bool, err error)
 
 	ListPaged(ctx context.Context, page int, pageSize int) (badges []*entity.Badge, total int64, err error)
 	ListActivated(ctx context.Context, page int, pageSize int) (badges []*entity.Badge, total int64, err error)
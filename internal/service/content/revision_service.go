@@ -425,6 +425,7 @@ func (rs *RevisionService) parseItem(ctx context.Context, item *schema.GetRevisi
 		answerInfo   *schema.AnswerInfo
 		tag          entity.Tag
 		tagInfo      *schema.GetTagResp
+		jobPosting   entity.JobPosting
 	)
 
 	shortID := handler.GetEnableShortID(ctx)
@@ -473,6 +474,14 @@ func (rs *RevisionService) parseItem(ctx context.Context, item *schema.GetRevisi
 		}
 		tagInfo.GetExcerpt()
 		item.ContentParsed = tagInfo
+	case constant.ObjectTypeStrMapping[constant.JobPostingObjectType]:
+		err = json.Unmarshal([]byte(item.Content), &jobPosting)
+		if err != nil {
+			break
+		}
+		jobPostingInfo := &schema.JobPostingResp{}
+		jobPostingInfo.ConvertFromJobPostingEntity(&jobPosting)
+		item.ContentParsed = jobPostingInfo
 	}
 
 	if err != nil {
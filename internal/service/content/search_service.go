@@ -21,25 +21,48 @@ package content
 
 import (
 	"context"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
 
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/handler"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/search_common"
 	"github.com/apache/answer/internal/service/search_parser"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/apache/answer/pkg/htmltext"
+	"github.com/apache/answer/pkg/uid"
 	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/log"
 )
 
+// searchSuggestMaxSize the max number of suggestions, and the number of candidates of each type
+const searchSuggestMaxSize = 10
+
 type SearchService struct {
-	searchParser *search_parser.SearchParser
-	searchRepo   search_common.SearchRepo
+	data              *data.Data
+	searchParser      *search_parser.SearchParser
+	searchRepo        search_common.SearchRepo
+	searchSuggestRepo search_common.SearchSuggestRepo
+	userCommon        *usercommon.UserCommon
 }
 
 func NewSearchService(
+	data *data.Data,
 	searchParser *search_parser.SearchParser,
 	searchRepo search_common.SearchRepo,
+	searchSuggestRepo search_common.SearchSuggestRepo,
+	userCommon *usercommon.UserCommon,
 ) *SearchService {
 	return &SearchService{
-		searchParser: searchParser,
-		searchRepo:   searchRepo,
+		data:              data,
+		searchParser:      searchParser,
+		searchRepo:        searchRepo,
+		searchSuggestRepo: searchSuggestRepo,
+		userCommon:        userCommon,
 	}
 }
 
@@ -70,13 +93,13 @@ func (ss *SearchService) Search(ctx context.Context, dto *schema.SearchDTO) (res
 	if finder == nil {
 		if cond.SearchAll() {
 			resp.SearchResults, resp.Total, err =
-				ss.searchRepo.SearchContents(ctx, cond.Words, cond.Tags, cond.UserID, cond.VoteAmount, dto.Page, dto.Size, dto.Order)
+				ss.searchRepo.SearchContents(ctx, cond, dto.Page, dto.Size, dto.Order)
 		} else if cond.SearchQuestion() {
 			resp.SearchResults, resp.Total, err =
-				ss.searchRepo.SearchQuestions(ctx, cond.Words, cond.Tags, cond.NotAccepted, cond.Views, cond.AnswerAmount, dto.Page, dto.Size, dto.Order)
+				ss.searchRepo.SearchQuestions(ctx, cond, dto.Page, dto.Size, dto.Order)
 		} else if cond.SearchAnswer() {
 			resp.SearchResults, resp.Total, err =
-				ss.searchRepo.SearchAnswers(ctx, cond.Words, cond.Tags, cond.Accepted, cond.QuestionID, dto.Page, dto.Size, dto.Order)
+				ss.searchRepo.SearchAnswers(ctx, cond, dto.Page, dto.Size, dto.Order)
 		}
 		return
 	}
@@ -97,3 +120,168 @@ func (ss *SearchService) searchByPlugin(ctx context.Context, finder plugin.Searc
 	resp.SearchResults, err = ss.searchRepo.ParseSearchPluginResult(ctx, res, cond.Words)
 	return resp, err
 }
+
+// Suggest returns the questions, tags and users for search-as-you-type, ranked by how the query matches
+// their names and their popularity. The suggestions of the hot queries are cached for a while.
+func (ss *SearchService) Suggest(ctx context.Context, req *schema.SearchSuggestReq) (
+	resp []*schema.SearchSuggestResp, err error) {
+	query := strings.Join(strings.Fields(strings.ToLower(req.Query)), " ")
+	if len(query) == 0 {
+		return make([]*schema.SearchSuggestResp, 0), nil
+	}
+	cacheKey := constant.SearchSuggestCacheKeyPrefix + query
+	if handler.GetEnableShortID(ctx) {
+		cacheKey += ":short"
+	}
+
+	cached, exist, err := ss.data.Cache.GetString(ctx, cacheKey)
+	if err == nil && exist && json.Unmarshal([]byte(cached), &resp) == nil {
+		return limitSuggestions(resp, req.Size), nil
+	}
+
+	resp, err = ss.suggest(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	content, _ := json.Marshal(resp)
+	if err := ss.data.Cache.SetString(ctx, cacheKey, string(content), constant.SearchSuggestCacheTime); err != nil {
+		log.Error(err)
+	}
+	return limitSuggestions(resp, req.Size), nil
+}
+
+func (ss *SearchService) suggest(ctx context.Context, query string) (resp []*schema.SearchSuggestResp, err error) {
+	questions, err := ss.searchSuggestRepo.SuggestQuestions(ctx, query, searchSuggestMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := ss.searchSuggestRepo.SuggestTags(ctx, query, searchSuggestMaxSize)
+	if err != nil {
+		return nil, err
+	}
+	users, err := ss.searchSuggestRepo.SuggestUsers(ctx, query, searchSuggestMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*suggestCandidate, 0, len(questions)+len(tags)+len(users))
+	questionCandidates := make([]*suggestCandidate, 0, len(questions))
+	for _, question := range questions {
+		id := question.ID
+		if handler.GetEnableShortID(ctx) {
+			id = uid.EnShortID(id)
+		}
+		questionCandidates = append(questionCandidates, &suggestCandidate{
+			match: suggestMatchScore(query, question.Title),
+			SearchSuggestResp: &schema.SearchSuggestResp{
+				ObjectType: constant.QuestionObjectType,
+				ID:         id,
+				Title:      question.Title,
+				Slug:       htmltext.UrlTitle(question.Title),
+				Popularity: question.ViewCount,
+			},
+		})
+	}
+	tagCandidates := make([]*suggestCandidate, 0, len(tags))
+	for _, tag := range tags {
+		tagCandidates = append(tagCandidates, &suggestCandidate{
+			match: max(suggestMatchScore(query, tag.SlugName), suggestMatchScore(query, tag.DisplayName)),
+			SearchSuggestResp: &schema.SearchSuggestResp{
+				ObjectType: constant.TagObjectType,
+				ID:         tag.ID,
+				Title:      tag.DisplayName,
+				Slug:       tag.SlugName,
+				Popularity: tag.QuestionCount,
+			},
+		})
+	}
+	userCandidates := make([]*suggestCandidate, 0, len(users))
+	for _, user := range users {
+		userCandidates = append(userCandidates, &suggestCandidate{
+			match: max(suggestMatchScore(query, user.Username), suggestMatchScore(query, user.DisplayName)),
+			SearchSuggestResp: &schema.SearchSuggestResp{
+				ObjectType: constant.UserObjectType,
+				ID:         user.ID,
+				Title:      user.DisplayName,
+				Slug:       user.Username,
+				Popularity: user.Rank,
+			},
+		})
+	}
+	candidates = append(candidates, rankSuggestions(questionCandidates)...)
+	candidates = append(candidates, rankSuggestions(tagCandidates)...)
+	candidates = append(candidates, rankSuggestions(userCandidates)...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	resp = make([]*schema.SearchSuggestResp, 0, searchSuggestMaxSize)
+	userIDs := make([]string, 0)
+	for _, c := range candidates {
+		if len(resp) >= searchSuggestMaxSize {
+			break
+		}
+		resp = append(resp, c.SearchSuggestResp)
+		if c.ObjectType == constant.UserObjectType {
+			userIDs = append(userIDs, c.ID)
+		}
+	}
+
+	userInfoMap, err := ss.userCommon.BatchUserBasicInfoByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range resp {
+		if userInfo, ok := userInfoMap[item.ID]; ok && item.ObjectType == constant.UserObjectType {
+			item.Avatar = userInfo.Avatar
+		}
+	}
+	return resp, nil
+}
+
+type suggestCandidate struct {
+	*schema.SearchSuggestResp
+	// match how the query matches the name, see suggestMatchScore
+	match float64
+	// score the match plus the popularity relative to the most popular candidate of the same type
+	score float64
+}
+
+// rankSuggestions scores the candidates of the same type, the popularity is compared in log scale
+// and adds at most 1 to the match, so a better match is ranked higher unless it is much less popular.
+func rankSuggestions(candidates []*suggestCandidate) []*suggestCandidate {
+	maxPopularity := 0.0
+	for _, c := range candidates {
+		maxPopularity = math.Max(maxPopularity, math.Log1p(float64(max(c.Popularity, 0))))
+	}
+	for _, c := range candidates {
+		c.score = c.match
+		if maxPopularity > 0 {
+			c.score += math.Log1p(float64(max(c.Popularity, 0))) / maxPopularity
+		}
+	}
+	return candidates
+}
+
+// suggestMatchScore returns 3 if the name is the query, 2 if the name starts with the query,
+// 1 if a word of the name starts with the query, otherwise 0.
+func suggestMatchScore(query, name string) float64 {
+	name = strings.ToLower(name)
+	switch {
+	case name == query:
+		return 3
+	case strings.HasPrefix(name, query):
+		return 2
+	case strings.Contains(name, " "+query):
+		return 1
+	default:
+		return 0
+	}
+}
+
+func limitSuggestions(suggestions []*schema.SearchSuggestResp, size int) []*schema.SearchSuggestResp {
+	if size > 0 && len(suggestions) > size {
+		return suggestions[:size]
+	}
+	return suggestions
+}
@@ -0,0 +1,153 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// AddContractReview add the review of the other party, only after the contract is completed.
+// Each party can review the contract once, and the review is sent to the moderation pipeline like posts.
+func (cs *ContractService) AddContractReview(ctx context.Context, req *schema.AddContractReviewReq) (
+	resp *schema.ContractReviewResp, err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ContractID)
+	if err != nil {
+		return nil, err
+	}
+	party := ""
+	if exist {
+		party = contractParty(contract, req.LoginUserID)
+	}
+	if len(party) == 0 {
+		return nil, errors.NotFound(reason.ContractNotFound)
+	}
+	if contract.Status != entity.ContractStatusCompleted {
+		return nil, errors.BadRequest(reason.ContractNotCompleted)
+	}
+	_, exist, err = cs.contractReviewRepo.GetContractReviewByReviewer(ctx, contract.ID, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, errors.BadRequest(reason.ContractReviewExists)
+	}
+
+	review := &entity.ContractReview{
+		ContractID:   contract.ID,
+		ReviewerID:   req.LoginUserID,
+		RevieweeID:   contract.ClientID,
+		ReviewerRole: entity.ContractReviewerRoleFreelancer,
+		Rating:       req.Rating,
+		OriginalText: req.Content,
+		ParsedText:   req.HTML,
+		Status:       entity.ContractReviewStatusPending,
+	}
+	if party == contractPartyClient {
+		review.RevieweeID = contract.FreelancerID
+		review.ReviewerRole = entity.ContractReviewerRoleClient
+	}
+	// pending review is not counted into the rating until the moderation result is known
+	if err = cs.contractReviewRepo.AddContractReview(ctx, review); err != nil {
+		return nil, err
+	}
+	status := cs.reviewService.AddContractReviewReview(ctx, review, req.IP, req.UserAgent)
+	if status != review.Status {
+		if err = cs.contractReviewRepo.UpdateContractReviewStatus(ctx, review, status); err != nil {
+			return nil, err
+		}
+	}
+	return cs.formatContractReview(ctx, review), nil
+}
+
+// ReplyContractReview the reviewee can reply the review once
+func (cs *ContractService) ReplyContractReview(ctx context.Context, req *schema.ReplyContractReviewReq) (err error) {
+	review, exist, err := cs.contractReviewRepo.GetContractReview(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist || review.Status != entity.ContractReviewStatusAvailable || review.RevieweeID != req.LoginUserID {
+		return errors.NotFound(reason.ContractReviewNotFound)
+	}
+	if len(review.ReplyText) > 0 {
+		return errors.BadRequest(reason.ContractReviewReplied)
+	}
+	review.ReplyText = req.Content
+	review.ReplyHTML = req.HTML
+	review.RepliedAt = time.Now()
+	return cs.contractReviewRepo.UpdateContractReviewReply(ctx, review)
+}
+
+// RemoveContractReview remove the review, only the reviewer and admin can do it
+func (cs *ContractService) RemoveContractReview(ctx context.Context, req *schema.RemoveContractReviewReq) (err error) {
+	review, exist, err := cs.contractReviewRepo.GetContractReview(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist || review.Status == entity.ContractReviewStatusDeleted {
+		return nil
+	}
+	if !req.IsAdmin && review.ReviewerID != req.LoginUserID {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	return cs.contractReviewRepo.UpdateContractReviewStatus(ctx, review, entity.ContractReviewStatusDeleted)
+}
+
+// GetContractReviewPage get the available reviews of the user with the average rating
+func (cs *ContractService) GetContractReviewPage(ctx context.Context, req *schema.GetContractReviewsReq) (
+	resp *schema.GetContractReviewsResp, err error) {
+	reviews, total, err := cs.contractReviewRepo.GetContractReviewPage(ctx, req.Page, req.PageSize, req.UserID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	resp = &schema.GetContractReviewsResp{Count: total, List: make([]*schema.ContractReviewResp, 0, len(reviews))}
+	resp.Rating, _, err = cs.contractReviewRepo.GetRevieweeRating(ctx, req.UserID, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(reviews))
+	for _, review := range reviews {
+		userIDs = append(userIDs, review.ReviewerID)
+	}
+	users, err := cs.userCommon.BatchUserBasicInfoByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, review := range reviews {
+		item := &schema.ContractReviewResp{}
+		item.ConvertFromContractReviewEntity(review)
+		item.Reviewer = users[review.ReviewerID]
+		resp.List = append(resp.List, item)
+	}
+	return resp, nil
+}
+
+func (cs *ContractService) formatContractReview(ctx context.Context, review *entity.ContractReview) *schema.ContractReviewResp {
+	resp := &schema.ContractReviewResp{}
+	resp.ConvertFromContractReviewEntity(review)
+	resp.Reviewer, _, _ = cs.userCommon.GetUserBasicInfoByID(ctx, review.ReviewerID)
+	return resp
+}
@@ -0,0 +1,200 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockContractReviewRepo is a mock implementation of ContractReviewRepo
+type MockContractReviewRepo struct {
+	mock.Mock
+}
+
+func (m *MockContractReviewRepo) AddContractReview(ctx context.Context, review *entity.ContractReview) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockContractReviewRepo) GetContractReview(ctx context.Context, id string) (*entity.ContractReview, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.ContractReview), args.Bool(1), args.Error(2)
+}
+
+func (m *MockContractReviewRepo) GetContractReviewByReviewer(ctx context.Context, contractID string, reviewerID string) (*entity.ContractReview, bool, error) {
+	args := m.Called(ctx, contractID, reviewerID)
+	return args.Get(0).(*entity.ContractReview), args.Bool(1), args.Error(2)
+}
+
+func (m *MockContractReviewRepo) GetContractReviewPage(ctx context.Context, page int, pageSize int, revieweeID string, reviewerRole string) ([]*entity.ContractReview, int64, error) {
+	args := m.Called(ctx, page, pageSize, revieweeID, reviewerRole)
+	return args.Get(0).([]*entity.ContractReview), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockContractReviewRepo) GetRevieweeRating(ctx context.Context, revieweeID string, reviewerRole string) (float64, int64, error) {
+	args := m.Called(ctx, revieweeID, reviewerRole)
+	return args.Get(0).(float64), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockContractReviewRepo) UpdateContractReviewStatus(ctx context.Context, review *entity.ContractReview, status int) error {
+	args := m.Called(ctx, review, status)
+	return args.Error(0)
+}
+
+func (m *MockContractReviewRepo) UpdateContractReviewReply(ctx context.Context, review *entity.ContractReview) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockContractReviewRepo) RefreshFreelancerStats(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func TestAddContractReview(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*ContractService, *MockContractReviewRepo) {
+		mockRepo := new(MockContractRepo)
+		mockRepo.On("GetContract", ctx, "1").Return(&entity.Contract{
+			ID: "1", ClientID: "client", FreelancerID: "freelancer", Status: status}, true, nil)
+		mockReviewRepo := new(MockContractReviewRepo)
+		return NewContractService(mockRepo, mockReviewRepo, nil, nil, nil, nil), mockReviewRepo
+	}
+	req := func(userID string) *schema.AddContractReviewReq {
+		return &schema.AddContractReviewReq{ContractID: "1", Rating: 5, Content: "great", LoginUserID: userID}
+	}
+
+	t.Run("contract_not_completed", func(t *testing.T) {
+		service, mockReviewRepo := newService(entity.ContractStatusActive)
+
+		_, err := service.AddContractReview(ctx, req("client"))
+		assertReason(t, err, reason.ContractNotCompleted)
+		mockReviewRepo.AssertNotCalled(t, "AddContractReview", mock.Anything, mock.Anything)
+	})
+
+	t.Run("other_user_can_not_review", func(t *testing.T) {
+		service, _ := newService(entity.ContractStatusCompleted)
+
+		_, err := service.AddContractReview(ctx, req("other"))
+		assertReason(t, err, reason.ContractNotFound)
+	})
+
+	t.Run("review_once", func(t *testing.T) {
+		service, mockReviewRepo := newService(entity.ContractStatusCompleted)
+		mockReviewRepo.On("GetContractReviewByReviewer", ctx, "1", "freelancer").Return(
+			&entity.ContractReview{ID: "100"}, true, nil)
+
+		_, err := service.AddContractReview(ctx, req("freelancer"))
+		assertReason(t, err, reason.ContractReviewExists)
+		mockReviewRepo.AssertNotCalled(t, "AddContractReview", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReplyContractReview(t *testing.T) {
+	ctx := context.Background()
+	newService := func(review *entity.ContractReview) (*ContractService, *MockContractReviewRepo) {
+		mockReviewRepo := new(MockContractReviewRepo)
+		mockReviewRepo.On("GetContractReview", ctx, "100").Return(review, true, nil)
+		return NewContractService(new(MockContractRepo), mockReviewRepo, nil, nil, nil, nil), mockReviewRepo
+	}
+	req := func(userID string) *schema.ReplyContractReviewReq {
+		return &schema.ReplyContractReviewReq{ID: "100", Content: "thanks", HTML: "<p>thanks</p>", LoginUserID: userID}
+	}
+
+	t.Run("reviewee_replies", func(t *testing.T) {
+		service, mockReviewRepo := newService(&entity.ContractReview{
+			ID: "100", ReviewerID: "client", RevieweeID: "freelancer", Status: entity.ContractReviewStatusAvailable})
+		mockReviewRepo.On("UpdateContractReviewReply", ctx, mock.MatchedBy(func(review *entity.ContractReview) bool {
+			return review.ReplyText == "thanks" && review.ReplyHTML == "<p>thanks</p>" && !review.RepliedAt.IsZero()
+		})).Return(nil)
+
+		err := service.ReplyContractReview(ctx, req("freelancer"))
+		require.NoError(t, err)
+		mockReviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("reviewer_can_not_reply", func(t *testing.T) {
+		service, _ := newService(&entity.ContractReview{
+			ID: "100", ReviewerID: "client", RevieweeID: "freelancer", Status: entity.ContractReviewStatusAvailable})
+
+		err := service.ReplyContractReview(ctx, req("client"))
+		assertReason(t, err, reason.ContractReviewNotFound)
+	})
+
+	t.Run("pending_review_can_not_be_replied", func(t *testing.T) {
+		service, _ := newService(&entity.ContractReview{
+			ID: "100", ReviewerID: "client", RevieweeID: "freelancer", Status: entity.ContractReviewStatusPending})
+
+		err := service.ReplyContractReview(ctx, req("freelancer"))
+		assertReason(t, err, reason.ContractReviewNotFound)
+	})
+
+	t.Run("reply_once", func(t *testing.T) {
+		service, mockReviewRepo := newService(&entity.ContractReview{ID: "100", ReviewerID: "client",
+			RevieweeID: "freelancer", Status: entity.ContractReviewStatusAvailable, ReplyText: "thanks"})
+
+		err := service.ReplyContractReview(ctx, req("freelancer"))
+		assertReason(t, err, reason.ContractReviewReplied)
+		mockReviewRepo.AssertNotCalled(t, "UpdateContractReviewReply", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRemoveContractReview(t *testing.T) {
+	ctx := context.Background()
+	newService := func() (*ContractService, *MockContractReviewRepo) {
+		mockReviewRepo := new(MockContractReviewRepo)
+		mockReviewRepo.On("GetContractReview", ctx, "100").Return(&entity.ContractReview{
+			ID: "100", ReviewerID: "client", RevieweeID: "freelancer", Status: entity.ContractReviewStatusAvailable}, true, nil)
+		return NewContractService(new(MockContractRepo), mockReviewRepo, nil, nil, nil, nil), mockReviewRepo
+	}
+
+	t.Run("reviewer_removes", func(t *testing.T) {
+		service, mockReviewRepo := newService()
+		mockReviewRepo.On("UpdateContractReviewStatus", ctx, mock.Anything, entity.ContractReviewStatusDeleted).Return(nil)
+
+		err := service.RemoveContractReview(ctx, &schema.RemoveContractReviewReq{ID: "100", LoginUserID: "client"})
+		require.NoError(t, err)
+		mockReviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("reviewee_can_not_remove", func(t *testing.T) {
+		service, mockReviewRepo := newService()
+
+		err := service.RemoveContractReview(ctx, &schema.RemoveContractReviewReq{ID: "100", LoginUserID: "freelancer"})
+		assert.Error(t, err)
+		mockReviewRepo.AssertNotCalled(t, "UpdateContractReviewStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("admin_removes", func(t *testing.T) {
+		service, mockReviewRepo := newService()
+		mockReviewRepo.On("UpdateContractReviewStatus", ctx, mock.Anything, entity.ContractReviewStatusDeleted).Return(nil)
+
+		err := service.RemoveContractReview(ctx, &schema.RemoveContractReviewReq{ID: "100", LoginUserID: "admin", IsAdmin: true})
+		require.NoError(t, err)
+	})
+}
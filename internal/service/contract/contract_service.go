@@ -0,0 +1,529 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/contract_common"
+	"github.com/apache/answer/internal/service/review"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// ContractRepo contract repository
+type ContractRepo interface {
+	AddContract(ctx context.Context, contract *entity.Contract, milestones []*entity.Milestone) (err error)
+	GetContract(ctx context.Context, id string) (contract *entity.Contract, exist bool, err error)
+	GetContractPage(ctx context.Context, page, pageSize int, userID, role, status string) (
+		contracts []*entity.Contract, total int64, err error)
+	UpdateContractStatus(ctx context.Context, id, status string) (err error)
+	ChangeContractStatus(ctx context.Context, id, fromStatus, toStatus string) (changed bool, err error)
+	ConfirmContractWork(ctx context.Context, id string) (err error)
+	AddMilestone(ctx context.Context, milestone *entity.Milestone) (err error)
+	GetMilestone(ctx context.Context, id string) (milestone *entity.Milestone, exist bool, err error)
+	GetMilestoneList(ctx context.Context, contractID string) (milestones []*entity.Milestone, err error)
+	ChangeMilestoneStatus(ctx context.Context, milestone *entity.Milestone, fromStatus string,
+		cols ...string) (changed bool, err error)
+}
+
+const (
+	contractPartyClient     = "client"
+	contractPartyFreelancer = "freelancer"
+)
+
+// milestoneStatusTransitions the statuses which a milestone can be changed to from current status,
+// and the party of the contract who can do it
+var milestoneStatusTransitions = map[string]map[string]string{
+	entity.MilestoneStatusDraft: {
+		entity.MilestoneStatusFunded: contractPartyClient,
+	},
+	entity.MilestoneStatusFunded: {
+		entity.MilestoneStatusSubmitted: contractPartyFreelancer,
+	},
+	entity.MilestoneStatusSubmitted: {
+		entity.MilestoneStatusApproved: contractPartyClient,
+		entity.MilestoneStatusDisputed: contractPartyClient,
+	},
+	entity.MilestoneStatusDisputed: {
+		entity.MilestoneStatusSubmitted: contractPartyFreelancer,
+		entity.MilestoneStatusApproved:  contractPartyClient,
+	},
+	entity.MilestoneStatusApproved: {
+		entity.MilestoneStatusReleased: contractPartyClient,
+	},
+}
+
+// milestoneProcessingStatus the status which the milestone is in while the payment provider is called
+// for changing it to the funded or released status
+var milestoneProcessingStatus = map[string]string{
+	entity.MilestoneStatusFunded:   entity.MilestoneStatusFunding,
+	entity.MilestoneStatusReleased: entity.MilestoneStatusReleasing,
+}
+
+// canChangeMilestoneStatus check if the party can change the milestone status
+func canChangeMilestoneStatus(from, to, party string) bool {
+	return milestoneStatusTransitions[from][to] == party
+}
+
+// isMilestoneInEscrow the amount of the milestone is funded but not released
+func isMilestoneInEscrow(status string) bool {
+	return status != entity.MilestoneStatusDraft && status != entity.MilestoneStatusReleased
+}
+
+// ContractService contract service
+type ContractService struct {
+	contractRepo       ContractRepo
+	contractReviewRepo contract_common.ContractReviewRepo
+	freelancerRepo     freelancer.FreelancerRepo
+	userRepo           usercommon.UserRepo
+	userCommon         *usercommon.UserCommon
+	reviewService      *review.ReviewService
+}
+
+// NewContractService new contract service
+func NewContractService(
+	contractRepo ContractRepo,
+	contractReviewRepo contract_common.ContractReviewRepo,
+	freelancerRepo freelancer.FreelancerRepo,
+	userRepo usercommon.UserRepo,
+	userCommon *usercommon.UserCommon,
+	reviewService *review.ReviewService,
+) *ContractService {
+	return &ContractService{
+		contractRepo:       contractRepo,
+		contractReviewRepo: contractReviewRepo,
+		freelancerRepo:     freelancerRepo,
+		userRepo:           userRepo,
+		userCommon:         userCommon,
+		reviewService:      reviewService,
+	}
+}
+
+// CreateContract create contract, the login user is the client.
+// The contract is offered to the freelancer, and becomes active after the freelancer accepts it.
+func (cs *ContractService) CreateContract(ctx context.Context, req *schema.CreateContractReq) (
+	resp *schema.CreateContractResp, err error) {
+	if req.FreelancerUserID == req.LoginUserID {
+		return nil, errors.BadRequest(reason.ContractWithYourself)
+	}
+	_, exist, err := cs.userRepo.GetByUserID(ctx, req.FreelancerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.UserNotFound)
+	}
+
+	contract := &entity.Contract{
+		ClientID:        req.LoginUserID,
+		FreelancerID:    req.FreelancerUserID,
+		JobID:           "0",
+		ApplicationID:   "0",
+		Title:           req.Title,
+		Description:     req.Description,
+		Currency:        req.Currency,
+		Status:          entity.ContractStatusOffered,
+		PaymentProvider: currentPaymentProvider().Info().SlugName,
+	}
+	if err = cs.checkContractJob(ctx, contract, req.JobID, req.ApplicationID); err != nil {
+		return nil, err
+	}
+	if len(contract.Currency) == 0 {
+		contract.Currency = "USD"
+	}
+
+	milestones := make([]*entity.Milestone, 0, len(req.Milestones))
+	for _, item := range req.Milestones {
+		milestones = append(milestones, &entity.Milestone{
+			Title:       item.Title,
+			Description: item.Description,
+			Amount:      item.Amount,
+			DueAt:       item.DueTime(),
+			Status:      entity.MilestoneStatusDraft,
+		})
+		contract.TotalAmount += item.Amount
+	}
+	if err = cs.contractRepo.AddContract(ctx, contract, milestones); err != nil {
+		return nil, err
+	}
+	return &schema.CreateContractResp{ID: contract.ID}, nil
+}
+
+// checkContractJob check the job posting and the job application which the contract is made for.
+// The job posting must be posted by the client, and the application must be an accepted one of the freelancer.
+func (cs *ContractService) checkContractJob(ctx context.Context, contract *entity.Contract, jobID, applicationID string) error {
+	if len(applicationID) > 0 {
+		application, exist, err := cs.freelancerRepo.GetJobApplicationByID(ctx, applicationID)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return errors.NotFound(reason.JobApplicationNotFound)
+		}
+		if application.ApplicantID != contract.FreelancerID ||
+			(len(jobID) > 0 && application.JobID != jobID) {
+			return errors.BadRequest(reason.JobApplicationNotFound)
+		}
+		if application.Status != entity.JobApplicationStatusAccepted {
+			return errors.BadRequest(reason.JobApplicationStatusInvalid)
+		}
+		contract.ApplicationID = application.ID
+		if len(contract.Currency) == 0 {
+			contract.Currency = application.Currency
+		}
+		jobID = application.JobID
+	}
+	if len(jobID) == 0 {
+		return nil
+	}
+	posting, exist, err := cs.freelancerRepo.GetJobPostingByID(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.JobPostingNotFound)
+	}
+	if posting.UserID != contract.ClientID {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	contract.JobID = posting.ID
+	if len(contract.Currency) == 0 {
+		contract.Currency = posting.Currency
+	}
+	return nil
+}
+
+// GetContract get contract with its milestones, only the parties of the contract and admin can see it
+func (cs *ContractService) GetContract(ctx context.Context, req *schema.GetContractReq) (
+	resp *schema.ContractResp, err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || (!req.IsAdmin && len(contractParty(contract, req.LoginUserID)) == 0) {
+		return nil, errors.NotFound(reason.ContractNotFound)
+	}
+	milestones, err := cs.contractRepo.GetMilestoneList(ctx, contract.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &schema.ContractResp{}
+	resp.ConvertFromContractEntity(contract)
+	for _, milestone := range milestones {
+		item := &schema.MilestoneResp{}
+		item.ConvertFromMilestoneEntity(milestone)
+		resp.Milestones = append(resp.Milestones, item)
+	}
+	return resp, nil
+}
+
+// GetContractPage get the contracts of login user
+func (cs *ContractService) GetContractPage(ctx context.Context, req *schema.GetContractsReq) (
+	pageModel *pager.PageModel, err error) {
+	contracts, total, err := cs.contractRepo.GetContractPage(ctx, req.Page, req.PageSize,
+		req.LoginUserID, req.Role, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*schema.ContractResp, 0, len(contracts))
+	for _, contract := range contracts {
+		item := &schema.ContractResp{}
+		item.ConvertFromContractEntity(contract)
+		list = append(list, item)
+	}
+	return pager.NewPageModel(total, list), nil
+}
+
+// AddMilestone add a draft milestone to the active contract, only the client can do it
+func (cs *ContractService) AddMilestone(ctx context.Context, req *schema.CreateMilestoneReq) (err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ContractID)
+	if err != nil {
+		return err
+	}
+	if !exist || len(contractParty(contract, req.LoginUserID)) == 0 {
+		return errors.NotFound(reason.ContractNotFound)
+	}
+	if contract.ClientID != req.LoginUserID {
+		return errors.Forbidden(reason.ContractPartyNotAllowed)
+	}
+	if contract.Status != entity.ContractStatusActive {
+		return errors.BadRequest(reason.ContractNotActive)
+	}
+	return cs.contractRepo.AddMilestone(ctx, &entity.Milestone{
+		ContractID:  contract.ID,
+		Title:       req.Title,
+		Description: req.Description,
+		Amount:      req.Amount,
+		DueAt:       req.DueTime(),
+		Status:      entity.MilestoneStatusDraft,
+	})
+}
+
+// UpdateMilestoneStatus move the milestone to the next status.
+// The payment provider is called when the milestone is funded or released.
+func (cs *ContractService) UpdateMilestoneStatus(ctx context.Context, req *schema.UpdateMilestoneStatusReq) (err error) {
+	milestone, exist, err := cs.contractRepo.GetMilestone(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.MilestoneNotFound)
+	}
+	contract, exist, err := cs.contractRepo.GetContract(ctx, milestone.ContractID)
+	if err != nil {
+		return err
+	}
+	party := ""
+	if exist {
+		party = contractParty(contract, req.LoginUserID)
+	}
+	if len(party) == 0 {
+		return errors.NotFound(reason.MilestoneNotFound)
+	}
+	if contract.Status != entity.ContractStatusActive {
+		return errors.BadRequest(reason.ContractNotActive)
+	}
+	if !canChangeMilestoneStatus(milestone.Status, req.Status, party) {
+		return errors.BadRequest(reason.MilestoneStatusInvalid)
+	}
+
+	milestone.Note = req.Note
+	if _, ok := milestoneProcessingStatus[req.Status]; ok {
+		err = cs.payMilestone(ctx, contract, milestone, req.Status)
+	} else {
+		err = cs.changeMilestoneStatus(ctx, milestone, req.Status, "note")
+	}
+	if err != nil {
+		return err
+	}
+	if milestone.Status == entity.MilestoneStatusReleased {
+		return cs.completeContractIfReleased(ctx, contract)
+	}
+	return nil
+}
+
+// changeMilestoneStatus change the milestone from its current status to the status
+func (cs *ContractService) changeMilestoneStatus(ctx context.Context, milestone *entity.Milestone, status string,
+	cols ...string) error {
+	fromStatus := milestone.Status
+	milestone.Status = status
+	changed, err := cs.contractRepo.ChangeMilestoneStatus(ctx, milestone, fromStatus, cols...)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return errors.BadRequest(reason.MilestoneStatusInvalid)
+	}
+	return nil
+}
+
+// payMilestone fund or release the milestone by the payment provider.
+// The milestone is claimed in the processing status first, so that it can not be paid twice by concurrent requests.
+// The payment provider is called outside of any database transaction, and the milestone is changed back if it fails.
+func (cs *ContractService) payMilestone(ctx context.Context, contract *entity.Contract, milestone *entity.Milestone,
+	status string) (err error) {
+	fromStatus := milestone.Status
+	if err = cs.changeMilestoneStatus(ctx, milestone, milestoneProcessingStatus[status]); err != nil {
+		return err
+	}
+
+	if status == entity.MilestoneStatusFunded {
+		milestone.FundTransactionID, err = cs.callPayment(contract, milestone,
+			func(p plugin.Payment, order *plugin.PaymentOrder) (string, error) {
+				return p.Fund(order)
+			})
+	} else {
+		milestone.ReleaseTransactionID, err = cs.callPayment(contract, milestone,
+			func(p plugin.Payment, order *plugin.PaymentOrder) (string, error) {
+				return p.Release(order, milestone.FundTransactionID)
+			})
+	}
+	if err != nil {
+		if revertErr := cs.changeMilestoneStatus(ctx, milestone, fromStatus); revertErr != nil {
+			log.Errorf("change milestone %s back to %s failed: %v", milestone.ID, fromStatus, revertErr)
+		}
+		return err
+	}
+
+	err = cs.changeMilestoneStatus(ctx, milestone, status, "note", "fund_transaction_id", "release_transaction_id")
+	if err != nil {
+		log.Errorf("record the payment of milestone %s failed, fund transaction: %s, release transaction: %s",
+			milestone.ID, milestone.FundTransactionID, milestone.ReleaseTransactionID)
+	}
+	return err
+}
+
+// callPayment call the payment provider of the contract
+func (cs *ContractService) callPayment(contract *entity.Contract, milestone *entity.Milestone,
+	fn func(p plugin.Payment, order *plugin.PaymentOrder) (string, error)) (transactionID string, err error) {
+	provider, ok := getPaymentProvider(contract.PaymentProvider)
+	if !ok {
+		return "", errors.BadRequest(reason.PaymentProviderUnavailable)
+	}
+	transactionID, err = fn(provider, &plugin.PaymentOrder{
+		ContractID:  contract.ID,
+		MilestoneID: milestone.ID,
+		PayerUserID: contract.ClientID,
+		PayeeUserID: contract.FreelancerID,
+		Amount:      milestone.Amount,
+		Currency:    contract.Currency,
+		Description: milestone.Title,
+	})
+	if err != nil {
+		log.Errorf("call payment provider %s for milestone %s failed: %v", contract.PaymentProvider, milestone.ID, err)
+		return "", errors.BadRequest(reason.PaymentFailed)
+	}
+	return transactionID, nil
+}
+
+// completeContractIfReleased the contract is completed when all milestones are released
+func (cs *ContractService) completeContractIfReleased(ctx context.Context, contract *entity.Contract) error {
+	milestones, err := cs.contractRepo.GetMilestoneList(ctx, contract.ID)
+	if err != nil {
+		return err
+	}
+	for _, milestone := range milestones {
+		if milestone.Status != entity.MilestoneStatusReleased {
+			return nil
+		}
+	}
+	if err = cs.contractRepo.UpdateContractStatus(ctx, contract.ID, entity.ContractStatusCompleted); err != nil {
+		return err
+	}
+	return cs.contractReviewRepo.RefreshFreelancerStats(ctx, contract.FreelancerID)
+}
+
+// AcceptContract accept the offered contract, only the freelancer can do it
+func (cs *ContractService) AcceptContract(ctx context.Context, req *schema.AcceptContractReq) (err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist || len(contractParty(contract, req.LoginUserID)) == 0 {
+		return errors.NotFound(reason.ContractNotFound)
+	}
+	if contract.FreelancerID != req.LoginUserID {
+		return errors.Forbidden(reason.ContractPartyNotAllowed)
+	}
+	changed, err := cs.contractRepo.ChangeContractStatus(ctx, contract.ID,
+		entity.ContractStatusOffered, entity.ContractStatusActive)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return errors.BadRequest(reason.ContractNotOffered)
+	}
+	return nil
+}
+
+// CancelContract cancel the offered or active contract, either party can do it when no milestone is held in escrow.
+// The freelancer declines the offered contract by cancelling it.
+func (cs *ContractService) CancelContract(ctx context.Context, req *schema.CancelContractReq) (err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist || len(contractParty(contract, req.LoginUserID)) == 0 {
+		return errors.NotFound(reason.ContractNotFound)
+	}
+	if contract.Status != entity.ContractStatusOffered && contract.Status != entity.ContractStatusActive {
+		return errors.BadRequest(reason.ContractNotActive)
+	}
+	milestones, err := cs.contractRepo.GetMilestoneList(ctx, contract.ID)
+	if err != nil {
+		return err
+	}
+	for _, milestone := range milestones {
+		if isMilestoneInEscrow(milestone.Status) {
+			return errors.BadRequest(reason.ContractCannotCancel)
+		}
+	}
+	return cs.contractRepo.UpdateContractStatus(ctx, contract.ID, entity.ContractStatusCancelled)
+}
+
+// CompleteContract complete the contract which the freelancer has accepted, when no milestone is held in escrow.
+// The freelancer confirms the work is done, and the client completes the contract after a milestone is released
+// or the freelancer has confirmed. It is how a contract made by hiring a freelancer is closed for reviews.
+func (cs *ContractService) CompleteContract(ctx context.Context, req *schema.CompleteContractReq) (err error) {
+	contract, exist, err := cs.contractRepo.GetContract(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	party := ""
+	if exist {
+		party = contractParty(contract, req.LoginUserID)
+	}
+	if len(party) == 0 {
+		return errors.NotFound(reason.ContractNotFound)
+	}
+	// the contract becomes active only after the freelancer accepts it
+	if contract.Status != entity.ContractStatusActive {
+		return errors.BadRequest(reason.ContractNotActive)
+	}
+	if party == contractPartyFreelancer {
+		return cs.contractRepo.ConfirmContractWork(ctx, contract.ID)
+	}
+
+	milestones, err := cs.contractRepo.GetMilestoneList(ctx, contract.ID)
+	if err != nil {
+		return err
+	}
+	released := false
+	for _, milestone := range milestones {
+		if isMilestoneInEscrow(milestone.Status) {
+			return errors.BadRequest(reason.ContractCannotComplete)
+		}
+		if milestone.Status == entity.MilestoneStatusReleased {
+			released = true
+		}
+	}
+	if !released && !contract.WorkConfirmed {
+		return errors.BadRequest(reason.ContractNotConfirmed)
+	}
+	changed, err := cs.contractRepo.ChangeContractStatus(ctx, contract.ID,
+		entity.ContractStatusActive, entity.ContractStatusCompleted)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return errors.BadRequest(reason.ContractNotActive)
+	}
+	return cs.contractReviewRepo.RefreshFreelancerStats(ctx, contract.FreelancerID)
+}
+
+// contractParty the party of the user in the contract, empty if the user is not a party of it
+func contractParty(contract *entity.Contract, userID string) string {
+	switch userID {
+	case contract.ClientID:
+		return contractPartyClient
+	case contract.FreelancerID:
+		return contractPartyFreelancer
+	}
+	return ""
+}
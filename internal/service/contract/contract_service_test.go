@@ -0,0 +1,363 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockContractRepo is a mock implementation of ContractRepo
+type MockContractRepo struct {
+	mock.Mock
+}
+
+func (m *MockContractRepo) AddContract(ctx context.Context, contract *entity.Contract, milestones []*entity.Milestone) error {
+	args := m.Called(ctx, contract, milestones)
+	return args.Error(0)
+}
+
+func (m *MockContractRepo) GetContract(ctx context.Context, id string) (*entity.Contract, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Contract), args.Bool(1), args.Error(2)
+}
+
+func (m *MockContractRepo) GetContractPage(ctx context.Context, page int, pageSize int, userID string, role string, status string) ([]*entity.Contract, int64, error) {
+	args := m.Called(ctx, page, pageSize, userID, role, status)
+	return args.Get(0).([]*entity.Contract), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockContractRepo) UpdateContractStatus(ctx context.Context, id string, status string) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockContractRepo) ChangeContractStatus(ctx context.Context, id string, fromStatus string, toStatus string) (bool, error) {
+	args := m.Called(ctx, id, fromStatus, toStatus)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockContractRepo) AddMilestone(ctx context.Context, milestone *entity.Milestone) error {
+	args := m.Called(ctx, milestone)
+	return args.Error(0)
+}
+
+func (m *MockContractRepo) GetMilestone(ctx context.Context, id string) (*entity.Milestone, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Milestone), args.Bool(1), args.Error(2)
+}
+
+func (m *MockContractRepo) GetMilestoneList(ctx context.Context, contractID string) ([]*entity.Milestone, error) {
+	args := m.Called(ctx, contractID)
+	return args.Get(0).([]*entity.Milestone), args.Error(1)
+}
+
+func (m *MockContractRepo) ChangeMilestoneStatus(ctx context.Context, milestone *entity.Milestone, fromStatus string, cols ...string) (bool, error) {
+	args := m.Called(ctx, milestone, fromStatus, cols)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockContractRepo) ConfirmContractWork(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// failedPayment the payment provider which always fails, it is registered but only enabled by the tests which use it
+type failedPayment struct{}
+
+const failedPaymentSlugName = "failed_payment"
+
+func (p *failedPayment) Info() plugin.Info {
+	return plugin.Info{SlugName: failedPaymentSlugName}
+}
+
+func (p *failedPayment) Fund(order *plugin.PaymentOrder) (transactionID string, err error) {
+	return "", fmt.Errorf("fund %s failed", order.MilestoneID)
+}
+
+func (p *failedPayment) Release(order *plugin.PaymentOrder, fundTransactionID string) (transactionID string, err error) {
+	return "", fmt.Errorf("release %s failed", order.MilestoneID)
+}
+
+func init() {
+	plugin.Register(&failedPayment{})
+}
+
+func assertReason(t *testing.T, err error, reason string) {
+	var e *errors.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, reason, e.Reason)
+}
+
+// milestoneStatusIs match the milestone which is being changed to the status
+func milestoneStatusIs(status string) interface{} {
+	return mock.MatchedBy(func(milestone *entity.Milestone) bool {
+		return milestone.Status == status
+	})
+}
+
+func TestCanChangeMilestoneStatus(t *testing.T) {
+	assert.True(t, canChangeMilestoneStatus(entity.MilestoneStatusDraft, entity.MilestoneStatusFunded, contractPartyClient))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusDraft, entity.MilestoneStatusFunded, contractPartyFreelancer))
+	assert.True(t, canChangeMilestoneStatus(entity.MilestoneStatusFunded, entity.MilestoneStatusSubmitted, contractPartyFreelancer))
+	assert.True(t, canChangeMilestoneStatus(entity.MilestoneStatusSubmitted, entity.MilestoneStatusDisputed, contractPartyClient))
+	assert.True(t, canChangeMilestoneStatus(entity.MilestoneStatusDisputed, entity.MilestoneStatusSubmitted, contractPartyFreelancer))
+	assert.True(t, canChangeMilestoneStatus(entity.MilestoneStatusApproved, entity.MilestoneStatusReleased, contractPartyClient))
+
+	// the milestone can not skip the escrow
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusDraft, entity.MilestoneStatusReleased, contractPartyClient))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusSubmitted, entity.MilestoneStatusReleased, contractPartyClient))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusSubmitted, entity.MilestoneStatusApproved, contractPartyFreelancer))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusReleased, entity.MilestoneStatusFunded, contractPartyClient))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusDraft, entity.MilestoneStatusFunded, ""))
+
+	// the milestone can not be changed while the payment provider is called
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusFunding, entity.MilestoneStatusFunded, contractPartyClient))
+	assert.False(t, canChangeMilestoneStatus(entity.MilestoneStatusReleasing, entity.MilestoneStatusReleased, contractPartyClient))
+}
+
+func TestIsMilestoneInEscrow(t *testing.T) {
+	assert.False(t, isMilestoneInEscrow(entity.MilestoneStatusDraft))
+	assert.True(t, isMilestoneInEscrow(entity.MilestoneStatusFunding))
+	assert.True(t, isMilestoneInEscrow(entity.MilestoneStatusFunded))
+	assert.True(t, isMilestoneInEscrow(entity.MilestoneStatusDisputed))
+	assert.True(t, isMilestoneInEscrow(entity.MilestoneStatusReleasing))
+	assert.False(t, isMilestoneInEscrow(entity.MilestoneStatusReleased))
+}
+
+func TestContractParty(t *testing.T) {
+	contract := &entity.Contract{ClientID: "1", FreelancerID: "2"}
+	assert.Equal(t, contractPartyClient, contractParty(contract, "1"))
+	assert.Equal(t, contractPartyFreelancer, contractParty(contract, "2"))
+	assert.Empty(t, contractParty(contract, "3"))
+}
+
+func TestManualPayment(t *testing.T) {
+	provider := currentPaymentProvider()
+	assert.Equal(t, ManualPaymentSlugName, provider.Info().SlugName)
+
+	order := &plugin.PaymentOrder{ContractID: "1", MilestoneID: "10", Amount: 100, Currency: "USD"}
+	fundID, err := provider.Fund(order)
+	assert.NoError(t, err)
+	assert.Equal(t, "manual-fund-10", fundID)
+	releaseID, err := provider.Release(order, fundID)
+	assert.NoError(t, err)
+	assert.Equal(t, "manual-release-10", releaseID)
+
+	_, ok := getPaymentProvider(ManualPaymentSlugName)
+	assert.True(t, ok)
+	_, ok = getPaymentProvider("not_installed")
+	assert.False(t, ok)
+}
+
+func TestAcceptContract(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*ContractService, *MockContractRepo) {
+		mockRepo := new(MockContractRepo)
+		mockRepo.On("GetContract", ctx, "1").Return(&entity.Contract{
+			ID: "1", ClientID: "client", FreelancerID: "freelancer", Status: status}, true, nil)
+		return NewContractService(mockRepo, nil, nil, nil, nil, nil), mockRepo
+	}
+
+	t.Run("freelancer_accepts", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusOffered)
+		mockRepo.On("ChangeContractStatus", ctx, "1", entity.ContractStatusOffered, entity.ContractStatusActive).
+			Return(true, nil)
+
+		err := service.AcceptContract(ctx, &schema.AcceptContractReq{ID: "1", LoginUserID: "freelancer"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("client_can_not_accept", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusOffered)
+
+		err := service.AcceptContract(ctx, &schema.AcceptContractReq{ID: "1", LoginUserID: "client"})
+		assertReason(t, err, reason.ContractPartyNotAllowed)
+		mockRepo.AssertNotCalled(t, "ChangeContractStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("other_user_can_not_see_contract", func(t *testing.T) {
+		service, _ := newService(entity.ContractStatusOffered)
+
+		err := service.AcceptContract(ctx, &schema.AcceptContractReq{ID: "1", LoginUserID: "other"})
+		assertReason(t, err, reason.ContractNotFound)
+	})
+
+	t.Run("contract_already_accepted", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusActive)
+		mockRepo.On("ChangeContractStatus", ctx, "1", entity.ContractStatusOffered, entity.ContractStatusActive).
+			Return(false, nil)
+
+		err := service.AcceptContract(ctx, &schema.AcceptContractReq{ID: "1", LoginUserID: "freelancer"})
+		assertReason(t, err, reason.ContractNotOffered)
+	})
+}
+
+func TestUpdateMilestoneStatus(t *testing.T) {
+	ctx := context.Background()
+	newService := func(contractStatus, milestoneStatus, paymentProvider string) (*ContractService, *MockContractRepo) {
+		mockRepo := new(MockContractRepo)
+		mockRepo.On("GetMilestone", ctx, "10").Return(&entity.Milestone{
+			ID: "10", ContractID: "1", Amount: 100, Status: milestoneStatus}, true, nil)
+		mockRepo.On("GetContract", ctx, "1").Return(&entity.Contract{
+			ID: "1", ClientID: "client", FreelancerID: "freelancer", Status: contractStatus,
+			PaymentProvider: paymentProvider}, true, nil)
+		return NewContractService(mockRepo, nil, nil, nil, nil, nil), mockRepo
+	}
+	fundReq := &schema.UpdateMilestoneStatusReq{ID: "10", Status: entity.MilestoneStatusFunded, LoginUserID: "client"}
+
+	t.Run("contract_not_accepted", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusOffered, entity.MilestoneStatusDraft, ManualPaymentSlugName)
+
+		err := service.UpdateMilestoneStatus(ctx, fundReq)
+		assertReason(t, err, reason.ContractNotActive)
+		mockRepo.AssertNotCalled(t, "ChangeMilestoneStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("fund_claims_then_records_transaction", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusActive, entity.MilestoneStatusDraft, ManualPaymentSlugName)
+		mockRepo.On("ChangeMilestoneStatus", ctx, milestoneStatusIs(entity.MilestoneStatusFunding),
+			entity.MilestoneStatusDraft, []string(nil)).Return(true, nil).Once()
+		mockRepo.On("ChangeMilestoneStatus", ctx, mock.MatchedBy(func(milestone *entity.Milestone) bool {
+			return milestone.Status == entity.MilestoneStatusFunded && milestone.FundTransactionID == "manual-fund-10"
+		}), entity.MilestoneStatusFunding, []string{"note", "fund_transaction_id", "release_transaction_id"}).
+			Return(true, nil).Once()
+
+		err := service.UpdateMilestoneStatus(ctx, fundReq)
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("fund_claimed_by_other_request", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusActive, entity.MilestoneStatusDraft, failedPaymentSlugName)
+		mockRepo.On("ChangeMilestoneStatus", ctx, milestoneStatusIs(entity.MilestoneStatusFunding),
+			entity.MilestoneStatusDraft, []string(nil)).Return(false, nil).Once()
+
+		err := service.UpdateMilestoneStatus(ctx, fundReq)
+		assertReason(t, err, reason.MilestoneStatusInvalid)
+		mockRepo.AssertNumberOfCalls(t, "ChangeMilestoneStatus", 1)
+	})
+
+	t.Run("payment_failed_changes_back", func(t *testing.T) {
+		plugin.StatusManager.Enable(failedPaymentSlugName, true)
+		t.Cleanup(func() { plugin.StatusManager.Enable(failedPaymentSlugName, false) })
+		service, mockRepo := newService(entity.ContractStatusActive, entity.MilestoneStatusDraft, failedPaymentSlugName)
+		mockRepo.On("ChangeMilestoneStatus", ctx, milestoneStatusIs(entity.MilestoneStatusFunding),
+			entity.MilestoneStatusDraft, []string(nil)).Return(true, nil).Once()
+		mockRepo.On("ChangeMilestoneStatus", ctx, milestoneStatusIs(entity.MilestoneStatusDraft),
+			entity.MilestoneStatusFunding, []string(nil)).Return(true, nil).Once()
+
+		err := service.UpdateMilestoneStatus(ctx, fundReq)
+		assertReason(t, err, reason.PaymentFailed)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("submit_without_payment", func(t *testing.T) {
+		service, mockRepo := newService(entity.ContractStatusActive, entity.MilestoneStatusFunded, failedPaymentSlugName)
+		mockRepo.On("ChangeMilestoneStatus", ctx, mock.MatchedBy(func(milestone *entity.Milestone) bool {
+			return milestone.Status == entity.MilestoneStatusSubmitted && milestone.Note == "done"
+		}), entity.MilestoneStatusFunded, []string{"note"}).Return(true, nil).Once()
+
+		err := service.UpdateMilestoneStatus(ctx, &schema.UpdateMilestoneStatusReq{
+			ID: "10", Status: entity.MilestoneStatusSubmitted, Note: "done", LoginUserID: "freelancer"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCompleteContract(t *testing.T) {
+	ctx := context.Background()
+	newService := func(contract *entity.Contract, milestones ...*entity.Milestone) (
+		*ContractService, *MockContractRepo, *MockContractReviewRepo) {
+		contract.ID, contract.ClientID, contract.FreelancerID = "1", "client", "freelancer"
+		mockRepo := new(MockContractRepo)
+		mockRepo.On("GetContract", ctx, "1").Return(contract, true, nil)
+		mockRepo.On("GetMilestoneList", ctx, "1").Return(milestones, nil).Maybe()
+		mockReviewRepo := new(MockContractReviewRepo)
+		return NewContractService(mockRepo, mockReviewRepo, nil, nil, nil, nil), mockRepo, mockReviewRepo
+	}
+	clientReq := &schema.CompleteContractReq{ID: "1", LoginUserID: "client"}
+
+	t.Run("contract_not_accepted", func(t *testing.T) {
+		service, _, _ := newService(&entity.Contract{Status: entity.ContractStatusOffered})
+
+		err := service.CompleteContract(ctx, clientReq)
+		assertReason(t, err, reason.ContractNotActive)
+	})
+
+	t.Run("freelancer_confirms_work", func(t *testing.T) {
+		service, mockRepo, _ := newService(&entity.Contract{Status: entity.ContractStatusActive})
+		mockRepo.On("ConfirmContractWork", ctx, "1").Return(nil)
+
+		err := service.CompleteContract(ctx, &schema.CompleteContractReq{ID: "1", LoginUserID: "freelancer"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "ChangeContractStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("work_not_confirmed", func(t *testing.T) {
+		service, mockRepo, _ := newService(&entity.Contract{Status: entity.ContractStatusActive},
+			&entity.Milestone{ID: "10", Status: entity.MilestoneStatusDraft})
+
+		err := service.CompleteContract(ctx, clientReq)
+		assertReason(t, err, reason.ContractNotConfirmed)
+		mockRepo.AssertNotCalled(t, "ChangeContractStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("milestone_in_escrow", func(t *testing.T) {
+		service, _, _ := newService(&entity.Contract{Status: entity.ContractStatusActive, WorkConfirmed: true},
+			&entity.Milestone{ID: "10", Status: entity.MilestoneStatusSubmitted})
+
+		err := service.CompleteContract(ctx, clientReq)
+		assertReason(t, err, reason.ContractCannotComplete)
+	})
+
+	for name, tc := range map[string]struct {
+		workConfirmed bool
+		milestones    []*entity.Milestone
+	}{
+		"completed_after_work_confirmed":    {workConfirmed: true},
+		"completed_after_milestone_release": {milestones: []*entity.Milestone{{ID: "10", Status: entity.MilestoneStatusReleased}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			service, mockRepo, mockReviewRepo := newService(&entity.Contract{
+				Status: entity.ContractStatusActive, WorkConfirmed: tc.workConfirmed}, tc.milestones...)
+			mockRepo.On("ChangeContractStatus", ctx, "1", entity.ContractStatusActive, entity.ContractStatusCompleted).
+				Return(true, nil)
+			mockReviewRepo.On("RefreshFreelancerStats", ctx, "freelancer").Return(nil)
+
+			err := service.CompleteContract(ctx, clientReq)
+			require.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+			mockReviewRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract
+
+import (
+	"fmt"
+
+	"github.com/apache/answer/plugin"
+)
+
+// ManualPaymentSlugName slug name of the built-in manual payment provider
+const ManualPaymentSlugName = "manual_payment"
+
+// manualPayment the built-in payment provider which is used when no payment plugin is enabled.
+// The money is paid offline, the site only records the escrow state confirmed by the client.
+type manualPayment struct{}
+
+func (m *manualPayment) Info() plugin.Info {
+	return plugin.Info{
+		Name:        plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Manual payment" }},
+		SlugName:    ManualPaymentSlugName,
+		Description: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Pay offline and confirm on site" }},
+	}
+}
+
+func (m *manualPayment) Fund(order *plugin.PaymentOrder) (transactionID string, err error) {
+	return fmt.Sprintf("manual-fund-%s", order.MilestoneID), nil
+}
+
+func (m *manualPayment) Release(order *plugin.PaymentOrder, fundTransactionID string) (transactionID string, err error) {
+	return fmt.Sprintf("manual-release-%s", order.MilestoneID), nil
+}
+
+// getPaymentProvider get the payment provider by slug name, the built-in manual provider is always available
+func getPaymentProvider(slugName string) (provider plugin.Payment, ok bool) {
+	if slugName == ManualPaymentSlugName || len(slugName) == 0 {
+		return &manualPayment{}, true
+	}
+	_ = plugin.CallPayment(func(payment plugin.Payment) error {
+		if payment.Info().SlugName == slugName {
+			provider, ok = payment, true
+		}
+		return nil
+	})
+	return provider, ok
+}
+
+// currentPaymentProvider the enabled payment plugin, or the built-in manual provider if no one is enabled
+func currentPaymentProvider() (provider plugin.Payment) {
+	provider = &manualPayment{}
+	_ = plugin.CallPayment(func(payment plugin.Payment) error {
+		provider = payment
+		return nil
+	})
+	return provider
+}
@@ -0,0 +1,42 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package contract_common
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/entity"
+)
+
+// ContractReviewRepo contract review repository.
+// The rating and the completed projects of the freelancer profile are recomputed in the same transaction
+// whenever a review becomes available or unavailable.
+type ContractReviewRepo interface {
+	AddContractReview(ctx context.Context, review *entity.ContractReview) (err error)
+	GetContractReview(ctx context.Context, id string) (review *entity.ContractReview, exist bool, err error)
+	GetContractReviewByReviewer(ctx context.Context, contractID, reviewerID string) (
+		review *entity.ContractReview, exist bool, err error)
+	GetContractReviewPage(ctx context.Context, page, pageSize int, revieweeID, reviewerRole string) (
+		reviews []*entity.ContractReview, total int64, err error)
+	GetRevieweeRating(ctx context.Context, revieweeID, reviewerRole string) (rating float64, count int64, err error)
+	UpdateContractReviewStatus(ctx context.Context, review *entity.ContractReview, status int) (err error)
+	UpdateContractReviewReply(ctx context.Context, review *entity.ContractReview) (err error)
+	RefreshFreelancerStats(ctx context.Context, userID string) (err error)
+}
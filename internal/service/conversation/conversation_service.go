@@ -0,0 +1,407 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package conversation
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/contract"
+	"github.com/apache/answer/internal/service/file_record"
+	"github.com/apache/answer/internal/service/notice_queue"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/apache/answer/pkg/converter"
+	"github.com/apache/answer/pkg/htmltext"
+	"github.com/apache/answer/pkg/token"
+	"github.com/goccy/go-json"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// ConversationRepo conversation repository
+type ConversationRepo interface {
+	AddConversation(ctx context.Context, conversation *entity.Conversation) (err error)
+	GetConversation(ctx context.Context, id string) (conversation *entity.Conversation, exist bool, err error)
+	GetConversationByContext(ctx context.Context, cond *entity.Conversation) (
+		conversation *entity.Conversation, exist bool, err error)
+	GetConversationPage(ctx context.Context, page, pageSize int, userID string) (
+		conversations []*entity.Conversation, total int64, err error)
+	GetConversationMembers(ctx context.Context, conversationID string) (members []*entity.ConversationMember, err error)
+	GetConversationMembersByIDs(ctx context.Context, conversationIDs []string) (
+		members []*entity.ConversationMember, err error)
+	AddMessage(ctx context.Context, message *entity.Message) (err error)
+	GetMessagePage(ctx context.Context, page, pageSize int, conversationID string) (
+		messages []*entity.Message, total int64, err error)
+	GetMessagesByIDs(ctx context.Context, ids []string) (messages []*entity.Message, err error)
+	ReadConversation(ctx context.Context, conversationID, userID, lastMessageID string) (err error)
+}
+
+const (
+	conversationRoleClient     = "client"
+	conversationRoleFreelancer = "freelancer"
+)
+
+// ConversationService conversation service
+type ConversationService struct {
+	conversationRepo                 ConversationRepo
+	contractRepo                     contract.ContractRepo
+	freelancerRepo                   freelancer.FreelancerRepo
+	userRepo                         usercommon.UserRepo
+	userCommon                       *usercommon.UserCommon
+	fileRecordService                *file_record.FileRecordService
+	notificationQueueService         notice_queue.NotificationQueueService
+	externalNotificationQueueService notice_queue.ExternalNotificationQueueService
+}
+
+// NewConversationService new conversation service
+func NewConversationService(
+	conversationRepo ConversationRepo,
+	contractRepo contract.ContractRepo,
+	freelancerRepo freelancer.FreelancerRepo,
+	userRepo usercommon.UserRepo,
+	userCommon *usercommon.UserCommon,
+	fileRecordService *file_record.FileRecordService,
+	notificationQueueService notice_queue.NotificationQueueService,
+	externalNotificationQueueService notice_queue.ExternalNotificationQueueService,
+) *ConversationService {
+	return &ConversationService{
+		conversationRepo:                 conversationRepo,
+		contractRepo:                     contractRepo,
+		freelancerRepo:                   freelancerRepo,
+		userRepo:                         userRepo,
+		userCommon:                       userCommon,
+		fileRecordService:                fileRecordService,
+		notificationQueueService:         notificationQueueService,
+		externalNotificationQueueService: externalNotificationQueueService,
+	}
+}
+
+// StartConversation send the first message of the contract or the job application to the other party.
+// If the conversation already exists, the message is appended to it.
+func (cs *ConversationService) StartConversation(ctx context.Context, req *schema.StartConversationReq) (
+	resp *schema.StartConversationResp, err error) {
+	cond, err := cs.getConversationContext(ctx, req.ContractID, req.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if req.LoginUserID != cond.ClientID && req.LoginUserID != cond.FreelancerID {
+		return nil, errors.BadRequest(reason.ConversationContextInvalid)
+	}
+
+	conversation, exist, err := cs.conversationRepo.GetConversationByContext(ctx, cond)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		conversation = cond
+		if len(req.Subject) > 0 {
+			conversation.Subject = req.Subject
+		}
+		if err = cs.conversationRepo.AddConversation(ctx, conversation); err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := cs.sendMessage(ctx, conversation, req.LoginUserID, req.Content, req.HTML, req.Attachments)
+	if err != nil {
+		return nil, err
+	}
+	return &schema.StartConversationResp{ConversationID: conversation.ID, Message: message}, nil
+}
+
+// getConversationContext the client, the freelancer and the subject of the conversation
+// are decided by the contract or the job application
+func (cs *ConversationService) getConversationContext(ctx context.Context, contractID, applicationID string) (
+	cond *entity.Conversation, err error) {
+	cond = &entity.Conversation{ContractID: "0", ApplicationID: "0", JobID: "0", LastMessageID: "0"}
+	if len(contractID) > 0 {
+		c, exist, err := cs.contractRepo.GetContract(ctx, contractID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return nil, errors.BadRequest(reason.ConversationContextInvalid)
+		}
+		cond.ContractID = c.ID
+		cond.ClientID = c.ClientID
+		cond.FreelancerID = c.FreelancerID
+		cond.JobID = c.JobID
+		cond.Subject = c.Title
+		return cond, nil
+	}
+
+	application, exist, err := cs.freelancerRepo.GetJobApplicationByID(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.BadRequest(reason.ConversationContextInvalid)
+	}
+	posting, exist, err := cs.freelancerRepo.GetJobPostingByID(ctx, application.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.BadRequest(reason.ConversationContextInvalid)
+	}
+	cond.ApplicationID = application.ID
+	cond.ClientID = posting.UserID
+	cond.FreelancerID = application.ApplicantID
+	cond.JobID = posting.ID
+	cond.Subject = posting.Title
+	return cond, nil
+}
+
+// SendMessage send message to the conversation, only the members can do it
+func (cs *ConversationService) SendMessage(ctx context.Context, req *schema.SendMessageReq) (
+	resp *schema.MessageResp, err error) {
+	conversation, _, err := cs.getMemberConversation(ctx, req.ConversationID, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	return cs.sendMessage(ctx, conversation, req.LoginUserID, req.Content, req.HTML, req.Attachments)
+}
+
+func (cs *ConversationService) sendMessage(ctx context.Context, conversation *entity.Conversation,
+	senderID, content, html string, attachments []string) (resp *schema.MessageResp, err error) {
+	for _, attachment := range attachments {
+		if err = cs.fileRecordService.BindMessageAttachment(ctx, senderID, attachment, conversation.ID); err != nil {
+			return nil, err
+		}
+	}
+	message := &entity.Message{
+		ConversationID: conversation.ID,
+		SenderID:       senderID,
+		OriginalText:   content,
+		ParsedText:     html,
+		Status:         entity.MessageStatusAvailable,
+	}
+	if len(attachments) > 0 {
+		data, _ := json.Marshal(attachments)
+		message.Attachments = string(data)
+	}
+	if err = cs.conversationRepo.AddMessage(ctx, message); err != nil {
+		return nil, err
+	}
+
+	receiverID := conversation.ClientID
+	if senderID == conversation.ClientID {
+		receiverID = conversation.FreelancerID
+	}
+	cs.notifyNewMessage(ctx, conversation, message, receiverID)
+
+	resp = &schema.MessageResp{}
+	resp.ConvertFromMessageEntity(message)
+	return resp, nil
+}
+
+// notifyNewMessage send the new message alert to the inbox and the email of the receiver
+func (cs *ConversationService) notifyNewMessage(ctx context.Context, conversation *entity.Conversation,
+	message *entity.Message, receiverID string) {
+	summary := htmltext.FetchExcerpt(message.ParsedText, "...", 240)
+	cs.notificationQueueService.Send(ctx, &schema.NotificationMsg{
+		TriggerUserID:       message.SenderID,
+		ReceiverUserID:      receiverID,
+		Type:                schema.NotificationTypeInbox,
+		Title:               conversation.Subject,
+		ObjectID:            conversation.ID,
+		ObjectType:          constant.ConversationObjectType,
+		NotificationAction:  constant.NotificationNewMessage,
+		NoNeedPushAllFollow: true,
+		ExtraInfo:           map[string]string{"message_summary": summary},
+	})
+
+	receiver, exist, err := cs.userRepo.GetByUserID(ctx, receiverID)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if !exist {
+		log.Warnf("user %s not found", receiverID)
+		return
+	}
+	rawData := &schema.NewMessageTemplateRawData{
+		ConversationID:  conversation.ID,
+		Subject:         conversation.Subject,
+		MessageSummary:  summary,
+		UnsubscribeCode: token.GenerateToken(),
+	}
+	if sender, _, _ := cs.userCommon.GetUserBasicInfoByID(ctx, message.SenderID); sender != nil {
+		rawData.SenderDisplayName = sender.DisplayName
+	}
+	cs.externalNotificationQueueService.Send(ctx, &schema.ExternalNotificationMsg{
+		ReceiverUserID:            receiver.ID,
+		ReceiverEmail:             receiver.EMail,
+		ReceiverLang:              receiver.Language,
+		NewMessageTemplateRawData: rawData,
+	})
+}
+
+// GetConversationPage get the conversations of login user with the last message and the unread count
+func (cs *ConversationService) GetConversationPage(ctx context.Context, req *schema.GetConversationsReq) (
+	pageModel *pager.PageModel, err error) {
+	conversations, total, err := cs.conversationRepo.GetConversationPage(ctx, req.Page, req.PageSize, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationIDs := make([]string, 0, len(conversations))
+	messageIDs := make([]string, 0, len(conversations))
+	userIDs := make([]string, 0, len(conversations))
+	for _, conversation := range conversations {
+		conversationIDs = append(conversationIDs, conversation.ID)
+		messageIDs = append(messageIDs, conversation.LastMessageID)
+		userIDs = append(userIDs, conversation.ClientID, conversation.FreelancerID)
+	}
+	members, err := cs.conversationRepo.GetConversationMembersByIDs(ctx, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := cs.conversationRepo.GetMessagesByIDs(ctx, messageIDs)
+	if err != nil {
+		return nil, err
+	}
+	users, err := cs.userCommon.BatchUserBasicInfoByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	memberMapping := make(map[string]*entity.ConversationMember, len(members))
+	for _, member := range members {
+		memberMapping[member.ConversationID+"_"+member.UserID] = member
+	}
+	messageMapping := make(map[string]*entity.Message, len(messages))
+	for _, message := range messages {
+		messageMapping[message.ID] = message
+	}
+
+	list := make([]*schema.ConversationResp, 0, len(conversations))
+	for _, conversation := range conversations {
+		item := &schema.ConversationResp{}
+		item.ConvertFromConversationEntity(conversation)
+		item.Role, item.Participant = conversationRoleClient, users[conversation.FreelancerID]
+		if req.LoginUserID == conversation.FreelancerID {
+			item.Role, item.Participant = conversationRoleFreelancer, users[conversation.ClientID]
+		}
+		if member := memberMapping[conversation.ID+"_"+req.LoginUserID]; member != nil {
+			item.UnreadCount = member.UnreadCount
+		}
+		if message := messageMapping[conversation.LastMessageID]; message != nil {
+			item.LastMessage = &schema.MessageResp{}
+			item.LastMessage.ConvertFromMessageEntity(message)
+			item.LastMessage.IsRead = isMessageRead(message,
+				memberMapping[conversation.ID+"_"+conversation.ClientID],
+				memberMapping[conversation.ID+"_"+conversation.FreelancerID])
+		}
+		list = append(list, item)
+	}
+	return pager.NewPageModel(total, list), nil
+}
+
+// GetMessagePage get the messages of the conversation with the read receipts, the latest first
+func (cs *ConversationService) GetMessagePage(ctx context.Context, req *schema.GetMessagesReq) (
+	pageModel *pager.PageModel, err error) {
+	_, members, err := cs.getMemberConversation(ctx, req.ConversationID, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	messages, total, err := cs.conversationRepo.GetMessagePage(ctx, req.Page, req.PageSize, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*schema.MessageResp, 0, len(messages))
+	for _, message := range messages {
+		item := &schema.MessageResp{}
+		item.ConvertFromMessageEntity(message)
+		item.IsRead = isMessageRead(message, members...)
+		list = append(list, item)
+	}
+	return pager.NewPageModel(total, list), nil
+}
+
+// ReadConversation mark all messages of the conversation as read by login user
+func (cs *ConversationService) ReadConversation(ctx context.Context, req *schema.ReadConversationReq) (err error) {
+	conversation, _, err := cs.getMemberConversation(ctx, req.ConversationID, req.LoginUserID)
+	if err != nil {
+		return err
+	}
+	return cs.conversationRepo.ReadConversation(ctx, conversation.ID, req.LoginUserID, conversation.LastMessageID)
+}
+
+// GetMessageAttachment get the local path and the original filename of the message attachment,
+// only the members of the conversation which the attachment is bound to can download it
+func (cs *ConversationService) GetMessageAttachment(ctx context.Context, req *schema.GetMessageAttachmentReq) (
+	localPath, filename string, err error) {
+	record, localPath, filename, err := cs.fileRecordService.GetLocalAttachment(ctx,
+		constant.FilesMessageSubPath, req.FilePath)
+	if err != nil {
+		return "", "", err
+	}
+	// the attachment which is not sent yet can only be downloaded by the uploader
+	if converter.StringToInt64(record.ObjectID) == 0 {
+		if record.UserID != req.LoginUserID {
+			return "", "", errors.NotFound(reason.ObjectNotFound)
+		}
+		return localPath, filename, nil
+	}
+	if _, _, err = cs.getMemberConversation(ctx, record.ObjectID, req.LoginUserID); err != nil {
+		return "", "", errors.NotFound(reason.ObjectNotFound)
+	}
+	return localPath, filename, nil
+}
+
+// getMemberConversation get the conversation and its members, the user must be a member of it
+func (cs *ConversationService) getMemberConversation(ctx context.Context, conversationID, userID string) (
+	conversation *entity.Conversation, members []*entity.ConversationMember, err error) {
+	conversation, exist, err := cs.conversationRepo.GetConversation(ctx, conversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exist {
+		return nil, nil, errors.NotFound(reason.ConversationNotFound)
+	}
+	members, err = cs.conversationRepo.GetConversationMembers(ctx, conversation.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, member := range members {
+		if member.UserID == userID {
+			return conversation, members, nil
+		}
+	}
+	return nil, nil, errors.NotFound(reason.ConversationNotFound)
+}
+
+// isMessageRead the message is read when every member except the sender has read it
+func isMessageRead(message *entity.Message, members ...*entity.ConversationMember) bool {
+	for _, member := range members {
+		if member == nil || member.UserID == message.SenderID {
+			continue
+		}
+		if converter.StringToInt64(member.LastReadMessageID) < converter.StringToInt64(message.ID) {
+			return false
+		}
+	}
+	return true
+}
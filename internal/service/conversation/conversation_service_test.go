@@ -0,0 +1,424 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package conversation
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/file_record"
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockConversationRepo struct {
+	mock.Mock
+}
+
+type MockFileRecordRepo struct {
+	mock.Mock
+}
+
+type MockUserRepo struct {
+	mock.Mock
+}
+
+type MockNotificationQueueService struct {
+	mock.Mock
+}
+
+type MockExternalNotificationQueueService struct {
+	mock.Mock
+}
+
+func (m *MockConversationRepo) AddConversation(ctx context.Context, conversation *entity.Conversation) error {
+	args := m.Called(ctx, conversation)
+	return args.Error(0)
+}
+
+func (m *MockConversationRepo) GetConversation(ctx context.Context, id string) (*entity.Conversation, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Conversation), args.Bool(1), args.Error(2)
+}
+
+func (m *MockConversationRepo) GetConversationByContext(ctx context.Context, cond *entity.Conversation) (*entity.Conversation, bool, error) {
+	args := m.Called(ctx, cond)
+	return args.Get(0).(*entity.Conversation), args.Bool(1), args.Error(2)
+}
+
+func (m *MockConversationRepo) GetConversationPage(ctx context.Context, page int, pageSize int, userID string) ([]*entity.Conversation, int64, error) {
+	args := m.Called(ctx, page, pageSize, userID)
+	return args.Get(0).([]*entity.Conversation), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockConversationRepo) GetConversationMembers(ctx context.Context, conversationID string) ([]*entity.ConversationMember, error) {
+	args := m.Called(ctx, conversationID)
+	return args.Get(0).([]*entity.ConversationMember), args.Error(1)
+}
+
+func (m *MockConversationRepo) GetConversationMembersByIDs(ctx context.Context, conversationIDs []string) ([]*entity.ConversationMember, error) {
+	args := m.Called(ctx, conversationIDs)
+	return args.Get(0).([]*entity.ConversationMember), args.Error(1)
+}
+
+func (m *MockConversationRepo) AddMessage(ctx context.Context, message *entity.Message) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
+
+func (m *MockConversationRepo) GetMessagePage(ctx context.Context, page int, pageSize int, conversationID string) ([]*entity.Message, int64, error) {
+	args := m.Called(ctx, page, pageSize, conversationID)
+	return args.Get(0).([]*entity.Message), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockConversationRepo) GetMessagesByIDs(ctx context.Context, ids []string) ([]*entity.Message, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.Message), args.Error(1)
+}
+
+func (m *MockConversationRepo) ReadConversation(ctx context.Context, conversationID string, userID string, lastMessageID string) error {
+	args := m.Called(ctx, conversationID, userID, lastMessageID)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) AddFileRecord(ctx context.Context, fileRecord *entity.FileRecord) error {
+	args := m.Called(ctx, fileRecord)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) UpdateFileRecord(ctx context.Context, fileRecord *entity.FileRecord) error {
+	args := m.Called(ctx, fileRecord)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordPage(ctx context.Context, page int, pageSize int, cond *entity.FileRecord) ([]*entity.FileRecord, int64, error) {
+	args := m.Called(ctx, page, pageSize, cond)
+	return args.Get(0).([]*entity.FileRecord), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFileRecordRepo) DeleteFileRecord(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordByURL(ctx context.Context, fileURL string) (*entity.FileRecord, error) {
+	args := m.Called(ctx, fileURL)
+	return args.Get(0).(*entity.FileRecord), args.Error(1)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordByPath(ctx context.Context, filePath string) (*entity.FileRecord, error) {
+	args := m.Called(ctx, filePath)
+	return args.Get(0).(*entity.FileRecord), args.Error(1)
+}
+
+func (m *MockUserRepo) AddUser(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseAnswerCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseQuestionCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateQuestionCount(ctx context.Context, userID string, count int64) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateAnswerCount(ctx context.Context, userID string, count int) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateLastLoginDate(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmailStatus(ctx context.Context, userID string, emailStatus int) error {
+	args := m.Called(ctx, userID, emailStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateNoticeStatus(ctx context.Context, userID string, noticeStatus int) error {
+	args := m.Called(ctx, userID, noticeStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmail(ctx context.Context, userID string, email string) error {
+	args := m.Called(ctx, userID, email)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateUserInterface(ctx context.Context, userID string, language string, colorSchema string) error {
+	args := m.Called(ctx, userID, language, colorSchema)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdatePass(ctx context.Context, userID string, pass string) error {
+	args := m.Called(ctx, userID, pass)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateInfo(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateUserProfile(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetByUserID(ctx context.Context, userID string) (*entity.User, bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) BatchGetByID(ctx context.Context, ids []string) ([]*entity.User, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByUsername(ctx context.Context, username string) (*entity.User, bool, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetByUsernames(ctx context.Context, usernames []string) ([]*entity.User, error) {
+	args := m.Called(ctx, usernames)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByEmail(ctx context.Context, email string) (*entity.User, bool, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetUserCount(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepo) SearchUserListByName(ctx context.Context, name string, limit int, onlyStaff bool) ([]*entity.User, error) {
+	args := m.Called(ctx, name, limit, onlyStaff)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) IsAvatarFileUsed(ctx context.Context, filePath string) (bool, error) {
+	args := m.Called(ctx, filePath)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationQueueService) Send(ctx context.Context, msg *schema.NotificationMsg) {
+	m.Called(ctx, msg)
+}
+
+func (m *MockNotificationQueueService) RegisterHandler(handler func(ctx context.Context, msg *schema.NotificationMsg) error) {
+	m.Called(handler)
+}
+
+func (m *MockExternalNotificationQueueService) Send(ctx context.Context, msg *schema.ExternalNotificationMsg) {
+	m.Called(ctx, msg)
+}
+
+func (m *MockExternalNotificationQueueService) RegisterHandler(handler func(ctx context.Context, msg *schema.ExternalNotificationMsg) error) {
+	m.Called(handler)
+}
+
+func assertReason(t *testing.T, err error, reason string) {
+	var e *errors.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, reason, e.Reason)
+}
+
+// newTestConversationService new conversation service with the mock repos,
+// the conversation 1 is between the client 1 and the freelancer 2
+func newTestConversationService(t *testing.T) (
+	cs *ConversationService, repo *MockConversationRepo, fileRecordRepo *MockFileRecordRepo, uploadPath string) {
+	repo = new(MockConversationRepo)
+	repo.On("GetConversation", mock.Anything, "1").Return(&entity.Conversation{
+		ID: "1", ClientID: "1", FreelancerID: "2", Subject: "website", LastMessageID: "9",
+	}, true, nil).Maybe()
+	repo.On("GetConversation", mock.Anything, mock.Anything).Return((*entity.Conversation)(nil), false, nil).Maybe()
+	repo.On("GetConversationMembers", mock.Anything, "1").Return([]*entity.ConversationMember{
+		{ConversationID: "1", UserID: "1"},
+		{ConversationID: "1", UserID: "2"},
+	}, nil).Maybe()
+
+	userRepo := new(MockUserRepo)
+	userRepo.On("GetByUserID", mock.Anything, mock.Anything).Return((*entity.User)(nil), false, nil).Maybe()
+	notificationQueueService := new(MockNotificationQueueService)
+	notificationQueueService.On("Send", mock.Anything, mock.Anything).Return().Maybe()
+
+	uploadPath = t.TempDir()
+	fileRecordRepo = new(MockFileRecordRepo)
+	fileRecordService := file_record.NewFileRecordService(fileRecordRepo, nil,
+		&service_config.ServiceConfig{UploadPath: uploadPath}, nil, nil, nil)
+	cs = NewConversationService(repo, nil, nil, userRepo, nil, fileRecordService,
+		notificationQueueService, new(MockExternalNotificationQueueService))
+	return cs, repo, fileRecordRepo, uploadPath
+}
+
+func TestSendMessage(t *testing.T) {
+	t.Run("not member", func(t *testing.T) {
+		cs, repo, _, _ := newTestConversationService(t)
+		_, err := cs.SendMessage(context.TODO(), &schema.SendMessageReq{
+			ConversationID: "1", Content: "hello", LoginUserID: "3",
+		})
+		assertReason(t, err, reason.ConversationNotFound)
+		_, err = cs.SendMessage(context.TODO(), &schema.SendMessageReq{
+			ConversationID: "2", Content: "hello", LoginUserID: "1",
+		})
+		assertReason(t, err, reason.ConversationNotFound)
+		repo.AssertNotCalled(t, "AddMessage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("send with attachment", func(t *testing.T) {
+		cs, repo, fileRecordRepo, _ := newTestConversationService(t)
+		attachment := "https://example.com/answer/api/v1/conversation/attachment/abc/spec.pdf"
+		fileRecordRepo.On("GetFileRecordByURL", mock.Anything, attachment).Return(&entity.FileRecord{
+			ID: 1, UserID: "2", FilePath: "files/message/abc.pdf", FileURL: attachment, ObjectID: "0",
+		}, nil).Once()
+		fileRecordRepo.On("UpdateFileRecord", mock.Anything, mock.MatchedBy(func(record *entity.FileRecord) bool {
+			return record.ID == 1 && record.ObjectID == "1"
+		})).Return(nil).Once()
+		repo.On("AddMessage", mock.Anything, mock.MatchedBy(func(message *entity.Message) bool {
+			return message.ConversationID == "1" && message.SenderID == "2" &&
+				message.Attachments == `["`+attachment+`"]`
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.Message).ID = "10"
+		}).Return(nil).Once()
+
+		resp, err := cs.SendMessage(context.TODO(), &schema.SendMessageReq{
+			ConversationID: "1", Content: "the spec", HTML: "<p>the spec</p>",
+			Attachments: []string{attachment}, LoginUserID: "2",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "10", resp.ID)
+		assert.Equal(t, []string{attachment}, resp.Attachments)
+		fileRecordRepo.AssertExpectations(t)
+		repo.AssertExpectations(t)
+		// the client is alerted
+		cs.notificationQueueService.(*MockNotificationQueueService).AssertCalled(t, "Send", mock.Anything,
+			mock.MatchedBy(func(msg *schema.NotificationMsg) bool {
+				return msg.ReceiverUserID == "1" && msg.TriggerUserID == "2" && msg.ObjectID == "1"
+			}))
+	})
+
+	t.Run("attachment of others", func(t *testing.T) {
+		cs, repo, fileRecordRepo, _ := newTestConversationService(t)
+		attachment := "https://example.com/answer/api/v1/conversation/attachment/abc/spec.pdf"
+		fileRecordRepo.On("GetFileRecordByURL", mock.Anything, attachment).Return(&entity.FileRecord{
+			ID: 1, UserID: "3", FilePath: "files/message/abc.pdf", FileURL: attachment, ObjectID: "0",
+		}, nil).Once()
+
+		_, err := cs.SendMessage(context.TODO(), &schema.SendMessageReq{
+			ConversationID: "1", Content: "the spec", Attachments: []string{attachment}, LoginUserID: "2",
+		})
+		assertReason(t, err, reason.ForbiddenError)
+		repo.AssertNotCalled(t, "AddMessage", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetMessagePageAccess(t *testing.T) {
+	cs, repo, _, _ := newTestConversationService(t)
+	_, err := cs.GetMessagePage(context.TODO(), &schema.GetMessagesReq{ConversationID: "1", LoginUserID: "3"})
+	assertReason(t, err, reason.ConversationNotFound)
+	err = cs.ReadConversation(context.TODO(), &schema.ReadConversationReq{ConversationID: "1", LoginUserID: "3"})
+	assertReason(t, err, reason.ConversationNotFound)
+	repo.AssertNotCalled(t, "GetMessagePage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "ReadConversation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetMessageAttachment(t *testing.T) {
+	cs, _, fileRecordRepo, uploadPath := newTestConversationService(t)
+	require.NoError(t, os.MkdirAll(filepath.Join(uploadPath, constant.FilesMessageSubPath), os.ModePerm))
+	for _, name := range []string{"sent.pdf", "draft.pdf"} {
+		require.NoError(t, os.WriteFile(filepath.Join(uploadPath, constant.FilesMessageSubPath, name), nil, 0o600))
+	}
+	fileRecordRepo.On("GetFileRecordByPath", mock.Anything, "files/message/sent.pdf").Return(&entity.FileRecord{
+		ID: 1, UserID: "2", FilePath: "files/message/sent.pdf", ObjectID: "1",
+	}, nil)
+	fileRecordRepo.On("GetFileRecordByPath", mock.Anything, "files/message/draft.pdf").Return(&entity.FileRecord{
+		ID: 2, UserID: "2", FilePath: "files/message/draft.pdf", ObjectID: "0",
+	}, nil)
+	fileRecordRepo.On("GetFileRecordByPath", mock.Anything, mock.Anything).Return(&entity.FileRecord{}, nil)
+
+	// the members of the conversation can download the sent attachment
+	for _, userID := range []string{"1", "2"} {
+		localPath, filename, err := cs.GetMessageAttachment(context.TODO(), &schema.GetMessageAttachmentReq{
+			FilePath: "/sent/spec.pdf", LoginUserID: userID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(uploadPath, "files/message/sent.pdf"), localPath)
+		assert.Equal(t, "spec.pdf", filename)
+	}
+	_, _, err := cs.GetMessageAttachment(context.TODO(), &schema.GetMessageAttachmentReq{
+		FilePath: "/sent/spec.pdf", LoginUserID: "3",
+	})
+	assertReason(t, err, reason.ObjectNotFound)
+
+	// the attachment not sent yet can only be downloaded by the uploader
+	_, _, err = cs.GetMessageAttachment(context.TODO(), &schema.GetMessageAttachmentReq{
+		FilePath: "/draft/spec.pdf", LoginUserID: "2",
+	})
+	require.NoError(t, err)
+	_, _, err = cs.GetMessageAttachment(context.TODO(), &schema.GetMessageAttachmentReq{
+		FilePath: "/draft/spec.pdf", LoginUserID: "1",
+	})
+	assertReason(t, err, reason.ObjectNotFound)
+
+	// the files out of the message attachment directory are not served
+	_, _, err = cs.GetMessageAttachment(context.TODO(), &schema.GetMessageAttachmentReq{
+		FilePath: "/../../post/abc/spec.png", LoginUserID: "1",
+	})
+	assertReason(t, err, reason.ObjectNotFound)
+	fileRecordRepo.AssertNotCalled(t, "GetFileRecordByPath", mock.Anything, "post/abc.png")
+}
+
+func TestIsMessageRead(t *testing.T) {
+	client := &entity.ConversationMember{UserID: "1", LastReadMessageID: "12"}
+	freelancer := &entity.ConversationMember{UserID: "2", LastReadMessageID: "9"}
+
+	// the receiver has read until message 9
+	assert.True(t, isMessageRead(&entity.Message{ID: "9", SenderID: "1"}, client, freelancer))
+	assert.False(t, isMessageRead(&entity.Message{ID: "10", SenderID: "1"}, client, freelancer))
+	// the ids are compared as numbers
+	assert.True(t, isMessageRead(&entity.Message{ID: "11", SenderID: "2"}, client, freelancer))
+	assert.False(t, isMessageRead(&entity.Message{ID: "100", SenderID: "2"}, client, freelancer))
+	// the sender is not counted
+	assert.True(t, isMessageRead(&entity.Message{ID: "100", SenderID: "2"}, freelancer))
+}
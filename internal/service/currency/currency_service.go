@@ -0,0 +1,170 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package currency
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/service/siteinfo_common"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// CurrencyService normalize the amounts in different currencies to the base currency of the site,
+// so that the rates and the budgets can be filtered and sorted together
+type CurrencyService struct {
+	siteInfoService siteinfo_common.SiteInfoCommonService
+	freelancerRepo  freelancer.FreelancerRepo
+}
+
+// NewCurrencyService new currency service
+func NewCurrencyService(
+	siteInfoService siteinfo_common.SiteInfoCommonService,
+	freelancerRepo freelancer.FreelancerRepo,
+) *CurrencyService {
+	return &CurrencyService{
+		siteInfoService: siteInfoService,
+		freelancerRepo:  freelancerRepo,
+	}
+}
+
+// GetConverter get the converter with the exchange rates of the current provider
+func (cs *CurrencyService) GetConverter(ctx context.Context) (converter *Converter, err error) {
+	siteCurrency, err := cs.siteInfoService.GetSiteCurrency(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newConverter(siteCurrency.BaseCurrency, currentExchangeRateProvider(siteCurrency)), nil
+}
+
+// Normalize get the currency code and the amount in the base currency of the amount to be saved,
+// the empty currency is the base currency. It returns an error if the currency can not be converted.
+func (cs *CurrencyService) Normalize(ctx context.Context, amount float64, currency string) (
+	code string, baseAmount float64, err error) {
+	converter, err := cs.GetConverter(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	code = converter.Code(currency)
+	baseAmount, ok := converter.ToBase(amount, code)
+	if !ok {
+		return "", 0, errors.BadRequest(reason.CurrencyRateUnavailable)
+	}
+	return code, baseAmount, nil
+}
+
+// RefreshBaseAmounts convert the amounts of all listings to the base currency with the latest exchange rates,
+// the amounts in the currency without a rate are kept as before
+func (cs *CurrencyService) RefreshBaseAmounts(ctx context.Context) {
+	converter, err := cs.GetConverter(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	currencies, err := cs.freelancerRepo.GetAmountCurrencies(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, currency := range currencies {
+		rate, ok := converter.Rate(converter.Code(currency), converter.BaseCurrency)
+		if !ok {
+			log.Warnf("no exchange rate from %s to %s, base amounts are not refreshed", currency, converter.BaseCurrency)
+			continue
+		}
+		if err = cs.freelancerRepo.UpdateBaseAmounts(ctx, currency, rate); err != nil {
+			log.Errorf("refresh base amounts of %s failed: %v", currency, err)
+		}
+	}
+}
+
+// Converter converts the amounts between the currencies, the rates are cached in the converter,
+// so a converter should only be used for one request or one task
+type Converter struct {
+	BaseCurrency string
+	provider     plugin.ExchangeRate
+	rates        map[string]float64
+}
+
+func newConverter(baseCurrency string, provider plugin.ExchangeRate) *Converter {
+	return &Converter{
+		BaseCurrency: strings.ToUpper(baseCurrency),
+		provider:     provider,
+		rates:        make(map[string]float64),
+	}
+}
+
+// Code the upper case currency code, the empty currency is the base currency
+func (c *Converter) Code(currency string) string {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) == 0 {
+		return c.BaseCurrency
+	}
+	return currency
+}
+
+// Rate how many units of the currency `to` one unit of the currency `from` is worth
+func (c *Converter) Rate(from, to string) (rate float64, ok bool) {
+	if from == to {
+		return 1, true
+	}
+	key := from + ":" + to
+	if rate, ok = c.rates[key]; ok {
+		return rate, rate > 0
+	}
+	rate, ok, err := c.provider.GetRate(from, to)
+	if err != nil {
+		log.Errorf("get exchange rate from %s to %s failed: %v", from, to, err)
+	}
+	if err != nil || !ok || rate <= 0 {
+		rate = 0
+	}
+	c.rates[key] = rate
+	return rate, rate > 0
+}
+
+// Convert convert the amount from one currency to another, ok is false if the rate is unknown
+func (c *Converter) Convert(amount float64, from, to string) (result float64, ok bool) {
+	rate, ok := c.Rate(c.Code(from), c.Code(to))
+	if !ok {
+		return 0, false
+	}
+	return Round(amount * rate), true
+}
+
+// ToBase convert the amount in the currency to the base currency
+func (c *Converter) ToBase(amount float64, currency string) (result float64, ok bool) {
+	return c.Convert(amount, currency, c.BaseCurrency)
+}
+
+// FromBase convert the amount in the base currency to the currency
+func (c *Converter) FromBase(amount float64, currency string) (result float64, ok bool) {
+	return c.Convert(amount, c.BaseCurrency, currency)
+}
+
+// Round round the amount to the cent
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,57 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package currency
+
+import (
+	"testing"
+
+	"github.com/apache/answer/internal/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_Convert(t *testing.T) {
+	converter := newConverter("USD", newStaticExchangeRate(&schema.SiteCurrencyResp{
+		BaseCurrency: "USD",
+		Rates:        map[string]float64{"EUR": 0.8, "INR": 80},
+	}))
+
+	assert.Equal(t, "USD", converter.Code(""))
+	assert.Equal(t, "EUR", converter.Code(" eur"))
+
+	amount, ok := converter.ToBase(8000, "INR")
+	assert.True(t, ok)
+	assert.Equal(t, float64(100), amount)
+
+	amount, ok = converter.FromBase(100, "EUR")
+	assert.True(t, ok)
+	assert.Equal(t, float64(80), amount)
+
+	// the cross rate goes through the base currency
+	amount, ok = converter.Convert(10, "EUR", "INR")
+	assert.True(t, ok)
+	assert.Equal(t, float64(1000), amount)
+
+	amount, ok = converter.Convert(10, "USD", "")
+	assert.True(t, ok)
+	assert.Equal(t, float64(10), amount)
+
+	_, ok = converter.ToBase(10, "JPY")
+	assert.False(t, ok)
+}
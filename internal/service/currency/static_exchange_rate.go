@@ -0,0 +1,77 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package currency
+
+import (
+	"strings"
+
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/plugin"
+)
+
+// StaticExchangeRateSlugName slug name of the built-in static exchange rate provider
+const StaticExchangeRateSlugName = "static_exchange_rate"
+
+// staticExchangeRate the built-in exchange rate provider which is used when no exchange rate plugin is enabled.
+// The rates are configured by the admin in the site currency settings.
+type staticExchangeRate struct {
+	baseCurrency string
+	// currency code -> how many units of it one unit of the base currency is worth
+	rates map[string]float64
+}
+
+func newStaticExchangeRate(siteCurrency *schema.SiteCurrencyResp) *staticExchangeRate {
+	s := &staticExchangeRate{
+		baseCurrency: strings.ToUpper(siteCurrency.BaseCurrency),
+		rates:        make(map[string]float64, len(siteCurrency.Rates)),
+	}
+	for code, rate := range siteCurrency.Rates {
+		s.rates[strings.ToUpper(code)] = rate
+	}
+	s.rates[s.baseCurrency] = 1
+	return s
+}
+
+func (s *staticExchangeRate) Info() plugin.Info {
+	return plugin.Info{
+		Name:        plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Static exchange rate" }},
+		SlugName:    StaticExchangeRateSlugName,
+		Description: plugin.Translator{Fn: func(ctx *plugin.GinContext) string { return "Exchange rates configured in the site settings" }},
+	}
+}
+
+func (s *staticExchangeRate) GetRate(from, to string) (rate float64, ok bool, err error) {
+	fromRate, fromOK := s.rates[from]
+	toRate, toOK := s.rates[to]
+	if !fromOK || !toOK || fromRate <= 0 {
+		return 0, false, nil
+	}
+	return toRate / fromRate, true, nil
+}
+
+// currentExchangeRateProvider the enabled exchange rate plugin, or the built-in static provider if no one is enabled
+func currentExchangeRateProvider(siteCurrency *schema.SiteCurrencyResp) (provider plugin.ExchangeRate) {
+	provider = newStaticExchangeRate(siteCurrency)
+	_ = plugin.CallExchangeRate(func(exchangeRate plugin.ExchangeRate) error {
+		provider = exchangeRate
+		return nil
+	})
+	return provider
+}
@@ -35,6 +35,7 @@ import (
 
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/activity_common"
 	answercommon "github.com/apache/answer/internal/service/answer_common"
@@ -45,6 +46,7 @@ import (
 	"github.com/apache/answer/internal/service/report_common"
 	"github.com/apache/answer/internal/service/service_config"
 	"github.com/apache/answer/internal/service/siteinfo_common"
+	tagcommon "github.com/apache/answer/internal/service/tag_common"
 	usercommon "github.com/apache/answer/internal/service/user_common"
 	"github.com/apache/answer/pkg/dir"
 	"github.com/segmentfault/pacman/log"
@@ -63,6 +65,8 @@ type dashboardService struct {
 	reviewService   *review.ReviewService
 	revisionRepo    revision.RevisionRepo
 	data            *data.Data
+	analyticsRepo   freelancer.AnalyticsRepo
+	tagCommon       *tagcommon.TagCommonService
 }
 
 func NewDashboardService(
@@ -78,6 +82,8 @@ func NewDashboardService(
 	reviewService *review.ReviewService,
 	revisionRepo revision.RevisionRepo,
 	data *data.Data,
+	analyticsRepo freelancer.AnalyticsRepo,
+	tagCommon *tagcommon.TagCommonService,
 ) DashboardService {
 	return &dashboardService{
 		questionRepo:    questionRepo,
@@ -92,11 +98,17 @@ func NewDashboardService(
 		reviewService:   reviewService,
 		revisionRepo:    revisionRepo,
 		data:            data,
+		analyticsRepo:   analyticsRepo,
+		tagCommon:       tagCommon,
 	}
 }
 
 type DashboardService interface {
 	Statistical(ctx context.Context) (resp *schema.DashboardInfo, err error)
+	MarketplaceStatistical(ctx context.Context, req *schema.GetMarketplaceStatisticalReq) (
+		resp *schema.MarketplaceStatisticalResp, err error)
+	ExportMarketplaceStatistical(ctx context.Context, req *schema.ExportMarketplaceStatisticalReq) (
+		content []byte, err error)
 }
 
 func (ds *dashboardService) Statistical(ctx context.Context) (*schema.DashboardInfo, error) {
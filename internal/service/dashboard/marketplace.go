@@ -0,0 +1,305 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/segmentfault/pacman/log"
+)
+
+const trendDateLayout = "2006-01-02"
+
+// MarketplaceStatistical get the statistical of the job postings, applications and freelancers
+func (ds *dashboardService) MarketplaceStatistical(ctx context.Context, req *schema.GetMarketplaceStatisticalReq) (
+	resp *schema.MarketplaceStatisticalResp, err error) {
+	cacheKey := fmt.Sprintf("%s:%d", schema.MarketplaceStatisticalCacheKey, req.GetDays())
+	cacheData, exist, err := ds.data.Cache.GetString(ctx, cacheKey)
+	if err != nil {
+		log.Errorf("get marketplace statistical from cache failed: %s", err)
+	}
+	if exist {
+		resp = &schema.MarketplaceStatisticalResp{}
+		if err = json.Unmarshal([]byte(cacheData), resp); err == nil {
+			return resp, nil
+		}
+	}
+
+	resp, err = ds.marketplaceStatistical(ctx, req.GetDays())
+	if err != nil {
+		return nil, err
+	}
+	respStr, _ := json.Marshal(resp)
+	err = ds.data.Cache.SetString(ctx, cacheKey, string(respStr), schema.MarketplaceStatisticalCacheTime)
+	if err != nil {
+		log.Errorf("set marketplace statistical failed: %s", err)
+	}
+	return resp, nil
+}
+
+// ExportMarketplaceStatistical export the marketplace statistical as csv,
+// the summary contains the metrics and the daily trend, the jobs contains one row for each job posting
+func (ds *dashboardService) ExportMarketplaceStatistical(ctx context.Context,
+	req *schema.ExportMarketplaceStatisticalReq) (content []byte, err error) {
+	resp, err := ds.marketplaceStatistical(ctx, req.GetDays())
+	if err != nil {
+		return nil, err
+	}
+	var records [][]string
+	if req.Type == schema.MarketplaceExportTypeJobs {
+		records = marketplaceJobRecords(resp)
+	} else {
+		records = marketplaceSummaryRecords(resp)
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err = w.WriteAll(records); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (ds *dashboardService) marketplaceStatistical(ctx context.Context, days int) (
+	resp *schema.MarketplaceStatisticalResp, err error) {
+	loc := ds.getLocation(ctx)
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1-days)
+
+	resp = &schema.MarketplaceStatisticalResp{Days: days}
+	if siteCurrency, err := ds.siteInfoService.GetSiteCurrency(ctx); err == nil {
+		resp.BaseCurrency = siteCurrency.BaseCurrency
+	}
+	trend := make(map[string]*schema.MarketplaceTrendItem, days)
+	for i := 0; i < days; i++ {
+		item := &schema.MarketplaceTrendItem{Date: start.AddDate(0, 0, i).Format(trendDateLayout)}
+		trend[item.Date] = item
+		resp.Trend = append(resp.Trend, item)
+	}
+	trendItem := func(t time.Time) *schema.MarketplaceTrendItem {
+		if item := trend[t.In(loc).Format(trendDateLayout)]; item != nil {
+			return item
+		}
+		return &schema.MarketplaceTrendItem{}
+	}
+
+	postings, err := ds.analyticsRepo.GetJobPostingsCreatedSince(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	jobIDs := make([]string, 0, len(postings))
+	for _, posting := range postings {
+		trendItem(posting.CreatedAt).Created++
+		jobIDs = append(jobIDs, posting.ID)
+	}
+	resp.PostingsCreated = int64(len(postings))
+
+	accepted, err := ds.analyticsRepo.GetAcceptedJobApplicationsSince(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range accepted {
+		trendItem(application.UpdatedAt).Filled++
+	}
+	resp.PostingsFilled = int64(len(accepted))
+
+	expired, err := ds.analyticsRepo.GetJobPostingsExpiredBetween(ctx, start, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, posting := range expired {
+		trendItem(posting.ExpiresAt).Expired++
+	}
+	resp.PostingsExpired = int64(len(expired))
+
+	if resp.PostingsOpen, err = ds.analyticsRepo.CountOpenJobPostings(ctx); err != nil {
+		return nil, err
+	}
+	if resp.ActiveVerifiedFreelancers, err = ds.analyticsRepo.CountActiveVerifiedFreelancers(ctx); err != nil {
+		return nil, err
+	}
+	if err = ds.setMarketplaceJobStatistical(ctx, resp, postings, jobIDs); err != nil {
+		return nil, err
+	}
+	if err = ds.setMarketplaceSkillStatistical(ctx, resp, start); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// setMarketplaceJobStatistical count the applications and hires of the job postings created in the period
+func (ds *dashboardService) setMarketplaceJobStatistical(ctx context.Context, resp *schema.MarketplaceStatisticalResp,
+	postings []*entity.JobPosting, jobIDs []string) (err error) {
+	applications, err := ds.analyticsRepo.GetJobApplicationsByJobIDs(ctx, jobIDs)
+	if err != nil {
+		return err
+	}
+	applicationsByJob := make(map[string][]*entity.JobApplication, len(postings))
+	for _, application := range applications {
+		applicationsByJob[application.JobID] = append(applicationsByJob[application.JobID], application)
+	}
+
+	var (
+		totalApplications, hired int64
+		hoursToFirst             []float64
+		totalHoursToFirst        float64
+	)
+	for _, posting := range postings {
+		job := &schema.JobPostingAnalyticsResp{}
+		job.ConvertFromJobPosting(posting, applicationsByJob[posting.ID])
+		resp.Jobs = append(resp.Jobs, job)
+		totalApplications += int64(job.Applications)
+		if job.Accepted > 0 {
+			hired++
+		}
+		if job.FirstApplicationAt > 0 {
+			hoursToFirst = append(hoursToFirst, job.HoursToFirstApplication)
+			totalHoursToFirst += job.HoursToFirstApplication
+		}
+	}
+	if len(postings) > 0 {
+		resp.ApplicationsPerJob = schema.RoundAnalytics(float64(totalApplications) / float64(len(postings)))
+	}
+	if len(hoursToFirst) > 0 {
+		resp.AvgHoursToFirstApplication = schema.RoundAnalytics(totalHoursToFirst / float64(len(hoursToFirst)))
+	}
+	resp.MedianHoursToFirstApplication = schema.Median(hoursToFirst)
+	resp.HireConversionRate = schema.Percentage(hired, int64(len(postings)))
+	return nil
+}
+
+// setMarketplaceSkillStatistical get the most requested skills with the median rate of the freelancers
+func (ds *dashboardService) setMarketplaceSkillStatistical(ctx context.Context, resp *schema.MarketplaceStatisticalResp,
+	since time.Time) (err error) {
+	resp.TopSkills, err = ds.analyticsRepo.GetTopJobPostingSkills(ctx, since, schema.MarketplaceStatisticalTopSkills)
+	if err != nil {
+		return err
+	}
+	tagIDs := make([]string, 0, len(resp.TopSkills))
+	for _, skill := range resp.TopSkills {
+		tagIDs = append(tagIDs, skill.TagID)
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	tags, err := ds.tagCommon.GetTagListByIDs(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+	tagMapping := make(map[string]*entity.Tag, len(tags))
+	for _, tag := range tags {
+		tagMapping[tag.ID] = tag
+	}
+	rates, err := ds.analyticsRepo.GetFreelancerRatesBySkill(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+	for _, skill := range resp.TopSkills {
+		if tag := tagMapping[skill.TagID]; tag != nil {
+			skill.SlugName = tag.SlugName
+			skill.DisplayName = tag.DisplayName
+		}
+		skill.MedianRate = schema.Median(rates[skill.TagID])
+		skill.Freelancers = len(rates[skill.TagID])
+	}
+	return nil
+}
+
+// getLocation the time zone of the site which the days are counted in
+func (ds *dashboardService) getLocation(ctx context.Context) *time.Location {
+	timezone := ds.getTimezone(ctx)
+	if len(timezone) == 0 {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		log.Warnf("load time zone %s failed: %s", timezone, err)
+		return time.UTC
+	}
+	return loc
+}
+
+func marketplaceSummaryRecords(resp *schema.MarketplaceStatisticalResp) (records [][]string) {
+	records = append(records,
+		[]string{"metric", "dimension", "value"},
+		[]string{"days", "", fmt.Sprint(resp.Days)},
+		[]string{"base_currency", "", resp.BaseCurrency},
+		[]string{"postings_open", "", fmt.Sprint(resp.PostingsOpen)},
+		[]string{"postings_created", "", fmt.Sprint(resp.PostingsCreated)},
+		[]string{"postings_filled", "", fmt.Sprint(resp.PostingsFilled)},
+		[]string{"postings_expired", "", fmt.Sprint(resp.PostingsExpired)},
+		[]string{"applications_per_job", "", fmt.Sprint(resp.ApplicationsPerJob)},
+		[]string{"avg_hours_to_first_application", "", fmt.Sprint(resp.AvgHoursToFirstApplication)},
+		[]string{"median_hours_to_first_application", "", fmt.Sprint(resp.MedianHoursToFirstApplication)},
+		[]string{"hire_conversion_rate", "", fmt.Sprint(resp.HireConversionRate)},
+		[]string{"active_verified_freelancers", "", fmt.Sprint(resp.ActiveVerifiedFreelancers)},
+	)
+	for _, item := range resp.Trend {
+		records = append(records,
+			[]string{"postings_created", item.Date, fmt.Sprint(item.Created)},
+			[]string{"postings_filled", item.Date, fmt.Sprint(item.Filled)},
+			[]string{"postings_expired", item.Date, fmt.Sprint(item.Expired)},
+		)
+	}
+	for _, skill := range resp.TopSkills {
+		records = append(records,
+			[]string{"skill_demand", csvSafe(skill.SlugName), fmt.Sprint(skill.Demand)},
+			[]string{"skill_median_rate", csvSafe(skill.SlugName), fmt.Sprint(skill.MedianRate)},
+		)
+	}
+	return records
+}
+
+func marketplaceJobRecords(resp *schema.MarketplaceStatisticalResp) (records [][]string) {
+	records = append(records, []string{"job_id", "title", "status", "created_at", "views", "applications",
+		"pending", "accepted", "rejected", "withdrawn", "hours_to_first_application"})
+	for _, job := range resp.Jobs {
+		records = append(records, []string{
+			job.JobID,
+			csvSafe(job.Title),
+			job.Status,
+			time.Unix(job.CreatedAt, 0).UTC().Format(time.RFC3339),
+			fmt.Sprint(job.Views),
+			fmt.Sprint(job.Applications),
+			fmt.Sprint(job.Pending),
+			fmt.Sprint(job.Accepted),
+			fmt.Sprint(job.Rejected),
+			fmt.Sprint(job.Withdrawn),
+			fmt.Sprint(job.HoursToFirstApplication),
+		})
+	}
+	return records
+}
+
+// csvSafe prevent the user input from being run as a formula by the spreadsheet
+func csvSafe(value string) string {
+	if len(value) > 0 && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
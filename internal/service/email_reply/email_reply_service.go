@@ -0,0 +1,332 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email_reply
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/base/validator"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/action"
+	"github.com/apache/answer/internal/service/comment"
+	"github.com/apache/answer/internal/service/comment_common"
+	"github.com/apache/answer/internal/service/content"
+	"github.com/apache/answer/internal/service/email_reply_common"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/apache/answer/internal/service/rank"
+	"github.com/apache/answer/internal/service/role"
+	"github.com/apache/answer/internal/service/siteinfo_common"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/i18n"
+	"github.com/segmentfault/pacman/log"
+)
+
+const (
+	// replyMessageCacheKeyPrefix the handled message ids, the same reply is never posted twice
+	replyMessageCacheKeyPrefix = "answer:email_reply:message:"
+	replyMessageCacheTime      = 7 * 24 * time.Hour
+	// replyUserAgent the user agent of the answers posted by email, it is shown in the review
+	replyUserAgent = "email-reply"
+)
+
+// EmailReplyService post the replies to the notification emails as the answers and the comments
+type EmailReplyService struct {
+	emailReplyCommon      *email_reply_common.EmailReplyCommon
+	data                  *data.Data
+	userRepo              usercommon.UserRepo
+	userRoleService       *role.UserRoleRelService
+	rankService           *rank.RankService
+	actionService         *action.CaptchaService
+	answerService         *content.AnswerService
+	commentService        *comment.CommentService
+	commentCommonRepo     comment_common.CommentCommonRepo
+	siteInfoCommonService siteinfo_common.SiteInfoCommonService
+}
+
+// NewEmailReplyService new email reply service, the maildir is polled until the cleanup if it is configured
+func NewEmailReplyService(
+	emailReplyCommon *email_reply_common.EmailReplyCommon,
+	data *data.Data,
+	userRepo usercommon.UserRepo,
+	userRoleService *role.UserRoleRelService,
+	rankService *rank.RankService,
+	actionService *action.CaptchaService,
+	answerService *content.AnswerService,
+	commentService *comment.CommentService,
+	commentCommonRepo comment_common.CommentCommonRepo,
+	siteInfoCommonService siteinfo_common.SiteInfoCommonService,
+) (*EmailReplyService, func()) {
+	es := &EmailReplyService{
+		emailReplyCommon:      emailReplyCommon,
+		data:                  data,
+		userRepo:              userRepo,
+		userRoleService:       userRoleService,
+		rankService:           rankService,
+		actionService:         actionService,
+		answerService:         answerService,
+		commentService:        commentService,
+		commentCommonRepo:     commentCommonRepo,
+		siteInfoCommonService: siteInfoCommonService,
+	}
+	conf := emailReplyCommon.Config()
+	if !conf.Enabled || len(conf.Maildir) == 0 {
+		return es, func() {}
+	}
+	stop, done := make(chan struct{}), make(chan struct{})
+	go es.pollMaildir(conf.Maildir, time.Duration(conf.PollIntervalSeconds)*time.Second, stop, done)
+	return es, func() {
+		close(stop)
+		<-done
+	}
+}
+
+// CheckIngestToken check the token of the ingest endpoint, the endpoint is closed without the token
+func (es *EmailReplyService) CheckIngestToken(token string) bool {
+	conf := es.emailReplyCommon.Config()
+	return conf.Enabled && len(conf.IngestToken) > 0 &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(conf.IngestToken)) == 1
+}
+
+// HandleReply post the reply in the raw inbound email on behalf of the user the reply address is signed for
+func (es *EmailReplyService) HandleReply(ctx context.Context, raw io.Reader) (err error) {
+	if !es.emailReplyCommon.Config().Enabled {
+		return errors.BadRequest(reason.EmailReplyDisabled)
+	}
+	msg, err := parseReplyMessage(raw)
+	if err != nil {
+		log.Warnf("parse the email reply failed: %v", err)
+		return errors.BadRequest(reason.RequestFormatError)
+	}
+	target, ok := es.emailReplyCommon.ParseReplyAddress(msg.Recipients)
+	if !ok {
+		return errors.BadRequest(reason.EmailReplyAddressInvalid)
+	}
+
+	if len(msg.MessageID) > 0 {
+		key := replyMessageCacheKeyPrefix + msg.MessageID
+		_, handled, err := es.data.Cache.GetString(ctx, key)
+		if err != nil {
+			log.Error(err)
+		}
+		if handled {
+			log.Infof("email reply %s has been handled", msg.MessageID)
+			return nil
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			if err := es.data.Cache.SetString(ctx, key, target.ObjectID, replyMessageCacheTime); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
+
+	userInfo, exist, err := es.userRepo.GetByUserID(ctx, target.UserID)
+	if err != nil {
+		return err
+	}
+	if !exist || userInfo.Status != entity.UserStatusAvailable || userInfo.MailStatus != entity.EmailStatusAvailable {
+		return errors.Forbidden(reason.UserNotFound)
+	}
+	// the address could be forwarded, only the owner of the account can reply
+	if !strings.EqualFold(userInfo.EMail, msg.From) {
+		return errors.Forbidden(reason.EmailReplySenderMismatch)
+	}
+	if len(msg.Text) == 0 {
+		return errors.BadRequest(reason.EmailReplyContentEmpty)
+	}
+	if len(userInfo.Language) > 0 {
+		ctx = context.WithValue(ctx, constant.AcceptLanguageFlag, i18n.Language(userInfo.Language))
+	}
+
+	switch target.Kind {
+	case email_reply_common.ReplyKindAnswer:
+		return es.addAnswer(ctx, userInfo.ID, target.ObjectID, msg.Text)
+	case email_reply_common.ReplyKindComment:
+		return es.addComment(ctx, userInfo.ID, target.ObjectID, "", msg.Text)
+	default:
+		replyComment, exist, err := es.commentCommonRepo.GetComment(ctx, target.ObjectID)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return errors.BadRequest(reason.CommentNotFound)
+		}
+		return es.addComment(ctx, userInfo.ID, replyComment.ObjectID, replyComment.ID, msg.Text)
+	}
+}
+
+// addAnswer answer the question as the answer controller does, the captcha can not be solved by email,
+// so the reply is rejected when the user has to pass the captcha
+func (es *EmailReplyService) addAnswer(ctx context.Context, userID, questionID, text string) (err error) {
+	req := &schema.AnswerAddReq{QuestionID: questionID, Content: text, UserID: userID, UserAgent: replyUserAgent}
+	if _, err = validator.GetValidatorByLang(handler.GetLangByCtx(ctx)).Check(req); err != nil {
+		return err
+	}
+	canList, err := es.rankService.CheckOperationPermissions(ctx, userID, []string{
+		permission.AnswerAdd,
+		permission.LinkUrlLimit,
+	})
+	if err != nil {
+		return err
+	}
+	if !canList[0] {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	needCaptcha, err := es.needCaptcha(ctx, userID, canList[1], entity.CaptchaActionAnswer)
+	if err != nil {
+		return err
+	}
+	if needCaptcha && !es.actionService.ValidationStrategy(ctx, userID, entity.CaptchaActionAnswer) {
+		return errors.BadRequest(reason.EmailReplyCaptchaRequired)
+	}
+
+	write, err := es.siteInfoCommonService.GetSiteWrite(ctx)
+	if err != nil {
+		return err
+	}
+	if write.RestrictAnswer {
+		ids, err := es.answerService.GetCountByUserIDQuestionID(ctx, userID, questionID)
+		if err != nil {
+			return err
+		}
+		if len(ids) >= 1 {
+			return errors.Forbidden(reason.AnswerRestrictAnswer)
+		}
+	}
+
+	// the answer is reviewed by the answer service
+	if _, err = es.answerService.Insert(ctx, req); err != nil {
+		return err
+	}
+	if needCaptcha {
+		_, _ = es.actionService.ActionRecordAdd(ctx, entity.CaptchaActionAnswer, userID)
+	}
+	return nil
+}
+
+// addComment comment on the question or the answer as the comment controller does
+func (es *EmailReplyService) addComment(ctx context.Context, userID, objectID, replyCommentID, text string) (err error) {
+	req := &schema.AddCommentReq{ObjectID: objectID, ReplyCommentID: replyCommentID, OriginalText: text, UserID: userID}
+	if _, err = validator.GetValidatorByLang(handler.GetLangByCtx(ctx)).Check(req); err != nil {
+		return err
+	}
+	canList, err := es.rankService.CheckOperationPermissions(ctx, userID, []string{
+		permission.CommentAdd,
+		permission.CommentEdit,
+		permission.CommentDelete,
+		permission.LinkUrlLimit,
+	})
+	if err != nil {
+		return err
+	}
+	req.CanAdd, req.CanEdit, req.CanDelete = canList[0], canList[1], canList[2]
+	if !req.CanAdd {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	needCaptcha, err := es.needCaptcha(ctx, userID, canList[3], entity.CaptchaActionComment)
+	if err != nil {
+		return err
+	}
+	if needCaptcha && !es.actionService.ValidationStrategy(ctx, userID, entity.CaptchaActionComment) {
+		return errors.BadRequest(reason.EmailReplyCaptchaRequired)
+	}
+
+	if _, err = es.commentService.AddComment(ctx, req); err != nil {
+		return err
+	}
+	if needCaptcha {
+		_, _ = es.actionService.ActionRecordAdd(ctx, entity.CaptchaActionComment, userID)
+	}
+	return nil
+}
+
+// needCaptcha only the admins and the moderators who are not limited by the links skip the captcha
+func (es *EmailReplyService) needCaptcha(ctx context.Context, userID string, linkUrlLimitUser bool,
+	actionType string) (bool, error) {
+	roleID, err := es.userRoleService.GetUserRole(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	isAdmin := roleID == role.RoleAdminID || roleID == role.RoleModeratorID
+	return !isAdmin || !linkUrlLimitUser, nil
+}
+
+// pollMaildir post the replies delivered to the new directory of the maildir, the handled replies are moved
+// to the cur directory and flagged as seen, the rejected ones are flagged as trashed
+func (es *EmailReplyService) pollMaildir(maildir string, interval time.Duration, stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		es.readMaildir(context.Background(), maildir)
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (es *EmailReplyService) readMaildir(ctx context.Context, maildir string) {
+	entries, err := os.ReadDir(filepath.Join(maildir, "new"))
+	if err != nil {
+		log.Errorf("read the maildir %s failed: %v", maildir, err)
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(maildir, "new", entry.Name())
+		flag := "S"
+		if err := es.handleMaildirFile(ctx, path); err != nil {
+			log.Warnf("email reply %s is rejected: %v", entry.Name(), err)
+			flag = "T"
+		}
+		if err := os.Rename(path, filepath.Join(maildir, "cur", entry.Name()+":2,"+flag)); err != nil {
+			log.Errorf("move the email reply %s failed: %v", entry.Name(), err)
+		}
+	}
+}
+
+func (es *EmailReplyService) handleMaildirFile(ctx context.Context, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	return es.HandleReply(ctx, file)
+}
@@ -0,0 +1,195 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email_reply
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	strip "github.com/grokify/html-strip-tags-go"
+	"golang.org/x/net/html/charset"
+)
+
+// maxReplyMessageSize the inbound email larger than it is rejected, the attachments are not posted anyway
+const maxReplyMessageSize = 10 << 20
+
+// replyRecipientHeaders the headers which could carry the reply address, the mail servers usually keep
+// the original recipient in Delivered-To or X-Original-To when the reply is forwarded to the mailbox
+var replyRecipientHeaders = []string{"To", "Cc", "Delivered-To", "X-Original-To", "Envelope-To"}
+
+var (
+	// the line introducing the quoted email, e.g. "On Mon, Jan 1, 2024 at 10:00 AM Alice <alice@example.com> wrote:"
+	quoteHeaderReg = regexp.MustCompile(`(?is)^on\s.+wrote:$`)
+	// the separators of outlook and the other clients
+	quoteSeparatorReg = regexp.MustCompile(`(?i)^(-{2,}\s*original message\s*-{2,}|_{5,}|from:\s.+)$`)
+	// the signature delimiter and the signatures of the mobile clients
+	signatureReg = regexp.MustCompile(`(?i)^(--|sent from my .+|get outlook for .+)$`)
+
+	htmlQuoteReg     = regexp.MustCompile(`(?is)<blockquote.*</blockquote>|<div class="gmail_quote.*`)
+	htmlLineBreakReg = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+)
+
+// replyMessage the parts of the inbound email used to post the reply
+type replyMessage struct {
+	MessageID  string
+	From       string
+	Recipients []string
+	Text       string
+}
+
+// parseReplyMessage parse the raw inbound email, the text is the reply without the quoted email and the signature
+func parseReplyMessage(r io.Reader) (msg *replyMessage, err error) {
+	m, err := mail.ReadMessage(io.LimitReader(r, maxReplyMessageSize))
+	if err != nil {
+		return nil, fmt.Errorf("read message failed: %w", err)
+	}
+	from, err := mail.ParseAddress(m.Header.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("parse from address failed: %w", err)
+	}
+	msg = &replyMessage{
+		MessageID: strings.Trim(m.Header.Get("Message-Id"), "<> "),
+		From:      strings.ToLower(from.Address),
+	}
+	for _, key := range replyRecipientHeaders {
+		for _, value := range m.Header[key] {
+			addresses, err := mail.ParseAddressList(value)
+			if err != nil {
+				msg.Recipients = append(msg.Recipients, strings.Trim(value, "<> "))
+				continue
+			}
+			for _, addr := range addresses {
+				msg.Recipients = append(msg.Recipients, addr.Address)
+			}
+		}
+	}
+
+	plain, htmlText, err := readMessageText(m.Header.Get("Content-Type"),
+		m.Header.Get("Content-Transfer-Encoding"), m.Body)
+	if err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(plain)) == 0 && len(htmlText) > 0 {
+		plain = htmlToText(htmlText)
+	}
+	msg.Text = stripReplyText(plain)
+	return msg, nil
+}
+
+// readMessageText read the first text/plain and text/html parts of the message
+func readMessageText(contentType, transferEncoding string, body io.Reader) (plain, htmlText string, err error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		// the content type is text/plain by default
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err == io.EOF {
+				return plain, htmlText, nil
+			}
+			if err != nil {
+				return plain, htmlText, fmt.Errorf("read multipart failed: %w", err)
+			}
+			if strings.HasPrefix(part.Header.Get("Content-Disposition"), "attachment") {
+				continue
+			}
+			partPlain, partHTML, err := readMessageText(part.Header.Get("Content-Type"),
+				part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return plain, htmlText, err
+			}
+			if len(plain) == 0 {
+				plain = partPlain
+			}
+			if len(htmlText) == 0 {
+				htmlText = partHTML
+			}
+		}
+	}
+	if mediaType != "text/plain" && mediaType != "text/html" {
+		return "", "", nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	if label := params["charset"]; len(label) > 0 {
+		if body, err = charset.NewReaderLabel(label, body); err != nil {
+			return "", "", fmt.Errorf("unsupported charset %s: %w", label, err)
+		}
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return "", "", fmt.Errorf("read message body failed: %w", err)
+	}
+	if mediaType == "text/html" {
+		return "", string(content), nil
+	}
+	return string(content), "", nil
+}
+
+// htmlToText keep the lines of the html reply and drop the quoted email
+func htmlToText(htmlText string) string {
+	htmlText = htmlQuoteReg.ReplaceAllString(htmlText, "")
+	htmlText = htmlLineBreakReg.ReplaceAllString(htmlText, "\n")
+	return html.UnescapeString(strip.StripTags(htmlText))
+}
+
+// stripReplyText cut the quoted email and the signature off the reply, the reply is written above them
+func stripReplyText(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	reply := make([]string, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " \t")
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		if quoteSeparatorReg.MatchString(trimmed) || signatureReg.MatchString(trimmed) {
+			break
+		}
+		// the quote header could be wrapped into two lines
+		if strings.HasPrefix(strings.ToLower(trimmed), "on ") {
+			header := trimmed
+			if i+1 < len(lines) {
+				header += " " + strings.TrimSpace(lines[i+1])
+			}
+			if quoteHeaderReg.MatchString(trimmed) || quoteHeaderReg.MatchString(header) {
+				break
+			}
+		}
+		reply = append(reply, line)
+	}
+	return strings.TrimSpace(strings.Join(reply, "\n"))
+}
@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email_reply
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReplyMessage_Plain(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: Alice <Alice@Example.com>",
+		"To: Answer <reply+abc@example.com>",
+		"Message-ID: <123@mail.example.com>",
+		"Subject: Re: New answer",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"Thanks, this works for me.",
+		"",
+		"On Mon, Jan 1, 2024 at 10:00 AM Answer <reply+abc@example.com>",
+		"wrote:",
+		"> Bob answered your question",
+	}, "\r\n")
+
+	msg, err := parseReplyMessage(strings.NewReader(raw))
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@example.com", msg.From)
+	assert.Equal(t, "123@mail.example.com", msg.MessageID)
+	assert.Contains(t, msg.Recipients, "reply+abc@example.com")
+	assert.Equal(t, "Thanks, this works for me.", msg.Text)
+}
+
+func TestParseReplyMessage_Multipart(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: alice@example.com",
+		"To: someone@example.com",
+		"Delivered-To: reply+abc@example.com",
+		"Content-Type: multipart/alternative; boundary=\"b1\"",
+		"",
+		"--b1",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>html reply</p>",
+		"--b1",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Caf=C3=A9 is the answer.",
+		"",
+		"-- ",
+		"Alice",
+		"--b1--",
+	}, "\r\n")
+
+	msg, err := parseReplyMessage(strings.NewReader(raw))
+	assert.NoError(t, err)
+	assert.Contains(t, msg.Recipients, "reply+abc@example.com")
+	assert.Equal(t, "Café is the answer.", msg.Text)
+}
+
+func TestParseReplyMessage_HTMLOnly(t *testing.T) {
+	raw := strings.Join([]string{
+		"From: alice@example.com",
+		"To: reply+abc@example.com",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<div>First line</div><div>Second &amp; last</div>",
+		"<div class=\"gmail_quote\">On Mon wrote:<blockquote>old</blockquote></div>",
+	}, "\r\n")
+
+	msg, err := parseReplyMessage(strings.NewReader(raw))
+	assert.NoError(t, err)
+	assert.Equal(t, "First line\nSecond & last", msg.Text)
+}
+
+func TestStripReplyText(t *testing.T) {
+	assert.Equal(t, "Looks good", stripReplyText("Looks good\n\n-----Original Message-----\nFrom: x"))
+	assert.Equal(t, "Looks good", stripReplyText("Looks good\nSent from my iPhone"))
+	assert.Equal(t, "one\ntwo", stripReplyText("one\n> quoted\ntwo\n"))
+	assert.Equal(t, "", stripReplyText("> only quoted"))
+}
@@ -0,0 +1,182 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email_reply_common
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/apache/answer/pkg/uid"
+	"github.com/segmentfault/pacman/log"
+)
+
+// ReplyKind what the reply to the notification email is posted as
+type ReplyKind byte
+
+const (
+	// ReplyKindAnswer answer the question
+	ReplyKindAnswer ReplyKind = iota + 1
+	// ReplyKindComment comment on the question or the answer
+	ReplyKindComment
+	// ReplyKindCommentReply reply to the comment
+	ReplyKindCommentReply
+)
+
+// the signature is truncated to keep the local part of the address within 64 characters
+const tokenSignatureSize = 6
+
+var tokenEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ReplyTarget the user and the object the reply address is signed for
+type ReplyTarget struct {
+	Kind     ReplyKind
+	UserID   string
+	ObjectID string
+	ExpireAt time.Time
+}
+
+// EmailReplyCommon sign and verify the reply addresses of the notification emails
+type EmailReplyCommon struct {
+	conf *service_config.EmailReplyConfig
+}
+
+// NewEmailReplyCommon new email reply common
+func NewEmailReplyCommon(serviceConfig *service_config.ServiceConfig) *EmailReplyCommon {
+	return &EmailReplyCommon{conf: serviceConfig.EmailReply.WithDefault()}
+}
+
+// Config get the email reply config with the default values
+func (ec *EmailReplyCommon) Config() *service_config.EmailReplyConfig {
+	return ec.conf
+}
+
+// ReplyAddress get the signed reply address for the user replying to the object,
+// it is empty if replying by email is disabled
+func (ec *EmailReplyCommon) ReplyAddress(kind ReplyKind, userID, objectID string) string {
+	if !ec.conf.Enabled {
+		return ""
+	}
+	local, domain, _ := ec.conf.SplitAddress()
+	token, err := encodeToken(ec.conf.Secret, &ReplyTarget{
+		Kind:     kind,
+		UserID:   userID,
+		ObjectID: uid.DeShortID(objectID),
+		ExpireAt: time.Now().AddDate(0, 0, ec.conf.ValidDays),
+	})
+	if err != nil {
+		log.Errorf("sign the reply address failed: %v", err)
+		return ""
+	}
+	return fmt.Sprintf("%s+%s@%s", local, token, domain)
+}
+
+// ParseReplyAddress find the valid signed reply address in the recipients
+func (ec *EmailReplyCommon) ParseReplyAddress(recipients []string) (target *ReplyTarget, ok bool) {
+	if !ec.conf.Enabled {
+		return nil, false
+	}
+	local, domain, _ := ec.conf.SplitAddress()
+	now := time.Now()
+	for _, recipient := range recipients {
+		recipientLocal, recipientDomain, found := strings.Cut(strings.ToLower(recipient), "@")
+		if !found || recipientDomain != domain {
+			continue
+		}
+		token, found := strings.CutPrefix(recipientLocal, local+"+")
+		if !found {
+			continue
+		}
+		if target, ok = decodeToken(ec.conf.Secret, token, now); ok {
+			return target, true
+		}
+	}
+	return nil, false
+}
+
+func encodeToken(secret string, target *ReplyTarget) (token string, err error) {
+	userID, err := strconv.ParseUint(target.UserID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid user id %s: %w", target.UserID, err)
+	}
+	objectID, err := strconv.ParseUint(target.ObjectID, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid object id %s: %w", target.ObjectID, err)
+	}
+	payload := []byte{byte(target.Kind)}
+	payload = binary.AppendUvarint(payload, userID)
+	payload = binary.AppendUvarint(payload, objectID)
+	payload = binary.BigEndian.AppendUint32(payload, uint32(target.ExpireAt.Unix()))
+	payload = append(payload, sign(secret, payload)...)
+	// some mail servers lower the local part of the address
+	return strings.ToLower(tokenEncoding.EncodeToString(payload)), nil
+}
+
+func decodeToken(secret, token string, now time.Time) (target *ReplyTarget, ok bool) {
+	data, err := tokenEncoding.DecodeString(strings.ToUpper(token))
+	if err != nil || len(data) <= tokenSignatureSize {
+		return nil, false
+	}
+	payload, signature := data[:len(data)-tokenSignatureSize], data[len(data)-tokenSignatureSize:]
+	if !hmac.Equal(sign(secret, payload), signature) {
+		return nil, false
+	}
+
+	kind := ReplyKind(payload[0])
+	if kind < ReplyKindAnswer || kind > ReplyKindCommentReply {
+		return nil, false
+	}
+	rest := payload[1:]
+	userID, n := binary.Uvarint(rest)
+	if n <= 0 {
+		return nil, false
+	}
+	rest = rest[n:]
+	objectID, n := binary.Uvarint(rest)
+	if n <= 0 {
+		return nil, false
+	}
+	rest = rest[n:]
+	if len(rest) != 4 {
+		return nil, false
+	}
+	expireAt := time.Unix(int64(binary.BigEndian.Uint32(rest)), 0)
+	if now.After(expireAt) {
+		return nil, false
+	}
+	return &ReplyTarget{
+		Kind:     kind,
+		UserID:   strconv.FormatUint(userID, 10),
+		ObjectID: strconv.FormatUint(objectID, 10),
+		ExpireAt: expireAt,
+	}, true
+}
+
+func sign(secret string, payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return mac.Sum(nil)[:tokenSignatureSize]
+}
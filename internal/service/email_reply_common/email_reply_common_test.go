@@ -0,0 +1,99 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package email_reply_common
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEmailReplyCommon() *EmailReplyCommon {
+	return NewEmailReplyCommon(&service_config.ServiceConfig{
+		EmailReply: &service_config.EmailReplyConfig{
+			Enabled: true,
+			Address: "Replies <reply@example.com>",
+			Secret:  "secret",
+		},
+	})
+}
+
+func TestEmailReplyCommon_ReplyAddress(t *testing.T) {
+	ec := newTestEmailReplyCommon()
+	address := ec.ReplyAddress(ReplyKindCommentReply, "10010000000000001", "10040000000000123")
+	local, domain, _ := strings.Cut(address, "@")
+	assert.Equal(t, "example.com", domain)
+	assert.True(t, strings.HasPrefix(local, "reply+"))
+	assert.LessOrEqual(t, len(local), 64)
+
+	target, ok := ec.ParseReplyAddress([]string{"someone@example.com", strings.ToUpper(address)})
+	assert.True(t, ok)
+	assert.Equal(t, ReplyKindCommentReply, target.Kind)
+	assert.Equal(t, "10010000000000001", target.UserID)
+	assert.Equal(t, "10040000000000123", target.ObjectID)
+}
+
+func TestEmailReplyCommon_ParseReplyAddress_Invalid(t *testing.T) {
+	ec := newTestEmailReplyCommon()
+	address := ec.ReplyAddress(ReplyKindAnswer, "1", "10010000000000002")
+
+	// signed by another secret
+	other := NewEmailReplyCommon(&service_config.ServiceConfig{
+		EmailReply: &service_config.EmailReplyConfig{Enabled: true, Address: "reply@example.com", Secret: "other"},
+	})
+	_, ok := other.ParseReplyAddress([]string{address})
+	assert.False(t, ok)
+
+	// tampered token
+	local, domain, _ := strings.Cut(address, "@")
+	// change a char in the middle, the last one could only carry the padding bits
+	i := len("reply+") + 3
+	replaced := "a"
+	if local[i] == 'a' {
+		replaced = "b"
+	}
+	tampered := local[:i] + replaced + local[i+1:]
+	_, ok = ec.ParseReplyAddress([]string{tampered + "@" + domain})
+	assert.False(t, ok)
+
+	// another domain
+	_, ok = ec.ParseReplyAddress([]string{local + "@example.org"})
+	assert.False(t, ok)
+
+	// expired
+	token, err := encodeToken("secret", &ReplyTarget{
+		Kind: ReplyKindAnswer, UserID: "1", ObjectID: "2", ExpireAt: time.Now().Add(-time.Minute),
+	})
+	assert.NoError(t, err)
+	_, ok = ec.ParseReplyAddress([]string{"reply+" + token + "@example.com"})
+	assert.False(t, ok)
+}
+
+func TestEmailReplyCommon_Disabled(t *testing.T) {
+	ec := NewEmailReplyCommon(&service_config.ServiceConfig{
+		EmailReply: &service_config.EmailReplyConfig{Enabled: true, Address: "reply@example.com"},
+	})
+	// the secret is required
+	assert.False(t, ec.Config().Enabled)
+	assert.Empty(t, ec.ReplyAddress(ReplyKindAnswer, "1", "2"))
+}
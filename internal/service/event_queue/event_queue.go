@@ -21,9 +21,13 @@ package event_queue
 
 import (
 	"context"
+	"errors"
+	"sync"
 
+	"github.com/apache/answer/internal/base/queue"
 	"github.com/apache/answer/internal/schema"
-	"github.com/segmentfault/pacman/log"
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/apache/answer/pkg/token"
 )
 
 type EventQueueService interface {
@@ -32,38 +36,47 @@ type EventQueueService interface {
 }
 
 type eventQueueService struct {
-	Queue   chan *schema.EventMsg
-	Handler func(ctx context.Context, msg *schema.EventMsg) error
+	queue    *queue.Queue[*schema.EventMsg]
+	lock     sync.RWMutex
+	handlers []func(ctx context.Context, msg *schema.EventMsg) error
 }
 
+// Send add the event to the queue, the event is given an id which the handlers can use to handle it only once
 func (ns *eventQueueService) Send(ctx context.Context, msg *schema.EventMsg) {
-	ns.Queue <- msg
+	if len(msg.ID) == 0 {
+		msg.ID = token.GenerateToken()
+	}
+	ns.queue.Send(ctx, msg)
 }
 
+// RegisterHandler add the handler of the events, each event is handled by all the handlers
 func (ns *eventQueueService) RegisterHandler(
 	handler func(ctx context.Context, msg *schema.EventMsg) error) {
-	ns.Handler = handler
+	ns.lock.Lock()
+	ns.handlers = append(ns.handlers, handler)
+	ns.lock.Unlock()
+	ns.queue.RegisterHandler(ns.handle)
 }
 
-func (ns *eventQueueService) working() {
-	go func() {
-		for msg := range ns.Queue {
-			log.Debugf("received badge %+v", msg)
-			if ns.Handler == nil {
-				log.Warnf("no handler for badge")
-				continue
-			}
-			if err := ns.Handler(context.Background(), msg); err != nil {
-				log.Error(err)
-			}
+// handle the event with all the handlers, the event is retried if any of them failed,
+// so the handlers must be idempotent with the event id
+func (ns *eventQueueService) handle(ctx context.Context, msg *schema.EventMsg) error {
+	ns.lock.RLock()
+	handlers := ns.handlers
+	ns.lock.RUnlock()
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, msg); err != nil {
+			errs = append(errs, err)
 		}
-	}()
+	}
+	return errors.Join(errs...)
 }
 
-// NewEventQueueService create a new badge queue service
-func NewEventQueueService() EventQueueService {
+// NewEventQueueService create a new badge queue service,
+// the messages are kept in the store until they are handled and drained when the service is closed
+func NewEventQueueService(store queue.Store, serviceConfig *service_config.ServiceConfig) (EventQueueService, func()) {
 	ns := &eventQueueService{}
-	ns.Queue = make(chan *schema.EventMsg, 128)
-	ns.working()
-	return ns
+	ns.queue = queue.New[*schema.EventMsg]("event", store, serviceConfig.Queue)
+	return ns, ns.queue.Close
 }
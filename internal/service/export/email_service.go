@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/apache/answer/pkg/display"
+	"html"
 	"mime"
 	"os"
 	"strings"
@@ -117,8 +118,23 @@ func (es *EmailService) SendAndSaveCodeWithTime(
 	es.Send(ctx, toEmailAddr, subject, body)
 }
 
+// SendAndSaveCodeWithReplyTo send email which could be replied to the address and save code
+func (es *EmailService) SendAndSaveCodeWithReplyTo(ctx context.Context,
+	userID, toEmailAddr, replyToAddr, subject, body, code, codeContent string, duration time.Duration) {
+	err := es.emailRepo.SetCode(ctx, userID, code, codeContent, duration)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	es.send(ctx, toEmailAddr, replyToAddr, subject, body)
+}
+
 // Send email send
 func (es *EmailService) Send(ctx context.Context, toEmailAddr, subject, body string) {
+	es.send(ctx, toEmailAddr, "", subject, body)
+}
+
+func (es *EmailService) send(ctx context.Context, toEmailAddr, replyToAddr, subject, body string) {
 	log.Infof("try to send email to %s", toEmailAddr)
 	ec, err := es.GetEmailConfig(ctx)
 	if err != nil {
@@ -135,6 +151,9 @@ func (es *EmailService) Send(ctx context.Context, toEmailAddr, subject, body str
 	m.SetHeader("From", fmt.Sprintf("%s <%s>", fromName, ec.FromEmail))
 	m.SetHeader("To", toEmailAddr)
 	m.SetHeader("Subject", subject)
+	if len(replyToAddr) > 0 {
+		m.SetHeader("Reply-To", replyToAddr)
+	}
 	m.SetBody("text/html", body)
 
 	d := gomail.NewDialer(ec.SMTPHost, ec.SMTPPort, ec.SMTPUsername, ec.SMTPPassword)
@@ -327,6 +346,113 @@ func (es *EmailService) NewQuestionTemplate(ctx context.Context, raw *schema.New
 	return title, body, nil
 }
 
+// NewMessageTemplate new private message template
+func (es *EmailService) NewMessageTemplate(ctx context.Context, raw *schema.NewMessageTemplateRawData) (
+	title, body string, err error) {
+	siteInfo, err := es.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		return
+	}
+	templateData := &schema.NewMessageTemplateData{
+		SiteName:        siteInfo.Name,
+		DisplayName:     raw.SenderDisplayName,
+		Subject:         raw.Subject,
+		ConversationUrl: display.ConversationURL(siteInfo.SiteUrl, raw.ConversationID),
+		MessageSummary:  raw.MessageSummary,
+		UnsubscribeUrl:  fmt.Sprintf("%s/users/unsubscribe?code=%s", siteInfo.SiteUrl, raw.UnsubscribeCode),
+	}
+
+	lang := handler.GetLangByCtx(ctx)
+	title = translator.TrWithData(lang, constant.EmailTplKeyNewMessageTitle, templateData)
+	body = translator.TrWithData(lang, constant.EmailTplKeyNewMessageBody, templateData)
+	return title, body, nil
+}
+
+// NewJobMatchTemplate new job postings match the saved search template, used by both the alert and the digest
+func (es *EmailService) NewJobMatchTemplate(ctx context.Context, raw *schema.NewJobMatchTemplateRawData) (
+	title, body string, err error) {
+	siteInfo, err := es.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		return
+	}
+	jobList := make([]string, 0, len(raw.JobPostings))
+	for _, posting := range raw.JobPostings {
+		jobList = append(jobList, fmt.Sprintf("<a href='%s'>%s</a>",
+			display.JobPostingURL(siteInfo.SiteUrl, posting.ID), html.EscapeString(posting.Title)))
+	}
+	templateData := &schema.NewJobMatchTemplateData{
+		SiteName:       siteInfo.Name,
+		SearchName:     raw.SearchName,
+		JobList:        strings.Join(jobList, "<br>\n"),
+		UnsubscribeUrl: fmt.Sprintf("%s/users/unsubscribe?code=%s", siteInfo.SiteUrl, raw.UnsubscribeCode),
+	}
+
+	lang := handler.GetLangByCtx(ctx)
+	title = translator.TrWithData(lang, constant.EmailTplKeyNewJobMatchTitle, templateData)
+	body = translator.TrWithData(lang, constant.EmailTplKeyNewJobMatchBody, templateData)
+	return title, body, nil
+}
+
+// NotificationDigestTemplate the daily or weekly digest of the notifications template
+func (es *EmailService) NotificationDigestTemplate(ctx context.Context, raw *schema.NotificationDigestTemplateRawData) (
+	title, body string, err error) {
+	siteInfo, err := es.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		return
+	}
+	seoInfo, err := es.siteInfoService.GetSiteSeo(ctx)
+	if err != nil {
+		return
+	}
+	lang := handler.GetLangByCtx(ctx)
+
+	questionURL := func(item *schema.NotificationDigestItem) string {
+		return display.QuestionURL(seoInfo.Permalink, siteInfo.SiteUrl, item.QuestionID, item.Title)
+	}
+	sections := make([]string, 0)
+	addSection := func(key string, items []*schema.NotificationDigestItem,
+		itemURL func(item *schema.NotificationDigestItem) string) {
+		if len(items) == 0 {
+			return
+		}
+		lines := make([]string, 0, len(items))
+		for _, item := range items {
+			line := fmt.Sprintf("<a href='%s'>%s</a>", itemURL(item), html.EscapeString(item.Title))
+			if len(item.DisplayName) > 0 {
+				line = html.EscapeString(item.DisplayName) + ": " + line
+			}
+			lines = append(lines, line)
+		}
+		sections = append(sections, fmt.Sprintf("<strong>%s</strong><br>\n%s",
+			translator.Tr(lang, key), strings.Join(lines, "<br>\n")))
+	}
+	addSection(constant.EmailTplKeyNotificationDigestAnswers, raw.Answers, func(item *schema.NotificationDigestItem) string {
+		return display.AnswerURL(seoInfo.Permalink, siteInfo.SiteUrl, item.QuestionID, item.Title, item.AnswerID)
+	})
+	addSection(constant.EmailTplKeyNotificationDigestComments, raw.Comments, func(item *schema.NotificationDigestItem) string {
+		return display.CommentURL(seoInfo.Permalink, siteInfo.SiteUrl, item.QuestionID, item.Title, item.AnswerID, item.CommentID)
+	})
+	addSection(constant.EmailTplKeyNotificationDigestInvites, raw.Invites, questionURL)
+	addSection(constant.EmailTplKeyNotificationDigestBadges, raw.Badges, func(item *schema.NotificationDigestItem) string {
+		return fmt.Sprintf("%s/badges/%s", siteInfo.SiteUrl, item.BadgeID)
+	})
+	addSection(constant.EmailTplKeyNotificationDigestQuestions, raw.Questions, questionURL)
+
+	templateData := &schema.NotificationDigestTemplateData{
+		SiteName:       siteInfo.Name,
+		Sections:       strings.Join(sections, "<br><br>\n\n"),
+		SettingsUrl:    fmt.Sprintf("%s/users/settings/notify", siteInfo.SiteUrl),
+		UnsubscribeUrl: fmt.Sprintf("%s/users/unsubscribe?code=%s", siteInfo.SiteUrl, raw.UnsubscribeCode),
+	}
+	titleKey := constant.EmailTplKeyNotificationDigestDailyTitle
+	if raw.Frequency == constant.NotificationFrequencyWeekly {
+		titleKey = constant.EmailTplKeyNotificationDigestWeeklyTitle
+	}
+	title = translator.TrWithData(lang, titleKey, templateData)
+	body = translator.TrWithData(lang, constant.EmailTplKeyNotificationDigestBody, templateData)
+	return title, body, nil
+}
+
 func (es *EmailService) GetEmailConfig(ctx context.Context) (ec *EmailConfig, err error) {
 	emailConf, err := es.configService.GetStringValue(ctx, constant.EmailConfigKey)
 	if err != nil {
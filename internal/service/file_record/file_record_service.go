@@ -28,7 +28,10 @@ import (
 	"time"
 
 	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/revision"
 	"github.com/apache/answer/internal/service/service_config"
 	"github.com/apache/answer/internal/service/siteinfo_common"
@@ -36,6 +39,7 @@ import (
 	"github.com/apache/answer/pkg/checker"
 	"github.com/apache/answer/pkg/dir"
 	"github.com/apache/answer/pkg/writer"
+	"github.com/segmentfault/pacman/errors"
 	"github.com/segmentfault/pacman/log"
 )
 
@@ -47,6 +51,7 @@ type FileRecordRepo interface {
 		fileRecordList []*entity.FileRecord, total int64, err error)
 	DeleteFileRecord(ctx context.Context, id int) (err error)
 	GetFileRecordByURL(ctx context.Context, fileURL string) (record *entity.FileRecord, err error)
+	GetFileRecordByPath(ctx context.Context, filePath string) (record *entity.FileRecord, err error)
 }
 
 // FileRecordService file record service
@@ -56,6 +61,8 @@ type FileRecordService struct {
 	serviceConfig   *service_config.ServiceConfig
 	siteInfoService siteinfo_common.SiteInfoCommonService
 	userService     *usercommon.UserCommon
+
+	portfolioItemRepo freelancer.PortfolioItemRepo
 }
 
 // NewFileRecordService new file record service
@@ -65,6 +72,7 @@ func NewFileRecordService(
 	serviceConfig *service_config.ServiceConfig,
 	siteInfoService siteinfo_common.SiteInfoCommonService,
 	userService *usercommon.UserCommon,
+	portfolioItemRepo freelancer.PortfolioItemRepo,
 ) *FileRecordService {
 	return &FileRecordService{
 		fileRecordRepo:  fileRecordRepo,
@@ -72,6 +80,8 @@ func NewFileRecordService(
 		serviceConfig:   serviceConfig,
 		siteInfoService: siteInfoService,
 		userService:     userService,
+
+		portfolioItemRepo: portfolioItemRepo,
 	}
 }
 
@@ -125,6 +135,27 @@ func (fs *FileRecordService) CleanOrphanUploadFiles(ctx context.Context) {
 				}
 				continue
 			}
+			// The portfolio file is used as long as any portfolio item of the uploader contains it
+			if isPortfolioFile(fileRecord.FilePath) {
+				if fs.isPortfolioFileUsed(ctx, fileRecord) {
+					continue
+				}
+				if err := fs.DeleteAndMoveFileRecord(ctx, fileRecord); err != nil {
+					log.Error(err)
+				}
+				continue
+			}
+			// The message attachment is bound to the conversation when the message is sent,
+			// and the verification evidence is bound to the verification request when it is submitted
+			if isBoundAttachmentFile(fileRecord.FilePath) {
+				if checker.IsNotZeroString(fileRecord.ObjectID) {
+					continue
+				}
+				if err := fs.DeleteAndMoveFileRecord(ctx, fileRecord); err != nil {
+					log.Error(err)
+				}
+				continue
+			}
 			if checker.IsNotZeroString(fileRecord.ObjectID) {
 				_, exist, err := fs.revisionRepo.GetLastRevisionByObjectID(ctx, fileRecord.ObjectID)
 				if err != nil {
@@ -162,6 +193,22 @@ func isBrandingOrAvatarFile(filePath string) bool {
 	return strings.Contains(filePath, constant.BrandingSubPath+"/") || strings.Contains(filePath, constant.AvatarSubPath+"/")
 }
 
+func isMessageAttachmentFile(filePath string) bool {
+	return strings.Contains(filePath, constant.FilesMessageSubPath+"/")
+}
+
+func isVerificationEvidenceFile(filePath string) bool {
+	return strings.Contains(filePath, constant.FilesVerificationSubPath+"/")
+}
+
+func isPortfolioFile(filePath string) bool {
+	return strings.Contains(filePath, constant.FilesPortfolioSubPath+"/")
+}
+
+func isBoundAttachmentFile(filePath string) bool {
+	return isMessageAttachmentFile(filePath) || isVerificationEvidenceFile(filePath)
+}
+
 func (fs *FileRecordService) PurgeDeletedFiles(ctx context.Context) {
 	deletedPath := filepath.Join(fs.serviceConfig.UploadPath, constant.DeletedSubPath)
 	log.Infof("purge deleted files: %s", deletedPath)
@@ -204,3 +251,123 @@ func (fs *FileRecordService) GetFileRecordByURL(ctx context.Context, fileURL str
 	}
 	return
 }
+
+// BindMessageAttachment bind the message attachment uploaded by the user to the conversation,
+// so it is not cleaned as an orphan file. The file uploaded by the storage plugin has no record, nothing to bind.
+func (fs *FileRecordService) BindMessageAttachment(ctx context.Context, userID, fileURL, conversationID string) (err error) {
+	return fs.bindAttachment(ctx, userID, fileURL, conversationID, isMessageAttachmentFile)
+}
+
+// CheckVerificationEvidence check the verification evidence is uploaded by the user and not bound yet
+func (fs *FileRecordService) CheckVerificationEvidence(ctx context.Context, userID, fileURL string) (err error) {
+	_, err = fs.getUnboundAttachment(ctx, userID, fileURL, isVerificationEvidenceFile)
+	return err
+}
+
+// BindVerificationEvidence bind the verification evidence uploaded by the user to the verification request
+func (fs *FileRecordService) BindVerificationEvidence(ctx context.Context, userID, fileURL, verificationID string) (err error) {
+	return fs.bindAttachment(ctx, userID, fileURL, verificationID, isVerificationEvidenceFile)
+}
+
+// CheckPortfolioFile check the portfolio file is uploaded by the user,
+// the file can be moved or shared between the portfolio items of the user
+func (fs *FileRecordService) CheckPortfolioFile(ctx context.Context, userID, fileURL string) (err error) {
+	_, err = fs.getPortfolioFile(ctx, userID, fileURL)
+	return err
+}
+
+// BindPortfolioFile bind the portfolio file to the portfolio item which uses it latest
+func (fs *FileRecordService) BindPortfolioFile(ctx context.Context, userID, fileURL, itemID string) (err error) {
+	record, err := fs.getPortfolioFile(ctx, userID, fileURL)
+	if err != nil || record == nil || record.ObjectID == itemID {
+		return err
+	}
+	record.ObjectID = itemID
+	return fs.fileRecordRepo.UpdateFileRecord(ctx, record)
+}
+
+// getPortfolioFile get the portfolio file record of the user, nil if the file has no record
+func (fs *FileRecordService) getPortfolioFile(ctx context.Context, userID, fileURL string) (
+	record *entity.FileRecord, err error) {
+	record, err = fs.fileRecordRepo.GetFileRecordByURL(ctx, fileURL)
+	if err != nil {
+		return nil, err
+	}
+	if record.ID == 0 {
+		return nil, nil
+	}
+	if record.UserID != userID || !isPortfolioFile(record.FilePath) {
+		return nil, errors.BadRequest(reason.FreelancerPortfolioFile)
+	}
+	return record, nil
+}
+
+// isPortfolioFileUsed whether any portfolio item of the uploader contains the file
+func (fs *FileRecordService) isPortfolioFileUsed(ctx context.Context, fileRecord *entity.FileRecord) bool {
+	items, err := fs.portfolioItemRepo.GetPortfolioItemsByUserID(ctx, fileRecord.UserID)
+	if err != nil {
+		log.Errorf("get portfolio items of user %s error: %v", fileRecord.UserID, err)
+		return true
+	}
+	for _, item := range items {
+		images, files := schema.PortfolioItemFileURLs(item)
+		for _, fileURL := range append(images, files...) {
+			if fileURL == fileRecord.FileURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (fs *FileRecordService) bindAttachment(ctx context.Context, userID, fileURL, objectID string,
+	isAttachmentFile func(filePath string) bool) (err error) {
+	record, err := fs.getUnboundAttachment(ctx, userID, fileURL, isAttachmentFile)
+	if err != nil || record == nil {
+		return err
+	}
+	record.ObjectID = objectID
+	return fs.fileRecordRepo.UpdateFileRecord(ctx, record)
+}
+
+// getUnboundAttachment get the attachment file record which is not bound yet, nil if the file has no record
+func (fs *FileRecordService) getUnboundAttachment(ctx context.Context, userID, fileURL string,
+	isAttachmentFile func(filePath string) bool) (record *entity.FileRecord, err error) {
+	record, err = fs.fileRecordRepo.GetFileRecordByURL(ctx, fileURL)
+	if err != nil {
+		return nil, err
+	}
+	if record.ID == 0 {
+		return nil, nil
+	}
+	if record.UserID != userID || !isAttachmentFile(record.FilePath) || checker.IsNotZeroString(record.ObjectID) {
+		return nil, errors.BadRequest(reason.ForbiddenError)
+	}
+	return record, nil
+}
+
+// GetLocalAttachment get the record and the local path of the attachment by the download path such as hash/123.pdf,
+// which is saved as fileSubPath/hash.pdf. The record does not exist if the file is not uploaded to the local storage.
+func (fs *FileRecordService) GetLocalAttachment(ctx context.Context, fileSubPath, downloadPath string) (
+	record *entity.FileRecord, localPath, originalFilename string, err error) {
+	// The original filename is 123.pdf
+	originalFilename = filepath.Base(downloadPath)
+	// The real filename is hash.pdf
+	realFilename := strings.TrimSuffix(strings.TrimPrefix(downloadPath, "/"), "/"+originalFilename) + filepath.Ext(originalFilename)
+	filePath := filepath.ToSlash(filepath.Join(fileSubPath, realFilename))
+	if !strings.HasPrefix(filePath, fileSubPath+"/") {
+		return nil, "", "", errors.NotFound(reason.ObjectNotFound)
+	}
+	record, err = fs.fileRecordRepo.GetFileRecordByPath(ctx, filePath)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if record.ID == 0 {
+		return nil, "", "", errors.NotFound(reason.ObjectNotFound)
+	}
+	localPath = filepath.Join(fs.serviceConfig.UploadPath, record.FilePath)
+	if !dir.CheckFileExist(localPath) {
+		return nil, "", "", errors.NotFound(reason.ObjectNotFound)
+	}
+	return record, localPath, originalFilename, nil
+}
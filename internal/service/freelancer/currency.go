@@ -0,0 +1,83 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/currency"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// getDisplayConverter get the currency converter and the currency chosen by the viewer of the list,
+// the base currency of the site is used if the viewer does not choose one
+func (fs *FreelancerService) getDisplayConverter(ctx context.Context, displayCurrency string) (
+	converter *currency.Converter, code string, err error) {
+	converter, err = fs.currencyService.GetConverter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return converter, converter.Code(displayCurrency), nil
+}
+
+// setProfilesFilterBaseRate convert the rate filter in the display currency to the base currency
+func setProfilesFilterBaseRate(converter *currency.Converter, displayCurrency string,
+	req *schema.GetFreelancerProfilesReq) (err error) {
+	req.MinBaseRate, err = toBaseAmount(converter, req.MinRate, displayCurrency)
+	if err != nil {
+		return err
+	}
+	req.MaxBaseRate, err = toBaseAmount(converter, req.MaxRate, displayCurrency)
+	return err
+}
+
+// setJobPostingsFilterBaseBudget convert the budget filter in the display currency to the base currency
+func setJobPostingsFilterBaseBudget(converter *currency.Converter, displayCurrency string,
+	req *schema.GetJobPostingsReq) (err error) {
+	req.MinBaseBudget, err = toBaseAmount(converter, req.MinBudget, displayCurrency)
+	if err != nil {
+		return err
+	}
+	req.MaxBaseBudget, err = toBaseAmount(converter, req.MaxBudget, displayCurrency)
+	return err
+}
+
+func toBaseAmount(converter *currency.Converter, amount float64, code string) (float64, error) {
+	if amount <= 0 {
+		return 0, nil
+	}
+	baseAmount, ok := converter.ToBase(amount, code)
+	if !ok {
+		return 0, errors.BadRequest(reason.CurrencyRateUnavailable)
+	}
+	return baseAmount, nil
+}
+
+// displayAmount convert the amount to the display currency, it is not displayed if the rate is unknown
+func displayAmount(converter *currency.Converter, amount float64, amountCurrency, displayCurrency string) (
+	float64, string) {
+	result, ok := converter.Convert(amount, amountCurrency, displayCurrency)
+	if !ok {
+		return 0, ""
+	}
+	return result, displayCurrency
+}
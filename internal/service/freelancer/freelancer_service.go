@@ -22,39 +22,113 @@ package freelancer
 import (
 	"context"
 	"encoding/json"
+	"math"
+	"strings"
 	"time"
 
+	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/schema"
-	"github.com/apache/answer/internal/service/export"
+	answercommon "github.com/apache/answer/internal/service/answer_common"
+	"github.com/apache/answer/internal/service/badge"
+	"github.com/apache/answer/internal/service/config"
+	"github.com/apache/answer/internal/service/contract"
+	"github.com/apache/answer/internal/service/conversation"
+	"github.com/apache/answer/internal/service/currency"
+	"github.com/apache/answer/internal/service/file_record"
+	"github.com/apache/answer/internal/service/notice_queue"
+	"github.com/apache/answer/internal/service/permission"
+	questioncommon "github.com/apache/answer/internal/service/question_common"
+	"github.com/apache/answer/internal/service/review"
+	"github.com/apache/answer/internal/service/revision_common"
 	"github.com/apache/answer/internal/service/siteinfo_common"
+	tagcommon "github.com/apache/answer/internal/service/tag_common"
 	"github.com/apache/answer/internal/service/user_common"
+	"github.com/apache/answer/internal/service/user_notification_config"
+	"github.com/apache/answer/pkg/converter"
 	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
 )
 
 // FreelancerService freelancer service
 type FreelancerService struct {
 	freelancerRepo freelancer.FreelancerRepo
 	userRepo       usercommon.UserRepo
-	emailService   *export.EmailService
 	siteInfoService siteinfo_common.SiteInfoCommonService
+	revisionService *revision_common.RevisionService
+
+	notificationQueueService notice_queue.NotificationQueueService
+	tagCommonService         *tagcommon.TagCommonService
+	contractService          *contract.ContractService
+	conversationService      *conversation.ConversationService
+	verificationRepo         freelancer.FreelancerVerificationRepo
+	userCommon               *usercommon.UserCommon
+	fileRecordService        *file_record.FileRecordService
+	badgeAwardService        *badge.BadgeAwardService
+	configService            *config.ConfigService
+
+	savedJobSearchRepo               freelancer.SavedJobSearchRepo
+	externalNotificationQueueService notice_queue.ExternalNotificationQueueService
+	userNotificationConfigService    *user_notification_config.UserNotificationConfigService
+	portfolioItemRepo                freelancer.PortfolioItemRepo
+	answerRepo                       answercommon.AnswerRepo
+	questionRepo                     questioncommon.QuestionRepo
+	currencyService                  *currency.CurrencyService
+	reviewService                    *review.ReviewService
 }
 
 // NewFreelancerService new freelancer service
 func NewFreelancerService(
 	freelancerRepo freelancer.FreelancerRepo,
 	userRepo usercommon.UserRepo,
-	emailService *export.EmailService,
 	siteInfoService siteinfo_common.SiteInfoCommonService,
+	revisionService *revision_common.RevisionService,
+	notificationQueueService notice_queue.NotificationQueueService,
+	tagCommonService *tagcommon.TagCommonService,
+	contractService *contract.ContractService,
+	conversationService *conversation.ConversationService,
+	verificationRepo freelancer.FreelancerVerificationRepo,
+	userCommon *usercommon.UserCommon,
+	fileRecordService *file_record.FileRecordService,
+	badgeAwardService *badge.BadgeAwardService,
+	configService *config.ConfigService,
+	savedJobSearchRepo freelancer.SavedJobSearchRepo,
+	externalNotificationQueueService notice_queue.ExternalNotificationQueueService,
+	userNotificationConfigService *user_notification_config.UserNotificationConfigService,
+	portfolioItemRepo freelancer.PortfolioItemRepo,
+	answerRepo answercommon.AnswerRepo,
+	questionRepo questioncommon.QuestionRepo,
+	currencyService *currency.CurrencyService,
+	reviewService *review.ReviewService,
 ) *FreelancerService {
-	return &FreelancerService{
+	fs := &FreelancerService{
 		freelancerRepo:  freelancerRepo,
 		userRepo:        userRepo,
-		emailService:    emailService,
 		siteInfoService: siteInfoService,
-	}
+		revisionService: revisionService,
+		notificationQueueService: notificationQueueService,
+		tagCommonService:         tagCommonService,
+		contractService:          contractService,
+		conversationService:      conversationService,
+		verificationRepo:         verificationRepo,
+		userCommon:               userCommon,
+		fileRecordService:        fileRecordService,
+		badgeAwardService:        badgeAwardService,
+		configService:            configService,
+
+		savedJobSearchRepo:               savedJobSearchRepo,
+		externalNotificationQueueService: externalNotificationQueueService,
+		userNotificationConfigService:    userNotificationConfigService,
+		portfolioItemRepo:                portfolioItemRepo,
+		answerRepo:                       answerRepo,
+		questionRepo:                     questionRepo,
+		currencyService:                  currencyService,
+		reviewService:                    reviewService,
+	}
+	reviewService.RegisterJobPostingReviewHandler(fs.handleJobPostingReview)
+	return fs
 }
 
 // CreateFreelancerProfile create freelancer profile
@@ -68,14 +142,12 @@ func (fs *FreelancerService) CreateFreelancerProfile(ctx context.Context, req *s
 		return errors.BadRequest(reason.FreelancerProfileAlreadyExists)
 	}
 
-	// Convert skills to JSON
-	skillsJSON, err := json.Marshal(req.Skills)
+	skillTags, err := fs.getSkillTags(ctx, req.Skills, req.LoginUserID, req.CanAddTag)
 	if err != nil {
-		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		return err
 	}
-
-	// Convert portfolio to JSON
-	portfolioJSON, err := json.Marshal(req.Portfolio)
+	// Convert skills to JSON
+	skillsJSON, err := json.Marshal(skillSlugNames(skillTags))
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
@@ -92,14 +164,19 @@ func (fs *FreelancerService) CreateFreelancerProfile(ctx context.Context, req *s
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
 
+	currencyCode, baseHourlyRate, err := fs.currencyService.Normalize(ctx, req.HourlyRate, req.Currency)
+	if err != nil {
+		return err
+	}
+
 	profile := &entity.FreelancerProfile{
 		UserID:            req.LoginUserID,
 		IsAvailable:       req.IsAvailable,
 		HourlyRate:        req.HourlyRate,
-		Currency:          req.Currency,
+		Currency:          currencyCode,
+		BaseHourlyRate:    baseHourlyRate,
 		Skills:            string(skillsJSON),
 		Experience:        req.Experience,
-		Portfolio:         string(portfolioJSON),
 		Availability:      req.Availability,
 		PreferredProjects: string(preferredProjectsJSON),
 		ContactEmail:      req.ContactEmail,
@@ -109,10 +186,16 @@ func (fs *FreelancerService) CreateFreelancerProfile(ctx context.Context, req *s
 		Bio:               req.Bio,
 		Languages:         string(languagesJSON),
 		TimeZone:          req.TimeZone,
+		ExperienceLevel:   req.ExperienceLevel,
+		LocationType:      req.LocationType,
 		ResponseTime:      req.ResponseTime,
 	}
+	setProfileUTCOffset(profile)
 
-	return fs.freelancerRepo.CreateFreelancerProfile(ctx, profile)
+	if err = fs.freelancerRepo.CreateFreelancerProfile(ctx, profile); err != nil {
+		return err
+	}
+	return fs.updateSkillRels(ctx, entity.SkillRelObjectTypeFreelancer, profile.UserID, skillTags)
 }
 
 // UpdateFreelancerProfile update freelancer profile
@@ -126,14 +209,12 @@ func (fs *FreelancerService) UpdateFreelancerProfile(ctx context.Context, req *s
 		return errors.NotFound(reason.FreelancerProfileNotFound)
 	}
 
-	// Convert skills to JSON
-	skillsJSON, err := json.Marshal(req.Skills)
+	skillTags, err := fs.getSkillTags(ctx, req.Skills, req.LoginUserID, req.CanAddTag)
 	if err != nil {
-		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+		return err
 	}
-
-	// Convert portfolio to JSON
-	portfolioJSON, err := json.Marshal(req.Portfolio)
+	// Convert skills to JSON
+	skillsJSON, err := json.Marshal(skillSlugNames(skillTags))
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
@@ -150,13 +231,18 @@ func (fs *FreelancerService) UpdateFreelancerProfile(ctx context.Context, req *s
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
 
+	currencyCode, baseHourlyRate, err := fs.currencyService.Normalize(ctx, req.HourlyRate, req.Currency)
+	if err != nil {
+		return err
+	}
+
 	// Update fields
 	profile.IsAvailable = req.IsAvailable
 	profile.HourlyRate = req.HourlyRate
-	profile.Currency = req.Currency
+	profile.Currency = currencyCode
+	profile.BaseHourlyRate = baseHourlyRate
 	profile.Skills = string(skillsJSON)
 	profile.Experience = req.Experience
-	profile.Portfolio = string(portfolioJSON)
 	profile.Availability = req.Availability
 	profile.PreferredProjects = string(preferredProjectsJSON)
 	profile.ContactEmail = req.ContactEmail
@@ -166,9 +252,15 @@ func (fs *FreelancerService) UpdateFreelancerProfile(ctx context.Context, req *s
 	profile.Bio = req.Bio
 	profile.Languages = string(languagesJSON)
 	profile.TimeZone = req.TimeZone
+	profile.ExperienceLevel = req.ExperienceLevel
+	profile.LocationType = req.LocationType
 	profile.ResponseTime = req.ResponseTime
+	setProfileUTCOffset(profile)
 
-	return fs.freelancerRepo.UpdateFreelancerProfile(ctx, profile)
+	if err = fs.freelancerRepo.UpdateFreelancerProfile(ctx, profile); err != nil {
+		return err
+	}
+	return fs.updateSkillRels(ctx, entity.SkillRelObjectTypeFreelancer, profile.UserID, skillTags)
 }
 
 // GetFreelancerProfile get freelancer profile
@@ -194,7 +286,6 @@ func (fs *FreelancerService) GetFreelancerProfile(ctx context.Context, req *sche
             Currency:          "USD",
             Skills:            "[]",
             Experience:        userInfo.Bio,
-            Portfolio:         "[]",
             Availability:      "Project-based",
             PreferredProjects: "[]",
             ContactEmail:      userInfo.EMail,
@@ -206,22 +297,58 @@ func (fs *FreelancerService) GetFreelancerProfile(ctx context.Context, req *sche
             TimeZone:          "",
             ResponseTime:      "Within 24 hours",
         }
-        return fs.convertFreelancerProfileToResp(defaultProfile), nil
+		profile = defaultProfile
     }
 
-    return fs.convertFreelancerProfileToResp(profile), nil
+	resp := fs.convertFreelancerProfileToResp(profile)
+	resp.Portfolio, err = fs.GetPortfolioItems(ctx, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // GetFreelancerProfiles get freelancer profiles
 func (fs *FreelancerService) GetFreelancerProfiles(ctx context.Context, req *schema.GetFreelancerProfilesReq) (*schema.GetFreelancerProfilesResp, error) {
-	profiles, total, err := fs.freelancerRepo.GetFreelancerProfiles(ctx, req)
+	if err := parseTimeZoneWindow(req); err != nil {
+		return nil, err
+	}
+	if len(strings.TrimSpace(req.Skills)) > 0 {
+		tagIDs, matchable, err := fs.getSkillFilterTagIDGroups(ctx, req.Skills, req.SkillMatch == schema.SkillMatchAll)
+		if err != nil {
+			return nil, err
+		}
+		if !matchable {
+			return &schema.GetFreelancerProfilesResp{List: make([]*schema.FreelancerProfileResp, 0)}, nil
+		}
+		req.SkillTagIDs = tagIDs
+	}
+	currencyConverter, displayCurrency, err := fs.getDisplayConverter(ctx, req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if err = setProfilesFilterBaseRate(currencyConverter, displayCurrency, req); err != nil {
+		return nil, err
+	}
+	var (
+		profiles []*entity.FreelancerProfile
+		total    int64
+	)
+	if fs.freelancerRepo.HasListingSearch() {
+		profiles, total, err = fs.searchFreelancerProfiles(ctx, req)
+	} else {
+		profiles, total, err = fs.freelancerRepo.GetFreelancerProfiles(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var resp []*schema.FreelancerProfileResp
 	for _, profile := range profiles {
-		resp = append(resp, fs.convertFreelancerProfileToResp(profile))
+		item := fs.convertFreelancerProfileToResp(profile)
+		item.DisplayHourlyRate, item.DisplayCurrency = displayAmount(currencyConverter,
+			profile.HourlyRate, profile.Currency, displayCurrency)
+		resp = append(resp, item)
 	}
 
 	return &schema.GetFreelancerProfilesResp{
@@ -232,40 +359,360 @@ func (fs *FreelancerService) GetFreelancerProfiles(ctx context.Context, req *sch
 
 // CreateJobPosting create job posting
 func (fs *FreelancerService) CreateJobPosting(ctx context.Context, req *schema.CreateJobPostingReq) error {
+	if err := filterText(req.Title, req.Description); err != nil {
+		return err
+	}
+	skillTags, err := fs.getSkillTags(ctx, req.Skills, req.LoginUserID, req.CanAddTag)
+	if err != nil {
+		return err
+	}
 	// Convert skills to JSON
-	skillsJSON, err := json.Marshal(req.Skills)
+	skillsJSON, err := json.Marshal(skillSlugNames(skillTags))
 	if err != nil {
 		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
 	}
+	expiresAt, err := fs.checkJobPostingExpiresAt(ctx, req.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	currencyCode, baseBudget, err := fs.currencyService.Normalize(ctx, req.Budget, req.Currency)
+	if err != nil {
+		return err
+	}
 
 	posting := &entity.JobPosting{
 		UserID:          req.LoginUserID,
 		Title:           req.Title,
 		Description:     req.Description,
+		DescriptionHTML: converter.Markdown2HTML(req.Description),
 		Budget:          req.Budget,
-		Currency:        req.Currency,
+		Currency:        currencyCode,
+		BaseBudget:      baseBudget,
 		BudgetType:      req.BudgetType,
 		Skills:          string(skillsJSON),
 		ExperienceLevel: req.ExperienceLevel,
 		Duration:        req.Duration,
 		Location:        req.Location,
 		ContactEmail:    req.ContactEmail,
-		ExpiresAt:       time.Unix(req.ExpiresAt, 0),
+		Status:          entity.JobPostingStatusPending,
+		IsActive:        true,
+		ExpiresAt:       expiresAt,
+	}
+
+	if err = fs.freelancerRepo.CreateJobPosting(ctx, posting); err != nil {
+		return err
+	}
+	if err = fs.reviewJobPosting(ctx, posting, skillTags, req.IP, req.UserAgent, true); err != nil {
+		return err
+	}
+	if err = fs.updateSkillRels(ctx, entity.SkillRelObjectTypeJobPosting, posting.ID, skillTags); err != nil {
+		return err
+	}
+	if err = fs.addJobPostingRevision(ctx, posting, req.LoginUserID, ""); err != nil {
+		return err
+	}
+	if posting.Status == entity.JobPostingStatusOpen {
+		go fs.evaluateSavedJobSearches(context.Background(), posting, skillTags)
+	}
+	return nil
+}
+
+// UpdateJobPosting update job posting, the change is recorded as a revision
+func (fs *FreelancerService) UpdateJobPosting(ctx context.Context, req *schema.UpdateJobPostingReq) error {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	// the job posting which is rejected by the review can not be edited
+	if !exist || posting.Status == entity.JobPostingStatusDeleted {
+		return errors.NotFound(reason.JobPostingNotFound)
+	}
+	if posting.UserID != req.LoginUserID && !req.CanEdit {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	if err = filterText(req.Title, req.Description); err != nil {
+		return err
+	}
+
+	skillTags, err := fs.getSkillTags(ctx, req.Skills, req.LoginUserID, req.CanAddTag)
+	if err != nil {
+		return err
+	}
+	skillsJSON, err := json.Marshal(skillSlugNames(skillTags))
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+
+	currencyCode, baseBudget, err := fs.currencyService.Normalize(ctx, req.Budget, req.Currency)
+	if err != nil {
+		return err
+	}
+
+	posting.Title = req.Title
+	posting.Description = req.Description
+	posting.DescriptionHTML = converter.Markdown2HTML(req.Description)
+	posting.Budget = req.Budget
+	posting.Currency = currencyCode
+	posting.BaseBudget = baseBudget
+	posting.BudgetType = req.BudgetType
+	posting.Skills = string(skillsJSON)
+	posting.ExperienceLevel = req.ExperienceLevel
+	posting.Duration = req.Duration
+	posting.Location = req.Location
+	posting.ContactEmail = req.ContactEmail
+	cols := []string{"title", "description", "description_html", "budget", "currency", "base_budget", "budget_type",
+		"skills", "experience_level", "duration", "location", "contact_email"}
+	if req.ExpiresAt > 0 {
+		posting.ExpiresAt, err = fs.checkJobPostingExpiresAt(ctx, req.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		posting.ExpiryNotified = false
+		cols = append(cols, "expires_at", "expiry_notified")
+	}
+	if err = fs.freelancerRepo.UpdateJobPosting(ctx, posting, cols); err != nil {
+		return err
+	}
+	if err = fs.reviewJobPosting(ctx, posting, skillTags, req.IP, req.UserAgent, false); err != nil {
+		return err
+	}
+	if err = fs.updateSkillRels(ctx, entity.SkillRelObjectTypeJobPosting, posting.ID, skillTags); err != nil {
+		return err
+	}
+	return fs.addJobPostingRevision(ctx, posting, req.LoginUserID, req.EditSummary)
+}
+
+// RenewJobPosting extend the expiration time of the job posting, only the poster can do it.
+// A closed job posting is reopened after renewal, the job posting which has not passed the review can not be renewed.
+func (fs *FreelancerService) RenewJobPosting(ctx context.Context, req *schema.RenewJobPostingReq) error {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.JobPostingNotFound)
+	}
+	if posting.UserID != req.LoginUserID {
+		return errors.Forbidden(reason.ForbiddenError)
+	}
+	if !posting.IsPublished() || posting.Status == entity.JobPostingStatusFilled {
+		return errors.BadRequest(reason.JobPostingStatusInvalid)
+	}
+	posting.ExpiresAt, err = fs.checkJobPostingExpiresAt(ctx, req.ExpiresAt)
+	if err != nil {
+		return err
+	}
+	posting.ExpiryNotified = false
+	posting.Status = entity.JobPostingStatusOpen
+	return fs.freelancerRepo.UpdateJobPosting(ctx, posting, []string{"expires_at", "expiry_notified", "status"})
+}
+
+// checkJobPostingExpiresAt check the expiration time does not exceed the max lifetime from now.
+// If expiresAt is 0, the job posting expires after the max lifetime.
+func (fs *FreelancerService) checkJobPostingExpiresAt(ctx context.Context, expiresAt int64) (time.Time, error) {
+	siteJob, err := fs.siteInfoService.GetSiteJob(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	now := time.Now()
+	latest := now.AddDate(0, 0, siteJob.MaxLifetimeDays)
+	if expiresAt == 0 {
+		return latest, nil
+	}
+	t := time.Unix(expiresAt, 0)
+	if !t.After(now) || t.After(latest) {
+		return time.Time{}, errors.BadRequest(reason.JobPostingExpiresAtInvalid)
+	}
+	return t, nil
+}
+
+// JobPostingExpiryCron notify the posters whose job posting is about to expire, and close the expired job postings
+func (fs *FreelancerService) JobPostingExpiryCron(ctx context.Context) {
+	siteJob, err := fs.siteInfoService.GetSiteJob(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	now := time.Now()
+	if siteJob.ExpiryNoticeDays > 0 {
+		postings, err := fs.freelancerRepo.GetJobPostingsExpireBefore(ctx, now.AddDate(0, 0, siteJob.ExpiryNoticeDays))
+		if err != nil {
+			log.Error(err)
+			return
+		}
+		for _, posting := range postings {
+			fs.notificationQueueService.Send(ctx, &schema.NotificationMsg{
+				TriggerUserID:       posting.UserID,
+				ReceiverUserID:      posting.UserID,
+				Type:                schema.NotificationTypeInbox,
+				Title:               posting.Title,
+				ObjectID:            posting.ID,
+				ObjectType:          constant.JobPostingObjectType,
+				NotificationAction:  constant.NotificationYourJobPostingWillExpire,
+				NoNeedPushAllFollow: true,
+			})
+			if err := fs.freelancerRepo.UpdateJobPostingExpiryNotified(ctx, posting.ID, true); err != nil {
+				log.Error(err)
+			}
+		}
+	}
+
+	closed, err := fs.freelancerRepo.CloseExpiredJobPostings(ctx, now)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if closed > 0 {
+		log.Infof("closed %d expired job postings", closed)
+	}
+}
+
+// SitemapCron refresh the sitemap cache of the job postings and the freelancer profiles
+func (fs *FreelancerService) SitemapCron(ctx context.Context) {
+	jobPostingNum, err := fs.freelancerRepo.GetSitemapJobPostingCount(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for i := 1; i <= sitemapPages(jobPostingNum); i++ {
+		if _, err = fs.freelancerRepo.SitemapJobPostings(ctx, i, constant.SitemapMaxSize); err != nil {
+			log.Errorf("get site map job posting error: %v", err)
+			return
+		}
+	}
+
+	freelancerNum, err := fs.freelancerRepo.GetSitemapFreelancerCount(ctx)
+	if err != nil {
+		log.Error(err)
+		return
 	}
+	for i := 1; i <= sitemapPages(freelancerNum); i++ {
+		if _, err = fs.freelancerRepo.SitemapFreelancers(ctx, i, constant.SitemapMaxSize); err != nil {
+			log.Errorf("get site map freelancer error: %v", err)
+			return
+		}
+	}
+}
+
+func sitemapPages(count int64) int {
+	return int(math.Ceil(float64(count) / float64(constant.SitemapMaxSize)))
+}
 
-	return fs.freelancerRepo.CreateJobPosting(ctx, posting)
+// addJobPostingRevision record the current content of the job posting as a passed revision
+// and point the job posting at it
+func (fs *FreelancerService) addJobPostingRevision(ctx context.Context, posting *entity.JobPosting,
+	userID, editSummary string) error {
+	content, err := json.Marshal(posting)
+	if err != nil {
+		return errors.InternalServer(reason.DatabaseError).WithError(err).WithStack()
+	}
+	revisionID, err := fs.revisionService.AddRevision(ctx, &schema.AddRevisionDTO{
+		UserID:   userID,
+		ObjectID: posting.ID,
+		Title:    posting.Title,
+		Content:  string(content),
+		Log:      editSummary,
+		Status:   entity.RevisionReviewPassStatus,
+	}, true)
+	if err != nil {
+		return err
+	}
+	posting.RevisionID = revisionID
+	return fs.freelancerRepo.UpdateJobPosting(ctx, posting, []string{"revision_id"})
+}
+
+// UpdateJobPostingStatus close, fill or reopen the job posting
+func (fs *FreelancerService) UpdateJobPostingStatus(ctx context.Context, req *schema.UpdateJobPostingStatusReq) error {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.JobPostingNotFound)
+	}
+	isOwner := posting.UserID == req.LoginUserID
+	if req.Status == entity.JobPostingStatusOpen {
+		if !isOwner && !req.CanReopen {
+			return errors.Forbidden(reason.RankFailToMeetTheCondition)
+		}
+	} else if !isOwner && !req.CanClose {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	if !canChangeJobPostingStatus(posting.Status, req.Status) {
+		return errors.BadRequest(reason.JobPostingStatusInvalid)
+	}
+	return fs.freelancerRepo.UpdateJobPostingStatus(ctx, posting.ID, req.Status)
+}
+
+// RemoveJobPosting delete the job posting with all of its applications
+func (fs *FreelancerService) RemoveJobPosting(ctx context.Context, req *schema.RemoveJobPostingReq) error {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return nil
+	}
+	if posting.UserID != req.LoginUserID && !req.CanDelete {
+		return errors.Forbidden(reason.RankFailToMeetTheCondition)
+	}
+	return fs.freelancerRepo.DeleteJobPosting(ctx, posting.ID)
+}
+
+// jobPostingStatusTransitions the statuses which a job posting can be changed to from current status
+var jobPostingStatusTransitions = map[string][]string{
+	entity.JobPostingStatusOpen:   {entity.JobPostingStatusClosed, entity.JobPostingStatusFilled},
+	entity.JobPostingStatusClosed: {entity.JobPostingStatusOpen},
+	entity.JobPostingStatusFilled: {entity.JobPostingStatusOpen},
+}
+
+func canChangeJobPostingStatus(from, to string) bool {
+	for _, status := range jobPostingStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
 }
 
 // GetJobPostings get job postings
 func (fs *FreelancerService) GetJobPostings(ctx context.Context, req *schema.GetJobPostingsReq) (*schema.GetJobPostingsResp, error) {
-	postings, total, err := fs.freelancerRepo.GetJobPostings(ctx, req)
+	if len(strings.TrimSpace(req.Skills)) > 0 {
+		tagIDs, matchable, err := fs.getSkillFilterTagIDGroups(ctx, req.Skills, req.SkillMatch == schema.SkillMatchAll)
+		if err != nil {
+			return nil, err
+		}
+		if !matchable {
+			return &schema.GetJobPostingsResp{List: make([]*schema.JobPostingResp, 0)}, nil
+		}
+		req.SkillTagIDs = tagIDs
+	}
+	currencyConverter, displayCurrency, err := fs.getDisplayConverter(ctx, req.DisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if err = setJobPostingsFilterBaseBudget(currencyConverter, displayCurrency, req); err != nil {
+		return nil, err
+	}
+	var (
+		postings []*entity.JobPosting
+		total    int64
+	)
+	if fs.freelancerRepo.HasListingSearch() {
+		postings, total, err = fs.searchJobPostings(ctx, req)
+	} else {
+		postings, total, err = fs.freelancerRepo.GetJobPostings(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var resp []*schema.JobPostingResp
 	for _, posting := range postings {
-		resp = append(resp, fs.convertJobPostingToResp(posting))
+		item := fs.convertJobPostingToResp(posting)
+		item.DisplayBudget, item.DisplayCurrency = displayAmount(currencyConverter,
+			posting.Budget, posting.Currency, displayCurrency)
+		resp = append(resp, item)
 	}
 
 	return &schema.GetJobPostingsResp{
@@ -275,34 +722,72 @@ func (fs *FreelancerService) GetJobPostings(ctx context.Context, req *schema.Get
 }
 
 // GetJobPosting get job posting by ID
-func (fs *FreelancerService) GetJobPosting(ctx context.Context, id string) (*schema.JobPostingResp, error) {
-	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, id)
+func (fs *FreelancerService) GetJobPosting(ctx context.Context, req *schema.GetJobPostingReq) (*schema.JobPostingResp, error) {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
 	if err != nil {
 		return nil, err
 	}
-	if !exist {
+	// the job posting waiting for the review is only visible to the poster and admin
+	if !exist || (!posting.IsPublished() && posting.UserID != req.LoginUserID && !req.IsAdmin) {
 		return nil, errors.NotFound(reason.JobPostingNotFound)
 	}
 
 	// Increment views count
 	go func() {
 		ctx := context.Background()
-		fs.freelancerRepo.IncrementJobViews(ctx, id)
+		if err := fs.freelancerRepo.IncrementJobViews(ctx, posting.ID); err != nil {
+			log.Error(err)
+		}
 	}()
 
-	return fs.convertJobPostingToResp(posting), nil
+	resp := fs.convertJobPostingToResp(posting)
+	resp.Operation = permission.GetJobPostingPermission(ctx, req.LoginUserID, posting.UserID, posting.Status,
+		req.CanEdit, req.CanDelete, req.CanClose, req.CanReopen)
+	return resp, nil
+}
+
+// GetJobPostingAnalytics get the views, applications and hiring funnel of the job posting for the poster
+func (fs *FreelancerService) GetJobPostingAnalytics(ctx context.Context, req *schema.GetJobPostingAnalyticsReq) (
+	resp *schema.JobPostingAnalyticsResp, err error) {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || posting.Status == entity.JobPostingStatusDeleted {
+		return nil, errors.NotFound(reason.JobPostingNotFound)
+	}
+	if posting.UserID != req.LoginUserID && !req.IsAdmin {
+		return nil, errors.Forbidden(reason.ForbiddenError)
+	}
+
+	applications, err := fs.freelancerRepo.GetJobApplicationsByJobID(ctx, posting.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp = &schema.JobPostingAnalyticsResp{}
+	resp.ConvertFromJobPosting(posting, applications)
+	return resp, nil
 }
 
 // CreateJobApplication create job application
 func (fs *FreelancerService) CreateJobApplication(ctx context.Context, req *schema.CreateJobApplicationReq) error {
-	// Check if job exists
-	_, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.JobID)
+	// Check if job exists and is still accepting applications
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.JobID)
 	if err != nil {
 		return err
 	}
 	if !exist {
 		return errors.NotFound(reason.JobPostingNotFound)
 	}
+	if posting.Status != entity.JobPostingStatusOpen {
+		return errors.BadRequest(reason.JobPostingNotOpen)
+	}
+	if posting.UserID == req.LoginUserID {
+		return errors.BadRequest(reason.JobApplicationOwnPosting)
+	}
+	if err = filterText(req.CoverLetter, req.Message); err != nil {
+		return err
+	}
 
 	// Check if user already applied
 	applications, err := fs.freelancerRepo.GetJobApplicationsByApplicantID(ctx, req.LoginUserID)
@@ -315,172 +800,196 @@ func (fs *FreelancerService) CreateJobApplication(ctx context.Context, req *sche
 		}
 	}
 
+	// the rate is proposed in the currency of the job posting if it is not set
+	if len(req.Currency) == 0 {
+		req.Currency = posting.Currency
+	}
+	currencyCode, baseProposedRate, err := fs.currencyService.Normalize(ctx, req.ProposedRate, req.Currency)
+	if err != nil {
+		return err
+	}
+
 	application := &entity.JobApplication{
 		JobID:        req.JobID,
 		ApplicantID:  req.LoginUserID,
 		CoverLetter:  req.CoverLetter,
 		ProposedRate: req.ProposedRate,
-		Currency:     req.Currency,
+		Currency:         currencyCode,
+		BaseProposedRate: baseProposedRate,
 		Message:      req.Message,
+		Status:       entity.JobApplicationStatusPending,
 	}
 
 	return fs.freelancerRepo.CreateJobApplication(ctx, application)
 }
 
-// HireFreelancer hire freelancer
-func (fs *FreelancerService) HireFreelancer(ctx context.Context, req *schema.HireFreelancerReq) (*schema.HireFreelancerResp, error) {
-	// Get freelancer user info
-	freelancerUser, exist, err := fs.userRepo.GetByUserID(ctx, req.FreelancerUserID)
+// GetJobApplications get job applications page.
+// If job id is set, only the poster of the job or admin can see all applications of the job,
+// otherwise the applications submitted by the login user are returned.
+func (fs *FreelancerService) GetJobApplications(ctx context.Context, req *schema.GetJobApplicationsReq) (*schema.GetJobApplicationsResp, error) {
+	if len(req.JobID) > 0 {
+		posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, req.JobID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return nil, errors.NotFound(reason.JobPostingNotFound)
+		}
+		if posting.UserID != req.LoginUserID && !req.IsAdmin {
+			return nil, errors.Forbidden(reason.ForbiddenError)
+		}
+	} else {
+		req.ApplicantID = req.LoginUserID
+	}
+
+	applications, total, err := fs.freelancerRepo.GetJobApplications(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	if !exist {
-		return nil, errors.NotFound(reason.UserNotFound)
+	currencyConverter, displayCurrency, err := fs.getDisplayConverter(ctx, req.DisplayCurrency)
+	if err != nil {
+		return nil, err
 	}
 
-    // Get freelancer profile (optional). If not exists, fall back to user email.
-    freelancerProfile, exist, err := fs.freelancerRepo.GetFreelancerProfileByUserID(ctx, req.FreelancerUserID)
-    if err != nil {
-        return nil, err
-    }
+	resp := make([]*schema.JobApplicationResp, 0, len(applications))
+	for _, application := range applications {
+		item := fs.convertJobApplicationToResp(application)
+		item.DisplayProposedRate, item.DisplayCurrency = displayAmount(currencyConverter,
+			application.ProposedRate, application.Currency, displayCurrency)
+		resp = append(resp, item)
+	}
+	return &schema.GetJobApplicationsResp{
+		Count: int(total),
+		List:  resp,
+	}, nil
+}
 
-	// Get current user info
-	currentUser, exist, err := fs.userRepo.GetByUserID(ctx, req.LoginUserID)
+// UpdateJobApplicationStatus update job application status.
+// The poster of the job can accept or reject a pending application, the applicant can withdraw it.
+// Accepting an application fills the job posting.
+func (fs *FreelancerService) UpdateJobApplicationStatus(ctx context.Context, req *schema.UpdateJobApplicationStatusReq) error {
+	application, exist, err := fs.freelancerRepo.GetJobApplicationByID(ctx, req.ID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	if !exist {
-		return nil, errors.NotFound(reason.UserNotFound)
+		return errors.NotFound(reason.JobApplicationNotFound)
 	}
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, application.JobID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.JobPostingNotFound)
+	}
+
+	switch req.Status {
+	case entity.JobApplicationStatusAccepted, entity.JobApplicationStatusRejected:
+		if posting.UserID != req.LoginUserID && !req.IsAdmin {
+			return errors.Forbidden(reason.ForbiddenError)
+		}
+	case entity.JobApplicationStatusWithdrawn:
+		if application.ApplicantID != req.LoginUserID {
+			return errors.Forbidden(reason.ForbiddenError)
+		}
+	}
+	if !canChangeJobApplicationStatus(application.Status, req.Status) {
+		return errors.BadRequest(reason.JobApplicationStatusInvalid)
+	}
+
+	jobStatus := ""
+	if req.Status == entity.JobApplicationStatusAccepted {
+		if posting.Status != entity.JobPostingStatusOpen {
+			return errors.BadRequest(reason.JobPostingNotOpen)
+		}
+		jobStatus = entity.JobPostingStatusFilled
+	}
+	application.Status = req.Status
+	return fs.freelancerRepo.UpdateJobApplicationStatus(ctx, application, jobStatus)
+}
+
+// jobApplicationStatusTransitions the statuses which an application can be changed to from current status
+var jobApplicationStatusTransitions = map[string][]string{
+	entity.JobApplicationStatusPending: {
+		entity.JobApplicationStatusAccepted,
+		entity.JobApplicationStatusRejected,
+		entity.JobApplicationStatusWithdrawn,
+	},
+}
+
+func canChangeJobApplicationStatus(from, to string) bool {
+	for _, status := range jobApplicationStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
 
-	// Get site info
-	siteInfo, err := fs.siteInfoService.GetSiteGeneral(ctx)
+// HireFreelancer hire freelancer, a contract without milestone is created
+// and the hiring message is sent to the freelancer in the conversation of the contract
+func (fs *FreelancerService) HireFreelancer(ctx context.Context, req *schema.HireFreelancerReq) (*schema.HireFreelancerResp, error) {
+	if err := filterText(req.Subject, req.Message); err != nil {
+		return nil, err
+	}
+	contractResp, err := fs.contractService.CreateContract(ctx, &schema.CreateContractReq{
+		FreelancerUserID: req.FreelancerUserID,
+		JobID:            req.JobID,
+		ApplicationID:    req.ApplicationID,
+		Title:            req.Subject,
+		Description:      req.Message,
+		LoginUserID:      req.LoginUserID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare email
-    contactEmail := freelancerUser.EMail
-    if exist && freelancerProfile.ContactEmail != "" {
-        contactEmail = freelancerProfile.ContactEmail
+	conversationResp, err := fs.conversationService.StartConversation(ctx, &schema.StartConversationReq{
+		ContractID:  contractResp.ID,
+		Subject:     req.Subject,
+		Content:     req.Message,
+		HTML:        converter.Markdown2HTML(req.Message),
+		LoginUserID: req.LoginUserID,
+	})
+	if err != nil {
+		return nil, err
     }
 
-	// Send hiring email
-	subject := req.Subject
-	body := fs.generateHiringEmailBody(req.Message, currentUser, freelancerUser, siteInfo.Name)
-
-	fs.emailService.Send(ctx, contactEmail, subject, body)
-
 	return &schema.HireFreelancerResp{
 		Success: true,
 		Message: "Hiring message sent successfully",
+		ContractID: contractResp.ID,
+		ConversationID: conversationResp.ConversationID,
 	}, nil
 }
 
 // convertFreelancerProfileToResp convert freelancer profile to response
 func (fs *FreelancerService) convertFreelancerProfileToResp(profile *entity.FreelancerProfile) *schema.FreelancerProfileResp {
-	var skills []string
-	json.Unmarshal([]byte(profile.Skills), &skills)
-
-	var portfolio []string
-	json.Unmarshal([]byte(profile.Portfolio), &portfolio)
-
-	var preferredProjects []string
-	json.Unmarshal([]byte(profile.PreferredProjects), &preferredProjects)
-
-	var languages []string
-	json.Unmarshal([]byte(profile.Languages), &languages)
-
-	return &schema.FreelancerProfileResp{
-		ID:                profile.ID,
-		UserID:            profile.UserID,
-		IsAvailable:       profile.IsAvailable,
-		HourlyRate:        profile.HourlyRate,
-		Currency:          profile.Currency,
-		Skills:            skills,
-		Experience:        profile.Experience,
-		Portfolio:         portfolio,
-		Availability:      profile.Availability,
-		PreferredProjects: preferredProjects,
-		ContactEmail:      profile.ContactEmail,
-		LinkedInProfile:   profile.LinkedInProfile,
-		GitHubProfile:     profile.GitHubProfile,
-		Website:           profile.Website,
-		Bio:               profile.Bio,
-		BioHTML:           profile.BioHTML,
-		Languages:         languages,
-		TimeZone:          profile.TimeZone,
-		ResponseTime:      profile.ResponseTime,
-		CompletedProjects: profile.CompletedProjects,
-		ClientSatisfaction: profile.ClientSatisfaction,
-		IsVerified:        profile.IsVerified,
-		VerificationDate:  profile.VerificationDate.Unix(),
-		CreatedAt:         profile.CreatedAt.Unix(),
-		UpdatedAt:         profile.UpdatedAt.Unix(),
-	}
+	resp := &schema.FreelancerProfileResp{}
+	resp.ConvertFromFreelancerProfileEntity(profile)
+	return resp
 }
 
 // convertJobPostingToResp convert job posting to response
 func (fs *FreelancerService) convertJobPostingToResp(posting *entity.JobPosting) *schema.JobPostingResp {
-	var skills []string
-	json.Unmarshal([]byte(posting.Skills), &skills)
-
-	return &schema.JobPostingResp{
-		ID:               posting.ID,
-		UserID:           posting.UserID,
-		Title:            posting.Title,
-		Description:      posting.Description,
-		DescriptionHTML:  posting.DescriptionHTML,
-		Budget:           posting.Budget,
-		Currency:        posting.Currency,
-		BudgetType:       posting.BudgetType,
-		Skills:           skills,
-		ExperienceLevel:  posting.ExperienceLevel,
-		Duration:         posting.Duration,
-		Location:         posting.Location,
-		Status:           posting.Status,
-		ContactEmail:     posting.ContactEmail,
-		ApplicationCount: posting.ApplicationCount,
-		ViewsCount:       posting.ViewsCount,
-		IsActive:         posting.IsActive,
-		ExpiresAt:        posting.ExpiresAt.Unix(),
-		CreatedAt:        posting.CreatedAt.Unix(),
-		UpdatedAt:        posting.UpdatedAt.Unix(),
-	}
+	resp := &schema.JobPostingResp{}
+	resp.ConvertFromJobPostingEntity(posting)
+	return resp
 }
 
-// generateHiringEmailBody generate hiring email body
-func (fs *FreelancerService) generateHiringEmailBody(message string, currentUser, freelancerUser *entity.User, siteName string) string {
-	return `
-<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>Job Opportunity from ` + siteName + `</title>
-</head>
-<body style="font-family: Arial, sans-serif; line-height: 1.6; color: #333;">
-    <div style="max-width: 600px; margin: 0 auto; padding: 20px;">
-        <h2 style="color: #2c3e50;">Job Opportunity from ` + siteName + `</h2>
-        
-        <p>Hello ` + freelancerUser.DisplayName + `,</p>
-        
-        <p>You have received a job opportunity from <strong>` + currentUser.DisplayName + `</strong> (` + currentUser.Username + `) on ` + siteName + `.</p>
-        
-        <div style="background-color: #f8f9fa; padding: 15px; border-left: 4px solid #007bff; margin: 20px 0;">
-            <h3 style="margin-top: 0; color: #007bff;">Message:</h3>
-            <p style="margin-bottom: 0;">` + message + `</p>
-        </div>
-        
-        <p>You can contact them directly at: <a href="mailto:` + currentUser.EMail + `">` + currentUser.EMail + `</a></p>
-        
-        <p>Best regards,<br>The ` + siteName + ` Team</p>
-        
-        <hr style="border: none; border-top: 1px solid #eee; margin: 30px 0;">
-        <p style="font-size: 12px; color: #666;">
-            This message was sent through ` + siteName + `. Please do not reply to this email directly.
-        </p>
-    </div>
-</body>
-</html>
-`
+// convertJobApplicationToResp convert job application to response
+func (fs *FreelancerService) convertJobApplicationToResp(application *entity.JobApplication) *schema.JobApplicationResp {
+	return &schema.JobApplicationResp{
+		ID:           application.ID,
+		JobID:        application.JobID,
+		ApplicantID:  application.ApplicantID,
+		CoverLetter:  application.CoverLetter,
+		ProposedRate: application.ProposedRate,
+		Currency:     application.Currency,
+		BaseProposedRate: application.BaseProposedRate,
+		Status:       application.Status,
+		Message:      application.Message,
+		CreatedAt:    application.CreatedAt.Unix(),
+		UpdatedAt:    application.UpdatedAt.Unix(),
+	}
 }
@@ -22,11 +22,21 @@ package freelancer
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/currency"
+	"github.com/apache/answer/internal/service/review"
+	"github.com/apache/answer/internal/service/revision_common"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"xorm.io/xorm"
 )
 
 // MockFreelancerRepo is a mock implementation of FreelancerRepo
@@ -59,31 +69,311 @@ func (m *MockFreelancerRepo) DeleteFreelancerProfile(ctx context.Context, id str
 	return args.Error(0)
 }
 
-func (m *MockFreelancerRepo) GetFreelancerProfiles(ctx context.Context, page, pageSize int) ([]*entity.FreelancerProfile, int64, error) {
-	args := m.Called(ctx, page, pageSize)
+func (m *MockFreelancerRepo) GetFreelancerProfiles(ctx context.Context, req *schema.GetFreelancerProfilesReq) ([]*entity.FreelancerProfile, int64, error) {
+	args := m.Called(ctx, req)
 	return args.Get(0).([]*entity.FreelancerProfile), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockFreelancerRepo) CreateJobPosting(ctx context.Context, posting *entity.JobPosting) error {
+	args := m.Called(ctx, posting)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) UpdateJobPosting(ctx context.Context, posting *entity.JobPosting, cols []string) error {
+	args := m.Called(ctx, posting, cols)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) GetJobPostingByID(ctx context.Context, id string) (*entity.JobPosting, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.JobPosting), args.Bool(1), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) GetJobPostings(ctx context.Context, req *schema.GetJobPostingsReq) ([]*entity.JobPosting, int64, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).([]*entity.JobPosting), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) GetJobPostingsByUserID(ctx context.Context, userID string) ([]*entity.JobPosting, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entity.JobPosting), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) DeleteJobPosting(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) IncrementJobViews(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) CreateJobApplication(ctx context.Context, application *entity.JobApplication) error {
+	args := m.Called(ctx, application)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) UpdateJobApplication(ctx context.Context, application *entity.JobApplication) error {
+	args := m.Called(ctx, application)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) UpdateJobApplicationStatus(ctx context.Context, application *entity.JobApplication, jobStatus string) error {
+	args := m.Called(ctx, application, jobStatus)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) GetJobApplicationByID(ctx context.Context, id string) (*entity.JobApplication, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.JobApplication), args.Bool(1), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) GetJobApplications(ctx context.Context, req *schema.GetJobApplicationsReq) ([]*entity.JobApplication, int64, error) {
+	args := m.Called(ctx, req)
+	return args.Get(0).([]*entity.JobApplication), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) GetJobApplicationsByJobID(ctx context.Context, jobID string) ([]*entity.JobApplication, error) {
+	args := m.Called(ctx, jobID)
+	return args.Get(0).([]*entity.JobApplication), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetJobApplicationsByApplicantID(ctx context.Context, applicantID string) ([]*entity.JobApplication, error) {
+	args := m.Called(ctx, applicantID)
+	return args.Get(0).([]*entity.JobApplication), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) DeleteJobApplication(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) UpdateJobPostingStatus(ctx context.Context, id string, status string) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) GetJobPostingsExpireBefore(ctx context.Context, deadline time.Time) ([]*entity.JobPosting, error) {
+	args := m.Called(ctx, deadline)
+	return args.Get(0).([]*entity.JobPosting), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) UpdateJobPostingExpiryNotified(ctx context.Context, id string, notified bool) error {
+	args := m.Called(ctx, id, notified)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) CloseExpiredJobPostings(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetAvailableFreelancerProfiles(ctx context.Context, limit int) ([]*entity.FreelancerProfile, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*entity.FreelancerProfile), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetOpenJobPostings(ctx context.Context, limit int) ([]*entity.JobPosting, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*entity.JobPosting), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetAcceptedAnswerCountByTags(ctx context.Context, userIDs []string, tagIDs []string) (map[string]map[string]int64, error) {
+	args := m.Called(ctx, userIDs, tagIDs)
+	return args.Get(0).(map[string]map[string]int64), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) UpdateSkillRels(ctx context.Context, objectType string, objectID string, tagIDs []string) error {
+	args := m.Called(ctx, objectType, objectID, tagIDs)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) CountSkillObjects(ctx context.Context, tagIDs []string) (int64, int64, error) {
+	args := m.Called(ctx, tagIDs)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) GetFreelancerProfilesBySkill(ctx context.Context, tagIDs []string, limit int) ([]*entity.FreelancerProfile, error) {
+	args := m.Called(ctx, tagIDs, limit)
+	return args.Get(0).([]*entity.FreelancerProfile), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetOpenJobPostingsBySkill(ctx context.Context, tagIDs []string, limit int) ([]*entity.JobPosting, error) {
+	args := m.Called(ctx, tagIDs, limit)
+	return args.Get(0).([]*entity.JobPosting), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetFreelancerProfilesByUserIDs(ctx context.Context, userIDs []string) ([]*entity.FreelancerProfile, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).([]*entity.FreelancerProfile), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetJobPostingsByIDs(ctx context.Context, ids []string) ([]*entity.JobPosting, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.JobPosting), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) HasListingSearch() bool {
+	args := m.Called()
+	return args.Bool(0)
+}
+
+func (m *MockFreelancerRepo) SearchListings(ctx context.Context, cond *plugin.SearchListingCond) ([]string, int64, error) {
+	args := m.Called(ctx, cond)
+	return args.Get(0).([]string), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFreelancerRepo) UpdateListingSearch(ctx context.Context, listingType string, objectID string) error {
+	args := m.Called(ctx, listingType, objectID)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) UpdateUserListingSearch(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerRepo) SitemapJobPostings(ctx context.Context, page int, pageSize int) ([]*schema.SiteMapJobPostingInfo, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*schema.SiteMapJobPostingInfo), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetSitemapJobPostingCount(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) SitemapFreelancers(ctx context.Context, page int, pageSize int) ([]*schema.SiteMapFreelancerInfo, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*schema.SiteMapFreelancerInfo), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetSitemapFreelancerCount(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) GetAmountCurrencies(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockFreelancerRepo) UpdateBaseAmounts(ctx context.Context, currency string, rate float64) error {
+	args := m.Called(ctx, currency, rate)
+	return args.Error(0)
+}
+
 // MockUserRepo is a mock implementation of UserRepo
 type MockUserRepo struct {
 	mock.Mock
 }
 
-func (m *MockUserRepo) GetUserByID(ctx context.Context, userID string) (*entity.User, bool, error) {
+func (m *MockUserRepo) GetByUserID(ctx context.Context, userID string) (*entity.User, bool, error) {
 	args := m.Called(ctx, userID)
 	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
 }
 
-// MockEmailService is a mock implementation of EmailService
-type MockEmailService struct {
-	mock.Mock
+func (m *MockUserRepo) AddUser(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseAnswerCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseQuestionCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateQuestionCount(ctx context.Context, userID string, count int64) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateAnswerCount(ctx context.Context, userID string, count int) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateLastLoginDate(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmailStatus(ctx context.Context, userID string, emailStatus int) error {
+	args := m.Called(ctx, userID, emailStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateNoticeStatus(ctx context.Context, userID string, noticeStatus int) error {
+	args := m.Called(ctx, userID, noticeStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmail(ctx context.Context, userID string, email string) error {
+	args := m.Called(ctx, userID, email)
+	return args.Error(0)
 }
 
-func (m *MockEmailService) SendAndSaveCode(ctx context.Context, toEmail, subject, code, notificationType string) error {
-	args := m.Called(ctx, toEmail, subject, code, notificationType)
+func (m *MockUserRepo) UpdateUserInterface(ctx context.Context, userID string, language string, colorSchema string) error {
+	args := m.Called(ctx, userID, language, colorSchema)
 	return args.Error(0)
 }
 
+func (m *MockUserRepo) UpdatePass(ctx context.Context, userID string, pass string) error {
+	args := m.Called(ctx, userID, pass)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateInfo(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateUserProfile(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) BatchGetByID(ctx context.Context, ids []string) ([]*entity.User, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByUsername(ctx context.Context, username string) (*entity.User, bool, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetByUsernames(ctx context.Context, usernames []string) ([]*entity.User, error) {
+	args := m.Called(ctx, usernames)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByEmail(ctx context.Context, email string) (*entity.User, bool, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetUserCount(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepo) SearchUserListByName(ctx context.Context, name string, limit int, onlyStaff bool) ([]*entity.User, error) {
+	args := m.Called(ctx, name, limit, onlyStaff)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) IsAvatarFileUsed(ctx context.Context, filePath string) (bool, error) {
+	args := m.Called(ctx, filePath)
+	return args.Bool(0), args.Error(1)
+}
+
 // MockSiteInfoService is a mock implementation of SiteInfoService
 type MockSiteInfoService struct {
 	mock.Mock
@@ -94,6 +384,90 @@ func (m *MockSiteInfoService) GetSiteGeneral(ctx context.Context) (*schema.SiteG
 	return args.Get(0).(*schema.SiteGeneralResp), args.Error(1)
 }
 
+func (m *MockSiteInfoService) GetSiteInterface(ctx context.Context) (*schema.SiteInterfaceResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteInterfaceResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteBranding(ctx context.Context) (*schema.SiteBrandingResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteBrandingResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteUsers(ctx context.Context) (*schema.SiteUsersResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteUsersResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) FormatAvatar(ctx context.Context, originalAvatarData string, email string, userStatus int) *schema.AvatarInfo {
+	args := m.Called(ctx, originalAvatarData, email, userStatus)
+	return args.Get(0).(*schema.AvatarInfo)
+}
+
+func (m *MockSiteInfoService) FormatListAvatar(ctx context.Context, userList []*entity.User) map[string]*schema.AvatarInfo {
+	args := m.Called(ctx, userList)
+	return args.Get(0).(map[string]*schema.AvatarInfo)
+}
+
+func (m *MockSiteInfoService) GetSiteWrite(ctx context.Context) (*schema.SiteWriteResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteWriteResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteLegal(ctx context.Context) (*schema.SiteLegalResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteLegalResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteLogin(ctx context.Context) (*schema.SiteLoginResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteLoginResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteCustomCssHTML(ctx context.Context) (*schema.SiteCustomCssHTMLResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteCustomCssHTMLResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteTheme(ctx context.Context) (*schema.SiteThemeResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteThemeResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteSeo(ctx context.Context) (*schema.SiteSeoResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteSeoResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteInfoByType(ctx context.Context, siteType string, resp interface{}) error {
+	args := m.Called(ctx, siteType, resp)
+	return args.Error(0)
+}
+
+func (m *MockSiteInfoService) IsBrandingFileUsed(ctx context.Context, filePath string) bool {
+	args := m.Called(ctx, filePath)
+	return args.Bool(0)
+}
+
+func (m *MockSiteInfoService) GetSiteJob(ctx context.Context) (*schema.SiteJobResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteJobResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteCurrency(ctx context.Context) (*schema.SiteCurrencyResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteCurrencyResp), args.Error(1)
+}
+
+// newTestCurrencyService new currency service with USD as the base currency and the rate of EUR
+func newTestCurrencyService(mockSiteInfoService *MockSiteInfoService, mockRepo *MockFreelancerRepo) *currency.CurrencyService {
+	mockSiteInfoService.On("GetSiteCurrency", mock.Anything).Return(&schema.SiteCurrencyResp{
+		BaseCurrency: "USD",
+		Rates:        map[string]float64{"EUR": 0.9},
+	}, nil).Maybe()
+	return currency.NewCurrencyService(mockSiteInfoService, mockRepo)
+}
+
 func TestCreateFreelancerProfile(t *testing.T) {
 	ctx := context.Background()
 	
@@ -101,10 +475,10 @@ func TestCreateFreelancerProfile(t *testing.T) {
 	t.Run("successful_creation", func(t *testing.T) {
 		mockRepo := new(MockFreelancerRepo)
 		mockUserRepo := new(MockUserRepo)
-		mockEmailService := new(MockEmailService)
 		mockSiteInfoService := new(MockSiteInfoService)
+		tagCommonService, _ := newTestTagCommonService("go", "react", "docker")
 		
-		service := NewFreelancerService(mockRepo, mockUserRepo, mockEmailService, mockSiteInfoService)
+		service := NewFreelancerService(mockRepo, mockUserRepo, mockSiteInfoService, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), &review.ReviewService{})
 		
 		req := &schema.CreateFreelancerProfileReq{
 			LoginUserID:      "user123",
@@ -113,7 +487,6 @@ func TestCreateFreelancerProfile(t *testing.T) {
 			Currency:         "USD",
 			Skills:           []string{"Go", "React", "Docker"},
 			Experience:       "5 years of experience",
-			Portfolio:        []string{"project1.com", "project2.com"},
 			Availability:     "Full-time",
 			PreferredProjects: []string{"Web Development", "API Development"},
 			ContactEmail:     "freelancer@example.com",
@@ -129,6 +502,7 @@ func TestCreateFreelancerProfile(t *testing.T) {
 		// Mock that profile doesn't exist
 		mockRepo.On("GetFreelancerProfileByUserID", ctx, "user123").Return((*entity.FreelancerProfile)(nil), false, nil)
 		mockRepo.On("CreateFreelancerProfile", ctx, mock.AnythingOfType("*entity.FreelancerProfile")).Return(nil)
+		mockRepo.On("UpdateSkillRels", ctx, entity.SkillRelObjectTypeFreelancer, "user123", []string{"1", "2", "3"}).Return(nil)
 		
 		err := service.CreateFreelancerProfile(ctx, req)
 		
@@ -140,10 +514,9 @@ func TestCreateFreelancerProfile(t *testing.T) {
 	t.Run("profile_already_exists", func(t *testing.T) {
 		mockRepo := new(MockFreelancerRepo)
 		mockUserRepo := new(MockUserRepo)
-		mockEmailService := new(MockEmailService)
 		mockSiteInfoService := new(MockSiteInfoService)
 		
-		service := NewFreelancerService(mockRepo, mockUserRepo, mockEmailService, mockSiteInfoService)
+		service := NewFreelancerService(mockRepo, mockUserRepo, mockSiteInfoService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), &review.ReviewService{})
 		
 		req := &schema.CreateFreelancerProfileReq{
 			LoginUserID: "user123",
@@ -165,10 +538,10 @@ func TestUpdateFreelancerProfile(t *testing.T) {
 	t.Run("successful_update", func(t *testing.T) {
 		mockRepo := new(MockFreelancerRepo)
 		mockUserRepo := new(MockUserRepo)
-		mockEmailService := new(MockEmailService)
 		mockSiteInfoService := new(MockSiteInfoService)
+		tagCommonService, _ := newTestTagCommonService("python", "django")
 		
-		service := NewFreelancerService(mockRepo, mockUserRepo, mockEmailService, mockSiteInfoService)
+		service := NewFreelancerService(mockRepo, mockUserRepo, mockSiteInfoService, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), &review.ReviewService{})
 		
 		req := &schema.UpdateFreelancerProfileReq{
 			ID:            "profile123",
@@ -178,7 +551,6 @@ func TestUpdateFreelancerProfile(t *testing.T) {
 			Currency:      "EUR",
 			Skills:        []string{"Python", "Django"},
 			Experience:    "Updated experience",
-			Portfolio:     []string{"newproject.com"},
 			Availability:  "Part-time",
 			PreferredProjects: []string{"Data Science"},
 			ContactEmail:  "newemail@example.com",
@@ -198,6 +570,7 @@ func TestUpdateFreelancerProfile(t *testing.T) {
 		
 		mockRepo.On("GetFreelancerProfileByUserID", ctx, "user123").Return(existingProfile, true, nil)
 		mockRepo.On("UpdateFreelancerProfile", ctx, mock.AnythingOfType("*entity.FreelancerProfile")).Return(nil)
+		mockRepo.On("UpdateSkillRels", ctx, entity.SkillRelObjectTypeFreelancer, "user123", []string{"1", "2"}).Return(nil)
 		
 		err := service.UpdateFreelancerProfile(ctx, req)
 		
@@ -208,10 +581,9 @@ func TestUpdateFreelancerProfile(t *testing.T) {
 	t.Run("profile_not_found", func(t *testing.T) {
 		mockRepo := new(MockFreelancerRepo)
 		mockUserRepo := new(MockUserRepo)
-		mockEmailService := new(MockEmailService)
 		mockSiteInfoService := new(MockSiteInfoService)
 		
-		service := NewFreelancerService(mockRepo, mockUserRepo, mockEmailService, mockSiteInfoService)
+		service := NewFreelancerService(mockRepo, mockUserRepo, mockSiteInfoService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), &review.ReviewService{})
 		
 		req := &schema.UpdateFreelancerProfileReq{
 			ID:          "profile123",
@@ -226,3 +598,601 @@ func TestUpdateFreelancerProfile(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+// MockRevisionRepo is a mock implementation of RevisionRepo
+type MockRevisionRepo struct {
+	mock.Mock
+}
+
+func (m *MockRevisionRepo) AddRevision(ctx context.Context, revision *entity.Revision, autoUpdateRevisionID bool) (err error) {
+	args := m.Called(ctx, revision, autoUpdateRevisionID)
+	return args.Error(0)
+}
+
+func (m *MockRevisionRepo) GetRevisionByID(ctx context.Context, revisionID string) (*entity.Revision, bool, error) {
+	args := m.Called(ctx, revisionID)
+	return args.Get(0).(*entity.Revision), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRevisionRepo) GetLastRevisionByObjectID(ctx context.Context, objectID string) (*entity.Revision, bool, error) {
+	args := m.Called(ctx, objectID)
+	return args.Get(0).(*entity.Revision), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRevisionRepo) GetLastRevisionByFileURL(ctx context.Context, fileURL string) (*entity.Revision, bool, error) {
+	args := m.Called(ctx, fileURL)
+	return args.Get(0).(*entity.Revision), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRevisionRepo) GetRevisionList(ctx context.Context, revision *entity.Revision) ([]entity.Revision, error) {
+	args := m.Called(ctx, revision)
+	return args.Get(0).([]entity.Revision), args.Error(1)
+}
+
+func (m *MockRevisionRepo) UpdateObjectRevisionId(ctx context.Context, revision *entity.Revision, session *xorm.Session) error {
+	args := m.Called(ctx, revision, session)
+	return args.Error(0)
+}
+
+func (m *MockRevisionRepo) ExistUnreviewedByObjectID(ctx context.Context, objectID string) (*entity.Revision, bool, error) {
+	args := m.Called(ctx, objectID)
+	return args.Get(0).(*entity.Revision), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRevisionRepo) GetUnreviewedRevisionPage(ctx context.Context, page int, pageSize int, objectTypes []int) ([]*entity.Revision, int64, error) {
+	args := m.Called(ctx, page, pageSize, objectTypes)
+	return args.Get(0).([]*entity.Revision), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockRevisionRepo) CountUnreviewedRevision(ctx context.Context, objectTypeList []int) (int64, error) {
+	args := m.Called(ctx, objectTypeList)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRevisionRepo) UpdateStatus(ctx context.Context, id string, status int, reviewUserID string) error {
+	args := m.Called(ctx, id, status, reviewUserID)
+	return args.Error(0)
+}
+
+// MockNotificationQueueService is a mock implementation of NotificationQueueService
+type MockNotificationQueueService struct {
+	mock.Mock
+}
+
+func (m *MockNotificationQueueService) Send(ctx context.Context, msg *schema.NotificationMsg) {
+	m.Called(ctx, msg)
+}
+
+func (m *MockNotificationQueueService) RegisterHandler(handler func(ctx context.Context, msg *schema.NotificationMsg) error) {
+	m.Called(handler)
+}
+
+// MockSavedJobSearchRepo is a mock implementation of SavedJobSearchRepo
+type MockSavedJobSearchRepo struct {
+	mock.Mock
+}
+
+func (m *MockSavedJobSearchRepo) AddSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch) error {
+	args := m.Called(ctx, search)
+	return args.Error(0)
+}
+
+func (m *MockSavedJobSearchRepo) UpdateSavedJobSearch(ctx context.Context, search *entity.SavedJobSearch, cols []string) error {
+	args := m.Called(ctx, search, cols)
+	return args.Error(0)
+}
+
+func (m *MockSavedJobSearchRepo) GetSavedJobSearch(ctx context.Context, id string) (*entity.SavedJobSearch, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.SavedJobSearch), args.Bool(1), args.Error(2)
+}
+
+func (m *MockSavedJobSearchRepo) GetSavedJobSearchesByUserID(ctx context.Context, userID string) ([]*entity.SavedJobSearch, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entity.SavedJobSearch), args.Error(1)
+}
+
+func (m *MockSavedJobSearchRepo) CountSavedJobSearches(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockSavedJobSearchRepo) RemoveSavedJobSearch(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSavedJobSearchRepo) GetAlertingSavedJobSearches(ctx context.Context, afterID string, limit int) ([]*entity.SavedJobSearch, error) {
+	args := m.Called(ctx, afterID, limit)
+	return args.Get(0).([]*entity.SavedJobSearch), args.Error(1)
+}
+
+func (m *MockSavedJobSearchRepo) AddSavedJobSearchMatches(ctx context.Context, matches []*entity.SavedJobSearchMatch) error {
+	args := m.Called(ctx, matches)
+	return args.Error(0)
+}
+
+func (m *MockSavedJobSearchRepo) RemoveSavedJobSearchMatches(ctx context.Context, searchID string) error {
+	args := m.Called(ctx, searchID)
+	return args.Error(0)
+}
+
+func (m *MockSavedJobSearchRepo) GetDueDigestSavedJobSearches(ctx context.Context, lastDigestBefore time.Time) ([]*entity.SavedJobSearch, error) {
+	args := m.Called(ctx, lastDigestBefore)
+	return args.Get(0).([]*entity.SavedJobSearch), args.Error(1)
+}
+
+func (m *MockSavedJobSearchRepo) GetSavedJobSearchMatches(ctx context.Context, searchID string) ([]*entity.SavedJobSearchMatch, error) {
+	args := m.Called(ctx, searchID)
+	return args.Get(0).([]*entity.SavedJobSearchMatch), args.Error(1)
+}
+
+func (m *MockSavedJobSearchRepo) FinishSavedJobSearchDigest(ctx context.Context, searchID string, matchIDs []string, digestAt time.Time) error {
+	args := m.Called(ctx, searchID, matchIDs, digestAt)
+	return args.Error(0)
+}
+
+// mockNoSavedJobSearch the saved searches are evaluated in the background after the posting is created,
+// there is no saved search to alert
+func mockNoSavedJobSearch(service *FreelancerService, mockRepo *MockFreelancerRepo, posterID string) {
+	mockRepo.On("GetFreelancerProfileByUserID", mock.Anything, posterID).Return(
+		(*entity.FreelancerProfile)(nil), false, nil).Maybe()
+	mockSavedJobSearchRepo := new(MockSavedJobSearchRepo)
+	mockSavedJobSearchRepo.On("GetAlertingSavedJobSearches", mock.Anything, "", savedJobSearchBatchSize).Return(
+		[]*entity.SavedJobSearch{}, nil).Maybe()
+	service.savedJobSearchRepo = mockSavedJobSearchRepo
+}
+
+// newTestFreelancerService new freelancer service with the mock repo, the other dependencies are not used by the tests
+func newTestFreelancerService(mockRepo *MockFreelancerRepo) *FreelancerService {
+	mockSiteInfoService := new(MockSiteInfoService)
+	mockSiteInfoService.On("GetSiteJob", mock.Anything).Return(&schema.SiteJobResp{
+		ExpiryNoticeDays: 3,
+		MaxLifetimeDays:  30,
+	}, nil).Maybe()
+	mockRepo.On("UpdateSkillRels", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockRepo.On("HasListingSearch").Return(false).Maybe()
+	tagCommonService, _ := newTestTagCommonService("go")
+	reviewService, _ := newTestReviewService()
+	return NewFreelancerService(mockRepo, new(MockUserRepo), mockSiteInfoService, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), reviewService)
+}
+
+func assertReason(t *testing.T, err error, reason string) {
+	var e *errors.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, reason, e.Reason)
+}
+
+func TestCreateJobApplication(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("posting_not_open", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: entity.JobPostingStatusFilled}, true, nil)
+
+		err := service.CreateJobApplication(ctx, &schema.CreateJobApplicationReq{JobID: "job1", LoginUserID: "freelancer"})
+		assertReason(t, err, reason.JobPostingNotOpen)
+		mockRepo.AssertNotCalled(t, "CreateJobApplication", mock.Anything, mock.Anything)
+	})
+
+	t.Run("own_posting", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: entity.JobPostingStatusOpen}, true, nil)
+
+		err := service.CreateJobApplication(ctx, &schema.CreateJobApplicationReq{JobID: "job1", LoginUserID: "client"})
+		assertReason(t, err, reason.JobApplicationOwnPosting)
+	})
+
+	t.Run("pending_application", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: entity.JobPostingStatusOpen}, true, nil)
+		mockRepo.On("GetJobApplicationsByApplicantID", ctx, "freelancer").Return([]*entity.JobApplication{}, nil)
+		mockRepo.On("CreateJobApplication", ctx, mock.MatchedBy(func(application *entity.JobApplication) bool {
+			return application.JobID == "job1" && application.ApplicantID == "freelancer" &&
+				application.Status == entity.JobApplicationStatusPending
+		})).Return(nil)
+
+		err := service.CreateJobApplication(ctx, &schema.CreateJobApplicationReq{JobID: "job1", LoginUserID: "freelancer"})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestGetJobApplications(t *testing.T) {
+	ctx := context.Background()
+	posting := &entity.JobPosting{ID: "job1", UserID: "client", Status: entity.JobPostingStatusOpen}
+
+	t.Run("only_poster_can_list_applications_of_job", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(posting, true, nil)
+
+		_, err := service.GetJobApplications(ctx, &schema.GetJobApplicationsReq{JobID: "job1", LoginUserID: "other"})
+		assertReason(t, err, reason.ForbiddenError)
+	})
+
+	t.Run("own_applications_without_job", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+		mockRepo.On("GetJobApplications", ctx, mock.MatchedBy(func(req *schema.GetJobApplicationsReq) bool {
+			return req.ApplicantID == "freelancer"
+		})).Return([]*entity.JobApplication{{ID: "1", JobID: "job1", ApplicantID: "freelancer"}}, int64(1), nil)
+
+		resp, err := service.GetJobApplications(ctx, &schema.GetJobApplicationsReq{LoginUserID: "freelancer"})
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp.Count)
+		assert.Equal(t, "1", resp.List[0].ID)
+	})
+}
+
+func TestUpdateJobApplicationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	newService := func(applicationStatus, postingStatus string) (*FreelancerService, *MockFreelancerRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobApplicationByID", ctx, "1").Return(&entity.JobApplication{
+			ID: "1", JobID: "job1", ApplicantID: "freelancer", Status: applicationStatus}, true, nil)
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: postingStatus}, true, nil)
+		return newTestFreelancerService(mockRepo), mockRepo
+	}
+
+	t.Run("accept_fills_posting", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusOpen)
+		mockRepo.On("UpdateJobApplicationStatus", ctx, mock.MatchedBy(func(application *entity.JobApplication) bool {
+			return application.Status == entity.JobApplicationStatusAccepted
+		}), entity.JobPostingStatusFilled).Return(nil)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusAccepted, LoginUserID: "client"})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("reject_keeps_posting", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusOpen)
+		mockRepo.On("UpdateJobApplicationStatus", ctx, mock.Anything, "").Return(nil)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusRejected, LoginUserID: "client"})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("applicant_can_not_accept", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusOpen)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusAccepted, LoginUserID: "freelancer"})
+		assertReason(t, err, reason.ForbiddenError)
+		mockRepo.AssertNotCalled(t, "UpdateJobApplicationStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("only_applicant_can_withdraw", func(t *testing.T) {
+		service, _ := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusOpen)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusWithdrawn, LoginUserID: "client"})
+		assertReason(t, err, reason.ForbiddenError)
+	})
+
+	t.Run("application_not_pending", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobApplicationStatusRejected, entity.JobPostingStatusOpen)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusAccepted, LoginUserID: "client"})
+		assertReason(t, err, reason.JobApplicationStatusInvalid)
+		mockRepo.AssertNotCalled(t, "UpdateJobApplicationStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("posting_already_filled", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusFilled)
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusAccepted, LoginUserID: "client"})
+		assertReason(t, err, reason.JobPostingNotOpen)
+		mockRepo.AssertNotCalled(t, "UpdateJobApplicationStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("concurrent_change_is_rejected", func(t *testing.T) {
+		// another application of the job was accepted after the posting was read
+		service, mockRepo := newService(entity.JobApplicationStatusPending, entity.JobPostingStatusOpen)
+		mockRepo.On("UpdateJobApplicationStatus", ctx, mock.Anything, entity.JobPostingStatusFilled).
+			Return(errors.BadRequest(reason.JobPostingNotOpen))
+
+		err := service.UpdateJobApplicationStatus(ctx, &schema.UpdateJobApplicationStatusReq{
+			ID: "1", Status: entity.JobApplicationStatusAccepted, LoginUserID: "client"})
+		assertReason(t, err, reason.JobPostingNotOpen)
+	})
+}
+
+// newTestRevisionService new revision service which gives every added revision the id rev1
+func newTestRevisionService() (*revision_common.RevisionService, *MockRevisionRepo) {
+	mockRevisionRepo := new(MockRevisionRepo)
+	mockRevisionRepo.On("AddRevision", mock.Anything, mock.Anything, true).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.Revision).ID = "rev1"
+	}).Return(nil)
+	return revision_common.NewRevisionService(mockRevisionRepo, nil), mockRevisionRepo
+}
+
+func TestCreateJobPosting(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockFreelancerRepo)
+	service := newTestFreelancerService(mockRepo)
+	revisionService, mockRevisionRepo := newTestRevisionService()
+	service.revisionService = revisionService
+
+	mockRepo.On("CreateJobPosting", ctx, mock.AnythingOfType("*entity.JobPosting")).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.JobPosting).ID = "11010000000000001"
+	}).Return(nil)
+	mockRepo.On("UpdateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+		return posting.RevisionID == "rev1"
+	}), []string{"revision_id"}).Return(nil)
+	mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusOpen).Return(nil)
+	mockNoSavedJobSearch(service, mockRepo, "client")
+
+	err := service.CreateJobPosting(ctx, &schema.CreateJobPostingReq{
+		Title: "Build an API", Description: "**Go** developer", LoginUserID: "client"})
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+
+	revision := mockRevisionRepo.Calls[0].Arguments.Get(1).(*entity.Revision)
+	assert.Equal(t, "11010000000000001", revision.ObjectID)
+	assert.Equal(t, "client", revision.UserID)
+	assert.Equal(t, entity.RevisionReviewPassStatus, revision.Status)
+}
+
+func TestUpdateJobPosting(t *testing.T) {
+	ctx := context.Background()
+	newService := func() (*FreelancerService, *MockFreelancerRepo, *MockRevisionRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return(&entity.JobPosting{
+			ID: "11010000000000001", UserID: "client", Title: "Old title", Status: entity.JobPostingStatusOpen}, true, nil)
+		service := newTestFreelancerService(mockRepo)
+		revisionService, mockRevisionRepo := newTestRevisionService()
+		service.revisionService = revisionService
+		return service, mockRepo, mockRevisionRepo
+	}
+	req := func(userID string, canEdit bool) *schema.UpdateJobPostingReq {
+		return &schema.UpdateJobPostingReq{ID: "11010000000000001", Title: "New title", Description: "New description",
+			Skills: []string{"go"}, EditSummary: "fix title", LoginUserID: userID, CanEdit: canEdit}
+	}
+
+	t.Run("poster_edits_and_adds_revision", func(t *testing.T) {
+		service, mockRepo, mockRevisionRepo := newService()
+		mockRepo.On("UpdateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+			return posting.Title == "New title" && posting.DescriptionHTML != "" && posting.Skills == `["go"]`
+		}), mock.MatchedBy(func(cols []string) bool {
+			return len(cols) > 1
+		})).Return(nil).Once()
+		mockRepo.On("UpdateJobPosting", ctx, mock.Anything, []string{"revision_id"}).Return(nil).Once()
+
+		err := service.UpdateJobPosting(ctx, req("client", false))
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+
+		revision := mockRevisionRepo.Calls[0].Arguments.Get(1).(*entity.Revision)
+		assert.Equal(t, "11010000000000001", revision.ObjectID)
+		assert.Equal(t, "fix title", revision.Log)
+		assert.Contains(t, revision.Content, "New title")
+	})
+
+	t.Run("moderator_with_permission_edits", func(t *testing.T) {
+		service, mockRepo, _ := newService()
+		mockRepo.On("UpdateJobPosting", ctx, mock.Anything, mock.Anything).Return(nil)
+
+		err := service.UpdateJobPosting(ctx, req("moderator", true))
+		assert.NoError(t, err)
+	})
+
+	t.Run("other_user_can_not_edit", func(t *testing.T) {
+		service, mockRepo, mockRevisionRepo := newService()
+
+		err := service.UpdateJobPosting(ctx, req("other", false))
+		assertReason(t, err, reason.RankFailToMeetTheCondition)
+		mockRepo.AssertNotCalled(t, "UpdateJobPosting", mock.Anything, mock.Anything, mock.Anything)
+		mockRevisionRepo.AssertNotCalled(t, "AddRevision", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("posting_not_found", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return((*entity.JobPosting)(nil), false, nil)
+
+		err := newTestFreelancerService(mockRepo).UpdateJobPosting(ctx, req("client", false))
+		assertReason(t, err, reason.JobPostingNotFound)
+	})
+}
+
+func TestUpdateJobPostingStatus(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*FreelancerService, *MockFreelancerRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: status}, true, nil)
+		mockRepo.On("UpdateJobPostingStatus", ctx, "job1", mock.Anything).Return(nil)
+		return newTestFreelancerService(mockRepo), mockRepo
+	}
+
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		userID    string
+		canClose  bool
+		canReopen bool
+		reason    string
+	}{
+		{name: "poster_closes", from: entity.JobPostingStatusOpen, to: entity.JobPostingStatusClosed, userID: "client"},
+		{name: "poster_fills", from: entity.JobPostingStatusOpen, to: entity.JobPostingStatusFilled, userID: "client"},
+		{name: "poster_reopens_closed", from: entity.JobPostingStatusClosed, to: entity.JobPostingStatusOpen, userID: "client"},
+		{name: "poster_reopens_filled", from: entity.JobPostingStatusFilled, to: entity.JobPostingStatusOpen, userID: "client"},
+		{name: "moderator_closes", from: entity.JobPostingStatusOpen, to: entity.JobPostingStatusClosed,
+			userID: "moderator", canClose: true},
+		{name: "moderator_reopens", from: entity.JobPostingStatusClosed, to: entity.JobPostingStatusOpen,
+			userID: "moderator", canReopen: true},
+		{name: "close_permission_can_not_reopen", from: entity.JobPostingStatusClosed, to: entity.JobPostingStatusOpen,
+			userID: "moderator", canClose: true, reason: reason.RankFailToMeetTheCondition},
+		{name: "other_user_can_not_close", from: entity.JobPostingStatusOpen, to: entity.JobPostingStatusClosed,
+			userID: "other", reason: reason.RankFailToMeetTheCondition},
+		{name: "closed_can_not_be_filled", from: entity.JobPostingStatusClosed, to: entity.JobPostingStatusFilled,
+			userID: "client", reason: reason.JobPostingStatusInvalid},
+		{name: "open_can_not_be_reopened", from: entity.JobPostingStatusOpen, to: entity.JobPostingStatusOpen,
+			userID: "client", reason: reason.JobPostingStatusInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockRepo := newService(tt.from)
+			err := service.UpdateJobPostingStatus(ctx, &schema.UpdateJobPostingStatusReq{
+				ID: "job1", Status: tt.to, LoginUserID: tt.userID, CanClose: tt.canClose, CanReopen: tt.canReopen})
+			if len(tt.reason) > 0 {
+				assertReason(t, err, tt.reason)
+				mockRepo.AssertNotCalled(t, "UpdateJobPostingStatus", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			require.NoError(t, err)
+			mockRepo.AssertCalled(t, "UpdateJobPostingStatus", ctx, "job1", tt.to)
+		})
+	}
+}
+
+func TestRemoveJobPosting(t *testing.T) {
+	ctx := context.Background()
+	newService := func() (*FreelancerService, *MockFreelancerRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{ID: "job1", UserID: "client"}, true, nil)
+		mockRepo.On("DeleteJobPosting", ctx, "job1").Return(nil)
+		return newTestFreelancerService(mockRepo), mockRepo
+	}
+
+	service, mockRepo := newService()
+	assert.NoError(t, service.RemoveJobPosting(ctx, &schema.RemoveJobPostingReq{ID: "job1", LoginUserID: "client"}))
+	mockRepo.AssertCalled(t, "DeleteJobPosting", ctx, "job1")
+
+	service, mockRepo = newService()
+	err := service.RemoveJobPosting(ctx, &schema.RemoveJobPostingReq{ID: "job1", LoginUserID: "other"})
+	assertReason(t, err, reason.RankFailToMeetTheCondition)
+	mockRepo.AssertNotCalled(t, "DeleteJobPosting", mock.Anything, mock.Anything)
+}
+
+func TestRenewJobPosting(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*FreelancerService, *MockFreelancerRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "job1").Return(&entity.JobPosting{
+			ID: "job1", UserID: "client", Status: status, ExpiryNotified: true}, true, nil)
+		return newTestFreelancerService(mockRepo), mockRepo
+	}
+
+	t.Run("renew_reopens_expired_posting", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobPostingStatusClosed)
+		expiresAt := time.Now().AddDate(0, 0, 10).Truncate(time.Second)
+		mockRepo.On("UpdateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+			return posting.Status == entity.JobPostingStatusOpen && !posting.ExpiryNotified &&
+				posting.ExpiresAt.Equal(expiresAt)
+		}), []string{"expires_at", "expiry_notified", "status"}).Return(nil)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{
+			ID: "job1", ExpiresAt: expiresAt.Unix(), LoginUserID: "client"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("renew_without_time_uses_max_lifetime", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobPostingStatusOpen)
+		mockRepo.On("UpdateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+			days := time.Until(posting.ExpiresAt).Hours() / 24
+			return days > 29 && days <= 30
+		}), mock.Anything).Return(nil)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{ID: "job1", LoginUserID: "client"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("renew_beyond_max_lifetime", func(t *testing.T) {
+		service, mockRepo := newService(entity.JobPostingStatusOpen)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{
+			ID: "job1", ExpiresAt: time.Now().AddDate(0, 0, 31).Unix(), LoginUserID: "client"})
+		assertReason(t, err, reason.JobPostingExpiresAtInvalid)
+		mockRepo.AssertNotCalled(t, "UpdateJobPosting", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("renew_in_the_past", func(t *testing.T) {
+		service, _ := newService(entity.JobPostingStatusOpen)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{
+			ID: "job1", ExpiresAt: time.Now().Add(-time.Hour).Unix(), LoginUserID: "client"})
+		assertReason(t, err, reason.JobPostingExpiresAtInvalid)
+	})
+
+	t.Run("filled_posting_can_not_be_renewed", func(t *testing.T) {
+		service, _ := newService(entity.JobPostingStatusFilled)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{ID: "job1", LoginUserID: "client"})
+		assertReason(t, err, reason.JobPostingStatusInvalid)
+	})
+
+	t.Run("only_poster_can_renew", func(t *testing.T) {
+		service, _ := newService(entity.JobPostingStatusClosed)
+
+		err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{ID: "job1", LoginUserID: "other"})
+		assertReason(t, err, reason.ForbiddenError)
+	})
+}
+
+func TestJobPostingExpiryCron(t *testing.T) {
+	ctx := context.Background()
+	newService := func(siteJob *schema.SiteJobResp) (*FreelancerService, *MockFreelancerRepo, *MockNotificationQueueService) {
+		mockRepo := new(MockFreelancerRepo)
+		mockSiteInfoService := new(MockSiteInfoService)
+		mockSiteInfoService.On("GetSiteJob", ctx).Return(siteJob, nil)
+		mockNotificationQueueService := new(MockNotificationQueueService)
+		mockNotificationQueueService.On("Send", ctx, mock.Anything).Return()
+		service := NewFreelancerService(mockRepo, new(MockUserRepo), mockSiteInfoService, nil, mockNotificationQueueService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, newTestCurrencyService(mockSiteInfoService, mockRepo), &review.ReviewService{})
+		return service, mockRepo, mockNotificationQueueService
+	}
+
+	t.Run("notify_posters_before_expiry_and_close_expired", func(t *testing.T) {
+		service, mockRepo, mockNotificationQueueService := newService(&schema.SiteJobResp{ExpiryNoticeDays: 3, MaxLifetimeDays: 30})
+		mockRepo.On("GetJobPostingsExpireBefore", ctx, mock.MatchedBy(func(deadline time.Time) bool {
+			days := time.Until(deadline).Hours() / 24
+			return days > 2.9 && days <= 3
+		})).Return([]*entity.JobPosting{
+			{ID: "job1", UserID: "client1", Title: "Job 1"},
+			{ID: "job2", UserID: "client2", Title: "Job 2"},
+		}, nil)
+		mockRepo.On("UpdateJobPostingExpiryNotified", ctx, "job1", true).Return(nil)
+		mockRepo.On("UpdateJobPostingExpiryNotified", ctx, "job2", true).Return(nil)
+		mockRepo.On("CloseExpiredJobPostings", ctx, mock.AnythingOfType("time.Time")).Return(int64(1), nil)
+
+		service.JobPostingExpiryCron(ctx)
+		mockRepo.AssertExpectations(t)
+
+		require.Len(t, mockNotificationQueueService.Calls, 2)
+		msg := mockNotificationQueueService.Calls[0].Arguments.Get(1).(*schema.NotificationMsg)
+		assert.Equal(t, "client1", msg.ReceiverUserID)
+		assert.Equal(t, "job1", msg.ObjectID)
+		assert.Equal(t, constant.JobPostingObjectType, msg.ObjectType)
+		assert.Equal(t, constant.NotificationYourJobPostingWillExpire, msg.NotificationAction)
+	})
+
+	t.Run("notice_disabled", func(t *testing.T) {
+		service, mockRepo, mockNotificationQueueService := newService(&schema.SiteJobResp{MaxLifetimeDays: 30})
+		mockRepo.On("CloseExpiredJobPostings", ctx, mock.AnythingOfType("time.Time")).Return(int64(0), nil)
+
+		service.JobPostingExpiryCron(ctx)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetJobPostingsExpireBefore", mock.Anything, mock.Anything)
+		mockNotificationQueueService.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,119 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/day"
+	"github.com/segmentfault/pacman/errors"
+)
+
+// getSkillFilterTagIDGroups get the tag and its synonyms of each comma separated skill of the filter.
+// If any skill does not exist when all skills must be matched, or no skill exists, nothing can be matched.
+func (fs *FreelancerService) getSkillFilterTagIDGroups(ctx context.Context, skills string, matchAll bool) (
+	groups [][]string, matchable bool, err error) {
+	for _, skill := range strings.Split(skills, ",") {
+		if len(strings.TrimSpace(skill)) == 0 {
+			continue
+		}
+		_, tagIDs, err := fs.getSkillFilterTagIDs(ctx, skill)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(tagIDs) == 0 {
+			if matchAll {
+				return nil, false, nil
+			}
+			continue
+		}
+		groups = append(groups, tagIDs)
+	}
+	return groups, len(groups) > 0, nil
+}
+
+// parseTimeZoneWindow parse the time zone window of the freelancer profiles filter
+func parseTimeZoneWindow(req *schema.GetFreelancerProfilesReq) (err error) {
+	if !req.HasTimeZoneWindow() {
+		return nil
+	}
+	var fromOK, toOK bool
+	req.MinUTCOffset, fromOK = day.UTCOffset(req.TimeZoneFrom)
+	req.MaxUTCOffset, toOK = day.UTCOffset(req.TimeZoneTo)
+	if !fromOK || !toOK {
+		return errors.BadRequest(reason.FreelancerTimeZoneInvalid)
+	}
+	return nil
+}
+
+// setProfileUTCOffset set the UTC offset of the profile time zone, which is used by the time zone filter
+func setProfileUTCOffset(profile *entity.FreelancerProfile) {
+	profile.UTCOffset, profile.HasUTCOffset = day.UTCOffset(profile.TimeZone)
+}
+
+// searchFreelancerProfiles search the freelancer profiles by the search plugin
+func (fs *FreelancerService) searchFreelancerProfiles(ctx context.Context, req *schema.GetFreelancerProfilesReq) (
+	profiles []*entity.FreelancerProfile, total int64, err error) {
+	userIDs, total, err := fs.freelancerRepo.SearchListings(ctx, req.Convert2PluginSearchListingCond())
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := fs.freelancerRepo.GetFreelancerProfilesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+	mapping := make(map[string]*entity.FreelancerProfile, len(list))
+	for _, profile := range list {
+		mapping[profile.UserID] = profile
+	}
+	for _, userID := range userIDs {
+		if profile, ok := mapping[userID]; ok {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles, total, nil
+}
+
+// searchJobPostings search the job postings by the search plugin
+func (fs *FreelancerService) searchJobPostings(ctx context.Context, req *schema.GetJobPostingsReq) (
+	postings []*entity.JobPosting, total int64, err error) {
+	ids, total, err := fs.freelancerRepo.SearchListings(ctx, req.Convert2PluginSearchListingCond())
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := fs.freelancerRepo.GetJobPostingsByIDs(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	mapping := make(map[string]*entity.JobPosting, len(list))
+	for _, posting := range list {
+		mapping[posting.ID] = posting
+	}
+	for _, id := range ids {
+		if posting, ok := mapping[id]; ok {
+			postings = append(postings, posting)
+		}
+	}
+	return postings, total, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// filterText check the texts by the filter plugins, the texts which are rejected by any plugin can not be posted
+func filterText(texts ...string) (err error) {
+	_ = plugin.CallFilter(func(filter plugin.Filter) error {
+		for _, text := range texts {
+			if err != nil || len(strings.TrimSpace(text)) == 0 {
+				continue
+			}
+			if e := filter.FilterText(text); e != nil {
+				err = errors.BadRequest(reason.ObjectContentFiltered).WithError(e)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// reviewJobPosting send the new or edited job posting to the reviewer plugins,
+// the suspicious job posting is pending until it is approved in the review queue.
+// Only the new job posting is opened by passing the review, so an edit never reopens a closed job posting
+// or publishes the one which is still waiting in the review queue.
+func (fs *FreelancerService) reviewJobPosting(ctx context.Context, posting *entity.JobPosting,
+	skillTags []*entity.Tag, ip, ua string, isNew bool) (err error) {
+	status := fs.reviewService.AddJobPostingReview(ctx, posting, skillSlugNames(skillTags), ip, ua)
+	if status == posting.Status || (status == entity.JobPostingStatusOpen && !isNew) {
+		return nil
+	}
+	posting.Status = status
+	return fs.freelancerRepo.UpdateJobPostingStatus(ctx, posting.ID, status)
+}
+
+// handleJobPostingReview open the approved job posting and alert the matching saved searches,
+// the rejected job posting is marked as deleted
+func (fs *FreelancerService) handleJobPostingReview(ctx context.Context, postingID string, isApprove bool) (err error) {
+	posting, exist, err := fs.freelancerRepo.GetJobPostingByID(ctx, postingID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.BadRequest(reason.ObjectNotFound)
+	}
+	if posting.Status != entity.JobPostingStatusPending {
+		return nil
+	}
+	posting.Status = entity.JobPostingStatusDeleted
+	if isApprove {
+		posting.Status = entity.JobPostingStatusOpen
+	}
+	if err = fs.freelancerRepo.UpdateJobPostingStatus(ctx, posting.ID, posting.Status); err != nil {
+		return err
+	}
+	if !isApprove {
+		return nil
+	}
+
+	skills := make([]string, 0)
+	_ = json.Unmarshal([]byte(posting.Skills), &skills)
+	skillTags, err := fs.getSkillTags(ctx, skills, posting.UserID, false)
+	if err != nil {
+		log.Errorf("get skill tags of job posting %s failed: %v", posting.ID, err)
+		return nil
+	}
+	go fs.evaluateSavedJobSearches(context.Background(), posting, skillTags)
+	return nil
+}
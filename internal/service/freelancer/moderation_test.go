@@ -0,0 +1,345 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/review"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockReviewRepo struct {
+	mock.Mock
+}
+
+func (m *MockReviewRepo) AddReview(ctx context.Context, review *entity.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockReviewRepo) UpdateReviewStatus(ctx context.Context, reviewID int, reviewerUserID string, status int) error {
+	args := m.Called(ctx, reviewID, reviewerUserID, status)
+	return args.Error(0)
+}
+
+func (m *MockReviewRepo) GetReview(ctx context.Context, reviewID int) (*entity.Review, bool, error) {
+	args := m.Called(ctx, reviewID)
+	return args.Get(0).(*entity.Review), args.Bool(1), args.Error(2)
+}
+
+func (m *MockReviewRepo) GetReviewByObject(ctx context.Context, objectID string) (*entity.Review, bool, error) {
+	args := m.Called(ctx, objectID)
+	return args.Get(0).(*entity.Review), args.Bool(1), args.Error(2)
+}
+
+func (m *MockReviewRepo) GetReviewCount(ctx context.Context, status int) (int64, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockReviewRepo) GetReviewPage(ctx context.Context, page int, pageSize int, cond *entity.Review) ([]*entity.Review, int64, error) {
+	args := m.Called(ctx, page, pageSize, cond)
+	return args.Get(0).([]*entity.Review), args.Get(1).(int64), args.Error(2)
+}
+
+// testReviewer the reviewer which puts every content into the status of testReviewStatus,
+// it is registered but only enabled by the tests which use it
+type testReviewer struct{}
+
+const testReviewerSlugName = "test_reviewer"
+
+var testReviewStatus = plugin.ReviewStatusNeedReview
+
+func (r *testReviewer) Info() plugin.Info {
+	return plugin.Info{SlugName: testReviewerSlugName}
+}
+
+func (r *testReviewer) Review(content *plugin.ReviewContent) (result *plugin.ReviewResult) {
+	return &plugin.ReviewResult{Approved: false, ReviewStatus: testReviewStatus, Reason: "suspicious"}
+}
+
+// testFilter the filter which rejects the text containing spam, it is registered but only enabled by the tests which use it
+type testFilter struct{}
+
+const testFilterSlugName = "test_filter"
+
+func (f *testFilter) Info() plugin.Info {
+	return plugin.Info{SlugName: testFilterSlugName}
+}
+
+func (f *testFilter) FilterText(text string) (err error) {
+	if strings.Contains(text, "spam") {
+		return errors.BadRequest("spam")
+	}
+	return nil
+}
+
+func init() {
+	plugin.Register(&testReviewer{})
+	plugin.Register(&testFilter{})
+}
+
+// enableTestPlugin enable the test plugin until the test is finished
+func enableTestPlugin(t *testing.T, slugName string) {
+	plugin.StatusManager.Enable(slugName, true)
+	t.Cleanup(func() {
+		plugin.StatusManager.Enable(slugName, false)
+	})
+}
+
+// newTestReviewService new review service, the author of the reviewed content is unknown
+func newTestReviewService() (*review.ReviewService, *MockReviewRepo) {
+	mockReviewRepo := new(MockReviewRepo)
+	mockUserRepo := new(MockUserRepo)
+	mockUserRepo.On("GetByUserID", mock.Anything, mock.Anything).Return(&entity.User{}, false, nil).Maybe()
+	mockSiteInfoService := new(MockSiteInfoService)
+	mockSiteInfoService.On("FormatAvatar", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&schema.AvatarInfo{}).Maybe()
+	mockSiteInfoService.On("GetSiteInterface", mock.Anything).Return(&schema.SiteInterfaceResp{}, nil).Maybe()
+	userCommon := usercommon.NewUserCommon(mockUserRepo, nil, nil, mockSiteInfoService)
+	return review.NewReviewService(mockReviewRepo, nil, userCommon, mockUserRepo, nil, nil, nil, nil, nil, nil, nil,
+		mockSiteInfoService, nil), mockReviewRepo
+}
+
+// newTestModerationService new freelancer service whose job postings are reviewed by the mock review repo
+func newTestModerationService(mockRepo *MockFreelancerRepo) (*FreelancerService, *MockReviewRepo) {
+	service := newTestFreelancerService(mockRepo)
+	revisionService, _ := newTestRevisionService()
+	service.revisionService = revisionService
+	reviewService, mockReviewRepo := newTestReviewService()
+	reviewService.RegisterJobPostingReviewHandler(service.handleJobPostingReview)
+	service.reviewService = reviewService
+	return service, mockReviewRepo
+}
+
+func TestFilterText(t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, filterText("spam"))
+
+	enableTestPlugin(t, testFilterSlugName)
+	require.NoError(t, filterText("Build an API", "", "Go developer"))
+	assertReason(t, filterText("Build an API", "buy spam"), reason.ObjectContentFiltered)
+
+	t.Run("create_job_posting", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+		err := service.CreateJobPosting(ctx, &schema.CreateJobPostingReq{
+			Title: "Build an API", Description: "spam", LoginUserID: "client"})
+		assertReason(t, err, reason.ObjectContentFiltered)
+		mockRepo.AssertNotCalled(t, "CreateJobPosting", mock.Anything, mock.Anything)
+	})
+
+	t.Run("update_job_posting", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return(&entity.JobPosting{
+			ID: "11010000000000001", UserID: "client", Status: entity.JobPostingStatusOpen}, true, nil)
+		service := newTestFreelancerService(mockRepo)
+		err := service.UpdateJobPosting(ctx, &schema.UpdateJobPostingReq{
+			ID: "11010000000000001", Title: "spam", Description: "Go developer", LoginUserID: "client"})
+		assertReason(t, err, reason.ObjectContentFiltered)
+		mockRepo.AssertNotCalled(t, "UpdateJobPosting", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("create_job_application", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return(&entity.JobPosting{
+			ID: "11010000000000001", UserID: "client", Status: entity.JobPostingStatusOpen}, true, nil)
+		service := newTestFreelancerService(mockRepo)
+		err := service.CreateJobApplication(ctx, &schema.CreateJobApplicationReq{
+			JobID: "11010000000000001", CoverLetter: "spam", LoginUserID: "freelancer"})
+		assertReason(t, err, reason.ObjectContentFiltered)
+		mockRepo.AssertNotCalled(t, "CreateJobApplication", mock.Anything, mock.Anything)
+	})
+
+	t.Run("hire_freelancer", func(t *testing.T) {
+		service := newTestFreelancerService(new(MockFreelancerRepo))
+		_, err := service.HireFreelancer(ctx, &schema.HireFreelancerReq{
+			FreelancerUserID: "freelancer", Message: "spam", LoginUserID: "client"})
+		assertReason(t, err, reason.ObjectContentFiltered)
+	})
+}
+
+func TestCreateJobPostingReview(t *testing.T) {
+	ctx := context.Background()
+	newService := func() (*FreelancerService, *MockFreelancerRepo, *MockReviewRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		service, mockReviewRepo := newTestModerationService(mockRepo)
+		mockRepo.On("CreateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+			return posting.Status == entity.JobPostingStatusPending
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.JobPosting).ID = "11010000000000001"
+		}).Return(nil)
+		mockRepo.On("UpdateJobPosting", ctx, mock.Anything, []string{"revision_id"}).Return(nil)
+		return service, mockRepo, mockReviewRepo
+	}
+	req := &schema.CreateJobPostingReq{Title: "Build an API", Description: "Go developer", LoginUserID: "client"}
+
+	t.Run("approved_job_posting_is_opened", func(t *testing.T) {
+		service, mockRepo, mockReviewRepo := newService()
+		mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusOpen).Return(nil)
+		mockNoSavedJobSearch(service, mockRepo, "client")
+
+		require.NoError(t, service.CreateJobPosting(ctx, req))
+		mockRepo.AssertExpectations(t)
+		mockReviewRepo.AssertNotCalled(t, "AddReview", mock.Anything, mock.Anything)
+	})
+
+	t.Run("suspicious_job_posting_is_pending", func(t *testing.T) {
+		enableTestPlugin(t, testReviewerSlugName)
+		testReviewStatus = plugin.ReviewStatusNeedReview
+		service, mockRepo, mockReviewRepo := newService()
+		mockReviewRepo.On("AddReview", ctx, mock.MatchedBy(func(r *entity.Review) bool {
+			return r.ObjectID == "11010000000000001" && r.UserID == "client" &&
+				r.ObjectType == constant.ObjectTypeStrMapping[constant.JobPostingObjectType] &&
+				r.Submitter == testReviewerSlugName
+		})).Return(nil)
+
+		require.NoError(t, service.CreateJobPosting(ctx, req))
+		mockReviewRepo.AssertExpectations(t)
+		// the pending job posting is neither opened nor sent to the saved searches
+		mockRepo.AssertNotCalled(t, "UpdateJobPostingStatus", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "GetFreelancerProfileByUserID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("spam_job_posting_is_deleted", func(t *testing.T) {
+		enableTestPlugin(t, testReviewerSlugName)
+		testReviewStatus = plugin.ReviewStatusDeleteDirectly
+		service, mockRepo, mockReviewRepo := newService()
+		mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusDeleted).Return(nil)
+
+		require.NoError(t, service.CreateJobPosting(ctx, req))
+		mockRepo.AssertExpectations(t)
+		mockReviewRepo.AssertNotCalled(t, "AddReview", mock.Anything, mock.Anything)
+	})
+}
+
+func TestJobPostingReviewHandler(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*FreelancerService, *MockFreelancerRepo, *MockReviewRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		service, mockReviewRepo := newTestModerationService(mockRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return(&entity.JobPosting{
+			ID: "11010000000000001", UserID: "client", Skills: `["go"]`, Status: status}, true, nil)
+		mockReviewRepo.On("GetReview", ctx, 1).Return(&entity.Review{
+			ID:         1,
+			ObjectID:   "11010000000000001",
+			ObjectType: constant.ObjectTypeStrMapping[constant.JobPostingObjectType],
+			Status:     entity.ReviewStatusPending,
+		}, true, nil)
+		return service, mockRepo, mockReviewRepo
+	}
+
+	t.Run("approve", func(t *testing.T) {
+		service, mockRepo, mockReviewRepo := newService(entity.JobPostingStatusPending)
+		mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusOpen).Return(nil)
+		mockReviewRepo.On("UpdateReviewStatus", ctx, 1, "admin", entity.ReviewStatusApproved).Return(nil)
+		mockNoSavedJobSearch(service, mockRepo, "client")
+
+		err := service.reviewService.UpdateReview(ctx, &schema.UpdateReviewReq{
+			ReviewID: 1, Status: "approve", UserID: "admin"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockReviewRepo.AssertExpectations(t)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		service, mockRepo, mockReviewRepo := newService(entity.JobPostingStatusPending)
+		mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusDeleted).Return(nil)
+		mockReviewRepo.On("UpdateReviewStatus", ctx, 1, "admin", entity.ReviewStatusRejected).Return(nil)
+
+		err := service.reviewService.UpdateReview(ctx, &schema.UpdateReviewReq{
+			ReviewID: 1, Status: "reject", UserID: "admin"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetFreelancerProfileByUserID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reviewed_job_posting_is_kept", func(t *testing.T) {
+		service, mockRepo, mockReviewRepo := newService(entity.JobPostingStatusClosed)
+		mockReviewRepo.On("UpdateReviewStatus", ctx, 1, "admin", entity.ReviewStatusApproved).Return(nil)
+
+		err := service.reviewService.UpdateReview(ctx, &schema.UpdateReviewReq{
+			ReviewID: 1, Status: "approve", UserID: "admin"})
+		require.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "UpdateJobPostingStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUnreviewedJobPosting(t *testing.T) {
+	ctx := context.Background()
+	newService := func(status string) (*FreelancerService, *MockFreelancerRepo, *MockReviewRepo) {
+		mockRepo := new(MockFreelancerRepo)
+		service, mockReviewRepo := newTestModerationService(mockRepo)
+		mockRepo.On("GetJobPostingByID", ctx, "11010000000000001").Return(&entity.JobPosting{
+			ID: "11010000000000001", UserID: "client", Status: status}, true, nil)
+		return service, mockRepo, mockReviewRepo
+	}
+	updateReq := &schema.UpdateJobPostingReq{ID: "11010000000000001", Title: "New title",
+		Description: "New description", LoginUserID: "client"}
+
+	for _, status := range []string{entity.JobPostingStatusPending, entity.JobPostingStatusDeleted} {
+		t.Run("renew_"+status, func(t *testing.T) {
+			service, mockRepo, _ := newService(status)
+			err := service.RenewJobPosting(ctx, &schema.RenewJobPostingReq{ID: "11010000000000001", LoginUserID: "client"})
+			assertReason(t, err, reason.JobPostingStatusInvalid)
+			mockRepo.AssertNotCalled(t, "UpdateJobPosting", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("edit_deleted", func(t *testing.T) {
+		service, mockRepo, _ := newService(entity.JobPostingStatusDeleted)
+		err := service.UpdateJobPosting(ctx, updateReq)
+		assertReason(t, err, reason.JobPostingNotFound)
+		mockRepo.AssertNotCalled(t, "UpdateJobPosting", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("edit_pending_is_not_published_by_itself", func(t *testing.T) {
+		service, mockRepo, mockReviewRepo := newService(entity.JobPostingStatusPending)
+		mockRepo.On("UpdateJobPosting", ctx, mock.Anything, mock.Anything).Return(nil)
+
+		require.NoError(t, service.UpdateJobPosting(ctx, updateReq))
+		mockRepo.AssertNotCalled(t, "UpdateJobPostingStatus", mock.Anything, mock.Anything, mock.Anything)
+		mockReviewRepo.AssertNotCalled(t, "AddReview", mock.Anything, mock.Anything)
+	})
+
+	t.Run("suspicious_edit_is_pending", func(t *testing.T) {
+		enableTestPlugin(t, testReviewerSlugName)
+		testReviewStatus = plugin.ReviewStatusNeedReview
+		service, mockRepo, mockReviewRepo := newService(entity.JobPostingStatusOpen)
+		mockRepo.On("UpdateJobPosting", ctx, mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusPending).Return(nil)
+		mockReviewRepo.On("AddReview", ctx, mock.Anything).Return(nil)
+
+		require.NoError(t, service.UpdateJobPosting(ctx, updateReq))
+		mockRepo.AssertExpectations(t)
+		mockReviewRepo.AssertExpectations(t)
+	})
+}
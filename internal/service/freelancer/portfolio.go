@@ -0,0 +1,284 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/converter"
+	"github.com/apache/answer/pkg/display"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// AddPortfolioItem add the portfolio item of the login user
+func (fs *FreelancerService) AddPortfolioItem(ctx context.Context, req *schema.AddPortfolioItemReq) (
+	resp *schema.PortfolioItemResp, err error) {
+	count, err := fs.portfolioItemRepo.CountPortfolioItems(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= schema.PortfolioItemMaxPerUser {
+		return nil, errors.BadRequest(reason.FreelancerPortfolioLimit)
+	}
+	item := &entity.FreelancerPortfolioItem{UserID: req.LoginUserID}
+	if err = fs.setPortfolioItem(ctx, item, req); err != nil {
+		return nil, err
+	}
+	if err = fs.portfolioItemRepo.AddPortfolioItem(ctx, item); err != nil {
+		return nil, err
+	}
+	fs.bindPortfolioFiles(ctx, item)
+	return fs.formatPortfolioItem(ctx, item)
+}
+
+// UpdatePortfolioItem update the portfolio item of the login user,
+// the files removed from the item are cleaned by the orphan files cron if no other item uses them
+func (fs *FreelancerService) UpdatePortfolioItem(ctx context.Context, req *schema.UpdatePortfolioItemReq) (
+	resp *schema.PortfolioItemResp, err error) {
+	item, err := fs.getOwnPortfolioItem(ctx, req.ID, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if err = fs.setPortfolioItem(ctx, item, &req.AddPortfolioItemReq); err != nil {
+		return nil, err
+	}
+	if err = fs.portfolioItemRepo.UpdatePortfolioItem(ctx, item); err != nil {
+		return nil, err
+	}
+	fs.bindPortfolioFiles(ctx, item)
+	return fs.formatPortfolioItem(ctx, item)
+}
+
+// RemovePortfolioItem remove the portfolio item of the login user
+func (fs *FreelancerService) RemovePortfolioItem(ctx context.Context, req *schema.RemovePortfolioItemReq) (err error) {
+	item, err := fs.getOwnPortfolioItem(ctx, req.ID, req.LoginUserID)
+	if err != nil {
+		return err
+	}
+	return fs.portfolioItemRepo.RemovePortfolioItem(ctx, item.ID)
+}
+
+// GetPortfolioItems get the portfolio items of the freelancer with the linked answers
+func (fs *FreelancerService) GetPortfolioItems(ctx context.Context, userID string) (
+	resp []*schema.PortfolioItemResp, err error) {
+	items, err := fs.portfolioItemRepo.GetPortfolioItemsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return fs.formatPortfolioItems(ctx, items)
+}
+
+// setPortfolioItem check the request and set it to the portfolio item
+func (fs *FreelancerService) setPortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem,
+	req *schema.AddPortfolioItemReq) (err error) {
+	for _, imageURL := range req.Images {
+		if !isPortfolioImage(imageURL) {
+			return errors.BadRequest(reason.FreelancerPortfolioImage)
+		}
+	}
+	// the files already in the item are kept as they are, such as the links of the portfolio before it was itemized
+	oldImages, oldFiles := schema.PortfolioItemFileURLs(item)
+	existing := make(map[string]bool)
+	for _, fileURL := range append(oldImages, oldFiles...) {
+		existing[fileURL] = true
+	}
+	for _, fileURL := range append(req.Images, req.Files...) {
+		if existing[fileURL] {
+			continue
+		}
+		if err = fs.fileRecordService.CheckPortfolioFile(ctx, req.LoginUserID, fileURL); err != nil {
+			return err
+		}
+	}
+	if err = fs.checkPortfolioAnswers(ctx, req.LoginUserID, req.AnswerIDs); err != nil {
+		return err
+	}
+	tags, err := fs.getSkillTags(ctx, req.Tags, req.LoginUserID, req.CanAddTag)
+	if err != nil {
+		return err
+	}
+
+	images, _ := json.Marshal(nonNilStrings(req.Images))
+	files, _ := json.Marshal(nonNilStrings(req.Files))
+	tagNames, _ := json.Marshal(skillSlugNames(tags))
+	answerIDs, _ := json.Marshal(nonNilStrings(req.AnswerIDs))
+	item.Title = req.Title
+	item.Description = req.Description
+	item.DescriptionHTML = converter.Markdown2HTML(req.Description)
+	item.Images = string(images)
+	item.Files = string(files)
+	item.ExternalURL = req.ExternalURL
+	item.Tags = string(tagNames)
+	item.AnswerIDs = string(answerIDs)
+	item.Sort = req.Sort
+	return nil
+}
+
+// checkPortfolioAnswers only the available answers of the freelancer which have enough votes can be linked
+func (fs *FreelancerService) checkPortfolioAnswers(ctx context.Context, userID string, answerIDs []string) error {
+	if len(answerIDs) == 0 {
+		return nil
+	}
+	siteJob, err := fs.siteInfoService.GetSiteJob(ctx)
+	if err != nil {
+		return err
+	}
+	answers, err := fs.answerRepo.GetByIDs(ctx, answerIDs...)
+	if err != nil {
+		return err
+	}
+	mapping := make(map[string]*entity.Answer, len(answers))
+	for _, answer := range answers {
+		mapping[answer.ID] = answer
+	}
+	for _, answerID := range answerIDs {
+		answer, ok := mapping[answerID]
+		if !ok || answer.UserID != userID || answer.Status != entity.AnswerStatusAvailable ||
+			answer.VoteCount < siteJob.PortfolioAnswerMinVotes {
+			return errors.BadRequest(reason.FreelancerPortfolioAnswer)
+		}
+	}
+	return nil
+}
+
+// bindPortfolioFiles bind the uploaded files to the portfolio item, so they are not cleaned before the item saved
+func (fs *FreelancerService) bindPortfolioFiles(ctx context.Context, item *entity.FreelancerPortfolioItem) {
+	images, files := schema.PortfolioItemFileURLs(item)
+	for _, fileURL := range append(images, files...) {
+		if err := fs.fileRecordService.BindPortfolioFile(ctx, item.UserID, fileURL, item.ID); err != nil {
+			log.Errorf("bind portfolio file %s failed: %v", fileURL, err)
+		}
+	}
+}
+
+func (fs *FreelancerService) formatPortfolioItem(ctx context.Context, item *entity.FreelancerPortfolioItem) (
+	resp *schema.PortfolioItemResp, err error) {
+	list, err := fs.formatPortfolioItems(ctx, []*entity.FreelancerPortfolioItem{item})
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// formatPortfolioItems format the portfolio items, the linked answers which are no longer available are not shown
+func (fs *FreelancerService) formatPortfolioItems(ctx context.Context, items []*entity.FreelancerPortfolioItem) (
+	resp []*schema.PortfolioItemResp, err error) {
+	resp = make([]*schema.PortfolioItemResp, 0, len(items))
+	answerIDs := make([]string, 0)
+	for _, item := range items {
+		answerIDs = append(answerIDs, schema.PortfolioItemAnswerIDs(item)...)
+	}
+	answerMapping, err := fs.getPortfolioAnswers(ctx, answerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		r := &schema.PortfolioItemResp{}
+		r.ConvertFromPortfolioItemEntity(item)
+		for _, answerID := range schema.PortfolioItemAnswerIDs(item) {
+			if answer, ok := answerMapping[answerID]; ok && answer.ID != "" {
+				r.Answers = append(r.Answers, answer)
+			}
+		}
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// getPortfolioAnswers get the linked answers with their questions
+func (fs *FreelancerService) getPortfolioAnswers(ctx context.Context, answerIDs []string) (
+	mapping map[string]*schema.PortfolioAnswerItem, err error) {
+	mapping = make(map[string]*schema.PortfolioAnswerItem, len(answerIDs))
+	if len(answerIDs) == 0 {
+		return mapping, nil
+	}
+	answers, err := fs.answerRepo.GetByIDs(ctx, answerIDs...)
+	if err != nil {
+		return nil, err
+	}
+	questionIDs := make([]string, 0, len(answers))
+	for _, answer := range answers {
+		questionIDs = append(questionIDs, answer.QuestionID)
+	}
+	questions, err := fs.questionRepo.FindByID(ctx, questionIDs)
+	if err != nil {
+		return nil, err
+	}
+	questionMapping := make(map[string]*entity.Question, len(questions))
+	for _, question := range questions {
+		questionMapping[question.ID] = question
+	}
+	siteGeneral, err := fs.siteInfoService.GetSiteGeneral(ctx)
+	if err != nil {
+		return nil, err
+	}
+	siteSeo, err := fs.siteInfoService.GetSiteSeo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, answer := range answers {
+		question, ok := questionMapping[answer.QuestionID]
+		if !ok || answer.Status != entity.AnswerStatusAvailable || question.Status == entity.QuestionStatusDeleted {
+			continue
+		}
+		mapping[answer.ID] = &schema.PortfolioAnswerItem{
+			ID:            answer.ID,
+			QuestionID:    question.ID,
+			QuestionTitle: question.Title,
+			VoteCount:     answer.VoteCount,
+			Accepted:      answer.Accepted == schema.AnswerAcceptedEnable,
+			URL:           display.AnswerURL(siteSeo.Permalink, siteGeneral.SiteUrl, question.ID, question.Title, answer.ID),
+		}
+	}
+	return mapping, nil
+}
+
+func (fs *FreelancerService) getOwnPortfolioItem(ctx context.Context, id, userID string) (
+	item *entity.FreelancerPortfolioItem, err error) {
+	item, exist, err := fs.portfolioItemRepo.GetPortfolioItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || item.UserID != userID {
+		return nil, errors.NotFound(reason.FreelancerPortfolioNotFound)
+	}
+	return item, nil
+}
+
+// isPortfolioImage the image of the portfolio item must be one of the post image types
+func isPortfolioImage(imageURL string) bool {
+	ext := strings.ToLower(path.Ext(strings.SplitN(imageURL, "?", 2)[0]))
+	return plugin.DefaultFileTypeCheckMapping[plugin.UserPost][ext]
+}
+
+func nonNilStrings(list []string) []string {
+	if list == nil {
+		return make([]string, 0)
+	}
+	return list
+}
@@ -0,0 +1,292 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/token"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// savedJobSearchBatchSize the number of the saved searches evaluated in a batch
+const savedJobSearchBatchSize = 500
+
+// SaveJobSearch save the job postings filter of the login user
+func (fs *FreelancerService) SaveJobSearch(ctx context.Context, req *schema.SaveJobSearchReq) (
+	resp *schema.SavedJobSearchResp, err error) {
+	count, err := fs.savedJobSearchRepo.CountSavedJobSearches(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= schema.SavedJobSearchMaxPerUser {
+		return nil, errors.BadRequest(reason.JobSavedSearchLimitExceeded)
+	}
+	search := &entity.SavedJobSearch{
+		UserID:         req.LoginUserID,
+		Name:           req.Name,
+		Filter:         schema.SavedJobSearchFilterToJSON(req.Filter),
+		AlertFrequency: req.AlertFrequency,
+		LastDigestAt:   time.Now(),
+	}
+	if err = fs.savedJobSearchRepo.AddSavedJobSearch(ctx, search); err != nil {
+		return nil, err
+	}
+	fs.setDefaultSavedJobSearchNotification(ctx, search)
+	resp = &schema.SavedJobSearchResp{}
+	resp.ConvertFromSavedJobSearchEntity(search)
+	return resp, nil
+}
+
+// UpdateSavedJobSearch update the filter and the alert of the saved job search
+func (fs *FreelancerService) UpdateSavedJobSearch(ctx context.Context, req *schema.UpdateSavedJobSearchReq) (
+	resp *schema.SavedJobSearchResp, err error) {
+	search, err := fs.getOwnSavedJobSearch(ctx, req.ID, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	// the pending matches are only sent by the daily digest
+	if search.AlertFrequency == entity.SavedJobSearchAlertDaily && req.AlertFrequency != entity.SavedJobSearchAlertDaily {
+		if err = fs.savedJobSearchRepo.RemoveSavedJobSearchMatches(ctx, search.ID); err != nil {
+			return nil, err
+		}
+	}
+	search.Name = req.Name
+	search.Filter = schema.SavedJobSearchFilterToJSON(req.Filter)
+	search.AlertFrequency = req.AlertFrequency
+	err = fs.savedJobSearchRepo.UpdateSavedJobSearch(ctx, search, []string{"name", "filter", "alert_frequency"})
+	if err != nil {
+		return nil, err
+	}
+	fs.setDefaultSavedJobSearchNotification(ctx, search)
+	resp = &schema.SavedJobSearchResp{}
+	resp.ConvertFromSavedJobSearchEntity(search)
+	return resp, nil
+}
+
+// RemoveSavedJobSearch remove the saved job search of the login user
+func (fs *FreelancerService) RemoveSavedJobSearch(ctx context.Context, req *schema.RemoveSavedJobSearchReq) (err error) {
+	search, err := fs.getOwnSavedJobSearch(ctx, req.ID, req.LoginUserID)
+	if err != nil {
+		return err
+	}
+	return fs.savedJobSearchRepo.RemoveSavedJobSearch(ctx, search.ID)
+}
+
+// GetSavedJobSearches get the saved job searches of the login user
+func (fs *FreelancerService) GetSavedJobSearches(ctx context.Context, userID string) (
+	resp []*schema.SavedJobSearchResp, err error) {
+	searches, err := fs.savedJobSearchRepo.GetSavedJobSearchesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp = make([]*schema.SavedJobSearchResp, 0, len(searches))
+	for _, search := range searches {
+		r := &schema.SavedJobSearchResp{}
+		r.ConvertFromSavedJobSearchEntity(search)
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// SavedJobSearchDigestCron send the digest of the matched job postings to the daily alerting saved searches,
+// each search is digested at most once a day
+func (fs *FreelancerService) SavedJobSearchDigestCron(ctx context.Context) {
+	now := time.Now()
+	searches, err := fs.savedJobSearchRepo.GetDueDigestSavedJobSearches(ctx, now.AddDate(0, 0, -1))
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, search := range searches {
+		matches, err := fs.savedJobSearchRepo.GetSavedJobSearchMatches(ctx, search.ID)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		matchIDs := make([]string, 0, len(matches))
+		postingIDs := make([]string, 0, len(matches))
+		for _, match := range matches {
+			matchIDs = append(matchIDs, match.ID)
+			postingIDs = append(postingIDs, match.JobPostingID)
+		}
+		postings, err := fs.freelancerRepo.GetJobPostingsByIDs(ctx, postingIDs)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		// the postings closed or removed before the digest are not sent
+		openPostings := make([]*entity.JobPosting, 0, len(postings))
+		for _, posting := range postings {
+			if posting.IsActive && posting.Status == entity.JobPostingStatusOpen && posting.ExpiresAt.After(now) {
+				openPostings = append(openPostings, posting)
+			}
+		}
+		if len(openPostings) > 0 {
+			fs.sendSavedJobSearchAlert(ctx, search, openPostings)
+		}
+		if err = fs.savedJobSearchRepo.FinishSavedJobSearchDigest(ctx, search.ID, matchIDs, now); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// evaluateSavedJobSearches alert the saved searches which match the new job posting immediately,
+// or keep the match for the daily digest
+func (fs *FreelancerService) evaluateSavedJobSearches(ctx context.Context, posting *entity.JobPosting, skillTags []*entity.Tag) {
+	tagIDs := make([]string, 0, len(skillTags))
+	for _, tag := range skillTags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	posterVerified := false
+	if profile, exist, err := fs.freelancerRepo.GetFreelancerProfileByUserID(ctx, posting.UserID); err != nil {
+		log.Error(err)
+	} else if exist {
+		posterVerified = profile.IsVerified
+	}
+
+	currencyConverter, err := fs.currencyService.GetConverter(ctx)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	skillGroups := make(map[string][][]string)
+	afterID := ""
+	for {
+		searches, err := fs.savedJobSearchRepo.GetAlertingSavedJobSearches(ctx, afterID, savedJobSearchBatchSize)
+		if err != nil {
+			log.Error(err)
+			return
+		}
+		if len(searches) == 0 {
+			return
+		}
+		afterID = searches[len(searches)-1].ID
+
+		matches := make([]*entity.SavedJobSearchMatch, 0)
+		for _, search := range searches {
+			if search.UserID == posting.UserID {
+				continue
+			}
+			filter := schema.NewSavedJobSearchFilter(search.Filter)
+			if len(filter.Skills) > 0 {
+				key := filter.SkillMatch + ":" + filter.Skills
+				groups, ok := skillGroups[key]
+				if !ok {
+					groups, _, err = fs.getSkillFilterTagIDGroups(ctx, filter.Skills, filter.SkillMatch == schema.SkillMatchAll)
+					if err != nil {
+						log.Error(err)
+						continue
+					}
+					skillGroups[key] = groups
+				}
+				// none of the skills exists, nothing can match
+				if len(groups) == 0 {
+					continue
+				}
+				filter.SkillTagIDs = groups
+			}
+			err = setJobPostingsFilterBaseBudget(currencyConverter, currencyConverter.Code(filter.DisplayCurrency), filter)
+			if err != nil {
+				log.Errorf("saved job search %s budget filter can not be converted: %v", search.ID, err)
+				continue
+			}
+			if !filter.MatchJobPosting(posting, tagIDs, posterVerified) {
+				continue
+			}
+			if search.AlertFrequency == entity.SavedJobSearchAlertImmediate {
+				fs.sendSavedJobSearchAlert(ctx, search, []*entity.JobPosting{posting})
+				continue
+			}
+			matches = append(matches, &entity.SavedJobSearchMatch{SearchID: search.ID, JobPostingID: posting.ID})
+		}
+		if err = fs.savedJobSearchRepo.AddSavedJobSearchMatches(ctx, matches); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// sendSavedJobSearchAlert send the matched job postings to the inbox and the email of the search owner
+func (fs *FreelancerService) sendSavedJobSearchAlert(ctx context.Context, search *entity.SavedJobSearch,
+	postings []*entity.JobPosting) {
+	rawData := &schema.NewJobMatchTemplateRawData{
+		SearchName:      search.Name,
+		UnsubscribeCode: token.GenerateToken(),
+	}
+	for _, posting := range postings {
+		fs.notificationQueueService.Send(ctx, &schema.NotificationMsg{
+			TriggerUserID:       posting.UserID,
+			ReceiverUserID:      search.UserID,
+			Type:                schema.NotificationTypeInbox,
+			Title:               posting.Title,
+			ObjectID:            posting.ID,
+			ObjectType:          constant.JobPostingObjectType,
+			NotificationAction:  constant.NotificationNewJobMatchesSavedSearch,
+			NoNeedPushAllFollow: true,
+			ExtraInfo:           map[string]string{"saved_search_name": search.Name},
+		})
+		rawData.JobPostings = append(rawData.JobPostings, &schema.NewJobMatchItem{ID: posting.ID, Title: posting.Title})
+	}
+
+	receiver, exist, err := fs.userRepo.GetByUserID(ctx, search.UserID)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	if !exist {
+		log.Warnf("user %s not found", search.UserID)
+		return
+	}
+	fs.externalNotificationQueueService.Send(ctx, &schema.ExternalNotificationMsg{
+		ReceiverUserID:             receiver.ID,
+		ReceiverEmail:              receiver.EMail,
+		ReceiverLang:               receiver.Language,
+		NewJobMatchTemplateRawData: rawData,
+	})
+}
+
+// setDefaultSavedJobSearchNotification the email of the alerts is turned on when the user first turns on an alert
+func (fs *FreelancerService) setDefaultSavedJobSearchNotification(ctx context.Context, search *entity.SavedJobSearch) {
+	if search.AlertFrequency == entity.SavedJobSearchAlertNone {
+		return
+	}
+	if err := fs.userNotificationConfigService.SetDefaultSavedJobSearchNotificationConfig(ctx, search.UserID); err != nil {
+		log.Error(err)
+	}
+}
+
+func (fs *FreelancerService) getOwnSavedJobSearch(ctx context.Context, id, userID string) (
+	search *entity.SavedJobSearch, err error) {
+	search, exist, err := fs.savedJobSearchRepo.GetSavedJobSearch(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !exist || search.UserID != userID {
+		return nil, errors.NotFound(reason.JobSavedSearchNotFound)
+	}
+	return search, nil
+}
@@ -0,0 +1,216 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/converter"
+	"github.com/segmentfault/pacman/errors"
+)
+
+const defaultSkillTagListLimit = 10
+
+// formatSkillSlugName skill is stored as tag, so the same formatting rules are applied
+func formatSkillSlugName(skill string) string {
+	return strings.Join(strings.Fields(strings.ToLower(skill)), "-")
+}
+
+// getSkillTags get the main tags of the skills in the order of the skills.
+// The tags which do not exist are created if the user has the permission to add tag.
+func (fs *FreelancerService) getSkillTags(ctx context.Context, skills []string, userID string, canAddTag bool) (
+	tags []*entity.Tag, err error) {
+	slugNames := make([]string, 0, len(skills))
+	displayNames := make(map[string]string)
+	for _, skill := range skills {
+		slugName := formatSkillSlugName(skill)
+		if len(slugName) == 0 || len(displayNames[slugName]) > 0 {
+			continue
+		}
+		slugNames = append(slugNames, slugName)
+		displayNames[slugName] = strings.TrimSpace(skill)
+	}
+	if len(slugNames) == 0 {
+		return nil, nil
+	}
+
+	tagList, err := fs.tagCommonService.GetTagListByNames(ctx, slugNames)
+	if err != nil {
+		return nil, err
+	}
+	tagMapping := make(map[string]*entity.Tag)
+	mainTagIDs := make([]string, 0)
+	for _, tag := range tagList {
+		tagMapping[tag.SlugName] = tag
+		if tag.MainTagID > 0 {
+			mainTagIDs = append(mainTagIDs, converter.IntToString(tag.MainTagID))
+		}
+	}
+
+	// synonyms are replaced by their main tag
+	if len(mainTagIDs) > 0 {
+		mainTagList, err := fs.tagCommonService.GetTagListByIDs(ctx, mainTagIDs)
+		if err != nil {
+			return nil, err
+		}
+		mainTagMapping := make(map[string]*entity.Tag)
+		for _, tag := range mainTagList {
+			mainTagMapping[tag.ID] = tag
+		}
+		for slugName, tag := range tagMapping {
+			if mainTag := mainTagMapping[converter.IntToString(tag.MainTagID)]; mainTag != nil {
+				tagMapping[slugName] = mainTag
+			}
+		}
+	}
+
+	notFound := make([]string, 0)
+	for _, slugName := range slugNames {
+		if tagMapping[slugName] == nil {
+			notFound = append(notFound, slugName)
+		}
+	}
+	if len(notFound) > 0 && !canAddTag {
+		return nil, errors.BadRequest(reason.TagNotFound).WithMsg(fmt.Sprintf("tag [%s] does not exist",
+			strings.Join(notFound, ",")))
+	}
+	for _, slugName := range notFound {
+		displayName := displayNames[slugName]
+		if len(displayName) > 35 {
+			displayName = slugName
+		}
+		_, err = fs.tagCommonService.AddTag(ctx, &schema.AddTagReq{
+			SlugName:    slugName,
+			DisplayName: displayName,
+			UserID:      userID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tag, exist, err := fs.tagCommonService.GetTagBySlugName(ctx, slugName)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return nil, errors.BadRequest(reason.TagNotFound)
+		}
+		tagMapping[slugName] = tag
+	}
+
+	tags = make([]*entity.Tag, 0, len(slugNames))
+	added := make(map[string]bool)
+	for _, slugName := range slugNames {
+		tag := tagMapping[slugName]
+		if added[tag.ID] {
+			continue
+		}
+		added[tag.ID] = true
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// updateSkillRels replace the skill relations of the object
+func (fs *FreelancerService) updateSkillRels(ctx context.Context, objectType, objectID string, tags []*entity.Tag) error {
+	tagIDs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+	return fs.freelancerRepo.UpdateSkillRels(ctx, objectType, objectID, tagIDs)
+}
+
+// skillSlugNames the slug names of the skill tags, which are stored in the skills column for display
+func skillSlugNames(tags []*entity.Tag) []string {
+	slugNames := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		slugNames = append(slugNames, tag.SlugName)
+	}
+	return slugNames
+}
+
+// getSkillFilterTagIDs get the main tag and its synonyms of the skill
+func (fs *FreelancerService) getSkillFilterTagIDs(ctx context.Context, skill string) (
+	mainTag *entity.Tag, tagIDs []string, err error) {
+	tag, exist, err := fs.tagCommonService.GetTagBySlugName(ctx, formatSkillSlugName(skill))
+	if err != nil || !exist {
+		return nil, nil, err
+	}
+	mainTag = tag
+	if tag.MainTagID > 0 {
+		mainTag, exist, err = fs.tagCommonService.GetTagByID(ctx, converter.IntToString(tag.MainTagID))
+		if err != nil || !exist {
+			return nil, nil, err
+		}
+	}
+	synonymIDs, err := fs.tagCommonService.GetTagIDsByMainTagID(ctx, mainTag.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tagIDs = append([]string{mainTag.ID}, synonymIDs...)
+	return mainTag, tagIDs, nil
+}
+
+// GetSkillTag get the freelancers and open job postings of the skill tag
+func (fs *FreelancerService) GetSkillTag(ctx context.Context, req *schema.GetSkillTagReq) (
+	resp *schema.GetSkillTagResp, err error) {
+	mainTag, tagIDs, err := fs.getSkillFilterTagIDs(ctx, req.TagName)
+	if err != nil {
+		return nil, err
+	}
+	if mainTag == nil {
+		return nil, errors.NotFound(reason.TagNotFound)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultSkillTagListLimit
+	}
+
+	resp = &schema.GetSkillTagResp{
+		TagID:       mainTag.ID,
+		SlugName:    mainTag.SlugName,
+		DisplayName: mainTag.DisplayName,
+		Freelancers: make([]*schema.FreelancerProfileResp, 0),
+		JobPostings: make([]*schema.JobPostingResp, 0),
+	}
+	resp.FreelancerCount, resp.JobPostingCount, err = fs.freelancerRepo.CountSkillObjects(ctx, tagIDs)
+	if err != nil {
+		return nil, err
+	}
+	profiles, err := fs.freelancerRepo.GetFreelancerProfilesBySkill(ctx, tagIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		resp.Freelancers = append(resp.Freelancers, fs.convertFreelancerProfileToResp(profile))
+	}
+	postings, err := fs.freelancerRepo.GetOpenJobPostingsBySkill(ctx, tagIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, posting := range postings {
+		resp.JobPostings = append(resp.JobPostings, fs.convertJobPostingToResp(posting))
+	}
+	return resp, nil
+}
@@ -0,0 +1,325 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/review"
+	"github.com/apache/answer/internal/service/tag_common"
+	"github.com/apache/answer/pkg/converter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTagRepo is an in-memory implementation of TagCommonRepo and TagRepo, the tag id is the index plus one
+type memoryTagRepo struct {
+	tags []*entity.Tag
+}
+
+func (r *memoryTagRepo) add(slugName string) *entity.Tag {
+	tag := &entity.Tag{
+		ID:          strconv.Itoa(len(r.tags) + 1),
+		SlugName:    slugName,
+		DisplayName: slugName,
+		Status:      entity.TagStatusAvailable,
+	}
+	r.tags = append(r.tags, tag)
+	return tag
+}
+
+// addSynonym add a tag which is the synonym of the main tag
+func (r *memoryTagRepo) addSynonym(slugName string, mainTag *entity.Tag) *entity.Tag {
+	tag := r.add(slugName)
+	tag.MainTagID = converter.StringToInt64(mainTag.ID)
+	tag.MainTagSlugName = mainTag.SlugName
+	return tag
+}
+
+func (r *memoryTagRepo) AddTagList(ctx context.Context, tagList []*entity.Tag) (err error) {
+	for _, tag := range tagList {
+		tag.ID = strconv.Itoa(len(r.tags) + 1)
+		r.tags = append(r.tags, tag)
+	}
+	return nil
+}
+
+func (r *memoryTagRepo) GetTagListByIDs(ctx context.Context, ids []string) (tagList []*entity.Tag, err error) {
+	for _, tag := range r.tags {
+		for _, id := range ids {
+			if tag.ID == id {
+				tagList = append(tagList, tag)
+			}
+		}
+	}
+	return tagList, nil
+}
+
+func (r *memoryTagRepo) GetTagBySlugName(ctx context.Context, slugName string) (tagInfo *entity.Tag, exist bool, err error) {
+	for _, tag := range r.tags {
+		if tag.SlugName == slugName {
+			return tag, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (r *memoryTagRepo) GetTagListByName(ctx context.Context, name string, recommend, reserved bool) (tagList []*entity.Tag, err error) {
+	return nil, nil
+}
+
+func (r *memoryTagRepo) GetTagListByNames(ctx context.Context, names []string) (tagList []*entity.Tag, err error) {
+	for _, tag := range r.tags {
+		for _, name := range names {
+			if tag.SlugName == name {
+				tagList = append(tagList, tag)
+			}
+		}
+	}
+	return tagList, nil
+}
+
+func (r *memoryTagRepo) GetTagByID(ctx context.Context, tagID string, includeDeleted bool) (tag *entity.Tag, exist bool, err error) {
+	for _, tag := range r.tags {
+		if tag.ID == tagID {
+			return tag, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (r *memoryTagRepo) GetTagPage(ctx context.Context, page, pageSize int, tag *entity.Tag, queryCond string) (tagList []*entity.Tag, total int64, err error) {
+	return nil, 0, nil
+}
+
+func (r *memoryTagRepo) GetRecommendTagList(ctx context.Context) (tagList []*entity.Tag, err error) {
+	return nil, nil
+}
+
+func (r *memoryTagRepo) GetReservedTagList(ctx context.Context) (tagList []*entity.Tag, err error) {
+	return nil, nil
+}
+
+func (r *memoryTagRepo) UpdateTagsAttribute(ctx context.Context, tags []string, attribute string, value bool) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) UpdateTagQuestionCount(ctx context.Context, tagID string, questionCount int) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) RemoveTag(ctx context.Context, tagID string) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) UpdateTag(ctx context.Context, tag *entity.Tag) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) RecoverTag(ctx context.Context, tagID string) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) MustGetTagByNameOrID(ctx context.Context, tagID, slugName string) (tag *entity.Tag, exist bool, err error) {
+	return nil, false, nil
+}
+
+func (r *memoryTagRepo) UpdateTagSynonym(ctx context.Context, tagSlugNameList []string, mainTagID int64, mainTagSlugName string) (err error) {
+	return nil
+}
+
+func (r *memoryTagRepo) GetTagSynonymCount(ctx context.Context, tagID string) (count int64, err error) {
+	return 0, nil
+}
+
+func (r *memoryTagRepo) GetIDsByMainTagId(ctx context.Context, mainTagID string) (tagIDs []string, err error) {
+	for _, tag := range r.tags {
+		if converter.IntToString(tag.MainTagID) == mainTagID {
+			tagIDs = append(tagIDs, tag.ID)
+		}
+	}
+	return tagIDs, nil
+}
+
+func (r *memoryTagRepo) GetTagList(ctx context.Context, tag *entity.Tag) (tagList []*entity.Tag, err error) {
+	return r.tags, nil
+}
+
+// MockActivityQueueService is a mock implementation of ActivityQueueService
+type MockActivityQueueService struct {
+	mock.Mock
+}
+
+func (m *MockActivityQueueService) Send(ctx context.Context, msg *schema.ActivityMsg) {
+	m.Called(ctx, msg)
+}
+
+func (m *MockActivityQueueService) RegisterHandler(handler func(ctx context.Context, msg *schema.ActivityMsg) error) {
+	m.Called(handler)
+}
+
+// newTestTagCommonService new tag common service on an in-memory tag repo which contains the tags of the slug names
+func newTestTagCommonService(slugNames ...string) (*tag_common.TagCommonService, *memoryTagRepo) {
+	tagRepo := &memoryTagRepo{}
+	for _, slugName := range slugNames {
+		tagRepo.add(slugName)
+	}
+	mockSiteInfoService := new(MockSiteInfoService)
+	mockSiteInfoService.On("GetSiteWrite", mock.Anything).Return(&schema.SiteWriteResp{}, nil).Maybe()
+	mockActivityQueueService := new(MockActivityQueueService)
+	mockActivityQueueService.On("Send", mock.Anything, mock.Anything).Maybe()
+	revisionService, _ := newTestRevisionService()
+	return tag_common.NewTagCommonService(tagRepo, nil, tagRepo, revisionService,
+		mockSiteInfoService, mockActivityQueueService), tagRepo
+}
+
+func TestGetSkillTags(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("synonyms_are_replaced_by_main_tag", func(t *testing.T) {
+		tagCommonService, tagRepo := newTestTagCommonService("go", "react")
+		tagRepo.addSynonym("golang", tagRepo.tags[0])
+		service := NewFreelancerService(new(MockFreelancerRepo), nil, nil, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &review.ReviewService{})
+
+		tags, err := service.getSkillTags(ctx, []string{"React", "golang", " Go ", ""}, "user1", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"react", "go"}, skillSlugNames(tags))
+	})
+
+	t.Run("missing_tags_are_created", func(t *testing.T) {
+		tagCommonService, tagRepo := newTestTagCommonService("go")
+		service := NewFreelancerService(new(MockFreelancerRepo), nil, nil, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &review.ReviewService{})
+
+		tags, err := service.getSkillTags(ctx, []string{"Go", "React Native", "react native"}, "user1", true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"go", "react-native"}, skillSlugNames(tags))
+		require.Len(t, tagRepo.tags, 2)
+		assert.Equal(t, "React Native", tagRepo.tags[1].DisplayName)
+		assert.Equal(t, "user1", tagRepo.tags[1].UserID)
+	})
+
+	t.Run("missing_tags_without_permission", func(t *testing.T) {
+		tagCommonService, tagRepo := newTestTagCommonService("go")
+		service := NewFreelancerService(new(MockFreelancerRepo), nil, nil, nil, nil, tagCommonService, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &review.ReviewService{})
+
+		_, err := service.getSkillTags(ctx, []string{"Go", "Rust"}, "user1", false)
+		assertReason(t, err, reason.TagNotFound)
+		assert.Len(t, tagRepo.tags, 1)
+	})
+}
+
+func TestCreateJobPostingSkillRels(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockFreelancerRepo)
+	service := newTestFreelancerService(mockRepo)
+	tagCommonService, tagRepo := newTestTagCommonService("go", "docker")
+	tagRepo.addSynonym("golang", tagRepo.tags[0])
+	service.tagCommonService = tagCommonService
+	revisionService, _ := newTestRevisionService()
+	service.revisionService = revisionService
+
+	mockRepo.On("CreateJobPosting", ctx, mock.MatchedBy(func(posting *entity.JobPosting) bool {
+		return posting.Skills == `["go","docker","kubernetes"]`
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.JobPosting).ID = "11010000000000001"
+	}).Return(nil)
+	mockRepo.On("UpdateJobPosting", ctx, mock.Anything, []string{"revision_id"}).Return(nil)
+	mockRepo.On("UpdateJobPostingStatus", ctx, "11010000000000001", entity.JobPostingStatusOpen).Return(nil)
+	mockNoSavedJobSearch(service, mockRepo, "client")
+
+	err := service.CreateJobPosting(ctx, &schema.CreateJobPostingReq{
+		Title: "Deploy an API", Description: "Go and Docker", Skills: []string{"golang", "Docker", "Kubernetes"},
+		LoginUserID: "client", CanAddTag: true})
+	require.NoError(t, err)
+	mockRepo.AssertCalled(t, "UpdateSkillRels", ctx, entity.SkillRelObjectTypeJobPosting,
+		"11010000000000001", []string{"1", "2", "4"})
+}
+
+func TestGetJobPostingsSkillFilter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("filter_includes_synonyms", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+		tagCommonService, tagRepo := newTestTagCommonService("go")
+		tagRepo.addSynonym("golang", tagRepo.tags[0])
+		service.tagCommonService = tagCommonService
+
+		mockRepo.On("GetJobPostings", ctx, mock.MatchedBy(func(req *schema.GetJobPostingsReq) bool {
+			return assert.ObjectsAreEqual([][]string{{"1", "2"}}, req.SkillTagIDs)
+		})).Return([]*entity.JobPosting{}, int64(0), nil)
+
+		_, err := service.GetJobPostings(ctx, &schema.GetJobPostingsReq{Skills: "golang"})
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("unknown_skill_returns_empty_list", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+
+		resp, err := service.GetJobPostings(ctx, &schema.GetJobPostingsReq{Skills: "cobol"})
+		require.NoError(t, err)
+		assert.Empty(t, resp.List)
+		mockRepo.AssertNotCalled(t, "GetJobPostings", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetSkillTag(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("synonym_resolves_to_main_tag", func(t *testing.T) {
+		mockRepo := new(MockFreelancerRepo)
+		service := newTestFreelancerService(mockRepo)
+		tagCommonService, tagRepo := newTestTagCommonService("go")
+		tagRepo.addSynonym("golang", tagRepo.tags[0])
+		service.tagCommonService = tagCommonService
+
+		tagIDs := []string{"1", "2"}
+		mockRepo.On("CountSkillObjects", ctx, tagIDs).Return(int64(3), int64(1), nil)
+		mockRepo.On("GetFreelancerProfilesBySkill", ctx, tagIDs, defaultSkillTagListLimit).Return(
+			[]*entity.FreelancerProfile{{ID: "1", UserID: "freelancer", Skills: `["go"]`}}, nil)
+		mockRepo.On("GetOpenJobPostingsBySkill", ctx, tagIDs, defaultSkillTagListLimit).Return(
+			[]*entity.JobPosting{{ID: "2", UserID: "client", Skills: `["go"]`}}, nil)
+
+		resp, err := service.GetSkillTag(ctx, &schema.GetSkillTagReq{TagName: "golang"})
+		require.NoError(t, err)
+		assert.Equal(t, "1", resp.TagID)
+		assert.Equal(t, "go", resp.SlugName)
+		assert.Equal(t, int64(3), resp.FreelancerCount)
+		assert.Equal(t, int64(1), resp.JobPostingCount)
+		assert.Len(t, resp.Freelancers, 1)
+		assert.Len(t, resp.JobPostings, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("tag_not_found", func(t *testing.T) {
+		service := newTestFreelancerService(new(MockFreelancerRepo))
+
+		_, err := service.GetSkillTag(ctx, &schema.GetSkillTagReq{TagName: "cobol"})
+		assertReason(t, err, reason.TagNotFound)
+	})
+}
@@ -0,0 +1,269 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+// verifiedFreelancerBadgeHandler the handler of the badge awarded when the verification is approved
+const verifiedFreelancerBadgeHandler = "FreelancerVerified"
+
+// RequestVerification request the identity verification with the evidence files.
+// The freelancer can only have one pending request, and can request again after rejected or lapsed.
+func (fs *FreelancerService) RequestVerification(ctx context.Context, req *schema.RequestFreelancerVerificationReq) (
+	resp *schema.FreelancerVerificationResp, err error) {
+	profile, exist, err := fs.freelancerRepo.GetFreelancerProfileByUserID(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.FreelancerProfileNotFound)
+	}
+	if profile.IsVerified {
+		return nil, errors.BadRequest(reason.FreelancerAlreadyVerified)
+	}
+	latest, exist, err := fs.verificationRepo.GetLatestVerification(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if exist && latest.Status == entity.FreelancerVerificationStatusPending {
+		return nil, errors.BadRequest(reason.FreelancerVerificationPending)
+	}
+	for _, fileURL := range req.Evidence {
+		if err = fs.fileRecordService.CheckVerificationEvidence(ctx, req.LoginUserID, fileURL); err != nil {
+			return nil, err
+		}
+	}
+
+	evidence, _ := json.Marshal(req.Evidence)
+	verification := &entity.FreelancerVerification{
+		UserID:   req.LoginUserID,
+		Evidence: string(evidence),
+		Note:     req.Note,
+		Status:   entity.FreelancerVerificationStatusPending,
+	}
+	if err = fs.verificationRepo.AddVerification(ctx, verification); err != nil {
+		return nil, err
+	}
+	for _, fileURL := range req.Evidence {
+		if err := fs.fileRecordService.BindVerificationEvidence(ctx, req.LoginUserID, fileURL, verification.ID); err != nil {
+			log.Errorf("bind verification evidence %s failed: %v", fileURL, err)
+		}
+	}
+	return fs.formatVerification(ctx, verification, 0), nil
+}
+
+// GetMyVerification get the latest verification request of the login user
+func (fs *FreelancerService) GetMyVerification(ctx context.Context, userID string) (
+	resp *schema.FreelancerVerificationResp, err error) {
+	verification, exist, err := fs.verificationRepo.GetLatestVerification(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.FreelancerVerificationNotFound)
+	}
+	validDays := 0
+	if verification.Status == entity.FreelancerVerificationStatusApproved {
+		validDays = fs.getVerificationValidDays(ctx)
+	}
+	return fs.formatVerification(ctx, verification, validDays), nil
+}
+
+// GetVerificationPage get the verification requests for the admin review queue
+func (fs *FreelancerService) GetVerificationPage(ctx context.Context, req *schema.GetFreelancerVerificationsReq) (
+	pageModel *pager.PageModel, err error) {
+	verifications, total, err := fs.verificationRepo.GetVerificationPage(ctx, req.Page, req.PageSize, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(verifications))
+	for _, verification := range verifications {
+		userIDs = append(userIDs, verification.UserID)
+	}
+	users, err := fs.userCommon.BatchUserBasicInfoByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	validDays := fs.getVerificationValidDays(ctx)
+	resp := make([]*schema.FreelancerVerificationResp, 0, len(verifications))
+	for _, verification := range verifications {
+		r := fs.formatVerification(ctx, verification, validDays)
+		r.User = users[verification.UserID]
+		resp = append(resp, r)
+	}
+	return pager.NewPageModel(total, resp), nil
+}
+
+// GetVerificationEvidence get the local path and the original filename of the verification evidence,
+// the evidence is only downloaded by the admin who reviews the request
+func (fs *FreelancerService) GetVerificationEvidence(ctx context.Context, filePath string) (
+	localPath, filename string, err error) {
+	_, localPath, filename, err = fs.fileRecordService.GetLocalAttachment(ctx,
+		constant.FilesVerificationSubPath, filePath)
+	return localPath, filename, err
+}
+
+// ReviewVerification approve or reject the pending verification request.
+// The approved freelancer is awarded the verified badge, the rejected one is notified with the reason.
+func (fs *FreelancerService) ReviewVerification(ctx context.Context, req *schema.ReviewFreelancerVerificationReq) (err error) {
+	verification, exist, err := fs.verificationRepo.GetVerification(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.FreelancerVerificationNotFound)
+	}
+	if verification.Status != entity.FreelancerVerificationStatusPending {
+		return errors.BadRequest(reason.FreelancerVerificationReviewed)
+	}
+	verification.Status = req.Status
+	verification.ReviewerID = req.LoginUserID
+	verification.ReviewedAt = time.Now()
+	if req.Status == entity.FreelancerVerificationStatusRejected {
+		if err = fs.checkVerificationRejectReason(ctx, req.ReasonType); err != nil {
+			return err
+		}
+		verification.ReasonType = req.ReasonType
+		verification.ReasonMsg = req.ReasonMsg
+	}
+	reviewed, err := fs.verificationRepo.ReviewVerification(ctx, verification)
+	if err != nil {
+		return err
+	}
+	if !reviewed {
+		return errors.BadRequest(reason.FreelancerVerificationReviewed)
+	}
+
+	if req.Status == entity.FreelancerVerificationStatusApproved {
+		err = fs.badgeAwardService.AwardByHandler(ctx, verifiedFreelancerBadgeHandler, verification.UserID, verification.ID)
+		if err != nil {
+			log.Errorf("award verified freelancer badge to user %s failed: %v", verification.UserID, err)
+		}
+		return nil
+	}
+	fs.notificationQueueService.Send(ctx, &schema.NotificationMsg{
+		TriggerUserID:       req.LoginUserID,
+		ReceiverUserID:      verification.UserID,
+		Type:                schema.NotificationTypeInbox,
+		ObjectID:            verification.ID,
+		ObjectType:          constant.FreelancerVerificationObjectType,
+		NotificationAction:  constant.NotificationYourVerificationWasRejected,
+		NoNeedPushAllFollow: true,
+	})
+	return nil
+}
+
+// VerificationLapseCron revoke the verifications which are older than the valid days of the site config,
+// the verified badge is revoked too and awarded again when the freelancer is verified again
+func (fs *FreelancerService) VerificationLapseCron(ctx context.Context) {
+	validDays := fs.getVerificationValidDays(ctx)
+	if validDays <= 0 {
+		return
+	}
+	userIDs, err := fs.verificationRepo.LapseVerifications(ctx, time.Now().AddDate(0, 0, -validDays))
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, userID := range userIDs {
+		if err = fs.badgeAwardService.RevokeByHandler(ctx, verifiedFreelancerBadgeHandler, userID); err != nil {
+			log.Errorf("revoke verified freelancer badge from user %s failed: %v", userID, err)
+		}
+		verification, exist, err := fs.verificationRepo.GetLatestVerification(ctx, userID)
+		if err != nil || !exist {
+			continue
+		}
+		fs.notificationQueueService.Send(ctx, &schema.NotificationMsg{
+			TriggerUserID:       userID,
+			ReceiverUserID:      userID,
+			Type:                schema.NotificationTypeInbox,
+			ObjectID:            verification.ID,
+			ObjectType:          constant.FreelancerVerificationObjectType,
+			NotificationAction:  constant.NotificationYourVerificationHasLapsed,
+			NoNeedPushAllFollow: true,
+		})
+	}
+	if len(userIDs) > 0 {
+		log.Infof("lapsed %d freelancer verifications", len(userIDs))
+	}
+}
+
+// checkVerificationRejectReason the reason must be one of the freelancer verification reject reasons
+func (fs *FreelancerService) checkVerificationRejectReason(ctx context.Context, reasonType int) error {
+	cf, err := fs.configService.GetConfigByID(ctx, reasonType)
+	if err != nil || cf == nil {
+		return errors.BadRequest(reason.ReportNotFound)
+	}
+	reasonKeys, err := fs.configService.GetArrayStringValue(ctx,
+		fmt.Sprintf("%s.reject.reasons", constant.FreelancerVerificationObjectType))
+	if err != nil {
+		return err
+	}
+	for _, key := range reasonKeys {
+		if key == cf.Key {
+			return nil
+		}
+	}
+	return errors.BadRequest(reason.ReportNotFound)
+}
+
+// getVerificationValidDays the valid days of the verification, 0 means it never lapses
+func (fs *FreelancerService) getVerificationValidDays(ctx context.Context) int {
+	siteJob, err := fs.siteInfoService.GetSiteJob(ctx)
+	if err != nil {
+		log.Error(err)
+		return 0
+	}
+	return siteJob.VerificationValidDays
+}
+
+func (fs *FreelancerService) formatVerification(ctx context.Context, verification *entity.FreelancerVerification,
+	validDays int) (resp *schema.FreelancerVerificationResp) {
+	resp = &schema.FreelancerVerificationResp{}
+	resp.ConvertFromFreelancerVerificationEntity(verification)
+	if verification.Status == entity.FreelancerVerificationStatusApproved && validDays > 0 {
+		resp.ExpiresAt = verification.ReviewedAt.AddDate(0, 0, validDays).Unix()
+	}
+	if verification.ReasonType > 0 {
+		resp.Reason = &schema.ReasonItem{ReasonType: verification.ReasonType}
+		cf, err := fs.configService.GetConfigByID(ctx, verification.ReasonType)
+		if err != nil {
+			log.Error(err)
+		} else {
+			_ = json.Unmarshal(cf.GetByteValue(), resp.Reason)
+			resp.Reason.Translate(cf.Key, handler.GetLangByCtx(ctx))
+		}
+	}
+	return resp
+}
@@ -0,0 +1,471 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package freelancer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/badge"
+	"github.com/apache/answer/internal/service/config"
+	"github.com/apache/answer/internal/service/file_record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockFreelancerVerificationRepo struct {
+	mock.Mock
+}
+
+type MockFileRecordRepo struct {
+	mock.Mock
+}
+
+type MockBadgeRepo struct {
+	mock.Mock
+}
+
+type MockBadgeAwardRepo struct {
+	mock.Mock
+}
+
+type MockConfigRepo struct {
+	mock.Mock
+}
+
+func (m *MockFreelancerVerificationRepo) AddVerification(ctx context.Context, verification *entity.FreelancerVerification) error {
+	args := m.Called(ctx, verification)
+	return args.Error(0)
+}
+
+func (m *MockFreelancerVerificationRepo) GetVerification(ctx context.Context, id string) (*entity.FreelancerVerification, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.FreelancerVerification), args.Bool(1), args.Error(2)
+}
+
+func (m *MockFreelancerVerificationRepo) GetLatestVerification(ctx context.Context, userID string) (*entity.FreelancerVerification, bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*entity.FreelancerVerification), args.Bool(1), args.Error(2)
+}
+
+func (m *MockFreelancerVerificationRepo) GetVerificationPage(ctx context.Context, page int, pageSize int, status string) ([]*entity.FreelancerVerification, int64, error) {
+	args := m.Called(ctx, page, pageSize, status)
+	return args.Get(0).([]*entity.FreelancerVerification), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFreelancerVerificationRepo) ReviewVerification(ctx context.Context, verification *entity.FreelancerVerification) (bool, error) {
+	args := m.Called(ctx, verification)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockFreelancerVerificationRepo) LapseVerifications(ctx context.Context, verifiedBefore time.Time) ([]string, error) {
+	args := m.Called(ctx, verifiedBefore)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockFileRecordRepo) AddFileRecord(ctx context.Context, fileRecord *entity.FileRecord) error {
+	args := m.Called(ctx, fileRecord)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) UpdateFileRecord(ctx context.Context, fileRecord *entity.FileRecord) error {
+	args := m.Called(ctx, fileRecord)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordPage(ctx context.Context, page int, pageSize int, cond *entity.FileRecord) ([]*entity.FileRecord, int64, error) {
+	args := m.Called(ctx, page, pageSize, cond)
+	return args.Get(0).([]*entity.FileRecord), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockFileRecordRepo) DeleteFileRecord(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordByURL(ctx context.Context, fileURL string) (*entity.FileRecord, error) {
+	args := m.Called(ctx, fileURL)
+	return args.Get(0).(*entity.FileRecord), args.Error(1)
+}
+
+func (m *MockFileRecordRepo) GetFileRecordByPath(ctx context.Context, filePath string) (*entity.FileRecord, error) {
+	args := m.Called(ctx, filePath)
+	return args.Get(0).(*entity.FileRecord), args.Error(1)
+}
+
+func (m *MockBadgeRepo) GetByID(ctx context.Context, id string) (*entity.Badge, bool, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Badge), args.Bool(1), args.Error(2)
+}
+
+func (m *MockBadgeRepo) GetByIDs(ctx context.Context, ids []string) ([]*entity.Badge, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.Badge), args.Error(1)
+}
+
+func (m *MockBadgeRepo) GetByHandler(ctx context.Context, handler string) (*entity.Badge, bool, error) {
+	args := m.Called(ctx, handler)
+	return args.Get(0).(*entity.Badge), args.Bool(1), args.Error(2)
+}
+
+func (m *MockBadgeRepo) ListPaged(ctx context.Context, page int, pageSize int) ([]*entity.Badge, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*entity.Badge), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBadgeRepo) ListActivated(ctx context.Context, page int, pageSize int) ([]*entity.Badge, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*entity.Badge), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBadgeRepo) ListInactivated(ctx context.Context, page int, pageSize int) ([]*entity.Badge, int64, error) {
+	args := m.Called(ctx, page, pageSize)
+	return args.Get(0).([]*entity.Badge), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBadgeRepo) UpdateStatus(ctx context.Context, id string, status int8) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockBadgeRepo) UpdateAwardCount(ctx context.Context, badgeID string, awardCount int) error {
+	args := m.Called(ctx, badgeID, awardCount)
+	return args.Error(0)
+}
+
+func (m *MockBadgeAwardRepo) CheckIsAward(ctx context.Context, badgeID string, userID string, awardKey string, singleOrMulti int8) (bool, error) {
+	args := m.Called(ctx, badgeID, userID, awardKey, singleOrMulti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBadgeAwardRepo) AwardBadgeForUser(ctx context.Context, badgeAward *entity.BadgeAward) error {
+	args := m.Called(ctx, badgeAward)
+	return args.Error(0)
+}
+
+func (m *MockBadgeAwardRepo) CountByUserIdAndBadgeId(ctx context.Context, userID string, badgeID string) int64 {
+	args := m.Called(ctx, userID, badgeID)
+	return args.Get(0).(int64)
+}
+
+func (m *MockBadgeAwardRepo) CountByBadgeID(ctx context.Context, badgeID string) (int64, error) {
+	args := m.Called(ctx, badgeID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockBadgeAwardRepo) SumUserEarnedGroupByBadgeID(ctx context.Context, userID string) ([]*entity.BadgeEarnedCount, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entity.BadgeEarnedCount), args.Error(1)
+}
+
+func (m *MockBadgeAwardRepo) ListPagedByBadgeId(ctx context.Context, badgeID string, page int, pageSize int) ([]*entity.BadgeAward, int64, error) {
+	args := m.Called(ctx, badgeID, page, pageSize)
+	return args.Get(0).([]*entity.BadgeAward), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBadgeAwardRepo) ListPagedByBadgeIdAndUserId(ctx context.Context, badgeID string, userID string, page int, pageSize int) ([]*entity.BadgeAward, int64, error) {
+	args := m.Called(ctx, badgeID, userID, page, pageSize)
+	return args.Get(0).([]*entity.BadgeAward), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockBadgeAwardRepo) ListNewestEarned(ctx context.Context, userID string, limit int) ([]*entity.BadgeAwardRecent, error) {
+	args := m.Called(ctx, userID, limit)
+	return args.Get(0).([]*entity.BadgeAwardRecent), args.Error(1)
+}
+
+func (m *MockBadgeAwardRepo) GetByUserIdAndBadgeId(ctx context.Context, userID string, badgeID string) (*entity.BadgeAward, bool, error) {
+	args := m.Called(ctx, userID, badgeID)
+	return args.Get(0).(*entity.BadgeAward), args.Bool(1), args.Error(2)
+}
+
+func (m *MockBadgeAwardRepo) GetByUserIdAndBadgeIdAndAwardKey(ctx context.Context, userID string, badgeID string, awardKey string) (*entity.BadgeAward, bool, error) {
+	args := m.Called(ctx, userID, badgeID, awardKey)
+	return args.Get(0).(*entity.BadgeAward), args.Bool(1), args.Error(2)
+}
+
+func (m *MockBadgeAwardRepo) DeleteUserBadgeAward(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockBadgeAwardRepo) DeleteUserBadgeAwardByBadgeID(ctx context.Context, userID string, badgeID string) error {
+	args := m.Called(ctx, userID, badgeID)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) GetConfigByID(ctx context.Context, id int) (*entity.Config, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) GetConfigByKey(ctx context.Context, key string) (*entity.Config, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(*entity.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) UpdateConfig(ctx context.Context, key string, value string) error {
+	args := m.Called(ctx, key, value)
+	return args.Error(0)
+}
+
+// verificationTestMocks the mocks of the dependencies used by the verification
+type verificationTestMocks struct {
+	repo                     *MockFreelancerRepo
+	verificationRepo         *MockFreelancerVerificationRepo
+	fileRecordRepo           *MockFileRecordRepo
+	badgeAwardRepo           *MockBadgeAwardRepo
+	notificationQueueService *MockNotificationQueueService
+}
+
+// newTestVerificationService new freelancer service with the verification dependencies,
+// the verification valid days is 365 and the verified badge is active
+func newTestVerificationService() (*FreelancerService, *verificationTestMocks) {
+	m := &verificationTestMocks{
+		repo:                     new(MockFreelancerRepo),
+		verificationRepo:         new(MockFreelancerVerificationRepo),
+		fileRecordRepo:           new(MockFileRecordRepo),
+		badgeAwardRepo:           new(MockBadgeAwardRepo),
+		notificationQueueService: new(MockNotificationQueueService),
+	}
+	m.notificationQueueService.On("Send", mock.Anything, mock.Anything).Return().Maybe()
+
+	verifiedBadge := &entity.Badge{ID: "7", Name: "Verified Freelancer", Status: entity.BadgeStatusActive, Single: 1}
+	badgeRepo := new(MockBadgeRepo)
+	badgeRepo.On("GetByHandler", mock.Anything, verifiedFreelancerBadgeHandler).Return(verifiedBadge, true, nil).Maybe()
+	badgeRepo.On("GetByID", mock.Anything, verifiedBadge.ID).Return(verifiedBadge, true, nil).Maybe()
+
+	configRepo := new(MockConfigRepo)
+	configRepo.On("GetConfigByID", mock.Anything, 1).Return(&entity.Config{
+		ID: 1, Key: "reason.identity_mismatch", Value: `{"name":"identity mismatch"}`}, nil).Maybe()
+	configRepo.On("GetConfigByID", mock.Anything, 2).Return(&entity.Config{
+		ID: 2, Key: "reason.spam", Value: `{"name":"spam"}`}, nil).Maybe()
+	configRepo.On("GetConfigByKey", mock.Anything,
+		fmt.Sprintf("%s.reject.reasons", constant.FreelancerVerificationObjectType)).Return(&entity.Config{
+		Value: `["reason.identity_mismatch"]`}, nil).Maybe()
+
+	siteInfoService := new(MockSiteInfoService)
+	siteInfoService.On("GetSiteJob", mock.Anything).Return(&schema.SiteJobResp{VerificationValidDays: 365}, nil).Maybe()
+
+	fs := newTestFreelancerService(m.repo)
+	fs.siteInfoService = siteInfoService
+	fs.verificationRepo = m.verificationRepo
+	fs.notificationQueueService = m.notificationQueueService
+	fs.fileRecordService = file_record.NewFileRecordService(m.fileRecordRepo, nil, nil, nil, nil, nil)
+	fs.badgeAwardService = badge.NewBadgeAwardService(m.badgeAwardRepo, badgeRepo, nil, nil, m.notificationQueueService)
+	fs.configService = config.NewConfigService(configRepo)
+	return fs, m
+}
+
+func TestRequestVerification(t *testing.T) {
+	ctx := context.Background()
+	evidence := "https://example.com/answer/admin/api/freelancer/verification/evidence/abc/passport.pdf"
+	req := func() *schema.RequestFreelancerVerificationReq {
+		return &schema.RequestFreelancerVerificationReq{Evidence: []string{evidence}, LoginUserID: "2"}
+	}
+
+	t.Run("profile_not_found", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.repo.On("GetFreelancerProfileByUserID", ctx, "2").Return((*entity.FreelancerProfile)(nil), false, nil)
+
+		_, err := fs.RequestVerification(ctx, req())
+		assertReason(t, err, reason.FreelancerProfileNotFound)
+	})
+
+	t.Run("already_verified", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.repo.On("GetFreelancerProfileByUserID", ctx, "2").Return(
+			&entity.FreelancerProfile{UserID: "2", IsVerified: true}, true, nil)
+
+		_, err := fs.RequestVerification(ctx, req())
+		assertReason(t, err, reason.FreelancerAlreadyVerified)
+	})
+
+	t.Run("pending_request_exists", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.repo.On("GetFreelancerProfileByUserID", ctx, "2").Return(&entity.FreelancerProfile{UserID: "2"}, true, nil)
+		m.verificationRepo.On("GetLatestVerification", ctx, "2").Return(&entity.FreelancerVerification{
+			ID: "4", UserID: "2", Status: entity.FreelancerVerificationStatusPending}, true, nil)
+
+		_, err := fs.RequestVerification(ctx, req())
+		assertReason(t, err, reason.FreelancerVerificationPending)
+		m.verificationRepo.AssertNotCalled(t, "AddVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("evidence_of_others", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.repo.On("GetFreelancerProfileByUserID", ctx, "2").Return(&entity.FreelancerProfile{UserID: "2"}, true, nil)
+		m.verificationRepo.On("GetLatestVerification", ctx, "2").Return(
+			(*entity.FreelancerVerification)(nil), false, nil)
+		m.fileRecordRepo.On("GetFileRecordByURL", ctx, evidence).Return(&entity.FileRecord{
+			ID: 1, UserID: "3", FilePath: "files/verification/abc.pdf", ObjectID: "0"}, nil)
+
+		_, err := fs.RequestVerification(ctx, req())
+		assertReason(t, err, reason.ForbiddenError)
+		m.verificationRepo.AssertNotCalled(t, "AddVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("request_again_after_rejected", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.repo.On("GetFreelancerProfileByUserID", ctx, "2").Return(&entity.FreelancerProfile{UserID: "2"}, true, nil)
+		m.verificationRepo.On("GetLatestVerification", ctx, "2").Return(&entity.FreelancerVerification{
+			ID: "4", UserID: "2", Status: entity.FreelancerVerificationStatusRejected}, true, nil)
+		m.fileRecordRepo.On("GetFileRecordByURL", ctx, evidence).Return(&entity.FileRecord{
+			ID: 1, UserID: "2", FilePath: "files/verification/abc.pdf", ObjectID: "0"}, nil)
+		m.verificationRepo.On("AddVerification", ctx, mock.MatchedBy(func(v *entity.FreelancerVerification) bool {
+			return v.UserID == "2" && v.Status == entity.FreelancerVerificationStatusPending &&
+				v.Evidence == `["`+evidence+`"]`
+		})).Run(func(args mock.Arguments) {
+			args.Get(1).(*entity.FreelancerVerification).ID = "5"
+		}).Return(nil).Once()
+		m.fileRecordRepo.On("UpdateFileRecord", ctx, mock.MatchedBy(func(record *entity.FileRecord) bool {
+			return record.ID == 1 && record.ObjectID == "5"
+		})).Return(nil).Once()
+
+		resp, err := fs.RequestVerification(ctx, req())
+		require.NoError(t, err)
+		assert.Equal(t, "5", resp.ID)
+		assert.Equal(t, entity.FreelancerVerificationStatusPending, resp.Status)
+		m.verificationRepo.AssertExpectations(t)
+		m.fileRecordRepo.AssertExpectations(t)
+	})
+}
+
+func TestReviewVerification(t *testing.T) {
+	ctx := context.Background()
+	pending := func() *entity.FreelancerVerification {
+		return &entity.FreelancerVerification{ID: "5", UserID: "2", Status: entity.FreelancerVerificationStatusPending}
+	}
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("GetVerification", ctx, "5").Return(&entity.FreelancerVerification{
+			ID: "5", UserID: "2", Status: entity.FreelancerVerificationStatusApproved}, true, nil)
+
+		err := fs.ReviewVerification(ctx, &schema.ReviewFreelancerVerificationReq{
+			ID: "5", Status: entity.FreelancerVerificationStatusRejected, ReasonType: 1, LoginUserID: "1"})
+		assertReason(t, err, reason.FreelancerVerificationReviewed)
+		m.verificationRepo.AssertNotCalled(t, "ReviewVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reviewed_by_another_admin", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("GetVerification", ctx, "5").Return(pending(), true, nil)
+		m.verificationRepo.On("ReviewVerification", ctx, mock.Anything).Return(false, nil)
+
+		err := fs.ReviewVerification(ctx, &schema.ReviewFreelancerVerificationReq{
+			ID: "5", Status: entity.FreelancerVerificationStatusApproved, LoginUserID: "1"})
+		assertReason(t, err, reason.FreelancerVerificationReviewed)
+		m.badgeAwardRepo.AssertNotCalled(t, "AwardBadgeForUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reject_with_unknown_reason", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("GetVerification", ctx, "5").Return(pending(), true, nil)
+
+		err := fs.ReviewVerification(ctx, &schema.ReviewFreelancerVerificationReq{
+			ID: "5", Status: entity.FreelancerVerificationStatusRejected, ReasonType: 2, LoginUserID: "1"})
+		assertReason(t, err, reason.ReportNotFound)
+		m.verificationRepo.AssertNotCalled(t, "ReviewVerification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("reject_notifies_freelancer", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("GetVerification", ctx, "5").Return(pending(), true, nil)
+		m.verificationRepo.On("ReviewVerification", ctx, mock.MatchedBy(func(v *entity.FreelancerVerification) bool {
+			return v.Status == entity.FreelancerVerificationStatusRejected && v.ReviewerID == "1" &&
+				v.ReasonType == 1 && v.ReasonMsg == "blurry photo"
+		})).Return(true, nil).Once()
+
+		err := fs.ReviewVerification(ctx, &schema.ReviewFreelancerVerificationReq{ID: "5",
+			Status: entity.FreelancerVerificationStatusRejected, ReasonType: 1, ReasonMsg: "blurry photo", LoginUserID: "1"})
+		require.NoError(t, err)
+		m.verificationRepo.AssertExpectations(t)
+		m.notificationQueueService.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(msg *schema.NotificationMsg) bool {
+			return msg.ReceiverUserID == "2" && msg.ObjectID == "5" &&
+				msg.NotificationAction == constant.NotificationYourVerificationWasRejected
+		}))
+		m.badgeAwardRepo.AssertNotCalled(t, "AwardBadgeForUser", mock.Anything, mock.Anything)
+	})
+
+	t.Run("approve_awards_badge", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("GetVerification", ctx, "5").Return(pending(), true, nil)
+		m.verificationRepo.On("ReviewVerification", ctx, mock.MatchedBy(func(v *entity.FreelancerVerification) bool {
+			return v.Status == entity.FreelancerVerificationStatusApproved && v.ReviewerID == "1" && v.ReasonType == 0
+		})).Return(true, nil).Once()
+		m.badgeAwardRepo.On("CheckIsAward", ctx, "7", "2", "5", int8(1)).Return(false, nil)
+		m.badgeAwardRepo.On("AwardBadgeForUser", ctx, mock.MatchedBy(func(award *entity.BadgeAward) bool {
+			return award.BadgeID == "7" && award.UserID == "2" && award.AwardKey == "5"
+		})).Return(nil).Once()
+
+		err := fs.ReviewVerification(ctx, &schema.ReviewFreelancerVerificationReq{
+			ID: "5", Status: entity.FreelancerVerificationStatusApproved, LoginUserID: "1"})
+		require.NoError(t, err)
+		m.verificationRepo.AssertExpectations(t)
+		m.badgeAwardRepo.AssertExpectations(t)
+		m.notificationQueueService.AssertNotCalled(t, "Send", ctx, mock.MatchedBy(func(msg *schema.NotificationMsg) bool {
+			return msg.NotificationAction == constant.NotificationYourVerificationWasRejected
+		}))
+	})
+}
+
+func TestVerificationLapseCron(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("never_lapses", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		siteInfoService := new(MockSiteInfoService)
+		siteInfoService.On("GetSiteJob", ctx).Return(&schema.SiteJobResp{VerificationValidDays: 0}, nil)
+		fs.siteInfoService = siteInfoService
+
+		fs.VerificationLapseCron(ctx)
+		m.verificationRepo.AssertNotCalled(t, "LapseVerifications", mock.Anything, mock.Anything)
+	})
+
+	t.Run("lapse_revokes_badge", func(t *testing.T) {
+		fs, m := newTestVerificationService()
+		m.verificationRepo.On("LapseVerifications", ctx, mock.MatchedBy(func(verifiedBefore time.Time) bool {
+			expected := time.Now().AddDate(0, 0, -365)
+			return verifiedBefore.Sub(expected).Abs() < time.Minute
+		})).Return([]string{"2", "3"}, nil).Once()
+		m.badgeAwardRepo.On("DeleteUserBadgeAwardByBadgeID", ctx, "2", "7").Return(nil).Once()
+		m.badgeAwardRepo.On("DeleteUserBadgeAwardByBadgeID", ctx, "3", "7").Return(nil).Once()
+		m.verificationRepo.On("GetLatestVerification", ctx, "2").Return(&entity.FreelancerVerification{
+			ID: "5", UserID: "2", Status: entity.FreelancerVerificationStatusApproved}, true, nil)
+		m.verificationRepo.On("GetLatestVerification", ctx, "3").Return(
+			(*entity.FreelancerVerification)(nil), false, nil)
+
+		fs.VerificationLapseCron(ctx)
+		m.verificationRepo.AssertExpectations(t)
+		m.badgeAwardRepo.AssertExpectations(t)
+		m.notificationQueueService.AssertCalled(t, "Send", ctx, mock.MatchedBy(func(msg *schema.NotificationMsg) bool {
+			return msg.ReceiverUserID == "2" && msg.ObjectID == "5" &&
+				msg.NotificationAction == constant.NotificationYourVerificationHasLapsed
+		}))
+		m.notificationQueueService.AssertNumberOfCalls(t, "Send", 1)
+	})
+}
@@ -0,0 +1,316 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job_matching
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/repo/freelancer"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/tag_common"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/segmentfault/pacman/errors"
+)
+
+const (
+	// candidateLimit the max number of candidates to be scored in one recommendation
+	candidateLimit = 500
+	// defaultRecommendLimit the default number of recommendations returned
+	defaultRecommendLimit = 10
+	// maxRecommendLimit the max number of recommendations returned
+	maxRecommendLimit = 50
+)
+
+// JobMatchingService match freelancers and job postings
+type JobMatchingService struct {
+	freelancerRepo   freelancer.FreelancerRepo
+	userRepo         usercommon.UserRepo
+	tagCommonService *tag_common.TagCommonService
+}
+
+// NewJobMatchingService new job matching service
+func NewJobMatchingService(
+	freelancerRepo freelancer.FreelancerRepo,
+	userRepo usercommon.UserRepo,
+	tagCommonService *tag_common.TagCommonService,
+) *JobMatchingService {
+	return &JobMatchingService{
+		freelancerRepo:   freelancerRepo,
+		userRepo:         userRepo,
+		tagCommonService: tagCommonService,
+	}
+}
+
+// skillTag the canonical tag of a skill, synonyms are mapped to their main tag
+type skillTag struct {
+	SlugName string
+	TagID    string
+}
+
+// GetRecommendedFreelancers get the available freelancers which match the job posting best
+func (js *JobMatchingService) GetRecommendedFreelancers(ctx context.Context, req *schema.GetRecommendedFreelancersReq) (
+	resp []*schema.RecommendedFreelancerResp, err error) {
+	resp = make([]*schema.RecommendedFreelancerResp, 0)
+	posting, exist, err := js.freelancerRepo.GetJobPostingByID(ctx, req.JobID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.JobPostingNotFound)
+	}
+
+	profiles, err := js.freelancerRepo.GetAvailableFreelancerProfiles(ctx, candidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		if profile.UserID != posting.UserID {
+			userIDs = append(userIDs, profile.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return resp, nil
+	}
+	users, err := js.userRepo.BatchGetByID(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	userRank := make(map[string]int, len(users))
+	for _, user := range users {
+		if user.Status == entity.UserStatusAvailable {
+			userRank[user.ID] = user.Rank
+		}
+	}
+
+	jobSkills := parseJSONList(posting.Skills)
+	allSkills := append([]string{}, jobSkills...)
+	profileSkills := make(map[string][]string, len(profiles))
+	for _, profile := range profiles {
+		profileSkills[profile.UserID] = parseJSONList(profile.Skills)
+		allSkills = append(allSkills, profileSkills[profile.UserID]...)
+	}
+	mapping, err := js.getSkillMapping(ctx, allSkills)
+	if err != nil {
+		return nil, err
+	}
+	jobSkillKeys, jobTagIDs := canonicalSkills(jobSkills, mapping)
+	acceptedCount, err := js.freelancerRepo.GetAcceptedAnswerCountByTags(ctx, userIDs, jobTagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, profile := range profiles {
+		rank, ok := userRank[profile.UserID]
+		if !ok || profile.UserID == posting.UserID {
+			continue
+		}
+		skillKeys, _ := canonicalSkills(profileSkills[profile.UserID], mapping)
+		score, matched := calculateMatchScore(&matchFactors{
+			JobSkills:       jobSkillKeys,
+			JobBudget:       posting.Budget,
+			JobBudgetType:   posting.BudgetType,
+			JobCurrency:     posting.Currency,
+			JobText:         posting.Title + " " + posting.Description,
+			Skills:          skillKeys,
+			HourlyRate:      profile.HourlyRate,
+			Currency:        profile.Currency,
+			IsAvailable:     profile.IsAvailable,
+			Satisfaction:    profile.ClientSatisfaction,
+			Rank:            rank,
+			AcceptedAnswers: sumCount(acceptedCount[profile.UserID], jobTagIDs),
+			Preferred:       parseJSONList(profile.PreferredProjects),
+		})
+		profileResp := &schema.FreelancerProfileResp{}
+		profileResp.ConvertFromFreelancerProfileEntity(profile)
+		resp = append(resp, &schema.RecommendedFreelancerResp{
+			Score:         score,
+			MatchedSkills: matched,
+			Profile:       profileResp,
+		})
+	}
+
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Score > resp[j].Score
+	})
+	return resp[:min(len(resp), recommendLimit(req.Limit))], nil
+}
+
+// GetRecommendedJobs get the open job postings which match the login user best
+func (js *JobMatchingService) GetRecommendedJobs(ctx context.Context, req *schema.GetRecommendedJobsReq) (
+	resp []*schema.RecommendedJobResp, err error) {
+	resp = make([]*schema.RecommendedJobResp, 0)
+	user, exist, err := js.userRepo.GetByUserID(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.UserNotFound)
+	}
+	profile, exist, err := js.freelancerRepo.GetFreelancerProfileByUserID(ctx, req.LoginUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		// everyone is a freelancer by default, the user without profile is available but has no skill
+		profile = &entity.FreelancerProfile{UserID: user.ID, IsAvailable: true}
+	}
+
+	postings, err := js.freelancerRepo.GetOpenJobPostings(ctx, candidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	skills := parseJSONList(profile.Skills)
+	allSkills := append([]string{}, skills...)
+	postingSkills := make(map[string][]string, len(postings))
+	for _, posting := range postings {
+		postingSkills[posting.ID] = parseJSONList(posting.Skills)
+		allSkills = append(allSkills, postingSkills[posting.ID]...)
+	}
+	mapping, err := js.getSkillMapping(ctx, allSkills)
+	if err != nil {
+		return nil, err
+	}
+	skillKeys, _ := canonicalSkills(skills, mapping)
+
+	postingSkillKeys := make(map[string][]string, len(postings))
+	postingTagIDs := make(map[string][]string, len(postings))
+	allTagIDs := make([]string, 0)
+	for _, posting := range postings {
+		postingSkillKeys[posting.ID], postingTagIDs[posting.ID] = canonicalSkills(postingSkills[posting.ID], mapping)
+		allTagIDs = append(allTagIDs, postingTagIDs[posting.ID]...)
+	}
+	acceptedCount, err := js.freelancerRepo.GetAcceptedAnswerCountByTags(ctx, []string{user.ID}, allTagIDs)
+	if err != nil {
+		return nil, err
+	}
+	preferred := parseJSONList(profile.PreferredProjects)
+
+	for _, posting := range postings {
+		if posting.UserID == user.ID {
+			continue
+		}
+		score, matched := calculateMatchScore(&matchFactors{
+			JobSkills:       postingSkillKeys[posting.ID],
+			JobBudget:       posting.Budget,
+			JobBudgetType:   posting.BudgetType,
+			JobCurrency:     posting.Currency,
+			JobText:         posting.Title + " " + posting.Description,
+			Skills:          skillKeys,
+			HourlyRate:      profile.HourlyRate,
+			Currency:        profile.Currency,
+			IsAvailable:     profile.IsAvailable,
+			Satisfaction:    profile.ClientSatisfaction,
+			Rank:            user.Rank,
+			AcceptedAnswers: sumCount(acceptedCount[user.ID], postingTagIDs[posting.ID]),
+			Preferred:       preferred,
+		})
+		jobResp := &schema.JobPostingResp{}
+		jobResp.ConvertFromJobPostingEntity(posting)
+		resp = append(resp, &schema.RecommendedJobResp{
+			Score:         score,
+			MatchedSkills: matched,
+			Job:           jobResp,
+		})
+	}
+
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Score > resp[j].Score
+	})
+	return resp[:min(len(resp), recommendLimit(req.Limit))], nil
+}
+
+// getSkillMapping get the canonical tag of skills which are existing tags, the key is the normalized skill
+func (js *JobMatchingService) getSkillMapping(ctx context.Context, skills []string) (
+	mapping map[string]*skillTag, err error) {
+	mapping = make(map[string]*skillTag)
+	names := make([]string, 0, len(skills))
+	seen := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		name := normalizeSkill(skill)
+		if len(name) > 0 && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return mapping, nil
+	}
+	tags, err := js.tagCommonService.GetTagListByNames(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if tag.MainTagID > 0 {
+			mapping[tag.SlugName] = &skillTag{SlugName: tag.MainTagSlugName, TagID: strconv.FormatInt(tag.MainTagID, 10)}
+		} else {
+			mapping[tag.SlugName] = &skillTag{SlugName: tag.SlugName, TagID: tag.ID}
+		}
+	}
+	return mapping, nil
+}
+
+// canonicalSkills convert skills to the canonical skill keys and the tag ids of them.
+// The skill which is not a tag is kept as the normalized name.
+func canonicalSkills(skills []string, mapping map[string]*skillTag) (keys, tagIDs []string) {
+	keys = make([]string, 0, len(skills))
+	tagIDs = make([]string, 0, len(skills))
+	seen := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		key := normalizeSkill(skill)
+		tag, ok := mapping[key]
+		if ok {
+			key = tag.SlugName
+		}
+		if len(key) == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+		if ok {
+			tagIDs = append(tagIDs, tag.TagID)
+		}
+	}
+	return keys, tagIDs
+}
+
+func sumCount(countByTag map[string]int64, tagIDs []string) (sum int64) {
+	for _, tagID := range tagIDs {
+		sum += countByTag[tagID]
+	}
+	return sum
+}
+
+func parseJSONList(data string) (list []string) {
+	_ = json.Unmarshal([]byte(data), &list)
+	return list
+}
+
+func recommendLimit(limit int) int {
+	if limit <= 0 {
+		return defaultRecommendLimit
+	}
+	return min(limit, maxRecommendLimit)
+}
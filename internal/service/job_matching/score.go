@@ -0,0 +1,145 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job_matching
+
+import (
+	"math"
+	"strings"
+)
+
+// the weight of each factor in the match score, the sum of them is 1
+const (
+	skillWeight        = 0.30
+	rateWeight         = 0.15
+	availabilityWeight = 0.10
+	satisfactionWeight = 0.15
+	reputationWeight   = 0.10
+	acceptedWeight     = 0.15
+	preferenceWeight   = 0.05
+)
+
+const (
+	// maxClientSatisfaction client satisfaction is from 0 to 5
+	maxClientSatisfaction = 5
+	// fullReputationRank the rank with which the reputation factor gets the full score
+	fullReputationRank = 10000
+	// fullAcceptedAnswerCount the accepted answer count with which the accepted answer factor gets the full score
+	fullAcceptedAnswerCount = 10
+	// neutralScore used when a factor can not be compared, e.g. the budget is negotiable
+	neutralScore = 0.5
+)
+
+// matchFactors the information of a job posting and a freelancer used to calculate the match score.
+// Skills should be normalized by skill mapping first, so that synonyms are treated as the same skill.
+type matchFactors struct {
+	JobSkills       []string
+	JobBudget       float64
+	JobBudgetType   string
+	JobCurrency     string
+	JobText         string
+	Skills          []string
+	HourlyRate      float64
+	Currency        string
+	IsAvailable     bool
+	Satisfaction    float64
+	Rank            int
+	AcceptedAnswers int64
+	Preferred       []string
+}
+
+// calculateMatchScore calculate the match score from 0 to 100, and return the matched skills of the job
+func calculateMatchScore(f *matchFactors) (score float64, matched []string) {
+	matched = matchedSkills(f.JobSkills, f.Skills)
+	skillScore := 0.0
+	if len(f.JobSkills) > 0 {
+		skillScore = float64(len(matched)) / float64(len(f.JobSkills))
+	}
+
+	availabilityScore := 0.0
+	if f.IsAvailable {
+		availabilityScore = 1
+	}
+
+	score = skillWeight*skillScore +
+		rateWeight*rateFitScore(f.JobBudget, f.JobBudgetType, f.JobCurrency, f.HourlyRate, f.Currency) +
+		availabilityWeight*availabilityScore +
+		satisfactionWeight*clamp(f.Satisfaction/maxClientSatisfaction) +
+		reputationWeight*reputationScore(f.Rank) +
+		acceptedWeight*clamp(float64(f.AcceptedAnswers)/fullAcceptedAnswerCount) +
+		preferenceWeight*preferenceScore(f.Preferred, f.JobText)
+	return math.Round(score*10000) / 100, matched
+}
+
+// matchedSkills the job skills which the freelancer has, in the order of job skills
+func matchedSkills(jobSkills, skills []string) (matched []string) {
+	has := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		has[skill] = true
+	}
+	matched = make([]string, 0)
+	for _, skill := range jobSkills {
+		if has[skill] {
+			matched = append(matched, skill)
+		}
+	}
+	return matched
+}
+
+// rateFitScore only the hourly budget can be compared with the hourly rate in the same currency.
+// The rate within the budget gets the full score, otherwise the score decreases as the rate goes up.
+func rateFitScore(budget float64, budgetType, budgetCurrency string, rate float64, rateCurrency string) float64 {
+	if budgetType != "hourly" || budget <= 0 || rate <= 0 ||
+		!strings.EqualFold(budgetCurrency, rateCurrency) {
+		return neutralScore
+	}
+	if rate <= budget {
+		return 1
+	}
+	return budget / rate
+}
+
+// reputationScore use the log of rank, so that the users with a huge rank do not dominate the result
+func reputationScore(rank int) float64 {
+	if rank <= 0 {
+		return 0
+	}
+	return clamp(math.Log10(float64(rank)+1) / math.Log10(fullReputationRank+1))
+}
+
+// preferenceScore whether any preferred project type of the freelancer is mentioned by the job
+func preferenceScore(preferred []string, jobText string) float64 {
+	jobText = strings.ToLower(jobText)
+	for _, p := range preferred {
+		p = strings.ToLower(strings.TrimSpace(p))
+		if len(p) > 0 && strings.Contains(jobText, p) {
+			return 1
+		}
+	}
+	return 0
+}
+
+func clamp(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// normalizeSkill convert the skill to the format of tag slug name
+func normalizeSkill(skill string) string {
+	return strings.Join(strings.Fields(strings.ToLower(skill)), "-")
+}
@@ -0,0 +1,86 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package job_matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateMatchScore(t *testing.T) {
+	t.Run("Full match", func(t *testing.T) {
+		score, matched := calculateMatchScore(&matchFactors{
+			JobSkills:       []string{"go", "docker"},
+			JobBudget:       60,
+			JobBudgetType:   "hourly",
+			JobCurrency:     "USD",
+			JobText:         "Build an API service",
+			Skills:          []string{"docker", "go", "react"},
+			HourlyRate:      50,
+			Currency:        "usd",
+			IsAvailable:     true,
+			Satisfaction:    5,
+			Rank:            fullReputationRank,
+			AcceptedAnswers: fullAcceptedAnswerCount,
+			Preferred:       []string{"API"},
+		})
+		assert.Equal(t, float64(100), score)
+		assert.Equal(t, []string{"go", "docker"}, matched)
+	})
+
+	t.Run("No match", func(t *testing.T) {
+		score, matched := calculateMatchScore(&matchFactors{
+			JobSkills:     []string{"go"},
+			JobBudget:     60,
+			JobBudgetType: "hourly",
+			JobCurrency:   "USD",
+			Skills:        []string{"python"},
+			HourlyRate:    50,
+			Currency:      "EUR",
+		})
+		assert.Equal(t, float64(7.5), score)
+		assert.Empty(t, matched)
+	})
+
+	t.Run("More skills matched ranks higher", func(t *testing.T) {
+		one, _ := calculateMatchScore(&matchFactors{JobSkills: []string{"go", "docker"}, Skills: []string{"go"}})
+		two, _ := calculateMatchScore(&matchFactors{JobSkills: []string{"go", "docker"}, Skills: []string{"go", "docker"}})
+		assert.Greater(t, two, one)
+	})
+}
+
+func TestRateFitScore(t *testing.T) {
+	assert.Equal(t, float64(1), rateFitScore(50, "hourly", "USD", 40, "USD"))
+	assert.Equal(t, 0.5, rateFitScore(50, "hourly", "USD", 100, "USD"))
+	assert.Equal(t, neutralScore, rateFitScore(500, "fixed", "USD", 40, "USD"))
+	assert.Equal(t, neutralScore, rateFitScore(50, "hourly", "USD", 40, "EUR"))
+	assert.Equal(t, neutralScore, rateFitScore(0, "hourly", "USD", 40, "USD"))
+}
+
+func TestCanonicalSkills(t *testing.T) {
+	mapping := map[string]*skillTag{
+		"golang": {SlugName: "go", TagID: "10030000000000001"},
+		"go":     {SlugName: "go", TagID: "10030000000000001"},
+	}
+	keys, tagIDs := canonicalSkills([]string{"Golang", "Go", "Machine Learning", ""}, mapping)
+	assert.Equal(t, []string{"go", "machine-learning"}, keys)
+	assert.Equal(t, []string{"10030000000000001"}, tagIDs)
+}
@@ -213,6 +213,36 @@ func (mr *MockSiteInfoCommonServiceMockRecorder) GetSiteInterface(ctx any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSiteInterface", reflect.TypeOf((*MockSiteInfoCommonService)(nil).GetSiteInterface), ctx)
 }
 
+// GetSiteCurrency mocks base method.
+func (m *MockSiteInfoCommonService) GetSiteCurrency(ctx context.Context) (*schema.SiteCurrencyResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSiteCurrency", ctx)
+	ret0, _ := ret[0].(*schema.SiteCurrencyResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSiteCurrency indicates an expected call of GetSiteCurrency.
+func (mr *MockSiteInfoCommonServiceMockRecorder) GetSiteCurrency(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSiteCurrency", reflect.TypeOf((*MockSiteInfoCommonService)(nil).GetSiteCurrency), ctx)
+}
+
+// GetSiteJob mocks base method.
+func (m *MockSiteInfoCommonService) GetSiteJob(ctx context.Context) (*schema.SiteJobResp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSiteJob", ctx)
+	ret0, _ := ret[0].(*schema.SiteJobResp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSiteJob indicates an expected call of GetSiteJob.
+func (mr *MockSiteInfoCommonServiceMockRecorder) GetSiteJob(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSiteJob", reflect.TypeOf((*MockSiteInfoCommonService)(nil).GetSiteJob), ctx)
+}
+
 // GetSiteLegal mocks base method.
 func (m *MockSiteInfoCommonService) GetSiteLegal(ctx context.Context) (*schema.SiteLegalResp, error) {
 	m.ctrl.T.Helper()
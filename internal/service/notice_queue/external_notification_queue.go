@@ -22,8 +22,9 @@ package notice_queue
 import (
 	"context"
 
+	"github.com/apache/answer/internal/base/queue"
 	"github.com/apache/answer/internal/schema"
-	"github.com/segmentfault/pacman/log"
+	"github.com/apache/answer/internal/service/service_config"
 )
 
 type ExternalNotificationQueueService interface {
@@ -32,38 +33,22 @@ type ExternalNotificationQueueService interface {
 }
 
 type externalNotificationQueueService struct {
-	Queue   chan *schema.ExternalNotificationMsg
-	Handler func(ctx context.Context, msg *schema.ExternalNotificationMsg) error
+	queue *queue.Queue[*schema.ExternalNotificationMsg]
 }
 
 func (ns *externalNotificationQueueService) Send(ctx context.Context, msg *schema.ExternalNotificationMsg) {
-	ns.Queue <- msg
+	ns.queue.Send(ctx, msg)
 }
 
 func (ns *externalNotificationQueueService) RegisterHandler(
 	handler func(ctx context.Context, msg *schema.ExternalNotificationMsg) error) {
-	ns.Handler = handler
+	ns.queue.RegisterHandler(handler)
 }
 
-func (ns *externalNotificationQueueService) working() {
-	go func() {
-		for msg := range ns.Queue {
-			log.Debugf("received notification %+v", msg)
-			if ns.Handler == nil {
-				log.Warnf("no handler for notification")
-				continue
-			}
-			if err := ns.Handler(context.Background(), msg); err != nil {
-				log.Error(err)
-			}
-		}
-	}()
-}
-
-// NewNewQuestionNotificationQueueService create a new notification queue service
-func NewNewQuestionNotificationQueueService() ExternalNotificationQueueService {
+// NewNewQuestionNotificationQueueService create a new notification queue service,
+// the messages are kept in the store until they are handled and drained when the service is closed
+func NewNewQuestionNotificationQueueService(store queue.Store, serviceConfig *service_config.ServiceConfig) (ExternalNotificationQueueService, func()) {
 	ns := &externalNotificationQueueService{}
-	ns.Queue = make(chan *schema.ExternalNotificationMsg, 128)
-	ns.working()
-	return ns
+	ns.queue = queue.New[*schema.ExternalNotificationMsg]("external_notification", store, serviceConfig.Queue)
+	return ns, ns.queue.Close
 }
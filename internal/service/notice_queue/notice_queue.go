@@ -22,8 +22,9 @@ package notice_queue
 import (
 	"context"
 
+	"github.com/apache/answer/internal/base/queue"
 	"github.com/apache/answer/internal/schema"
-	"github.com/segmentfault/pacman/log"
+	"github.com/apache/answer/internal/service/service_config"
 )
 
 type NotificationQueueService interface {
@@ -32,38 +33,22 @@ type NotificationQueueService interface {
 }
 
 type notificationQueueService struct {
-	Queue   chan *schema.NotificationMsg
-	Handler func(ctx context.Context, msg *schema.NotificationMsg) error
+	queue *queue.Queue[*schema.NotificationMsg]
 }
 
 func (ns *notificationQueueService) Send(ctx context.Context, msg *schema.NotificationMsg) {
-	ns.Queue <- msg
+	ns.queue.Send(ctx, msg)
 }
 
 func (ns *notificationQueueService) RegisterHandler(
 	handler func(ctx context.Context, msg *schema.NotificationMsg) error) {
-	ns.Handler = handler
+	ns.queue.RegisterHandler(handler)
 }
 
-func (ns *notificationQueueService) working() {
-	go func() {
-		for msg := range ns.Queue {
-			log.Debugf("received notification %+v", msg)
-			if ns.Handler == nil {
-				log.Warnf("no handler for notification")
-				continue
-			}
-			if err := ns.Handler(context.Background(), msg); err != nil {
-				log.Error(err)
-			}
-		}
-	}()
-}
-
-// NewNotificationQueueService create a new notification queue service
-func NewNotificationQueueService() NotificationQueueService {
+// NewNotificationQueueService create a new notification queue service,
+// the messages are kept in the store until they are handled and drained when the service is closed
+func NewNotificationQueueService(store queue.Store, serviceConfig *service_config.ServiceConfig) (NotificationQueueService, func()) {
 	ns := &notificationQueueService{}
-	ns.Queue = make(chan *schema.NotificationMsg, 128)
-	ns.working()
-	return ns
+	ns.queue = queue.New[*schema.NotificationMsg]("notification", store, serviceConfig.Queue)
+	return ns, ns.queue.Close
 }
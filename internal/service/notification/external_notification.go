@@ -27,6 +27,7 @@ import (
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/activity_common"
+	"github.com/apache/answer/internal/service/email_reply_common"
 	"github.com/apache/answer/internal/service/export"
 	"github.com/apache/answer/internal/service/notice_queue"
 	"github.com/apache/answer/internal/service/siteinfo_common"
@@ -45,6 +46,8 @@ type ExternalNotificationService struct {
 	notificationQueueService   notice_queue.ExternalNotificationQueueService
 	userExternalLoginRepo      user_external_login.UserExternalLoginRepo
 	siteInfoService            siteinfo_common.SiteInfoCommonService
+	notificationDigestRepo     NotificationDigestRepo
+	emailReplyCommon           *email_reply_common.EmailReplyCommon
 }
 
 func NewExternalNotificationService(
@@ -56,6 +59,8 @@ func NewExternalNotificationService(
 	notificationQueueService notice_queue.ExternalNotificationQueueService,
 	userExternalLoginRepo user_external_login.UserExternalLoginRepo,
 	siteInfoService siteinfo_common.SiteInfoCommonService,
+	notificationDigestRepo NotificationDigestRepo,
+	emailReplyCommon *email_reply_common.EmailReplyCommon,
 ) *ExternalNotificationService {
 	n := &ExternalNotificationService{
 		data:                       data,
@@ -66,6 +71,8 @@ func NewExternalNotificationService(
 		notificationQueueService:   notificationQueueService,
 		userExternalLoginRepo:      userExternalLoginRepo,
 		siteInfoService:            siteInfoService,
+		notificationDigestRepo:     notificationDigestRepo,
+		emailReplyCommon:           emailReplyCommon,
 	}
 	notificationQueueService.RegisterHandler(n.Handler)
 	return n
@@ -92,6 +99,12 @@ func (ns *ExternalNotificationService) Handler(ctx context.Context, msg *schema.
 	if msg.NewInviteAnswerTemplateRawData != nil {
 		return ns.handleInviteAnswerNotification(ctx, msg)
 	}
+	if msg.NewMessageTemplateRawData != nil {
+		return ns.handleNewMessageNotification(ctx, msg)
+	}
+	if msg.NewJobMatchTemplateRawData != nil {
+		return ns.handleNewJobMatchNotification(ctx, msg)
+	}
 	log.Errorf("unknown notification message: %+v", msg)
 	return nil
 }
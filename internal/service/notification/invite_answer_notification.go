@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/email_reply_common"
 	"github.com/segmentfault/pacman/i18n"
 	"github.com/segmentfault/pacman/log"
 )
@@ -42,7 +43,8 @@ func (ns *ExternalNotificationService) handleInviteAnswerNotification(ctx contex
 	}
 	channels := schema.NewNotificationChannelsFormJson(notificationConfig.Channels)
 	for _, channel := range channels {
-		if !channel.Enable {
+		// the digested channel is sent by the digest cron
+		if !channel.Enable || channel.IsDigest() {
 			continue
 		}
 		switch channel.Key {
@@ -78,6 +80,8 @@ func (ns *ExternalNotificationService) sendInviteAnswerNotificationEmail(ctx con
 		return
 	}
 
-	ns.emailService.SendAndSaveCodeWithTime(
-		ctx, userID, email, title, body, rawData.UnsubscribeCode, codeContent.ToJSONString(), 1*24*time.Hour)
+	// the reply to the email is posted as an answer to the question
+	replyTo := ns.emailReplyCommon.ReplyAddress(email_reply_common.ReplyKindAnswer, userID, rawData.QuestionID)
+	ns.emailService.SendAndSaveCodeWithReplyTo(ctx, userID, email, replyTo,
+		title, body, rawData.UnsubscribeCode, codeContent.ToJSONString(), 1*24*time.Hour)
 }
@@ -25,6 +25,7 @@ import (
 
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/email_reply_common"
 	"github.com/segmentfault/pacman/i18n"
 	"github.com/segmentfault/pacman/log"
 )
@@ -42,7 +43,8 @@ func (ns *ExternalNotificationService) handleNewCommentNotification(ctx context.
 	}
 	channels := schema.NewNotificationChannelsFormJson(notificationConfig.Channels)
 	for _, channel := range channels {
-		if !channel.Enable {
+		// the digested channel is sent by the digest cron
+		if !channel.Enable || channel.IsDigest() {
 			continue
 		}
 		switch channel.Key {
@@ -77,6 +79,8 @@ func (ns *ExternalNotificationService) sendNewCommentNotificationEmail(ctx conte
 		return
 	}
 
-	ns.emailService.SendAndSaveCodeWithTime(
-		ctx, userID, email, title, body, rawData.UnsubscribeCode, codeContent.ToJSONString(), 1*24*time.Hour)
+	// the reply to the email is posted as a reply to the comment
+	replyTo := ns.emailReplyCommon.ReplyAddress(email_reply_common.ReplyKindCommentReply, userID, rawData.CommentID)
+	ns.emailService.SendAndSaveCodeWithReplyTo(ctx, userID, email, replyTo,
+		title, body, rawData.UnsubscribeCode, codeContent.ToJSONString(), 1*24*time.Hour)
 }
@@ -102,6 +102,11 @@ func (ns *ExternalNotificationService) getNewQuestionSubscribers(ctx context.Con
 		if _, ok := subscribersMapping[userNotificationConfig.UserID]; ok {
 			continue
 		}
+		// the questions of the following tags are sent by the digest cron
+		if userNotificationConfig.Frequency == string(constant.NotificationFrequencyDaily) ||
+			userNotificationConfig.Frequency == string(constant.NotificationFrequencyWeekly) {
+			continue
+		}
 		subscribersMapping[userNotificationConfig.UserID] = &NewQuestionSubscriber{
 			UserID:             userNotificationConfig.UserID,
 			Channels:           schema.NewNotificationChannelsFormJson(userNotificationConfig.Channels),
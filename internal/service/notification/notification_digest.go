@@ -0,0 +1,237 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/token"
+	"github.com/segmentfault/pacman/i18n"
+	"github.com/segmentfault/pacman/log"
+)
+
+// NotificationDigestRepo the notifications and the questions collected into the digest email
+type NotificationDigestRepo interface {
+	GetUserNotificationsSince(ctx context.Context, userID string, since time.Time, limit int) (
+		notifications []*entity.Notification, err error)
+	GetTagsQuestionsSince(ctx context.Context, tagIDs []string, since time.Time, limit int) (
+		questions []*entity.Question, err error)
+}
+
+const (
+	// notificationDigestFetchLimit the max number of the notifications read for one digest
+	notificationDigestFetchLimit = 200
+	// notificationDigestTolerance the digest is sent hourly, so it is due a bit earlier to keep the same hour every day
+	notificationDigestTolerance = 30 * time.Minute
+)
+
+var notificationDigestPeriods = map[constant.NotificationFrequency]time.Duration{
+	constant.NotificationFrequencyDaily:  24 * time.Hour,
+	constant.NotificationFrequencyWeekly: 7 * 24 * time.Hour,
+}
+
+// SendNotificationDigests send the digest email to the users whose daily or weekly digest is due
+func (ns *ExternalNotificationService) SendNotificationDigests(ctx context.Context) {
+	now := time.Now()
+	for _, frequency := range []constant.NotificationFrequency{
+		constant.NotificationFrequencyDaily, constant.NotificationFrequencyWeekly} {
+		period := notificationDigestPeriods[frequency]
+		configs, err := ns.userNotificationConfigRepo.GetDueDigestConfigs(ctx, frequency,
+			now.Add(-period).Add(notificationDigestTolerance))
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		userIDs := make([]string, 0)
+		userConfigs := make(map[string][]*entity.UserNotificationConfig)
+		for _, conf := range configs {
+			if _, ok := userConfigs[conf.UserID]; !ok {
+				userIDs = append(userIDs, conf.UserID)
+			}
+			userConfigs[conf.UserID] = append(userConfigs[conf.UserID], conf)
+		}
+		for _, userID := range userIDs {
+			ns.sendNotificationDigest(ctx, userID, frequency, userConfigs[userID], now)
+		}
+	}
+}
+
+// sendNotificationDigest send one digest email covering all the due sources of the user,
+// the sources are marked as digested even if there is nothing to send
+func (ns *ExternalNotificationService) sendNotificationDigest(ctx context.Context, userID string,
+	frequency constant.NotificationFrequency, configs []*entity.UserNotificationConfig, now time.Time) {
+	configIDs := make([]string, 0, len(configs))
+	for _, conf := range configs {
+		configIDs = append(configIDs, conf.ID)
+	}
+	defer func() {
+		if err := ns.userNotificationConfigRepo.SetLastDigestAt(ctx, configIDs, now); err != nil {
+			log.Error(err)
+		}
+	}()
+
+	if unavailable := ns.checkUserStatusBeforeNotification(ctx, userID); unavailable {
+		return
+	}
+	userInfo, exist, err := ns.userRepo.GetByUserID(ctx, userID)
+	if err != nil || !exist {
+		return
+	}
+
+	period := notificationDigestPeriods[frequency]
+	rawData := &schema.NotificationDigestTemplateRawData{
+		Frequency:       frequency,
+		UnsubscribeCode: token.GenerateToken(),
+	}
+	sources := make([]constant.NotificationSource, 0, len(configs))
+	for _, conf := range configs {
+		if !isEmailDigestChannel(conf) {
+			continue
+		}
+		// never look back further than one period, e.g. the frequency has just been changed
+		since := now.Add(-period)
+		if conf.LastDigestAt.After(since) {
+			since = conf.LastDigestAt
+		}
+		switch constant.NotificationSource(conf.Source) {
+		case constant.InboxSource:
+			ns.collectInboxDigest(ctx, userID, since, rawData)
+		case constant.AllNewQuestionForFollowingTagsSource:
+			ns.collectFollowingTagsDigest(ctx, userID, since, rawData)
+		default:
+			continue
+		}
+		sources = append(sources, constant.NotificationSource(conf.Source))
+	}
+	if rawData.IsEmpty() {
+		return
+	}
+
+	// If receiver has set language, use it to send email.
+	if len(userInfo.Language) > 0 {
+		ctx = context.WithValue(ctx, constant.AcceptLanguageFlag, i18n.Language(userInfo.Language))
+	}
+	title, body, err := ns.emailService.NotificationDigestTemplate(ctx, rawData)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	codeContent := &schema.EmailCodeContent{
+		SourceType:               schema.UnsubscribeSourceType,
+		Email:                    userInfo.EMail,
+		UserID:                   userID,
+		NotificationSources:      sources,
+		SkipValidationLatestCode: true,
+	}
+	// the unsubscribe link keeps working until the digest after the next one
+	ns.emailService.SendAndSaveCodeWithTime(
+		ctx, userID, userInfo.EMail, title, body, rawData.UnsubscribeCode, codeContent.ToJSONString(), 2*period)
+}
+
+func isEmailDigestChannel(conf *entity.UserNotificationConfig) bool {
+	for _, channel := range schema.NewNotificationChannelsFormJson(conf.Channels) {
+		if channel.Key == constant.EmailChannel && channel.Enable && channel.IsDigest() {
+			return true
+		}
+	}
+	return false
+}
+
+// collectInboxDigest collect the new answers, comments, invitations and badges from the notifications of the user
+func (ns *ExternalNotificationService) collectInboxDigest(ctx context.Context, userID string, since time.Time,
+	rawData *schema.NotificationDigestTemplateRawData) {
+	notifications, err := ns.notificationDigestRepo.GetUserNotificationsSince(
+		ctx, userID, since, notificationDigestFetchLimit)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, notification := range notifications {
+		content := &schema.NotificationContent{}
+		if err := json.Unmarshal([]byte(notification.Content), content); err != nil {
+			log.Errorf("notification %s content is invalid: %v", notification.ID, err)
+			continue
+		}
+		item := &schema.NotificationDigestItem{
+			Title:      content.ObjectInfo.Title,
+			QuestionID: content.ObjectInfo.ObjectMap["question"],
+			AnswerID:   content.ObjectInfo.ObjectMap["answer"],
+			CommentID:  content.ObjectInfo.ObjectMap["comment"],
+		}
+		if content.UserInfo != nil {
+			item.DisplayName = content.UserInfo.DisplayName
+		}
+
+		if notification.Type == schema.NotificationTypeAchievement {
+			if content.ObjectInfo.ObjectType == constant.BadgeAwardObjectType {
+				rawData.Badges = appendDigestItem(rawData.Badges, &schema.NotificationDigestItem{
+					Title:   content.ObjectInfo.Title,
+					BadgeID: content.ObjectInfo.ObjectMap["badge_id"],
+				})
+			}
+			continue
+		}
+		switch content.NotificationAction {
+		case constant.NotificationAnswerTheQuestion:
+			rawData.Answers = appendDigestItem(rawData.Answers, item)
+		case constant.NotificationCommentQuestion, constant.NotificationCommentAnswer, constant.NotificationReplyToYou:
+			rawData.Comments = appendDigestItem(rawData.Comments, item)
+		case constant.NotificationInvitedYouToAnswer:
+			rawData.Invites = appendDigestItem(rawData.Invites, item)
+		}
+	}
+}
+
+// collectFollowingTagsDigest collect the new questions in the tags followed by the user
+func (ns *ExternalNotificationService) collectFollowingTagsDigest(ctx context.Context, userID string, since time.Time,
+	rawData *schema.NotificationDigestTemplateRawData) {
+	tagIDs, err := ns.followRepo.GetFollowIDs(ctx, userID, entity.Tag{}.TableName())
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	questions, err := ns.notificationDigestRepo.GetTagsQuestionsSince(
+		ctx, tagIDs, since, constant.NotificationDigestMaxItems+1)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, question := range questions {
+		if question.UserID == userID {
+			continue
+		}
+		rawData.Questions = appendDigestItem(rawData.Questions, &schema.NotificationDigestItem{
+			Title:      question.Title,
+			QuestionID: question.ID,
+		})
+	}
+}
+
+func appendDigestItem(items []*schema.NotificationDigestItem, item *schema.NotificationDigestItem) []*schema.NotificationDigestItem {
+	if len(items) >= constant.NotificationDigestMaxItems {
+		return items
+	}
+	return append(items, item)
+}
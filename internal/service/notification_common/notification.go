@@ -128,6 +128,20 @@ func (ns *NotificationCommon) AddNotification(ctx context.Context, msg *schema.N
 		objectMap := make(map[string]string)
 		objectMap["badge_id"] = msg.ExtraInfo["badge_id"]
 		req.ObjectInfo.ObjectMap = objectMap
+	} else if msg.ObjectType == constant.JobPostingObjectType || msg.ObjectType == constant.FreelancerVerificationObjectType {
+		req.ObjectInfo.Title = msg.Title
+		req.ObjectInfo.ObjectID = msg.ObjectID
+		req.ObjectInfo.ObjectMap = map[string]string{msg.ObjectType: msg.ObjectID}
+	} else if msg.ObjectType == constant.ConversationObjectType {
+		req.ObjectInfo.Title = msg.Title
+		req.ObjectInfo.ObjectID = msg.ObjectID
+		req.ObjectInfo.ObjectMap = map[string]string{constant.ConversationObjectType: msg.ObjectID}
+		objInfo = &schema.SimpleObjectInfo{
+			ObjectID:   msg.ObjectID,
+			ObjectType: msg.ObjectType,
+			Title:      msg.Title,
+			Content:    msg.ExtraInfo["message_summary"],
+		}
 	} else {
 		objInfo, err = ns.objectInfoService.GetInfo(ctx, req.ObjectInfo.ObjectID)
 		if err != nil {
@@ -395,6 +409,10 @@ func (ns *NotificationCommon) syncNotificationToPlugin(ctx context.Context, objI
 		pluginNotificationMsg.CommentUrl =
 			display.CommentURL(seoInfo.Permalink, siteInfo.SiteUrl, objInfo.QuestionID, objInfo.Title, objInfo.AnswerID, objInfo.CommentID)
 	}
+	if objInfo.ObjectType == constant.ConversationObjectType {
+		pluginNotificationMsg.ConversationUrl = display.ConversationURL(siteInfo.SiteUrl, objInfo.ObjectID)
+		pluginNotificationMsg.MessageSummary = objInfo.Content
+	}
 
 	if len(msg.TriggerUserID) > 0 {
 		triggerUser, exist, err := ns.userCommon.GetUserBasicInfoByID(ctx, msg.TriggerUserID)
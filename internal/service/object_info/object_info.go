@@ -24,9 +24,11 @@ import (
 
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/repo/freelancer"
 	"github.com/apache/answer/internal/schema"
 	answercommon "github.com/apache/answer/internal/service/answer_common"
 	"github.com/apache/answer/internal/service/comment_common"
+	"github.com/apache/answer/internal/service/contract_common"
 	questioncommon "github.com/apache/answer/internal/service/question_common"
 	tagcommon "github.com/apache/answer/internal/service/tag_common"
 	"github.com/apache/answer/pkg/checker"
@@ -36,11 +38,13 @@ import (
 
 // ObjService user service
 type ObjService struct {
-	answerRepo   answercommon.AnswerRepo
-	questionRepo questioncommon.QuestionRepo
-	commentRepo  comment_common.CommentCommonRepo
-	tagRepo      tagcommon.TagCommonRepo
-	tagCommon    *tagcommon.TagCommonService
+	answerRepo         answercommon.AnswerRepo
+	questionRepo       questioncommon.QuestionRepo
+	commentRepo        comment_common.CommentCommonRepo
+	tagRepo            tagcommon.TagCommonRepo
+	tagCommon          *tagcommon.TagCommonService
+	contractReviewRepo contract_common.ContractReviewRepo
+	freelancerRepo     freelancer.FreelancerRepo
 }
 
 // NewObjService new object service
@@ -50,13 +54,17 @@ func NewObjService(
 	commentRepo comment_common.CommentCommonRepo,
 	tagRepo tagcommon.TagCommonRepo,
 	tagCommon *tagcommon.TagCommonService,
+	contractReviewRepo contract_common.ContractReviewRepo,
+	freelancerRepo freelancer.FreelancerRepo,
 ) *ObjService {
 	return &ObjService{
-		answerRepo:   answerRepo,
-		questionRepo: questionRepo,
-		commentRepo:  commentRepo,
-		tagRepo:      tagRepo,
-		tagCommon:    tagCommon,
+		answerRepo:         answerRepo,
+		questionRepo:       questionRepo,
+		commentRepo:        commentRepo,
+		tagRepo:            tagRepo,
+		tagCommon:          tagCommon,
+		contractReviewRepo: contractReviewRepo,
+		freelancerRepo:     freelancerRepo,
 	}
 }
 func (os *ObjService) GetUnreviewedRevisionInfo(ctx context.Context, objectID string) (objInfo *schema.UnreviewedRevisionInfoInfo, err error) {
@@ -177,6 +185,40 @@ func (os *ObjService) GetUnreviewedRevisionInfo(ctx context.Context, objectID st
 				objInfo.AnswerID = answerInfo.ID
 			}
 		}
+	case constant.ContractReviewObjectType:
+		reviewInfo, exist, err := os.contractReviewRepo.GetContractReview(ctx, objectID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		objInfo = &schema.UnreviewedRevisionInfoInfo{
+			CreatedAt:           reviewInfo.CreatedAt.Unix(),
+			ObjectID:            reviewInfo.ID,
+			ObjectType:          objectType,
+			ObjectCreatorUserID: reviewInfo.ReviewerID,
+			Content:             reviewInfo.OriginalText,
+			Html:                reviewInfo.ParsedText,
+			Status:              reviewInfo.Status,
+		}
+	case constant.JobPostingObjectType:
+		posting, exist, err := os.freelancerRepo.GetJobPostingByID(ctx, objectID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		objInfo = &schema.UnreviewedRevisionInfoInfo{
+			CreatedAt:           posting.CreatedAt.Unix(),
+			ObjectID:            posting.ID,
+			ObjectType:          objectType,
+			ObjectCreatorUserID: posting.UserID,
+			Title:               posting.Title,
+			Content:             posting.Description,
+			Html:                posting.DescriptionHTML,
+		}
 	}
 	if objInfo == nil {
 		err = errors.BadRequest(reason.ObjectNotFound)
@@ -283,6 +325,22 @@ func (os *ObjService) GetInfo(ctx context.Context, objectID string) (objInfo *sc
 			Title:      tagInfo.SlugName,
 			Content:    tagInfo.ParsedText, // todo trim
 		}
+	case constant.ContractReviewObjectType:
+		reviewInfo, exist, err := os.contractReviewRepo.GetContractReview(ctx, objectID)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			break
+		}
+		objInfo = &schema.SimpleObjectInfo{
+			ObjectID:             reviewInfo.ID,
+			ObjectCreatorUserID:  reviewInfo.ReviewerID,
+			ContractReviewID:     reviewInfo.ID,
+			ContractReviewStatus: reviewInfo.Status,
+			ObjectType:           objectType,
+			Content:              reviewInfo.ParsedText, // todo trim
+		}
 	}
 	if objInfo == nil {
 		err = errors.BadRequest(reason.ObjectNotFound)
@@ -0,0 +1,70 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package permission
+
+import (
+	"context"
+
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/translator"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+)
+
+// GetJobPostingPermission get job posting permission
+func GetJobPostingPermission(ctx context.Context, userID string, creatorUserID string, status string,
+	canEdit, canDelete, canClose, canReopen bool) (
+	actions []*schema.PermissionMemberAction) {
+	lang := handler.GetLangByCtx(ctx)
+	actions = make([]*schema.PermissionMemberAction, 0)
+	if len(userID) == 0 {
+		return actions
+	}
+	isCreator := userID == creatorUserID
+	if canEdit || isCreator {
+		actions = append(actions, &schema.PermissionMemberAction{
+			Action: "edit",
+			Name:   translator.Tr(lang, editActionName),
+			Type:   "edit",
+		})
+	}
+	if (canClose || isCreator) && status == entity.JobPostingStatusOpen {
+		actions = append(actions, &schema.PermissionMemberAction{
+			Action: "close",
+			Name:   translator.Tr(lang, closeActionName),
+			Type:   "confirm",
+		})
+	}
+	if (canReopen || isCreator) && status != entity.JobPostingStatusOpen {
+		actions = append(actions, &schema.PermissionMemberAction{
+			Action: "reopen",
+			Name:   translator.Tr(lang, reopenActionName),
+			Type:   "confirm",
+		})
+	}
+	if canDelete || isCreator {
+		actions = append(actions, &schema.PermissionMemberAction{
+			Action: "delete",
+			Name:   translator.Tr(lang, deleteActionName),
+			Type:   "confirm",
+		})
+	}
+	return actions
+}
@@ -63,6 +63,13 @@ const (
 	AnswerUnDelete              = "answer.undeleted"
 	QuestionUnDelete            = "question.undeleted"
 	TagUnDelete                 = "tag.undeleted"
+	JobPostingEdit              = "job_posting.edit"
+	JobPostingDelete            = "job_posting.delete"
+	JobPostingClose             = "job_posting.close"
+	JobPostingReopen            = "job_posting.reopen"
+	JobPostingAdd               = "job_posting.add"
+	JobApplicationAdd           = "job_application.add"
+	FreelancerHire              = "freelancer.hire"
 )
 
 const (
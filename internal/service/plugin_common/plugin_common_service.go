@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 
 	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/plugin/embedded_search"
 	"github.com/apache/answer/internal/repo/search_sync"
 
 	"github.com/segmentfault/pacman/errors"
@@ -135,6 +136,8 @@ func (ps *PluginCommonService) GetUserPluginConfig(ctx context.Context, req *sch
 }
 
 func (ps *PluginCommonService) initPluginData() {
+	embedded_search.SetDefaultSyncer(search_sync.NewPluginSyncer(ps.data))
+
 	_ = plugin.CallKVStorage(func(k plugin.KVStorage) error {
 		k.SetOperator(plugin.NewKVOperator(
 			ps.data.DB,
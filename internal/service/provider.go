@@ -33,13 +33,19 @@ import (
 	"github.com/apache/answer/internal/service/comment_common"
 	"github.com/apache/answer/internal/service/config"
 	"github.com/apache/answer/internal/service/content"
+	"github.com/apache/answer/internal/service/contract"
+	"github.com/apache/answer/internal/service/conversation"
+	"github.com/apache/answer/internal/service/currency"
 	"github.com/apache/answer/internal/service/dashboard"
+	"github.com/apache/answer/internal/service/email_reply"
+	"github.com/apache/answer/internal/service/email_reply_common"
 	"github.com/apache/answer/internal/service/event_queue"
 	"github.com/apache/answer/internal/service/export"
 	"github.com/apache/answer/internal/service/file_record"
 	"github.com/apache/answer/internal/service/follow"
 	"github.com/apache/answer/internal/service/freelancer"
 	"github.com/apache/answer/internal/service/importer"
+	"github.com/apache/answer/internal/service/job_matching"
 	"github.com/apache/answer/internal/service/meta"
 	"github.com/apache/answer/internal/service/meta_common"
 	"github.com/apache/answer/internal/service/notice_queue"
@@ -50,6 +56,7 @@ import (
 	questioncommon "github.com/apache/answer/internal/service/question_common"
 	"github.com/apache/answer/internal/service/rank"
 	"github.com/apache/answer/internal/service/reason"
+	"github.com/apache/answer/internal/service/related_question"
 	"github.com/apache/answer/internal/service/report"
 	"github.com/apache/answer/internal/service/report_handle"
 	"github.com/apache/answer/internal/service/review"
@@ -65,6 +72,7 @@ import (
 	usercommon "github.com/apache/answer/internal/service/user_common"
 	"github.com/apache/answer/internal/service/user_external_login"
 	"github.com/apache/answer/internal/service/user_notification_config"
+	"github.com/apache/answer/internal/service/webhook"
 	"github.com/google/wire"
 )
 
@@ -95,6 +103,7 @@ var ProviderSetService = wire.NewSet(
 	rank.NewRankService,
 	search_parser.NewSearchParser,
 	content.NewSearchService,
+	related_question.NewRelatedQuestionService,
 	metacommon.NewMetaCommonService,
 	object_info.NewObjService,
 	report_handle.NewReportHandle,
@@ -130,4 +139,11 @@ var ProviderSetService = wire.NewSet(
 	importer.NewImporterService,
 	file_record.NewFileRecordService,
 	freelancer.NewFreelancerService,
+	job_matching.NewJobMatchingService,
+	contract.NewContractService,
+	currency.NewCurrencyService,
+	conversation.NewConversationService,
+	webhook.NewWebhookService,
+	email_reply_common.NewEmailReplyCommon,
+	email_reply.NewEmailReplyService,
 )
@@ -0,0 +1,359 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package rank
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/config"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/apache/answer/internal/service/role"
+	usercommon "github.com/apache/answer/internal/service/user_common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockUserRepo struct {
+	mock.Mock
+}
+
+type MockSiteInfoService struct {
+	mock.Mock
+}
+
+type MockUserRoleRelRepo struct {
+	mock.Mock
+}
+
+type MockRolePowerRelRepo struct {
+	mock.Mock
+}
+
+type MockConfigRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserRepo) AddUser(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseAnswerCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IncreaseQuestionCount(ctx context.Context, userID string, amount int) error {
+	args := m.Called(ctx, userID, amount)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateQuestionCount(ctx context.Context, userID string, count int64) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateAnswerCount(ctx context.Context, userID string, count int) error {
+	args := m.Called(ctx, userID, count)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateLastLoginDate(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmailStatus(ctx context.Context, userID string, emailStatus int) error {
+	args := m.Called(ctx, userID, emailStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateNoticeStatus(ctx context.Context, userID string, noticeStatus int) error {
+	args := m.Called(ctx, userID, noticeStatus)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateEmail(ctx context.Context, userID string, email string) error {
+	args := m.Called(ctx, userID, email)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateUserInterface(ctx context.Context, userID string, language string, colorSchema string) error {
+	args := m.Called(ctx, userID, language, colorSchema)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdatePass(ctx context.Context, userID string, pass string) error {
+	args := m.Called(ctx, userID, pass)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateInfo(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateUserProfile(ctx context.Context, userInfo *entity.User) error {
+	args := m.Called(ctx, userInfo)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetByUserID(ctx context.Context, userID string) (*entity.User, bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) BatchGetByID(ctx context.Context, ids []string) ([]*entity.User, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByUsername(ctx context.Context, username string) (*entity.User, bool, error) {
+	args := m.Called(ctx, username)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetByUsernames(ctx context.Context, usernames []string) ([]*entity.User, error) {
+	args := m.Called(ctx, usernames)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) GetByEmail(ctx context.Context, email string) (*entity.User, bool, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(*entity.User), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRepo) GetUserCount(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepo) SearchUserListByName(ctx context.Context, name string, limit int, onlyStaff bool) ([]*entity.User, error) {
+	args := m.Called(ctx, name, limit, onlyStaff)
+	return args.Get(0).([]*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepo) IsAvatarFileUsed(ctx context.Context, filePath string) (bool, error) {
+	args := m.Called(ctx, filePath)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteGeneral(ctx context.Context) (*schema.SiteGeneralResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteGeneralResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteInterface(ctx context.Context) (*schema.SiteInterfaceResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteInterfaceResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteBranding(ctx context.Context) (*schema.SiteBrandingResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteBrandingResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteUsers(ctx context.Context) (*schema.SiteUsersResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteUsersResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteJob(ctx context.Context) (*schema.SiteJobResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteJobResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteCurrency(ctx context.Context) (*schema.SiteCurrencyResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteCurrencyResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) FormatAvatar(ctx context.Context, originalAvatarData string, email string, userStatus int) *schema.AvatarInfo {
+	args := m.Called(ctx, originalAvatarData, email, userStatus)
+	return args.Get(0).(*schema.AvatarInfo)
+}
+
+func (m *MockSiteInfoService) FormatListAvatar(ctx context.Context, userList []*entity.User) map[string]*schema.AvatarInfo {
+	args := m.Called(ctx, userList)
+	return args.Get(0).(map[string]*schema.AvatarInfo)
+}
+
+func (m *MockSiteInfoService) GetSiteWrite(ctx context.Context) (*schema.SiteWriteResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteWriteResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteLegal(ctx context.Context) (*schema.SiteLegalResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteLegalResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteLogin(ctx context.Context) (*schema.SiteLoginResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteLoginResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteCustomCssHTML(ctx context.Context) (*schema.SiteCustomCssHTMLResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteCustomCssHTMLResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteTheme(ctx context.Context) (*schema.SiteThemeResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteThemeResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteSeo(ctx context.Context) (*schema.SiteSeoResp, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*schema.SiteSeoResp), args.Error(1)
+}
+
+func (m *MockSiteInfoService) GetSiteInfoByType(ctx context.Context, siteType string, resp interface{}) error {
+	args := m.Called(ctx, siteType, resp)
+	return args.Error(0)
+}
+
+func (m *MockSiteInfoService) IsBrandingFileUsed(ctx context.Context, filePath string) bool {
+	args := m.Called(ctx, filePath)
+	return args.Bool(0)
+}
+
+func (m *MockUserRoleRelRepo) SaveUserRoleRel(ctx context.Context, userID string, roleID int) error {
+	args := m.Called(ctx, userID, roleID)
+	return args.Error(0)
+}
+
+func (m *MockUserRoleRelRepo) GetUserRoleRelList(ctx context.Context, userIDs []string) ([]*entity.UserRoleRel, error) {
+	args := m.Called(ctx, userIDs)
+	return args.Get(0).([]*entity.UserRoleRel), args.Error(1)
+}
+
+func (m *MockUserRoleRelRepo) GetUserRoleRelListByRoleID(ctx context.Context, roleIDs []int) ([]*entity.UserRoleRel, error) {
+	args := m.Called(ctx, roleIDs)
+	return args.Get(0).([]*entity.UserRoleRel), args.Error(1)
+}
+
+func (m *MockUserRoleRelRepo) GetUserRoleRel(ctx context.Context, userID string) (*entity.UserRoleRel, bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*entity.UserRoleRel), args.Bool(1), args.Error(2)
+}
+
+func (m *MockUserRoleRelRepo) SaveUsersRoleRel(ctx context.Context, userIDs []string, roleID int) error {
+	args := m.Called(ctx, userIDs, roleID)
+	return args.Error(0)
+}
+
+func (m *MockRolePowerRelRepo) GetRolePowerTypeList(ctx context.Context, roleID int) ([]string, error) {
+	args := m.Called(ctx, roleID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRolePowerRelRepo) SaveRolePowerTypes(ctx context.Context, roleID int, powerTypes []string) error {
+	args := m.Called(ctx, roleID, powerTypes)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) GetConfigByID(ctx context.Context, id int) (*entity.Config, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*entity.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) GetConfigByKey(ctx context.Context, key string) (*entity.Config, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(*entity.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) UpdateConfig(ctx context.Context, key string, value string) error {
+	args := m.Called(ctx, key, value)
+	return args.Error(0)
+}
+
+// newTestRankService new rank service with the user of the rank and role, the required ranks are the default of a fresh install
+func newTestRankService(rank, roleID int, powers []string) *RankService {
+	mockUserRepo := new(MockUserRepo)
+	mockUserRepo.On("GetByUserID", mock.Anything, "user").Return(&entity.User{ID: "user", Rank: rank}, true, nil)
+	mockSiteInfoService := new(MockSiteInfoService)
+	mockSiteInfoService.On("FormatAvatar", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&schema.AvatarInfo{})
+	mockUserRoleRelRepo := new(MockUserRoleRelRepo)
+	mockUserRoleRelRepo.On("GetUserRoleRel", mock.Anything, "user").Return(
+		&entity.UserRoleRel{UserID: "user", RoleID: roleID}, true, nil)
+	mockRolePowerRelRepo := new(MockRolePowerRelRepo)
+	mockRolePowerRelRepo.On("GetRolePowerTypeList", mock.Anything, roleID).Return(powers, nil)
+	mockConfigRepo := new(MockConfigRepo)
+	for key, value := range map[string]string{
+		"rank.job_posting.add":     "50",
+		"rank.job_application.add": "10",
+		"rank.freelancer.hire":     "50",
+	} {
+		mockConfigRepo.On("GetConfigByKey", mock.Anything, key).Return(&entity.Config{Key: key, Value: value}, nil)
+	}
+
+	userRoleRelService := role.NewUserRoleRelService(mockUserRoleRelRepo, nil)
+	return NewRankService(usercommon.NewUserCommon(mockUserRepo, userRoleRelService, nil, mockSiteInfoService), nil, nil,
+		userRoleRelService, role.NewRolePowerRelService(mockRolePowerRelRepo, userRoleRelService),
+		config.NewConfigService(mockConfigRepo))
+}
+
+func TestCheckOperationPermissionsForRanksFreelancer(t *testing.T) {
+	ctx := context.Background()
+	actions := []string{permission.JobPostingAdd, permission.JobApplicationAdd, permission.FreelancerHire}
+
+	t.Run("new_user_can_not_post_apply_or_hire", func(t *testing.T) {
+		rs := newTestRankService(1, 1, nil)
+		can, requireRanks, err := rs.CheckOperationPermissionsForRanks(ctx, "user", actions)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, false, false}, can)
+		assert.Equal(t, []int{50, 10, 50}, requireRanks)
+	})
+
+	t.Run("user_can_apply_before_posting", func(t *testing.T) {
+		rs := newTestRankService(10, 1, nil)
+		can, requireRanks, err := rs.CheckOperationPermissionsForRanks(ctx, "user", actions)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, true, false}, can)
+		assert.Equal(t, 50, requireRanks[0])
+	})
+
+	t.Run("user_with_enough_rank", func(t *testing.T) {
+		rs := newTestRankService(50, 1, nil)
+		can, _, err := rs.CheckOperationPermissionsForRanks(ctx, "user", actions)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true, true}, can)
+	})
+
+	t.Run("moderator_is_not_gated_by_rank", func(t *testing.T) {
+		rs := newTestRankService(1, 3, actions)
+		can, _, err := rs.CheckOperationPermissionsForRanks(ctx, "user", actions)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true, true, true}, can)
+	})
+
+	t.Run("guest_can_not_do_anything", func(t *testing.T) {
+		rs := newTestRankService(50, 1, nil)
+		can, _, err := rs.CheckOperationPermissionsForRanks(ctx, "", actions)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{false, false, false}, can)
+	})
+}
@@ -0,0 +1,289 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package related_question
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/pkg/htmltext"
+	"github.com/apache/answer/pkg/uid"
+	"github.com/segmentfault/pacman/log"
+)
+
+const (
+	// relatedQuestionLimit the max number of related questions
+	relatedQuestionLimit = 6
+	// relatedCandidateLimit the max number of candidates from shared tags or title terms
+	relatedCandidateLimit = 50
+
+	directLinkWeight = 3.0
+	twoHopLinkWeight = 1.0
+	sharedTagWeight  = 2.0
+	termWeight       = 2.0
+
+	// minTermLength the min rune length of a title term
+	minTermLength = 3
+	// maxTitleTerms the max number of title terms used to find candidates
+	maxTitleTerms = 8
+)
+
+// titleStopWords the common words ignored in title terms
+var titleStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "how": true, "what": true, "why": true,
+	"when": true, "where": true, "which": true, "who": true, "can": true, "does": true, "not": true,
+	"are": true, "was": true, "this": true, "that": true, "from": true, "into": true, "use": true,
+	"using": true, "get": true, "you": true, "your": true, "there": true, "have": true, "has": true,
+}
+
+// RelatedQuestionRepo related question repository
+type RelatedQuestionRepo interface {
+	GetQuestionTagIDs(ctx context.Context, questionID string) (tagIDs []string, err error)
+	GetSharedTagCounts(ctx context.Context, questionID string, tagIDs []string, limit int) (
+		sharedCount map[string]int, err error)
+	GetLinkedQuestionIDs(ctx context.Context, questionIDs []string) (linked map[string][]string, err error)
+	GetQuestionIDsByTitleTerms(ctx context.Context, questionID string, terms []string, limit int) (
+		questionIDs []string, err error)
+	GetQuestionsByIDs(ctx context.Context, questionIDs []string) (questions []*entity.Question, err error)
+}
+
+// RelatedQuestionService related question service
+type RelatedQuestionService struct {
+	data                *data.Data
+	relatedQuestionRepo RelatedQuestionRepo
+}
+
+// NewRelatedQuestionService new related question service
+func NewRelatedQuestionService(
+	data *data.Data,
+	relatedQuestionRepo RelatedQuestionRepo,
+) *RelatedQuestionService {
+	return &RelatedQuestionService{
+		data:                data,
+		relatedQuestionRepo: relatedQuestionRepo,
+	}
+}
+
+// GetRelatedQuestions get the questions related to the question by shared tags, links between questions
+// and common title terms. The result is cached for a while because it is shown on every question page.
+func (rs *RelatedQuestionService) GetRelatedQuestions(ctx context.Context, questionID string) (
+	resp []*schema.QuestionBaseInfo, err error) {
+	questionID = uid.DeShortID(questionID)
+	cacheKey := constant.RelatedQuestionCacheKeyPrefix + questionID
+	if handler.GetEnableShortID(ctx) {
+		cacheKey += ":short"
+	}
+
+	cached, exist, err := rs.data.Cache.GetString(ctx, cacheKey)
+	if err == nil && exist && json.Unmarshal([]byte(cached), &resp) == nil {
+		return resp, nil
+	}
+
+	resp, err = rs.getRelatedQuestions(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	content, _ := json.Marshal(resp)
+	if err := rs.data.Cache.SetString(ctx, cacheKey, string(content), constant.RelatedQuestionCacheTime); err != nil {
+		log.Error(err)
+	}
+	return resp, nil
+}
+
+func (rs *RelatedQuestionService) getRelatedQuestions(ctx context.Context, questionID string) (
+	resp []*schema.QuestionBaseInfo, err error) {
+	resp = make([]*schema.QuestionBaseInfo, 0)
+	sources, err := rs.relatedQuestionRepo.GetQuestionsByIDs(ctx, []string{questionID})
+	if err != nil || len(sources) == 0 {
+		return resp, err
+	}
+	sourceTerms := titleTerms(sources[0].Title)
+
+	scores := make(map[string]float64)
+
+	// shared tags
+	tagIDs, err := rs.relatedQuestionRepo.GetQuestionTagIDs(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tagIDs) > 0 {
+		sharedCount, err := rs.relatedQuestionRepo.GetSharedTagCounts(ctx, questionID, tagIDs, relatedCandidateLimit)
+		if err != nil {
+			return nil, err
+		}
+		for id, count := range sharedCount {
+			scores[id] += sharedTagWeight * float64(count) / float64(len(tagIDs))
+		}
+	}
+
+	// links between questions, the questions linked by the linked questions are also related but less
+	linked, err := rs.relatedQuestionRepo.GetLinkedQuestionIDs(ctx, []string{questionID})
+	if err != nil {
+		return nil, err
+	}
+	directIDs := linked[questionID]
+	if len(directIDs) > 0 {
+		twoHop, err := rs.relatedQuestionRepo.GetLinkedQuestionIDs(ctx, directIDs)
+		if err != nil {
+			return nil, err
+		}
+		addLinkScores(scores, questionID, directIDs, twoHop)
+	}
+
+	// title terms
+	if len(sourceTerms) > 0 {
+		terms := sourceTerms
+		if len(terms) > maxTitleTerms {
+			terms = terms[:maxTitleTerms]
+		}
+		ids, err := rs.relatedQuestionRepo.GetQuestionIDsByTitleTerms(ctx, questionID, terms, relatedCandidateLimit)
+		if err != nil {
+			return nil, err
+		}
+		// the term similarity is scored in rankRelatedQuestions with the titles
+		for _, id := range ids {
+			scores[id] += 0
+		}
+	}
+	delete(scores, questionID)
+	if len(scores) == 0 {
+		return resp, nil
+	}
+
+	candidateIDs := make([]string, 0, len(scores))
+	for id := range scores {
+		candidateIDs = append(candidateIDs, id)
+	}
+	candidates, err := rs.relatedQuestionRepo.GetQuestionsByIDs(ctx, candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, question := range rankRelatedQuestions(candidates, scores, sourceTerms, relatedQuestionLimit) {
+		item := &schema.QuestionBaseInfo{
+			ID:              question.ID,
+			Title:           question.Title,
+			UrlTitle:        htmltext.UrlTitle(question.Title),
+			ViewCount:       question.ViewCount,
+			AnswerCount:     question.AnswerCount,
+			CollectionCount: question.CollectionCount,
+			FollowCount:     question.FollowCount,
+			AcceptedAnswer:  question.AcceptedAnswerID != "0" && question.AcceptedAnswerID != "",
+		}
+		if status, ok := entity.AdminQuestionSearchStatusIntToString[question.Status]; ok {
+			item.Status = status
+		}
+		if handler.GetEnableShortID(ctx) {
+			item.ID = uid.EnShortID(item.ID)
+		}
+		resp = append(resp, item)
+	}
+	return resp, nil
+}
+
+// addLinkScores adds the scores of the questions linked directly, and the questions linked by them
+func addLinkScores(scores map[string]float64, questionID string, directIDs []string, twoHop map[string][]string) {
+	direct := make(map[string]bool, len(directIDs))
+	for _, id := range directIDs {
+		direct[id] = true
+		scores[id] += directLinkWeight
+	}
+	counted := make(map[string]bool)
+	for _, id := range directIDs {
+		for _, hopID := range twoHop[id] {
+			if hopID == questionID || direct[hopID] || counted[hopID] {
+				continue
+			}
+			counted[hopID] = true
+			scores[hopID] += twoHopLinkWeight
+		}
+	}
+}
+
+// rankRelatedQuestions adds the title term similarity to the scores and returns the top questions,
+// the more popular question is preferred if the scores are equal
+func rankRelatedQuestions(candidates []*entity.Question, scores map[string]float64,
+	sourceTerms []string, limit int) []*entity.Question {
+	total := make(map[string]float64, len(candidates))
+	for _, question := range candidates {
+		total[question.ID] = scores[question.ID] + termWeight*termSimilarity(sourceTerms, titleTerms(question.Title))
+	}
+	ranked := make([]*entity.Question, 0, len(candidates))
+	for _, question := range candidates {
+		if total[question.ID] > 0 {
+			ranked = append(ranked, question)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if total[ranked[i].ID] != total[ranked[j].ID] {
+			return total[ranked[i].ID] > total[ranked[j].ID]
+		}
+		if ranked[i].ViewCount != ranked[j].ViewCount {
+			return ranked[i].ViewCount > ranked[j].ViewCount
+		}
+		return ranked[i].AnswerCount > ranked[j].AnswerCount
+	})
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// titleTerms splits the title into distinct lowercase terms, short terms and stop words are ignored
+func titleTerms(title string) []string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	terms := make([]string, 0, len(words))
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		if len([]rune(word)) < minTermLength || titleStopWords[word] || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(terms, word)
+	}
+	return terms
+}
+
+// termSimilarity the Jaccard similarity of the two term sets
+func termSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	set := make(map[string]bool, len(a))
+	for _, term := range a {
+		set[term] = true
+	}
+	intersection := 0
+	for _, term := range b {
+		if set[term] {
+			intersection++
+		}
+	}
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
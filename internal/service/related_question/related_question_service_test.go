@@ -0,0 +1,130 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package related_question
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apache/answer/internal/base/data"
+	"github.com/apache/answer/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockRelatedQuestionRepo struct {
+	mock.Mock
+}
+
+func (m *MockRelatedQuestionRepo) GetQuestionTagIDs(ctx context.Context, questionID string) ([]string, error) {
+	args := m.Called(ctx, questionID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRelatedQuestionRepo) GetSharedTagCounts(ctx context.Context, questionID string, tagIDs []string, limit int) (map[string]int, error) {
+	args := m.Called(ctx, questionID, tagIDs, limit)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func (m *MockRelatedQuestionRepo) GetLinkedQuestionIDs(ctx context.Context, questionIDs []string) (map[string][]string, error) {
+	args := m.Called(ctx, questionIDs)
+	return args.Get(0).(map[string][]string), args.Error(1)
+}
+
+func (m *MockRelatedQuestionRepo) GetQuestionIDsByTitleTerms(ctx context.Context, questionID string, terms []string, limit int) ([]string, error) {
+	args := m.Called(ctx, questionID, terms, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRelatedQuestionRepo) GetQuestionsByIDs(ctx context.Context, questionIDs []string) ([]*entity.Question, error) {
+	args := m.Called(ctx, questionIDs)
+	return args.Get(0).([]*entity.Question), args.Error(1)
+}
+
+func TestTitleTerms(t *testing.T) {
+	assert.Equal(t, []string{"configure", "nginx", "reverse", "proxy"},
+		titleTerms("How to configure Nginx as a reverse-proxy? nginx"))
+	assert.Empty(t, titleTerms("How do I do it?"))
+}
+
+func TestTermSimilarity(t *testing.T) {
+	assert.Equal(t, 0.0, termSimilarity(nil, []string{"go"}))
+	assert.Equal(t, 1.0, termSimilarity([]string{"nginx", "proxy"}, []string{"proxy", "nginx"}))
+	assert.InDelta(t, 1.0/3, termSimilarity([]string{"nginx", "proxy"}, []string{"proxy", "apache"}), 1e-9)
+}
+
+func TestAddLinkScores(t *testing.T) {
+	scores := map[string]float64{"2": 1}
+	addLinkScores(scores, "1", []string{"2", "3"}, map[string][]string{
+		"2": {"1", "3", "4"},
+		"3": {"4", "5"},
+	})
+	assert.Equal(t, map[string]float64{
+		"2": 1 + directLinkWeight,
+		"3": directLinkWeight,
+		"4": twoHopLinkWeight,
+		"5": twoHopLinkWeight,
+	}, scores)
+}
+
+func TestRankRelatedQuestions(t *testing.T) {
+	candidates := []*entity.Question{
+		{ID: "2", Title: "Unrelated title", ViewCount: 100},
+		{ID: "3", Title: "Nginx reverse proxy timeout", ViewCount: 1},
+		{ID: "4", Title: "Something else", ViewCount: 50},
+		{ID: "5", Title: "Another one", ViewCount: 10},
+		{ID: "6", Title: "Nothing in common"},
+	}
+	scores := map[string]float64{"2": 1, "4": 1, "5": 3}
+	ranked := rankRelatedQuestions(candidates, scores, titleTerms("Nginx reverse proxy"), 3)
+
+	ids := make([]string, 0, len(ranked))
+	for _, question := range ranked {
+		ids = append(ids, question.ID)
+	}
+	assert.Equal(t, []string{"5", "3", "2"}, ids)
+}
+
+func TestGetRelatedQuestionsCached(t *testing.T) {
+	ctx := context.Background()
+	cache, _, err := data.NewCache(&data.CacheConf{})
+	require.NoError(t, err)
+	mockRepo := new(MockRelatedQuestionRepo)
+	mockRepo.On("GetQuestionsByIDs", ctx, []string{"10010000000000001"}).
+		Return([]*entity.Question{{ID: "10010000000000001", Title: "How do I do it?"}}, nil).Once()
+	mockRepo.On("GetQuestionTagIDs", ctx, "10010000000000001").Return([]string{}, nil).Once()
+	mockRepo.On("GetLinkedQuestionIDs", ctx, []string{"10010000000000001"}).
+		Return(map[string][]string{"10010000000000001": {"10010000000000002"}}, nil).Once()
+	mockRepo.On("GetLinkedQuestionIDs", ctx, []string{"10010000000000002"}).Return(map[string][]string{}, nil).Once()
+	mockRepo.On("GetQuestionsByIDs", ctx, []string{"10010000000000002"}).
+		Return([]*entity.Question{{ID: "10010000000000002", Title: "Linked question", Status: entity.QuestionStatusAvailable}}, nil).Once()
+	rs := NewRelatedQuestionService(&data.Data{Cache: cache}, mockRepo)
+
+	// the second call is served from the cache, the repo is only called once
+	for i := 0; i < 2; i++ {
+		resp, err := rs.GetRelatedQuestions(ctx, "10010000000000001")
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		assert.Equal(t, "10010000000000002", resp[0].ID)
+		assert.Equal(t, "linked-question", resp[0].UrlTitle)
+	}
+	mockRepo.AssertExpectations(t)
+}
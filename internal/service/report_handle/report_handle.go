@@ -27,6 +27,7 @@ import (
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/comment"
 	"github.com/apache/answer/internal/service/content"
+	"github.com/apache/answer/internal/service/contract"
 	"github.com/apache/answer/pkg/converter"
 	"github.com/apache/answer/pkg/obj"
 )
@@ -35,17 +36,20 @@ type ReportHandle struct {
 	questionService *content.QuestionService
 	answerService   *content.AnswerService
 	commentService  *comment.CommentService
+	contractService *contract.ContractService
 }
 
 func NewReportHandle(
 	questionService *content.QuestionService,
 	answerService *content.AnswerService,
 	commentService *comment.CommentService,
+	contractService *contract.ContractService,
 ) *ReportHandle {
 	return &ReportHandle{
 		questionService: questionService,
 		answerService:   answerService,
 		commentService:  commentService,
+		contractService: contractService,
 	}
 }
 
@@ -63,6 +67,8 @@ func (rh *ReportHandle) UpdateReportedObject(ctx context.Context,
 		err = rh.updateReportedAnswerReport(ctx, report, req)
 	case constant.CommentObjectType:
 		err = rh.updateReportedCommentReport(ctx, report, req)
+	case constant.ContractReviewObjectType:
+		err = rh.updateReportedContractReviewReport(ctx, report, req)
 	}
 	return
 }
@@ -130,3 +136,11 @@ func (rh *ReportHandle) updateReportedCommentReport(ctx context.Context, report
 	}
 	return nil
 }
+
+func (rh *ReportHandle) updateReportedContractReviewReport(ctx context.Context, report *entity.Report, req *schema.ReviewReportReq) (err error) {
+	if req.OperationType == constant.ReportOperationDeletePost {
+		err = rh.contractService.RemoveContractReview(ctx, &schema.RemoveContractReviewReq{
+			ID: report.ObjectID, LoginUserID: req.UserID, IsAdmin: true})
+	}
+	return
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
 	answercommon "github.com/apache/answer/internal/service/answer_common"
+	"github.com/apache/answer/internal/service/contract_common"
 	"github.com/apache/answer/internal/service/notice_queue"
 	"github.com/apache/answer/internal/service/object_info"
 	questioncommon "github.com/apache/answer/internal/service/question_common"
@@ -68,8 +69,13 @@ type ReviewService struct {
 	externalNotificationQueueService notice_queue.ExternalNotificationQueueService
 	notificationQueueService         notice_queue.NotificationQueueService
 	siteInfoService                  siteinfo_common.SiteInfoCommonService
+	contractReviewRepo               contract_common.ContractReviewRepo
+	jobPostingReviewHandler          JobPostingReviewHandler
 }
 
+// JobPostingReviewHandler update the job posting which is approved or rejected in the review queue
+type JobPostingReviewHandler func(ctx context.Context, postingID string, isApprove bool) error
+
 // NewReviewService new review service
 func NewReviewService(
 	reviewRepo ReviewRepo,
@@ -84,6 +90,7 @@ func NewReviewService(
 	questionCommon *questioncommon.QuestionCommon,
 	notificationQueueService notice_queue.NotificationQueueService,
 	siteInfoService siteinfo_common.SiteInfoCommonService,
+	contractReviewRepo contract_common.ContractReviewRepo,
 ) *ReviewService {
 	return &ReviewService{
 		reviewRepo:                       reviewRepo,
@@ -98,6 +105,7 @@ func NewReviewService(
 		questionCommon:                   questionCommon,
 		notificationQueueService:         notificationQueueService,
 		siteInfoService:                  siteInfoService,
+		contractReviewRepo:               contractReviewRepo,
 	}
 }
 
@@ -153,6 +161,56 @@ func (cs *ReviewService) AddAnswerReview(ctx context.Context,
 	return answerStatus
 }
 
+// AddContractReviewReview add review for contract review if needed
+func (cs *ReviewService) AddContractReviewReview(ctx context.Context,
+	review *entity.ContractReview, ip, ua string) (reviewStatus int) {
+	reviewContent := &plugin.ReviewContent{
+		ObjectType: constant.ContractReviewObjectType,
+		Content:    review.ParsedText,
+		IP:         ip,
+		UserAgent:  ua,
+	}
+	reviewContent.Author = cs.getReviewContentAuthorInfo(ctx, review.ReviewerID)
+	switch cs.callPluginToReview(ctx, review.ReviewerID, review.ID, reviewContent) {
+	case plugin.ReviewStatusNeedReview:
+		reviewStatus = entity.ContractReviewStatusPending
+	case plugin.ReviewStatusDeleteDirectly:
+		reviewStatus = entity.ContractReviewStatusDeleted
+	default:
+		reviewStatus = entity.ContractReviewStatusAvailable
+	}
+	return reviewStatus
+}
+
+// RegisterJobPostingReviewHandler register the handler of the reviewed job posting.
+// The job posting is managed by the freelancer service which depends on the review service.
+func (cs *ReviewService) RegisterJobPostingReviewHandler(handler JobPostingReviewHandler) {
+	cs.jobPostingReviewHandler = handler
+}
+
+// AddJobPostingReview add review for job posting if needed
+func (cs *ReviewService) AddJobPostingReview(ctx context.Context,
+	posting *entity.JobPosting, skills []string, ip, ua string) (postingStatus string) {
+	reviewContent := &plugin.ReviewContent{
+		ObjectType: constant.JobPostingObjectType,
+		Title:      posting.Title,
+		Content:    posting.DescriptionHTML,
+		Tags:       skills,
+		IP:         ip,
+		UserAgent:  ua,
+	}
+	reviewContent.Author = cs.getReviewContentAuthorInfo(ctx, posting.UserID)
+	switch cs.callPluginToReview(ctx, posting.UserID, posting.ID, reviewContent) {
+	case plugin.ReviewStatusNeedReview:
+		postingStatus = entity.JobPostingStatusPending
+	case plugin.ReviewStatusDeleteDirectly:
+		postingStatus = entity.JobPostingStatusDeleted
+	default:
+		postingStatus = entity.JobPostingStatusOpen
+	}
+	return postingStatus
+}
+
 // get review content author info
 func (cs *ReviewService) getReviewContentAuthorInfo(ctx context.Context, userID string) (author plugin.ReviewContentAuthor) {
 	user, exist, err := cs.userCommon.GetUserBasicInfoByID(ctx, userID)
@@ -314,6 +372,26 @@ func (cs *ReviewService) updateObjectStatus(ctx context.Context, review *entity.
 				log.Errorf("update user answer count failed, err: %v", err)
 			}
 		}
+	case constant.ContractReviewObjectType:
+		contractReview, exist, err := cs.contractReviewRepo.GetContractReview(ctx, review.ObjectID)
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return errors.BadRequest(reason.ObjectNotFound)
+		}
+		status := entity.ContractReviewStatusDeleted
+		if isApprove {
+			status = entity.ContractReviewStatusAvailable
+		}
+		if err := cs.contractReviewRepo.UpdateContractReviewStatus(ctx, contractReview, status); err != nil {
+			return err
+		}
+	case constant.JobPostingObjectType:
+		if cs.jobPostingReviewHandler == nil {
+			return errors.InternalServer(reason.UnknownError)
+		}
+		return cs.jobPostingReviewHandler(ctx, review.ObjectID, isApprove)
 	}
 	return
 }
@@ -21,18 +21,27 @@ package role
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
+	"strings"
 
 	"github.com/apache/answer/internal/base/handler"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/reason"
 	"github.com/apache/answer/internal/base/translator"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/auth"
+	"github.com/apache/answer/internal/service/permission"
 	"github.com/jinzhu/copier"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
 )
 
 const (
-	// Since there is currently no need to edit roles to add roles and other operations,
-	// the current role information is translated directly.
-	// Later on, when the relevant ability is available, it can be adjusted by the user himself.
+	// The built-in roles are translated directly, and they can not be changed or removed,
+	// because their powers are maintained by the migrations.
+	// The roles added by the admin are shown as they are.
 
 	RoleUserID      = 1
 	RoleAdminID     = 2
@@ -55,16 +64,37 @@ const (
 type RoleRepo interface {
 	GetRoleAllList(ctx context.Context) (roles []*entity.Role, err error)
 	GetRoleAllMapping(ctx context.Context) (roleMapping map[int]*entity.Role, err error)
+	GetRole(ctx context.Context, roleID int) (role *entity.Role, exist bool, err error)
+	AddRole(ctx context.Context, role *entity.Role) (err error)
+	UpdateRole(ctx context.Context, role *entity.Role) (err error)
+	RemoveRole(ctx context.Context, roleID int) (err error)
 }
 
 // RoleService user service
 type RoleService struct {
-	roleRepo RoleRepo
+	roleRepo         RoleRepo
+	powerRepo        PowerRepo
+	rolePowerRelRepo RolePowerRelRepo
+	userRoleRelRepo  UserRoleRelRepo
+	roleAuditLogRepo RoleAuditLogRepo
+	authService      *auth.AuthService
 }
 
-func NewRoleService(roleRepo RoleRepo) *RoleService {
+func NewRoleService(
+	roleRepo RoleRepo,
+	powerRepo PowerRepo,
+	rolePowerRelRepo RolePowerRelRepo,
+	userRoleRelRepo UserRoleRelRepo,
+	roleAuditLogRepo RoleAuditLogRepo,
+	authService *auth.AuthService,
+) *RoleService {
 	return &RoleService{
-		roleRepo: roleRepo,
+		roleRepo:         roleRepo,
+		powerRepo:        powerRepo,
+		rolePowerRelRepo: rolePowerRelRepo,
+		userRoleRelRepo:  userRoleRelRepo,
+		roleAuditLogRepo: roleAuditLogRepo,
+		authService:      authService,
 	}
 }
 
@@ -81,6 +111,9 @@ func (rs *RoleService) GetRoleList(ctx context.Context) (resp []*schema.GetRoleR
 
 	resp = []*schema.GetRoleResp{}
 	_ = copier.Copy(&resp, roles)
+	for _, role := range resp {
+		role.BuiltIn = IsBuiltInRole(role.ID)
+	}
 	return
 }
 
@@ -88,7 +121,291 @@ func (rs *RoleService) GetRoleMapping(ctx context.Context) (roleMapping map[int]
 	return rs.roleRepo.GetRoleAllMapping(ctx)
 }
 
+// GetPowerList get the powers which could be assigned to the custom roles
+func (rs *RoleService) GetPowerList(ctx context.Context) (resp []*schema.GetPowerResp, err error) {
+	powers, err := rs.getAssignablePowers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp = make([]*schema.GetPowerResp, 0, len(powers))
+	for _, power := range powers {
+		resp = append(resp, &schema.GetPowerResp{
+			ID:          power.ID,
+			Name:        power.Name,
+			PowerType:   power.PowerType,
+			Description: power.Description,
+		})
+	}
+	return resp, nil
+}
+
+// GetRolePowers get the power types of the role
+func (rs *RoleService) GetRolePowers(ctx context.Context, req *schema.GetRolePowersReq) (powerTypes []string, err error) {
+	if _, err = rs.getRole(ctx, req.RoleID); err != nil {
+		return nil, err
+	}
+	return rs.rolePowerRelRepo.GetRolePowerTypeList(ctx, req.RoleID)
+}
+
+// AddRole add the custom role with its powers
+func (rs *RoleService) AddRole(ctx context.Context, req *schema.AddRoleReq) (resp *schema.GetRoleResp, err error) {
+	name := strings.TrimSpace(req.Name)
+	if err = rs.checkRoleNameDuplicate(ctx, 0, name); err != nil {
+		return nil, err
+	}
+	powerTypes, err := rs.checkPowerTypes(ctx, req.PowerTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &entity.Role{Name: name, Description: strings.TrimSpace(req.Description)}
+	if err = rs.roleRepo.AddRole(ctx, role); err != nil {
+		return nil, err
+	}
+	if len(powerTypes) > 0 {
+		if err = rs.rolePowerRelRepo.SaveRolePowerTypes(ctx, role.ID, powerTypes); err != nil {
+			return nil, err
+		}
+	}
+
+	rs.addAuditLog(ctx, req.LoginUserID, role.ID, entity.RoleAuditActionCreate, &schema.RoleAuditContent{
+		Name:        role.Name,
+		Description: role.Description,
+		AddedPowers: powerTypes,
+	})
+	return &schema.GetRoleResp{ID: role.ID, Name: role.Name, Description: role.Description}, nil
+}
+
+// UpdateRole update the name and description of the custom role
+func (rs *RoleService) UpdateRole(ctx context.Context, req *schema.UpdateRoleReq) (err error) {
+	role, err := rs.getCustomRole(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSpace(req.Name)
+	if err = rs.checkRoleNameDuplicate(ctx, role.ID, name); err != nil {
+		return err
+	}
+
+	content := &schema.RoleAuditContent{OldName: role.Name, OldDescription: role.Description}
+	role.Name = name
+	role.Description = strings.TrimSpace(req.Description)
+	if err = rs.roleRepo.UpdateRole(ctx, role); err != nil {
+		return err
+	}
+
+	content.Name = role.Name
+	content.Description = role.Description
+	rs.addAuditLog(ctx, req.LoginUserID, role.ID, entity.RoleAuditActionUpdate, content)
+	return nil
+}
+
+// RemoveRole remove the custom role which is not assigned to any user
+func (rs *RoleService) RemoveRole(ctx context.Context, req *schema.RemoveRoleReq) (err error) {
+	role, err := rs.getCustomRole(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	powerTypes, err := rs.rolePowerRelRepo.GetRolePowerTypeList(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+
+	// the role is removed with its powers only if it is not in use, which is checked in the same transaction
+	if err = rs.roleRepo.RemoveRole(ctx, role.ID); err != nil {
+		return err
+	}
+
+	rs.addAuditLog(ctx, req.LoginUserID, role.ID, entity.RoleAuditActionDelete, &schema.RoleAuditContent{
+		Name:          role.Name,
+		Description:   role.Description,
+		RemovedPowers: powerTypes,
+	})
+	return nil
+}
+
+// UpdateRolePowers replace the powers of the custom role.
+// The cached powers of the role are removed, so the users of the role get the new powers at once.
+func (rs *RoleService) UpdateRolePowers(ctx context.Context, req *schema.UpdateRolePowersReq) (err error) {
+	role, err := rs.getCustomRole(ctx, req.RoleID)
+	if err != nil {
+		return err
+	}
+	powerTypes, err := rs.checkPowerTypes(ctx, req.PowerTypes)
+	if err != nil {
+		return err
+	}
+	oldPowerTypes, err := rs.rolePowerRelRepo.GetRolePowerTypeList(ctx, role.ID)
+	if err != nil {
+		return err
+	}
+	added, removed := diffPowerTypes(oldPowerTypes, powerTypes)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	if err = rs.rolePowerRelRepo.SaveRolePowerTypes(ctx, role.ID, powerTypes); err != nil {
+		return err
+	}
+
+	rs.addAuditLog(ctx, req.LoginUserID, role.ID, entity.RoleAuditActionUpdatePowers, &schema.RoleAuditContent{
+		AddedPowers:   added,
+		RemovedPowers: removed,
+	})
+	return nil
+}
+
+// UpdateRoleUsers assign the role to the users, the users are logged out to refresh their role
+func (rs *RoleService) UpdateRoleUsers(ctx context.Context, req *schema.UpdateRoleUsersReq) (err error) {
+	role, err := rs.getRole(ctx, req.RoleID)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]string, 0, len(req.UserIDs))
+	for _, userID := range req.UserIDs {
+		// Users cannot modify their roles
+		if userID == req.LoginUserID {
+			return errors.BadRequest(reason.UserCannotUpdateYourRole)
+		}
+		if !containsString(userIDs, userID) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	rels, err := rs.userRoleRelRepo.GetUserRoleRelList(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+	previousRoles := make(map[string]int, len(userIDs))
+	for _, userID := range userIDs {
+		previousRoles[userID] = RoleUserID
+	}
+	for _, rel := range rels {
+		previousRoles[rel.UserID] = rel.RoleID
+	}
+
+	if err = rs.userRoleRelRepo.SaveUsersRoleRel(ctx, userIDs, role.ID); err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		rs.authService.RemoveUserAllTokens(ctx, userID)
+	}
+
+	rs.addAuditLog(ctx, req.LoginUserID, role.ID, entity.RoleAuditActionAssignUsers, &schema.RoleAuditContent{
+		UserIDs:       userIDs,
+		PreviousRoles: previousRoles,
+	})
+	return nil
+}
+
+// GetRoleAuditLogPage get the role audit logs by page, the latest first
+func (rs *RoleService) GetRoleAuditLogPage(ctx context.Context, req *schema.GetRoleAuditLogsReq) (
+	pageModel *pager.PageModel, err error) {
+	auditLogs, total, err := rs.roleAuditLogRepo.GetRoleAuditLogPage(ctx, req.Page, req.PageSize, req.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*schema.RoleAuditLogResp, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		r := &schema.RoleAuditLogResp{}
+		r.ConvertFromRoleAuditLog(auditLog)
+		resp = append(resp, r)
+	}
+	return pager.NewPageModel(total, resp), nil
+}
+
+func (rs *RoleService) getRole(ctx context.Context, roleID int) (role *entity.Role, err error) {
+	role, exist, err := rs.roleRepo.GetRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.RoleNotFound)
+	}
+	return role, nil
+}
+
+func (rs *RoleService) getCustomRole(ctx context.Context, roleID int) (role *entity.Role, err error) {
+	role, err = rs.getRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if IsBuiltInRole(role.ID) {
+		return nil, errors.BadRequest(reason.RoleBuiltInReadOnly)
+	}
+	return role, nil
+}
+
+// checkRoleNameDuplicate check the name is not used by the other roles, case-insensitively
+func (rs *RoleService) checkRoleNameDuplicate(ctx context.Context, roleID int, name string) (err error) {
+	roles, err := rs.roleRepo.GetRoleAllList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if role.ID != roleID && strings.EqualFold(role.Name, name) {
+			return errors.BadRequest(reason.RoleNameDuplicate)
+		}
+	}
+	return nil
+}
+
+// checkPowerTypes check all the power types could be assigned, and return them without duplicates in order
+func (rs *RoleService) checkPowerTypes(ctx context.Context, powerTypes []string) (checked []string, err error) {
+	powers, err := rs.getAssignablePowers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	assignable := make(map[string]bool, len(powers))
+	for _, power := range powers {
+		assignable[power.PowerType] = true
+	}
+	checked = make([]string, 0, len(powerTypes))
+	for _, powerType := range powerTypes {
+		if !assignable[powerType] {
+			return nil, errors.BadRequest(reason.RolePowerInvalid)
+		}
+		if !containsString(checked, powerType) {
+			checked = append(checked, powerType)
+		}
+	}
+	sort.Strings(checked)
+	return checked, nil
+}
+
+// getAssignablePowers get the powers except the admin access, which is granted by the admin role only
+func (rs *RoleService) getAssignablePowers(ctx context.Context) (powers []*entity.Power, err error) {
+	allPowers, err := rs.powerRepo.GetPowerList(ctx, &entity.Power{})
+	if err != nil {
+		return nil, err
+	}
+	powers = make([]*entity.Power, 0, len(allPowers))
+	for _, power := range allPowers {
+		if power.PowerType != permission.AdminAccess {
+			powers = append(powers, power)
+		}
+	}
+	return powers, nil
+}
+
+func (rs *RoleService) addAuditLog(ctx context.Context, operatorID string, roleID int, action string,
+	content *schema.RoleAuditContent) {
+	contentJSON, _ := json.Marshal(content)
+	err := rs.roleAuditLogRepo.AddRoleAuditLog(ctx, &entity.RoleAuditLog{
+		OperatorID: operatorID,
+		RoleID:     roleID,
+		Action:     action,
+		Content:    string(contentJSON),
+	})
+	if err != nil {
+		log.Error(err)
+	}
+}
+
 func (rs *RoleService) translateRole(ctx context.Context, role *entity.Role) {
+	if !IsBuiltInRole(role.ID) {
+		return
+	}
 	switch role.Name {
 	case roleUserName:
 		role.Name = translator.Tr(handler.GetLangByCtx(ctx), trRoleNameUser)
@@ -101,3 +418,33 @@ func (rs *RoleService) translateRole(ctx context.Context, role *entity.Role) {
 		role.Description = translator.Tr(handler.GetLangByCtx(ctx), trRoleDescriptionModerator)
 	}
 }
+
+// IsBuiltInRole whether the role is a built-in role whose powers are maintained by the migrations
+func IsBuiltInRole(roleID int) bool {
+	return roleID == RoleUserID || roleID == RoleAdminID || roleID == RoleModeratorID
+}
+
+// diffPowerTypes get the power types added to and removed from the old ones
+func diffPowerTypes(oldPowerTypes, newPowerTypes []string) (added, removed []string) {
+	added, removed = make([]string, 0), make([]string, 0)
+	for _, powerType := range newPowerTypes {
+		if !containsString(oldPowerTypes, powerType) {
+			added = append(added, powerType)
+		}
+	}
+	for _, powerType := range oldPowerTypes {
+		if !containsString(newPowerTypes, powerType) {
+			removed = append(removed, powerType)
+		}
+	}
+	return added, removed
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
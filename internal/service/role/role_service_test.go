@@ -0,0 +1,339 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package role
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/auth"
+	"github.com/apache/answer/internal/service/permission"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRoleRepo struct {
+	roles        map[int]*entity.Role
+	powerRels    *memoryRolePowerRelRepo
+	userRoleRels *memoryUserRoleRelRepo
+}
+
+func (r *memoryRoleRepo) GetRoleAllList(_ context.Context) ([]*entity.Role, error) {
+	roles := make([]*entity.Role, 0, len(r.roles))
+	for id := 1; id <= len(r.roles)+10; id++ {
+		if role, ok := r.roles[id]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (r *memoryRoleRepo) GetRoleAllMapping(_ context.Context) (map[int]*entity.Role, error) {
+	return r.roles, nil
+}
+
+func (r *memoryRoleRepo) GetRole(_ context.Context, roleID int) (*entity.Role, bool, error) {
+	role, ok := r.roles[roleID]
+	return role, ok, nil
+}
+
+func (r *memoryRoleRepo) AddRole(_ context.Context, role *entity.Role) error {
+	role.ID = len(r.roles) + 1
+	r.roles[role.ID] = role
+	return nil
+}
+
+func (r *memoryRoleRepo) UpdateRole(_ context.Context, role *entity.Role) error {
+	r.roles[role.ID] = role
+	return nil
+}
+
+func (r *memoryRoleRepo) RemoveRole(_ context.Context, roleID int) error {
+	for _, id := range r.userRoleRels.rels {
+		if id == roleID {
+			return errors.BadRequest(reason.RoleInUse)
+		}
+	}
+	delete(r.roles, roleID)
+	delete(r.powerRels.powers, roleID)
+	return nil
+}
+
+type memoryPowerRepo struct{}
+
+func (memoryPowerRepo) GetPowerList(_ context.Context, _ *entity.Power) ([]*entity.Power, error) {
+	return []*entity.Power{
+		{ID: 1, PowerType: permission.AdminAccess},
+		{ID: 2, PowerType: permission.QuestionEdit},
+		{ID: 3, PowerType: permission.TagEdit},
+		{ID: 4, PowerType: permission.TagDelete},
+	}, nil
+}
+
+type memoryRolePowerRelRepo struct {
+	powers map[int][]string
+}
+
+func (r *memoryRolePowerRelRepo) GetRolePowerTypeList(_ context.Context, roleID int) ([]string, error) {
+	return append([]string{}, r.powers[roleID]...), nil
+}
+
+func (r *memoryRolePowerRelRepo) SaveRolePowerTypes(_ context.Context, roleID int, powerTypes []string) error {
+	r.powers[roleID] = powerTypes
+	return nil
+}
+
+type memoryUserRoleRelRepo struct {
+	rels  map[string]int
+	users map[string]bool
+}
+
+func (r *memoryUserRoleRelRepo) SaveUserRoleRel(_ context.Context, userID string, roleID int) error {
+	r.rels[userID] = roleID
+	return nil
+}
+
+func (r *memoryUserRoleRelRepo) SaveUsersRoleRel(_ context.Context, userIDs []string, roleID int) error {
+	for _, userID := range userIDs {
+		if !r.users[userID] {
+			return errors.BadRequest(reason.UserNotFound)
+		}
+	}
+	for _, userID := range userIDs {
+		r.rels[userID] = roleID
+	}
+	return nil
+}
+
+func (r *memoryUserRoleRelRepo) GetUserRoleRelList(_ context.Context, userIDs []string) (
+	[]*entity.UserRoleRel, error) {
+	rels := make([]*entity.UserRoleRel, 0)
+	for _, userID := range userIDs {
+		if roleID, ok := r.rels[userID]; ok {
+			rels = append(rels, &entity.UserRoleRel{UserID: userID, RoleID: roleID})
+		}
+	}
+	return rels, nil
+}
+
+func (r *memoryUserRoleRelRepo) GetUserRoleRelListByRoleID(_ context.Context, roleIDs []int) (
+	[]*entity.UserRoleRel, error) {
+	rels := make([]*entity.UserRoleRel, 0)
+	for userID, roleID := range r.rels {
+		for _, id := range roleIDs {
+			if roleID == id {
+				rels = append(rels, &entity.UserRoleRel{UserID: userID, RoleID: roleID})
+			}
+		}
+	}
+	return rels, nil
+}
+
+func (r *memoryUserRoleRelRepo) GetUserRoleRel(_ context.Context, userID string) (*entity.UserRoleRel, bool, error) {
+	roleID, ok := r.rels[userID]
+	return &entity.UserRoleRel{UserID: userID, RoleID: roleID}, ok, nil
+}
+
+type memoryRoleAuditLogRepo struct {
+	logs []*entity.RoleAuditLog
+}
+
+func (r *memoryRoleAuditLogRepo) AddRoleAuditLog(_ context.Context, auditLog *entity.RoleAuditLog) error {
+	r.logs = append(r.logs, auditLog)
+	return nil
+}
+
+func (r *memoryRoleAuditLogRepo) GetRoleAuditLogPage(_ context.Context, _, _ int, _ int) (
+	[]*entity.RoleAuditLog, int64, error) {
+	return r.logs, int64(len(r.logs)), nil
+}
+
+// memoryAuthRepo records the users whose tokens are removed
+type memoryAuthRepo struct {
+	auth.AuthRepo
+	removed []string
+}
+
+func (r *memoryAuthRepo) RemoveUserTokens(_ context.Context, userID string, _ string) {
+	r.removed = append(r.removed, userID)
+}
+
+type roleServiceTest struct {
+	service       *RoleService
+	powerRels     *memoryRolePowerRelRepo
+	userRoleRels  *memoryUserRoleRelRepo
+	auditLogs     *memoryRoleAuditLogRepo
+	authRepo      *memoryAuthRepo
+	rolePowerRels *RolePowerRelService
+}
+
+func newRoleServiceTest() *roleServiceTest {
+	t := &roleServiceTest{
+		powerRels: &memoryRolePowerRelRepo{powers: map[int][]string{RoleModeratorID: {permission.QuestionEdit}}},
+		userRoleRels: &memoryUserRoleRelRepo{
+			rels:  map[string]int{"10": RoleModeratorID},
+			users: map[string]bool{"1": true, "10": true, "11": true, "20": true},
+		},
+		auditLogs: &memoryRoleAuditLogRepo{},
+		authRepo:  &memoryAuthRepo{},
+	}
+	roleRepo := &memoryRoleRepo{roles: map[int]*entity.Role{
+		RoleUserID:      {ID: RoleUserID, Name: roleUserName},
+		RoleAdminID:     {ID: RoleAdminID, Name: roleAdminName},
+		RoleModeratorID: {ID: RoleModeratorID, Name: roleModeratorName},
+	}, powerRels: t.powerRels, userRoleRels: t.userRoleRels}
+	t.service = NewRoleService(roleRepo, memoryPowerRepo{}, t.powerRels, t.userRoleRels, t.auditLogs,
+		auth.NewAuthService(t.authRepo))
+	t.rolePowerRels = NewRolePowerRelService(t.powerRels, NewUserRoleRelService(t.userRoleRels, t.service))
+	return t
+}
+
+func (t *roleServiceTest) lastAuditContent() *schema.RoleAuditContent {
+	content := &schema.RoleAuditContent{}
+	_ = json.Unmarshal([]byte(t.auditLogs.logs[len(t.auditLogs.logs)-1].Content), content)
+	return content
+}
+
+func assertReason(t *testing.T, err error, reason string) {
+	var e *errors.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, reason, e.Reason)
+}
+
+func TestRoleService_AddRole(t *testing.T) {
+	ctx := context.Background()
+	st := newRoleServiceTest()
+
+	_, err := st.service.AddRole(ctx, &schema.AddRoleReq{Name: "moderator"})
+	assertReason(t, err, reason.RoleNameDuplicate)
+	_, err = st.service.AddRole(ctx, &schema.AddRoleReq{Name: "Tag curator", PowerTypes: []string{permission.AdminAccess}})
+	assertReason(t, err, reason.RolePowerInvalid)
+
+	resp, err := st.service.AddRole(ctx, &schema.AddRoleReq{
+		Name:        " Tag curator ",
+		PowerTypes:  []string{permission.TagEdit, permission.TagDelete, permission.TagEdit},
+		LoginUserID: "1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Tag curator", resp.Name)
+	assert.Equal(t, []string{permission.TagDelete, permission.TagEdit}, st.powerRels.powers[resp.ID])
+
+	require.Len(t, st.auditLogs.logs, 1)
+	assert.Equal(t, entity.RoleAuditActionCreate, st.auditLogs.logs[0].Action)
+	assert.Equal(t, "1", st.auditLogs.logs[0].OperatorID)
+	assert.Equal(t, []string{permission.TagDelete, permission.TagEdit}, st.lastAuditContent().AddedPowers)
+
+	roles, err := st.service.GetRoleList(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 4)
+	assert.True(t, roles[0].BuiltIn)
+	assert.False(t, roles[3].BuiltIn)
+}
+
+func TestRoleService_BuiltInRoleReadOnly(t *testing.T) {
+	ctx := context.Background()
+	st := newRoleServiceTest()
+
+	err := st.service.UpdateRole(ctx, &schema.UpdateRoleReq{ID: RoleModeratorID, Name: "Mod"})
+	assertReason(t, err, reason.RoleBuiltInReadOnly)
+	err = st.service.UpdateRolePowers(ctx, &schema.UpdateRolePowersReq{RoleID: RoleModeratorID})
+	assertReason(t, err, reason.RoleBuiltInReadOnly)
+	err = st.service.RemoveRole(ctx, &schema.RemoveRoleReq{ID: RoleUserID})
+	assertReason(t, err, reason.RoleBuiltInReadOnly)
+	err = st.service.RemoveRole(ctx, &schema.RemoveRoleReq{ID: 100})
+	assertReason(t, err, reason.RoleNotFound)
+	assert.Empty(t, st.auditLogs.logs)
+}
+
+func TestRoleService_UpdateRolePowers(t *testing.T) {
+	ctx := context.Background()
+	st := newRoleServiceTest()
+	role, err := st.service.AddRole(ctx, &schema.AddRoleReq{Name: "Tag curator", PowerTypes: []string{permission.TagEdit}})
+	require.NoError(t, err)
+	require.NoError(t, st.service.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{RoleID: role.ID, UserIDs: []string{"20"}}))
+
+	powers, err := st.rolePowerRels.GetUserPowerList(ctx, "20")
+	require.NoError(t, err)
+	assert.Equal(t, []string{permission.TagEdit}, powers)
+
+	err = st.service.UpdateRolePowers(ctx, &schema.UpdateRolePowersReq{
+		RoleID:     role.ID,
+		PowerTypes: []string{permission.TagDelete, permission.QuestionEdit},
+	})
+	require.NoError(t, err)
+	content := st.lastAuditContent()
+	assert.Equal(t, []string{permission.QuestionEdit, permission.TagDelete}, content.AddedPowers)
+	assert.Equal(t, []string{permission.TagEdit}, content.RemovedPowers)
+
+	powers, err = st.rolePowerRels.GetUserPowerList(ctx, "20")
+	require.NoError(t, err)
+	assert.Equal(t, []string{permission.QuestionEdit, permission.TagDelete}, powers)
+
+	// nothing changed, nothing audited
+	logCount := len(st.auditLogs.logs)
+	err = st.service.UpdateRolePowers(ctx, &schema.UpdateRolePowersReq{
+		RoleID:     role.ID,
+		PowerTypes: []string{permission.QuestionEdit, permission.TagDelete},
+	})
+	require.NoError(t, err)
+	assert.Len(t, st.auditLogs.logs, logCount)
+}
+
+func TestRoleService_UpdateRoleUsersAndRemoveRole(t *testing.T) {
+	ctx := context.Background()
+	st := newRoleServiceTest()
+	role, err := st.service.AddRole(ctx, &schema.AddRoleReq{Name: "Job board moderator"})
+	require.NoError(t, err)
+
+	err = st.service.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{
+		RoleID: role.ID, UserIDs: []string{"10", "1"}, LoginUserID: "1"})
+	assertReason(t, err, reason.UserCannotUpdateYourRole)
+
+	// nobody is assigned when any of the users does not exist
+	err = st.service.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{
+		RoleID: role.ID, UserIDs: []string{"10", "404"}, LoginUserID: "1"})
+	assertReason(t, err, reason.UserNotFound)
+	assert.Equal(t, RoleModeratorID, st.userRoleRels.rels["10"])
+
+	err = st.service.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{
+		RoleID: role.ID, UserIDs: []string{"10", "11", "10"}, LoginUserID: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, role.ID, st.userRoleRels.rels["10"])
+	assert.Equal(t, role.ID, st.userRoleRels.rels["11"])
+	assert.Equal(t, []string{"10", "11"}, st.authRepo.removed)
+	content := st.lastAuditContent()
+	assert.Equal(t, []string{"10", "11"}, content.UserIDs)
+	assert.Equal(t, map[string]int{"10": RoleModeratorID, "11": RoleUserID}, content.PreviousRoles)
+
+	err = st.service.RemoveRole(ctx, &schema.RemoveRoleReq{ID: role.ID})
+	assertReason(t, err, reason.RoleInUse)
+
+	require.NoError(t, st.service.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{
+		RoleID: RoleUserID, UserIDs: []string{"10", "11"}}))
+	require.NoError(t, st.service.RemoveRole(ctx, &schema.RemoveRoleReq{ID: role.ID}))
+	assert.NotContains(t, st.powerRels.powers, role.ID)
+	assert.Equal(t, entity.RoleAuditActionDelete, st.auditLogs.logs[len(st.auditLogs.logs)-1].Action)
+	assert.Equal(t, "Job board moderator", st.lastAuditContent().Name)
+}
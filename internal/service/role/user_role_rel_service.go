@@ -28,6 +28,7 @@ import (
 // UserRoleRelRepo userRoleRel repository
 type UserRoleRelRepo interface {
 	SaveUserRoleRel(ctx context.Context, userID string, roleID int) (err error)
+	SaveUsersRoleRel(ctx context.Context, userIDs []string, roleID int) (err error)
 	GetUserRoleRelList(ctx context.Context, userIDs []string) (userRoleRelList []*entity.UserRoleRel, err error)
 	GetUserRoleRelListByRoleID(ctx context.Context, roleIDs []int) (
 		userRoleRelList []*entity.UserRoleRel, err error)
@@ -22,13 +22,22 @@ package search_common
 import (
 	"context"
 
+	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/plugin"
 )
 
 type SearchRepo interface {
-	SearchContents(ctx context.Context, words []string, tagIDs [][]string, userID string, votes, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
-	SearchQuestions(ctx context.Context, words []string, tagIDs [][]string, notAccepted bool, views, answers int, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
-	SearchAnswers(ctx context.Context, words []string, tagIDs [][]string, accepted bool, questionID string, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
+	SearchContents(ctx context.Context, cond *schema.SearchCondition, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
+	SearchQuestions(ctx context.Context, cond *schema.SearchCondition, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
+	SearchAnswers(ctx context.Context, cond *schema.SearchCondition, page, size int, order string) (resp []*schema.SearchResult, total int64, err error)
 	ParseSearchPluginResult(ctx context.Context, sres []plugin.SearchResult, words []string) (resp []*schema.SearchResult, err error)
 }
+
+// SearchSuggestRepo finds the questions, tags and users whose names or words start with the query,
+// the most popular ones first
+type SearchSuggestRepo interface {
+	SuggestQuestions(ctx context.Context, query string, limit int) (questions []*entity.Question, err error)
+	SuggestTags(ctx context.Context, query string, limit int) (tags []*entity.Tag, err error)
+	SuggestUsers(ctx context.Context, query string, limit int) (users []*entity.User, err error)
+}
@@ -0,0 +1,310 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_parser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The query syntax:
+//
+//	golang channel        the terms are joined by AND
+//	golang OR rust        OR joins the neighbouring terms of the same kind, it binds tighter than AND
+//	"exact phrase"        the phrase must be matched as a whole
+//	-word -"phrase"       the content must not match the term
+//	[tag] -[tag]          the content must have or not have the tag
+//	key:value key:"a b"   the filter such as user:me, created:2024-01..2024-06 and intitle:"a b"
+
+type queryTermKind int
+
+const (
+	queryTermWord queryTermKind = iota
+	queryTermPhrase
+	queryTermTag
+	queryTermFilter
+)
+
+// queryFilterKeys the keys of key:value filters, the other key:value terms are words
+var queryFilterKeys = map[string]bool{
+	"user":        true,
+	"score":       true,
+	"views":       true,
+	"answers":     true,
+	"is":          true,
+	"isaccepted":  true,
+	"hasaccepted": true,
+	"inquestion":  true,
+	"closed":      true,
+	"created":     true,
+	"lastactive":  true,
+	"intitle":     true,
+	"inbody":      true,
+	"collection":  true,
+	"following":   true,
+}
+
+// queryTerm is a term of the search query
+type queryTerm struct {
+	kind queryTermKind
+	// negated the term is prefixed by "-"
+	negated bool
+	// key the key of the filter, such as "user" of "user:me"
+	key string
+	// value the word, phrase, tag slug name or the value of the filter
+	value string
+	// quoted the value of the filter is quoted, such as intitle:"hello world"
+	quoted bool
+}
+
+// keyword returns whether the term matches the text of the content
+func (t *queryTerm) keyword() bool {
+	return t.kind == queryTermWord || t.kind == queryTermPhrase ||
+		(t.kind == queryTermFilter && (t.key == "intitle" || t.key == "inbody"))
+}
+
+// query is the parsed search query. The groups are joined by AND,
+// and the terms in one group are joined by OR.
+type query struct {
+	groups [][]*queryTerm
+}
+
+// parseQuery parses the search query. It never fails, the malformed parts such as
+// an unclosed quote are read as far as possible.
+func parseQuery(q string) *query {
+	s := &queryScanner{src: []rune(q)}
+	res := &query{}
+	or := false
+	for {
+		s.skipSpaces()
+		if s.eof() {
+			break
+		}
+		if s.or() {
+			or = len(res.groups) > 0
+			continue
+		}
+		term := s.term()
+		if term == nil {
+			or = false
+			continue
+		}
+		if or && canJoinOr(res.groups[len(res.groups)-1], term) {
+			last := len(res.groups) - 1
+			res.groups[last] = append(res.groups[last], term)
+		} else {
+			res.groups = append(res.groups, []*queryTerm{term})
+		}
+		or = false
+	}
+	return res
+}
+
+// canJoinOr only joins the keywords with the keywords and the tags with the tags,
+// the negated terms and the other filters are always joined by AND.
+func canJoinOr(group []*queryTerm, term *queryTerm) bool {
+	prev := group[len(group)-1]
+	if prev.negated || term.negated {
+		return false
+	}
+	if prev.kind == queryTermTag || term.kind == queryTermTag {
+		return prev.kind == term.kind
+	}
+	return prev.keyword() && term.keyword()
+}
+
+type queryScanner struct {
+	src []rune
+	pos int
+}
+
+func (s *queryScanner) eof() bool {
+	return s.pos >= len(s.src)
+}
+
+func (s *queryScanner) skipSpaces() {
+	for !s.eof() && unicode.IsSpace(s.src[s.pos]) {
+		s.pos++
+	}
+}
+
+// or consumes the OR operator, it must be upper case and a whole word
+func (s *queryScanner) or() bool {
+	if s.pos+2 > len(s.src) || string(s.src[s.pos:s.pos+2]) != "OR" {
+		return false
+	}
+	if s.pos+2 < len(s.src) && !unicode.IsSpace(s.src[s.pos+2]) {
+		return false
+	}
+	s.pos += 2
+	return true
+}
+
+// term reads a term, it returns nil if the term is empty such as "" and []
+func (s *queryScanner) term() *queryTerm {
+	term := &queryTerm{}
+	if s.src[s.pos] == '-' && s.pos+1 < len(s.src) && !unicode.IsSpace(s.src[s.pos+1]) {
+		term.negated = true
+		s.pos++
+	}
+
+	switch s.src[s.pos] {
+	case '"':
+		term.kind = queryTermPhrase
+		term.value = s.quoted()
+	case '[':
+		term.kind = queryTermTag
+		term.value = s.tag()
+	default:
+		word := s.until(func(r rune) bool { return unicode.IsSpace(r) || r == ':' })
+		if !s.eof() && s.src[s.pos] == ':' && queryFilterKeys[strings.ToLower(word)] {
+			s.pos++
+			term.kind = queryTermFilter
+			term.key = strings.ToLower(word)
+			if !s.eof() && s.src[s.pos] == '"' {
+				term.quoted = true
+				term.value = s.quoted()
+			} else {
+				term.value = s.until(unicode.IsSpace)
+			}
+		} else {
+			term.kind = queryTermWord
+			term.value = word + s.until(unicode.IsSpace)
+		}
+	}
+	term.value = strings.TrimSpace(term.value)
+	if len(term.value) == 0 {
+		return nil
+	}
+	return term
+}
+
+// quoted reads the text between the quotes, the closing quote is optional
+func (s *queryScanner) quoted() string {
+	s.pos++
+	text := s.until(func(r rune) bool { return r == '"' })
+	if !s.eof() {
+		s.pos++
+	}
+	return text
+}
+
+// tag reads the slug name between the brackets, the closing bracket is optional
+func (s *queryScanner) tag() string {
+	s.pos++
+	slugName := s.until(func(r rune) bool { return r == ']' || unicode.IsSpace(r) })
+	if !s.eof() && s.src[s.pos] == ']' {
+		s.pos++
+	}
+	return slugName
+}
+
+func (s *queryScanner) until(stop func(r rune) bool) string {
+	start := s.pos
+	for !s.eof() && !stop(s.src[s.pos]) {
+		s.pos++
+	}
+	return string(s.src[start:s.pos])
+}
+
+// relativeTimePattern matches the relative time such as 7d, 2w, 3m and 1y
+var relativeTimePattern = regexp.MustCompile(`^(\d+)([dwmy])$`)
+
+// parseTimeRange parses the time range of the filters such as created:2024-01..2024-06 and lastactive:7d.
+// Each side of ".." is a year, a month, a day or a relative time before now, and one side can be omitted.
+// The range begins at the start of the first side and ends at the end of the second side.
+// A single relative time means since then, and a single date means the whole year, month or day.
+func parseTimeRange(value string, now time.Time) (after, before time.Time, ok bool) {
+	from, to, isRange := strings.Cut(value, "..")
+	if !isRange {
+		if point, ok := parseRelativeTime(value, now); ok {
+			return point, time.Time{}, true
+		}
+		return parseDatePeriod(value)
+	}
+	if len(from) == 0 && len(to) == 0 {
+		return time.Time{}, time.Time{}, false
+	}
+	if len(from) > 0 {
+		if after, ok = parseRelativeTime(from, now); !ok {
+			if after, _, ok = parseDatePeriod(from); !ok {
+				return time.Time{}, time.Time{}, false
+			}
+		}
+	}
+	if len(to) > 0 {
+		if before, ok = parseRelativeTime(to, now); !ok {
+			if _, before, ok = parseDatePeriod(to); !ok {
+				return time.Time{}, time.Time{}, false
+			}
+		}
+	}
+	return after, before, true
+}
+
+// parseRelativeTime returns the time the duration such as 7d before now
+func parseRelativeTime(value string, now time.Time) (point time.Time, ok bool) {
+	res := relativeTimePattern.FindStringSubmatch(value)
+	if len(res) != 3 {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(res[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+	switch res[2] {
+	case "d":
+		return now.AddDate(0, 0, -n), true
+	case "w":
+		return now.AddDate(0, 0, -7*n), true
+	case "m":
+		return now.AddDate(0, -n, 0), true
+	default:
+		return now.AddDate(-n, 0, 0), true
+	}
+}
+
+// parseDatePeriod returns the start and the end of the year, month or day in local time
+func parseDatePeriod(value string) (start, end time.Time, ok bool) {
+	layouts := []struct {
+		layout string
+		years  int
+		months int
+		days   int
+	}{
+		{layout: "2006", years: 1},
+		{layout: "2006-01", months: 1},
+		{layout: "2006-01-02", days: 1},
+	}
+	for _, l := range layouts {
+		if len(value) != len(l.layout) {
+			continue
+		}
+		start, err := time.ParseInLocation(l.layout, value, time.Local)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		return start, start.AddDate(l.years, l.months, l.days), true
+	}
+	return time.Time{}, time.Time{}, false
+}
@@ -0,0 +1,89 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package search_parser
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	q := parseQuery(`golang OR rust -python "exact phrase" [go] OR [rust] -[java] created:2024-01..2024-06 intitle:"hello world" OR inbody:foo OR`)
+	assert.Len(t, q.groups, 7)
+
+	assert.Equal(t, []*queryTerm{
+		{kind: queryTermWord, value: "golang"},
+		{kind: queryTermWord, value: "rust"},
+	}, q.groups[0])
+	assert.Equal(t, []*queryTerm{{kind: queryTermWord, value: "python", negated: true}}, q.groups[1])
+	assert.Equal(t, []*queryTerm{{kind: queryTermPhrase, value: "exact phrase"}}, q.groups[2])
+	assert.Equal(t, []*queryTerm{
+		{kind: queryTermTag, value: "go"},
+		{kind: queryTermTag, value: "rust"},
+	}, q.groups[3])
+	assert.Equal(t, []*queryTerm{{kind: queryTermTag, value: "java", negated: true}}, q.groups[4])
+	assert.Equal(t, []*queryTerm{{kind: queryTermFilter, key: "created", value: "2024-01..2024-06"}}, q.groups[5])
+	assert.Equal(t, []*queryTerm{
+		{kind: queryTermFilter, key: "intitle", value: "hello world", quoted: true},
+		{kind: queryTermFilter, key: "inbody", value: "foo"},
+	}, q.groups[6])
+
+	// OR does not join the tags with the words, and the unknown keys are words
+	q = parseQuery(`[go] OR golang foo:bar "unclosed`)
+	assert.Len(t, q.groups, 4)
+	assert.Equal(t, queryTermWord, q.groups[2][0].kind)
+	assert.Equal(t, "foo:bar", q.groups[2][0].value)
+	assert.Equal(t, "unclosed", q.groups[3][0].value)
+
+	assert.Empty(t, parseQuery(`"" [] OR`).groups)
+}
+
+func TestParseTimeRange(t *testing.T) {
+	now := time.Date(2024, 8, 15, 10, 0, 0, 0, time.Local)
+
+	after, before, ok := parseTimeRange("2024-01..2024-06", now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), after)
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.Local), before)
+
+	after, before, ok = parseTimeRange("2023", now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.Local), after)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), before)
+
+	after, before, ok = parseTimeRange("7d", now)
+	assert.True(t, ok)
+	assert.Equal(t, now.AddDate(0, 0, -7), after)
+	assert.True(t, before.IsZero())
+
+	after, before, ok = parseTimeRange("..1m", now)
+	assert.True(t, ok)
+	assert.True(t, after.IsZero())
+	assert.Equal(t, now.AddDate(0, -1, 0), before)
+
+	_, _, ok = parseTimeRange("yesterday", now)
+	assert.False(t, ok)
+	_, _, ok = parseTimeRange("..", now)
+	assert.False(t, ok)
+	_, _, ok = parseTimeRange("2024-13", now)
+	assert.False(t, ok)
+}
@@ -22,313 +22,298 @@ package search_parser
 import (
 	"context"
 	"fmt"
-	"github.com/apache/answer/internal/base/constant"
 	"regexp"
-	"strings"
+	"time"
 
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/activity_common"
+	collectioncommon "github.com/apache/answer/internal/service/collection_common"
 	"github.com/apache/answer/internal/service/tag_common"
 	usercommon "github.com/apache/answer/internal/service/user_common"
 	"github.com/apache/answer/pkg/converter"
+	"github.com/apache/answer/plugin"
+	"github.com/segmentfault/pacman/log"
+)
+
+const (
+	// limitWords the maximum number of the keywords and the excluded keywords
+	limitWords = 5
+	// limitTags the maximum number of the tag groups and the excluded tags
+	limitTags = 5
 )
 
+var numberPattern = regexp.MustCompile(`^\d+$`)
+
 type SearchParser struct {
 	tagCommonService *tag_common.TagCommonService
 	userCommon       *usercommon.UserCommon
+	collectionRepo   collectioncommon.CollectionRepo
+	followRepo       activity_common.FollowRepo
 }
 
-func NewSearchParser(tagCommonService *tag_common.TagCommonService, userCommon *usercommon.UserCommon) *SearchParser {
+func NewSearchParser(
+	tagCommonService *tag_common.TagCommonService,
+	userCommon *usercommon.UserCommon,
+	collectionRepo collectioncommon.CollectionRepo,
+	followRepo activity_common.FollowRepo,
+) *SearchParser {
 	return &SearchParser{
 		tagCommonService: tagCommonService,
 		userCommon:       userCommon,
+		collectionRepo:   collectionRepo,
+		followRepo:       followRepo,
 	}
 }
 
 // ParseStructure parse search structure, maybe match one of type all/questions/answers,
-// but if match two type, it will return false
+// but if match two type, the latter one is used
 func (sp *SearchParser) ParseStructure(ctx context.Context, dto *schema.SearchDTO) (cond *schema.SearchCondition) {
-	cond = &schema.SearchCondition{}
-	var (
-		query      = dto.Query
-		limitWords = 5
-	)
-
-	// match tags
-	cond.Tags = sp.parseTags(ctx, &query)
-
-	// match all
-	cond.UserID = sp.parseUserID(ctx, &query, dto.UserID)
-	cond.VoteAmount = sp.parseVotes(&query)
-	cond.Words = sp.parseWithin(&query)
-
-	// match questions
-	cond.NotAccepted = sp.parseNotAccepted(&query)
-	if cond.NotAccepted {
-		cond.TargetType = constant.QuestionObjectType
+	cond = &schema.SearchCondition{
+		VoteAmount:   -1,
+		Views:        -1,
+		AnswerAmount: -1,
 	}
-	cond.Views = sp.parseViews(&query)
-	if cond.Views != -1 {
-		cond.TargetType = constant.QuestionObjectType
+	for _, group := range parseQuery(dto.Query).groups {
+		switch {
+		case group[0].kind == queryTermTag:
+			sp.parseTags(ctx, cond, group)
+		case group[0].keyword():
+			sp.parseKeywords(cond, group)
+		default:
+			sp.parseFilter(ctx, cond, group[0], dto.UserID)
+		}
 	}
-	cond.AnswerAmount = sp.parseAnswers(&query)
-	if cond.AnswerAmount != -1 {
-		cond.TargetType = constant.QuestionObjectType
+
+	// flatten the keywords for the highlight and the relevance
+	for _, group := range cond.KeywordGroups {
+		for _, keyword := range group {
+			cond.Words = append(cond.Words, keyword.Text)
+		}
 	}
+	return
+}
 
-	// match answers
-	cond.Accepted = sp.parseAccepted(&query)
-	if cond.Accepted {
-		cond.TargetType = constant.AnswerObjectType
+// parseKeywords parse a group of keywords, the group is ignored if the keywords are more than the limit
+func (sp *SearchParser) parseKeywords(cond *schema.SearchCondition, group []*queryTerm) {
+	keywords := make([]plugin.SearchKeyword, 0)
+	for _, term := range group {
+		keywords = append(keywords, termKeywords(term)...)
 	}
-	cond.QuestionID = sp.parseQuestionID(&query)
-	if cond.QuestionID != "" {
-		cond.TargetType = constant.AnswerObjectType
+	if len(keywords) == 0 {
+		return
 	}
 
-	if sp.parseIsQuestion(&query) {
-		cond.TargetType = constant.QuestionObjectType
+	if group[0].negated {
+		if len(cond.ExcludedKeywords)+len(keywords) <= limitWords {
+			cond.ExcludedKeywords = append(cond.ExcludedKeywords, keywords...)
+		}
+		return
 	}
-	if sp.parseIsAnswer(&query) {
-		cond.TargetType = constant.AnswerObjectType
+	count := len(keywords)
+	for _, g := range cond.KeywordGroups {
+		count += len(g)
 	}
-
-	if len(strings.TrimSpace(query)) > 0 {
-		words := strings.Split(strings.TrimSpace(query), " ")
-		cond.Words = append(cond.Words, words...)
+	if count <= limitWords {
+		cond.KeywordGroups = append(cond.KeywordGroups, keywords)
 	}
+}
 
-	// check limit words
-	if len(cond.Words) > limitWords {
-		cond.Words = cond.Words[:limitWords]
+// termKeywords returns the keywords of the term, the words are split by the special characters
+// and the phrases are kept as they are
+func termKeywords(term *queryTerm) (keywords []plugin.SearchKeyword) {
+	var field plugin.SearchKeywordField
+	switch term.key {
+	case "intitle":
+		field = plugin.SearchKeywordFieldTitle
+	case "inbody":
+		field = plugin.SearchKeywordFieldContent
 	}
-	return
+	if term.kind == queryTermPhrase || term.quoted {
+		return []plugin.SearchKeyword{{Text: term.value, Phrase: true, Field: field}}
+	}
+	for _, word := range schema.SplitSearchWord(term.value) {
+		keywords = append(keywords, plugin.SearchKeyword{Text: word, Field: field})
+	}
+	return keywords
 }
 
-// parseTags parse search tags, return tag ids array
-func (sp *SearchParser) parseTags(ctx context.Context, query *string) (tags [][]string) {
-	var (
-		// expire tag pattern
-		exprTag = `\[(.*?)\]`
-		q       = *query
-		limit   = 5
-	)
-
-	re := regexp.MustCompile(exprTag)
-	res := re.FindAllStringSubmatch(q, -1)
-	if len(res) == 0 {
+// parseTags parse a group of tags, the content must have any of them, or must not have all of them if negated
+func (sp *SearchParser) parseTags(ctx context.Context, cond *schema.SearchCondition, group []*queryTerm) {
+	tagIDs := make([]string, 0)
+	for _, term := range group {
+		tagIDs = append(tagIDs, sp.getTagIDs(ctx, term.value)...)
+	}
+	if len(tagIDs) == 0 {
 		return
 	}
+	tagIDs = converter.UniqueArray(tagIDs)
 
-	tags = make([][]string, 0)
-	for _, item := range res {
-		tagGroup := make([]string, 0)
-		tag, exists, err := sp.tagCommonService.GetTagBySlugName(ctx, item[1])
-		if err != nil || !exists {
-			continue
-		}
-		tagGroup = append(tagGroup, tag.ID)
-		if tag.MainTagID > 0 {
-			tagGroup = append(tagGroup, fmt.Sprintf("%d", tag.MainTagID))
-		}
-		synIDs, err := sp.tagCommonService.GetTagIDsByMainTagID(ctx, tag.ID)
-		if err != nil || !exists {
-			continue
+	if group[0].negated {
+		if len(cond.ExcludedTags) < limitTags {
+			cond.ExcludedTags = append(cond.ExcludedTags, tagIDs...)
 		}
-		tagGroup = append(tagGroup, tag.ID)
-		tagGroup = append(tagGroup, synIDs...)
-		tagGroup = converter.UniqueArray(tagGroup)
-		tags = append(tags, tagGroup)
+		return
 	}
-
-	// limit maximum 5 tags
-	if len(tags) > limit {
-		tags = tags[:limit]
+	if len(cond.Tags) < limitTags {
+		cond.Tags = append(cond.Tags, tagIDs)
 	}
-
-	q = strings.TrimSpace(re.ReplaceAllString(q, ""))
-	*query = q
-	return
 }
 
-// parseUserID return user id or current login user id
-func (sp *SearchParser) parseUserID(ctx context.Context, query *string, currentUserID string) (userID string) {
-	var (
-		exprUsername = `user:(\S+)`
-		exprMe       = "user:me"
-		q            = *query
-	)
-
-	re := regexp.MustCompile(exprUsername)
-	res := re.FindStringSubmatch(q)
-	if strings.Contains(q, exprMe) {
-		userID = currentUserID
-		q = strings.ReplaceAll(q, exprMe, "")
-	} else if len(res) > 1 {
-		name := res[1]
-		user, has, err := sp.userCommon.GetUserBasicInfoByUserName(ctx, name)
-		if err == nil && has {
-			userID = user.ID
-			q = re.ReplaceAllString(q, "")
-		}
+// getTagIDs return the tag id and its synonyms' ids
+func (sp *SearchParser) getTagIDs(ctx context.Context, slugName string) (tagIDs []string) {
+	tag, exists, err := sp.tagCommonService.GetTagBySlugName(ctx, slugName)
+	if err != nil || !exists {
+		return nil
 	}
-	*query = strings.TrimSpace(q)
-	return
-}
-
-// parseVotes return the votes of search query
-func (sp *SearchParser) parseVotes(query *string) (votes int) {
-	var (
-		expr = `score:(\d+)`
-		q    = *query
-	)
-	votes = -1
-
-	re := regexp.MustCompile(expr)
-	res := re.FindStringSubmatch(q)
-	if len(res) > 1 {
-		votes = converter.StringToInt(res[1])
-		q = re.ReplaceAllString(q, "")
+	tagIDs = append(tagIDs, tag.ID)
+	if tag.MainTagID > 0 {
+		tagIDs = append(tagIDs, fmt.Sprintf("%d", tag.MainTagID))
 	}
-
-	*query = strings.TrimSpace(q)
-	return
+	synIDs, err := sp.tagCommonService.GetTagIDsByMainTagID(ctx, tag.ID)
+	if err != nil {
+		return nil
+	}
+	tagIDs = append(tagIDs, synIDs...)
+	return converter.UniqueArray(tagIDs)
 }
 
-// parseWithin parse quotes within words like: "hello world"
-func (sp *SearchParser) parseWithin(query *string) (words []string) {
-	var (
-		q    = *query
-		expr = `(?U)(".+")`
-	)
-	re := regexp.MustCompile(expr)
-	matches := re.FindAllStringSubmatch(q, -1)
-	words = []string{}
-	for _, match := range matches {
-		if len(match[1]) == 0 {
-			continue
+// parseFilter parse the key:value filter, the invalid value is ignored
+func (sp *SearchParser) parseFilter(ctx context.Context, cond *schema.SearchCondition, term *queryTerm, currentUserID string) {
+	switch term.key {
+	// match all
+	case "user":
+		cond.UserID = sp.parseUserID(ctx, term.value, currentUserID)
+	case "score":
+		if numberPattern.MatchString(term.value) {
+			cond.VoteAmount = converter.StringToInt(term.value)
+		}
+	case "created":
+		if after, before, ok := parseTimeRange(term.value, time.Now()); ok {
+			cond.CreatedAfter, cond.CreatedBefore = after, before
+		}
+	case "lastactive":
+		if after, before, ok := parseTimeRange(term.value, time.Now()); ok {
+			cond.ActiveAfter, cond.ActiveBefore = after, before
+		}
+	case "collection":
+		if term.value == "mine" {
+			limitObjectIDs(cond, sp.getCollectionObjectIDs(ctx, currentUserID))
+		}
+	case "is":
+		switch term.value {
+		case "question":
+			cond.TargetType = constant.QuestionObjectType
+		case "answer":
+			cond.TargetType = constant.AnswerObjectType
 		}
-		words = append(words, match[1])
-	}
-	q = re.ReplaceAllString(q, "")
-	*query = strings.TrimSpace(q)
-	return
-}
 
-// parseNotAccepted return the question has not accepted the answer
-func (sp *SearchParser) parseNotAccepted(query *string) (notAccepted bool) {
-	var (
-		q    = *query
-		expr = `hasaccepted:no`
-	)
+	// match questions
+	case "views":
+		if numberPattern.MatchString(term.value) {
+			cond.Views = converter.StringToInt(term.value)
+			cond.TargetType = constant.QuestionObjectType
+		}
+	case "answers":
+		if numberPattern.MatchString(term.value) {
+			cond.AnswerAmount = converter.StringToInt(term.value)
+			cond.TargetType = constant.QuestionObjectType
+		}
+	case "hasaccepted":
+		switch term.value {
+		case "yes":
+			cond.HasAccepted, cond.NotAccepted = true, false
+			cond.TargetType = constant.QuestionObjectType
+		case "no":
+			cond.HasAccepted, cond.NotAccepted = false, true
+			cond.TargetType = constant.QuestionObjectType
+		}
+	case "closed":
+		switch term.value {
+		case "yes":
+			cond.Closed, cond.NotClosed = true, false
+			cond.TargetType = constant.QuestionObjectType
+		case "no":
+			cond.Closed, cond.NotClosed = false, true
+			cond.TargetType = constant.QuestionObjectType
+		}
+	case "following":
+		if term.value == "yes" {
+			limitObjectIDs(cond, sp.getFollowingQuestionIDs(ctx, currentUserID))
+			cond.TargetType = constant.QuestionObjectType
+		}
 
-	if strings.Contains(q, expr) {
-		q = strings.ReplaceAll(q, expr, "")
-		notAccepted = true
+	// match answers
+	case "isaccepted":
+		if term.value == "yes" {
+			cond.Accepted = true
+			cond.TargetType = constant.AnswerObjectType
+		}
+	case "inquestion":
+		if numberPattern.MatchString(term.value) {
+			cond.QuestionID = term.value
+			cond.TargetType = constant.AnswerObjectType
+		}
 	}
-
-	*query = strings.TrimSpace(q)
-	return
 }
 
-// parseIsQuestion check the result if only limit question or not
-func (sp *SearchParser) parseIsQuestion(query *string) (isQuestion bool) {
-	var (
-		q    = *query
-		expr = `is:question`
-	)
-
-	if strings.Contains(q, expr) {
-		q = strings.ReplaceAll(q, expr, "")
-		isQuestion = true
+// parseUserID return user id or current login user id
+func (sp *SearchParser) parseUserID(ctx context.Context, name, currentUserID string) (userID string) {
+	if name == "me" {
+		return currentUserID
 	}
-
-	*query = strings.TrimSpace(q)
-	return
-}
-
-// parseViews check search has views or not
-func (sp *SearchParser) parseViews(query *string) (views int) {
-	var (
-		q    = *query
-		expr = `views:(\d+)`
-	)
-	views = -1
-
-	re := regexp.MustCompile(expr)
-	res := re.FindStringSubmatch(q)
-	if len(res) > 1 {
-		views = converter.StringToInt(res[1])
-		q = re.ReplaceAllString(q, "")
+	user, has, err := sp.userCommon.GetUserBasicInfoByUserName(ctx, name)
+	if err != nil || !has {
+		return ""
 	}
-	*query = strings.TrimSpace(q)
-	return
+	return user.ID
 }
 
-// parseAnswers check whether specified answer count for question
-func (sp *SearchParser) parseAnswers(query *string) (answers int) {
-	var (
-		q    = *query
-		expr = `answers:(\d+)`
-	)
-	answers = -1
-
-	re := regexp.MustCompile(expr)
-	res := re.FindStringSubmatch(q)
-	if len(res) > 1 {
-		answers = converter.StringToInt(res[1])
-		q = re.ReplaceAllString(q, "")
+// getCollectionObjectIDs return the ids of the questions and answers collected by the user
+func (sp *SearchParser) getCollectionObjectIDs(ctx context.Context, userID string) (objectIDs []string) {
+	if len(userID) == 0 {
+		return nil
 	}
-
-	*query = strings.TrimSpace(q)
-	return
-}
-
-// parseAccepted check the search is limit accepted answer or not
-func (sp *SearchParser) parseAccepted(query *string) (accepted bool) {
-	var (
-		q    = *query
-		expr = `isaccepted:yes`
-	)
-
-	if strings.Contains(q, expr) {
-		accepted = true
-		q = strings.ReplaceAll(q, expr, "")
+	collections, err := sp.collectionRepo.GetCollectionList(ctx, &entity.Collection{UserID: userID})
+	if err != nil {
+		log.Error(err)
+		return nil
 	}
-
-	*query = strings.TrimSpace(q)
-	return
+	for _, collection := range collections {
+		objectIDs = append(objectIDs, collection.ObjectID)
+	}
+	return converter.UniqueArray(objectIDs)
 }
 
-// parseQuestionID check whether specified question's id
-func (sp *SearchParser) parseQuestionID(query *string) (questionID string) {
-	var (
-		q    = *query
-		expr = `inquestion:(\d+)`
-	)
-
-	re := regexp.MustCompile(expr)
-	res := re.FindStringSubmatch(q)
-	if len(res) == 2 {
-		questionID = res[1]
-		q = re.ReplaceAllString(q, "")
+// getFollowingQuestionIDs return the ids of the questions followed by the user
+func (sp *SearchParser) getFollowingQuestionIDs(ctx context.Context, userID string) (questionIDs []string) {
+	if len(userID) == 0 {
+		return nil
 	}
-
-	*query = strings.TrimSpace(q)
-	return
+	questionIDs, err := sp.followRepo.GetFollowIDs(ctx, userID, entity.Question{}.TableName())
+	if err != nil {
+		log.Error(err)
+		return nil
+	}
+	return questionIDs
 }
 
-// parseIsAnswer check the result if only limit answer or not
-func (sp *SearchParser) parseIsAnswer(query *string) (isAnswer bool) {
-	var (
-		q    = *query
-		expr = `is:answer`
-	)
-
-	if strings.Contains(q, expr) {
-		isAnswer = true
-		q = strings.ReplaceAll(q, expr, "")
+// limitObjectIDs limits the result to the object ids, they are intersected with the previous ones
+func limitObjectIDs(cond *schema.SearchCondition, objectIDs []string) {
+	if !cond.HasObjectIDs {
+		cond.ObjectIDs = objectIDs
+		cond.HasObjectIDs = true
+		return
 	}
-
-	*query = strings.TrimSpace(q)
-	return
+	limited := make([]string, 0)
+	for _, objectID := range objectIDs {
+		for _, id := range cond.ObjectIDs {
+			if id == objectID {
+				limited = append(limited, objectID)
+				break
+			}
+		}
+	}
+	cond.ObjectIDs = limited
 }
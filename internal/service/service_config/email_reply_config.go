@@ -0,0 +1,75 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package service_config
+
+import (
+	"net/mail"
+	"strings"
+)
+
+const (
+	defaultEmailReplyPollIntervalSeconds = 30
+	defaultEmailReplyValidDays           = 30
+)
+
+// EmailReplyConfig the notification emails are replied to the address with a signed token,
+// e.g. reply+token@example.com, the replies are posted by the ingest endpoint or read from the maildir
+type EmailReplyConfig struct {
+	Enabled bool `json:"enabled" mapstructure:"enabled" yaml:"enabled"`
+	// Address the mailbox receiving the replies, the token is added after the + of the local part
+	Address string `json:"address" mapstructure:"address" yaml:"address"`
+	// Secret the key to sign the reply addresses
+	Secret string `json:"secret" mapstructure:"secret" yaml:"secret"`
+	// IngestToken the token required by the ingest endpoint, the endpoint is closed if it is empty
+	IngestToken string `json:"ingest_token" mapstructure:"ingest_token" yaml:"ingest_token"`
+	// Maildir the maildir polled for the replies, the replies are not polled if it is empty
+	Maildir             string `json:"maildir" mapstructure:"maildir" yaml:"maildir"`
+	PollIntervalSeconds int    `json:"poll_interval_seconds" mapstructure:"poll_interval_seconds" yaml:"poll_interval_seconds"`
+	ValidDays           int    `json:"valid_days" mapstructure:"valid_days" yaml:"valid_days"`
+}
+
+// WithDefault get the config with the default values filled in, the config could be nil
+func (c *EmailReplyConfig) WithDefault() *EmailReplyConfig {
+	conf := &EmailReplyConfig{}
+	if c != nil {
+		*conf = *c
+	}
+	if conf.PollIntervalSeconds <= 0 {
+		conf.PollIntervalSeconds = defaultEmailReplyPollIntervalSeconds
+	}
+	if conf.ValidDays <= 0 {
+		conf.ValidDays = defaultEmailReplyValidDays
+	}
+	// replying can not work without the mailbox or the key
+	if _, _, ok := conf.SplitAddress(); !ok || len(conf.Secret) == 0 {
+		conf.Enabled = false
+	}
+	return conf
+}
+
+// SplitAddress get the local part and the domain of the reply address
+func (c *EmailReplyConfig) SplitAddress() (local, domain string, ok bool) {
+	addr, err := mail.ParseAddress(c.Address)
+	if err != nil {
+		return "", "", false
+	}
+	local, domain, ok = strings.Cut(addr.Address, "@")
+	return strings.ToLower(local), strings.ToLower(domain), ok && len(local) > 0 && len(domain) > 0
+}
@@ -19,9 +19,13 @@
 
 package service_config
 
+import "github.com/apache/answer/internal/base/queue"
+
 type ServiceConfig struct {
-	UploadPath                    string `json:"upload_path" mapstructure:"upload_path" yaml:"upload_path"`
-	CleanUpUploads                bool   `json:"clean_up_uploads" mapstructure:"clean_up_uploads" yaml:"clean_up_uploads"`
-	CleanOrphanUploadsPeriodHours int    `json:"clean_orphan_uploads_period_hours" mapstructure:"clean_orphan_uploads_period_hours" yaml:"clean_orphan_uploads_period_hours"`
-	PurgeDeletedFilesPeriodDays   int    `json:"purge_deleted_files_period_days" mapstructure:"purge_deleted_files_period_days" yaml:"purge_deleted_files_period_days"`
+	UploadPath                    string            `json:"upload_path" mapstructure:"upload_path" yaml:"upload_path"`
+	CleanUpUploads                bool              `json:"clean_up_uploads" mapstructure:"clean_up_uploads" yaml:"clean_up_uploads"`
+	CleanOrphanUploadsPeriodHours int               `json:"clean_orphan_uploads_period_hours" mapstructure:"clean_orphan_uploads_period_hours" yaml:"clean_orphan_uploads_period_hours"`
+	PurgeDeletedFilesPeriodDays   int               `json:"purge_deleted_files_period_days" mapstructure:"purge_deleted_files_period_days" yaml:"purge_deleted_files_period_days"`
+	Queue                         *queue.Config     `json:"queue" mapstructure:"queue" yaml:"queue"`
+	EmailReply                    *EmailReplyConfig `json:"email_reply" mapstructure:"email_reply" yaml:"email_reply"`
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
 	"github.com/apache/answer/internal/service/config"
+	"github.com/apache/answer/internal/service/currency"
 	"github.com/apache/answer/internal/service/export"
 	"github.com/apache/answer/internal/service/file_record"
 	questioncommon "github.com/apache/answer/internal/service/question_common"
@@ -52,6 +53,7 @@ type SiteInfoService struct {
 	configService         *config.ConfigService
 	questioncommon        *questioncommon.QuestionCommon
 	fileRecordService     *file_record.FileRecordService
+	currencyService       *currency.CurrencyService
 }
 
 func NewSiteInfoService(
@@ -62,7 +64,7 @@ func NewSiteInfoService(
 	configService *config.ConfigService,
 	questioncommon *questioncommon.QuestionCommon,
 	fileRecordService *file_record.FileRecordService,
-
+	currencyService *currency.CurrencyService,
 ) *SiteInfoService {
 	plugin.RegisterGetSiteURLFunc(func() string {
 		generalSiteInfo, err := siteInfoCommonService.GetSiteGeneral(context.Background())
@@ -72,6 +74,14 @@ func NewSiteInfoService(
 		}
 		return generalSiteInfo.SiteUrl
 	})
+	plugin.RegisterGetSiteLanguageFunc(func() string {
+		interfaceSiteInfo, err := siteInfoCommonService.GetSiteInterface(context.Background())
+		if err != nil {
+			log.Error(err)
+			return ""
+		}
+		return interfaceSiteInfo.Language
+	})
 
 	return &SiteInfoService{
 		siteInfoRepo:          siteInfoRepo,
@@ -81,6 +91,7 @@ func NewSiteInfoService(
 		configService:         configService,
 		questioncommon:        questioncommon,
 		fileRecordService:     fileRecordService,
+		currencyService:       currencyService,
 	}
 }
 
@@ -104,6 +115,16 @@ func (s *SiteInfoService) GetSiteUsers(ctx context.Context) (resp *schema.SiteUs
 	return s.siteInfoCommonService.GetSiteUsers(ctx)
 }
 
+// GetSiteJob get site job posting config
+func (s *SiteInfoService) GetSiteJob(ctx context.Context) (resp *schema.SiteJobResp, err error) {
+	return s.siteInfoCommonService.GetSiteJob(ctx)
+}
+
+// GetSiteCurrency get site currency config
+func (s *SiteInfoService) GetSiteCurrency(ctx context.Context) (resp *schema.SiteCurrencyResp, err error) {
+	return s.siteInfoCommonService.GetSiteCurrency(ctx)
+}
+
 // GetSiteWrite get site info write
 func (s *SiteInfoService) GetSiteWrite(ctx context.Context) (resp *schema.SiteWriteResp, err error) {
 	resp = &schema.SiteWriteResp{}
@@ -274,6 +295,32 @@ func (s *SiteInfoService) SaveSiteUsers(ctx context.Context, req *schema.SiteUse
 	return s.siteInfoRepo.SaveByType(ctx, constant.SiteTypeUsers, data)
 }
 
+// SaveSiteJob save site job posting config
+func (s *SiteInfoService) SaveSiteJob(ctx context.Context, req *schema.SiteJobReq) (err error) {
+	content, _ := json.Marshal(req)
+	data := &entity.SiteInfo{
+		Type:    constant.SiteTypeJob,
+		Content: string(content),
+		Status:  1,
+	}
+	return s.siteInfoRepo.SaveByType(ctx, constant.SiteTypeJob, data)
+}
+
+// SaveSiteCurrency save site currency config, the base amounts are converted with the new rates in background
+func (s *SiteInfoService) SaveSiteCurrency(ctx context.Context, req *schema.SiteCurrencyReq) (err error) {
+	content, _ := json.Marshal(req)
+	data := &entity.SiteInfo{
+		Type:    constant.SiteTypeCurrency,
+		Content: string(content),
+		Status:  1,
+	}
+	if err = s.siteInfoRepo.SaveByType(ctx, constant.SiteTypeCurrency, data); err != nil {
+		return err
+	}
+	go s.currencyService.RefreshBaseAmounts(context.Background())
+	return nil
+}
+
 // GetSMTPConfig get smtp config
 func (s *SiteInfoService) GetSMTPConfig(ctx context.Context) (resp *schema.GetSMTPConfigResp, err error) {
 	emailConfig, err := s.emailService.GetEmailConfig(ctx)
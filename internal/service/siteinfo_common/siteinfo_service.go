@@ -48,6 +48,8 @@ type SiteInfoCommonService interface {
 	GetSiteInterface(ctx context.Context) (resp *schema.SiteInterfaceResp, err error)
 	GetSiteBranding(ctx context.Context) (resp *schema.SiteBrandingResp, err error)
 	GetSiteUsers(ctx context.Context) (resp *schema.SiteUsersResp, err error)
+	GetSiteJob(ctx context.Context) (resp *schema.SiteJobResp, err error)
+	GetSiteCurrency(ctx context.Context) (resp *schema.SiteCurrencyResp, err error)
 	FormatAvatar(ctx context.Context, originalAvatarData, email string, userStatus int) *schema.AvatarInfo
 	FormatListAvatar(ctx context.Context, userList []*entity.User) (userID2AvatarMapping map[string]*schema.AvatarInfo)
 	GetSiteWrite(ctx context.Context) (resp *schema.SiteWriteResp, err error)
@@ -158,6 +160,33 @@ func (s *siteInfoCommonService) selectedAvatar(
 	return avatarInfo
 }
 
+// GetSiteJob get site job posting config, the default value is used if it has never been saved
+func (s *siteInfoCommonService) GetSiteJob(ctx context.Context) (resp *schema.SiteJobResp, err error) {
+	resp = &schema.SiteJobResp{
+		ExpiryNoticeDays: constant.DefaultJobPostingExpiryNoticeDays,
+		MaxLifetimeDays:  constant.DefaultJobPostingMaxLifetimeDays,
+
+		VerificationValidDays:   constant.DefaultFreelancerVerificationValidDays,
+		PortfolioAnswerMinVotes: constant.DefaultPortfolioAnswerMinVotes,
+	}
+	if err = s.GetSiteInfoByType(ctx, constant.SiteTypeJob, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetSiteCurrency get site currency config, the default base currency is used if it has never been saved
+func (s *siteInfoCommonService) GetSiteCurrency(ctx context.Context) (resp *schema.SiteCurrencyResp, err error) {
+	resp = &schema.SiteCurrencyResp{
+		BaseCurrency: constant.DefaultBaseCurrency,
+		Rates:        make(map[string]float64),
+	}
+	if err = s.GetSiteInfoByType(ctx, constant.SiteTypeCurrency, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // GetSiteWrite get site info write
 func (s *siteInfoCommonService) GetSiteWrite(ctx context.Context) (resp *schema.SiteWriteResp, err error) {
 	resp = &schema.SiteWriteResp{}
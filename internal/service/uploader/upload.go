@@ -55,6 +55,9 @@ var (
 		constant.PostSubPath,
 		constant.BrandingSubPath,
 		constant.FilesPostSubPath,
+		constant.FilesMessageSubPath,
+		constant.FilesVerificationSubPath,
+		constant.FilesPortfolioSubPath,
 		constant.DeletedSubPath,
 	}
 	supportedThumbFileExtMapping = map[string]imaging.Format{
@@ -63,12 +66,21 @@ var (
 		".png":  imaging.PNG,
 		".gif":  imaging.GIF,
 	}
+	// privateAttachmentDownloadPathMapping the private attachments are not served as static files,
+	// they are downloaded by the api which checks the permission of the user
+	privateAttachmentDownloadPathMapping = map[string]string{
+		constant.FilesMessageSubPath:      "/answer/api/v1/conversation/attachment",
+		constant.FilesVerificationSubPath: "/answer/admin/api/freelancer/verification/evidence",
+	}
 )
 
 type UploaderService interface {
 	UploadAvatarFile(ctx *gin.Context, userID string) (url string, err error)
 	UploadPostFile(ctx *gin.Context, userID string) (url string, err error)
 	UploadPostAttachment(ctx *gin.Context, userID string) (url string, err error)
+	UploadMessageAttachment(ctx *gin.Context, userID string) (url string, err error)
+	UploadVerificationEvidence(ctx *gin.Context, userID string) (url string, err error)
+	UploadPortfolioFile(ctx *gin.Context, userID string) (url string, err error)
 	UploadBrandingFile(ctx *gin.Context, userID string) (url string, err error)
 	AvatarThumbFile(ctx *gin.Context, fileName string, size int) (url string, err error)
 }
@@ -226,7 +238,30 @@ func (us *uploaderService) UploadPostFile(ctx *gin.Context, userID string) (
 
 func (us *uploaderService) UploadPostAttachment(ctx *gin.Context, userID string) (
 	url string, err error) {
-	url, err = us.tryToUploadByPlugin(ctx, plugin.UserPostAttachment)
+	return us.uploadAttachment(ctx, userID, plugin.UserPostAttachment, constant.FilesPostSubPath)
+}
+
+// UploadMessageAttachment upload the attachment of the private message
+func (us *uploaderService) UploadMessageAttachment(ctx *gin.Context, userID string) (
+	url string, err error) {
+	return us.uploadAttachment(ctx, userID, plugin.UserMessageAttachment, constant.FilesMessageSubPath)
+}
+
+// UploadVerificationEvidence upload the evidence of the freelancer verification request
+func (us *uploaderService) UploadVerificationEvidence(ctx *gin.Context, userID string) (
+	url string, err error) {
+	return us.uploadAttachment(ctx, userID, plugin.UserVerificationEvidence, constant.FilesVerificationSubPath)
+}
+
+// UploadPortfolioFile upload the image or the file of the freelancer portfolio item
+func (us *uploaderService) UploadPortfolioFile(ctx *gin.Context, userID string) (
+	url string, err error) {
+	return us.uploadAttachment(ctx, userID, plugin.UserPortfolioFile, constant.FilesPortfolioSubPath)
+}
+
+func (us *uploaderService) uploadAttachment(ctx *gin.Context, userID string,
+	source plugin.UploadSource, fileSubPath string) (url string, err error) {
+	url, err = us.tryToUploadByPlugin(ctx, source)
 	if err != nil {
 		return "", err
 	}
@@ -251,12 +286,22 @@ func (us *uploaderService) UploadPostAttachment(ctx *gin.Context, userID string)
 
 	fileExt := strings.ToLower(path.Ext(fileHeader.Filename))
 	newFilename := fmt.Sprintf("%s%s", uid.IDStr12(), fileExt)
-	attachmentFilePath := path.Join(constant.FilesPostSubPath, newFilename)
+	attachmentFilePath := path.Join(fileSubPath, newFilename)
 	url, err = us.uploadAttachmentFile(ctx, fileHeader, fileHeader.Filename, attachmentFilePath)
 	if err != nil {
 		return "", err
 	}
-	us.fileRecordService.AddFileRecord(ctx, userID, attachmentFilePath, url, string(plugin.UserPostAttachment))
+	// The portfolio image is displayed in the page, so it is checked as the post image
+	if source == plugin.UserPortfolioFile && plugin.DefaultFileTypeCheckMapping[plugin.UserPost][fileExt] {
+		filePath := path.Join(us.serviceConfig.UploadPath, attachmentFilePath)
+		if !checker.DecodeAndCheckImageFile(filePath, resp.GetMaxImageMegapixel()) {
+			return "", errors.BadRequest(reason.UploadFileUnsupportedFileFormat)
+		}
+		if err := removeExif(filePath); err != nil {
+			log.Error(err)
+		}
+	}
+	us.fileRecordService.AddFileRecord(ctx, userID, attachmentFilePath, url, string(source))
 	return url, nil
 }
 
@@ -349,6 +394,9 @@ func (us *uploaderService) uploadAttachmentFile(ctx *gin.Context, file *multipar
 	// When downloading, the download link will be redirect to the local saved path. And the download filename will be 123.png.
 	downloadPath := strings.TrimSuffix(fileSubPath, filepath.Ext(fileSubPath)) + "/" + originalFilename
 	downloadUrl = fmt.Sprintf("%s/uploads/%s", siteGeneral.SiteUrl, downloadPath)
+	if apiPath, ok := privateAttachmentDownloadPathMapping[path.Dir(fileSubPath)]; ok {
+		downloadUrl = fmt.Sprintf("%s%s/%s", siteGeneral.SiteUrl, apiPath, strings.TrimPrefix(downloadPath, path.Dir(fileSubPath)+"/"))
+	}
 	return downloadUrl, nil
 }
 
@@ -75,6 +75,7 @@ type UserAdminRepo interface {
 type UserAdminService struct {
 	userRepo              UserAdminRepo
 	userRoleRelService    *role.UserRoleRelService
+	roleService           *role.RoleService
 	authService           *auth.AuthService
 	userCommonService     *usercommon.UserCommon
 	userActivity          activity.UserActiveActivityRepo
@@ -93,6 +94,7 @@ type UserAdminService struct {
 func NewUserAdminService(
 	userRepo UserAdminRepo,
 	userRoleRelService *role.UserRoleRelService,
+	roleService *role.RoleService,
 	authService *auth.AuthService,
 	userCommonService *usercommon.UserCommon,
 	userActivity activity.UserActiveActivityRepo,
@@ -109,6 +111,7 @@ func NewUserAdminService(
 	return &UserAdminService{
 		userRepo:              userRepo,
 		userRoleRelService:    userRoleRelService,
+		roleService:           roleService,
 		authService:           authService,
 		userCommonService:     userCommonService,
 		userActivity:          userActivity,
@@ -218,18 +221,11 @@ func (us *UserAdminService) removeAllUserCreatedContent(ctx context.Context, use
 
 // UpdateUserRole update user role
 func (us *UserAdminService) UpdateUserRole(ctx context.Context, req *schema.UpdateUserRoleReq) (err error) {
-	// Users cannot modify their roles
-	if req.UserID == req.LoginUserID {
-		return errors.BadRequest(reason.UserCannotUpdateYourRole)
-	}
-
-	err = us.userRoleRelService.SaveUserRole(ctx, req.UserID, req.RoleID)
-	if err != nil {
-		return err
-	}
-
-	us.authService.RemoveUserAllTokens(ctx, req.UserID)
-	return
+	return us.roleService.UpdateRoleUsers(ctx, &schema.UpdateRoleUsersReq{
+		RoleID:      req.RoleID,
+		UserIDs:     []string{req.UserID},
+		LoginUserID: req.LoginUserID,
+	})
 }
 
 // AddUser add user
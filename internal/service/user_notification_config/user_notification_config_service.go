@@ -21,6 +21,8 @@ package user_notification_config
 
 import (
 	"context"
+	"time"
+
 	"github.com/apache/answer/internal/base/constant"
 	"github.com/apache/answer/internal/entity"
 	"github.com/apache/answer/internal/schema"
@@ -36,6 +38,9 @@ type UserNotificationConfigRepo interface {
 		conf *entity.UserNotificationConfig, exist bool, err error)
 	GetByUsersAndSource(ctx context.Context, userIDs []string, source constant.NotificationSource) (
 		[]*entity.UserNotificationConfig, error)
+	GetDueDigestConfigs(ctx context.Context, frequency constant.NotificationFrequency, lastDigestBefore time.Time) (
+		[]*entity.UserNotificationConfig, error)
+	SetLastDigestAt(ctx context.Context, ids []string, digestAt time.Time) (err error)
 }
 
 type UserNotificationConfigService struct {
@@ -85,6 +90,11 @@ func (us *UserNotificationConfigService) UpdateUserNotificationConfig(
 	if err != nil {
 		return err
 	}
+	err = us.userNotificationConfigRepo.Save(ctx,
+		us.convertToEntity(ctx, req.UserID, constant.SavedJobSearchSource, req.NotificationConfig.SavedJobSearch))
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -95,14 +105,30 @@ func (us *UserNotificationConfigService) SetDefaultUserNotificationConfig(ctx co
 		string(constant.InboxSource), `[{"key":"email","enable":true}]`)
 }
 
+// SetDefaultSavedJobSearchNotificationConfig turn on the email of the saved job search alerts
+// when the user saves the first alerting search, unless the user has configured it
+func (us *UserNotificationConfigService) SetDefaultSavedJobSearchNotificationConfig(ctx context.Context, userID string) (
+	err error) {
+	_, exist, err := us.userNotificationConfigRepo.GetByUserIDAndSource(ctx, userID, constant.SavedJobSearchSource)
+	if err != nil || exist {
+		return err
+	}
+	return us.userNotificationConfigRepo.Add(ctx, []string{userID},
+		string(constant.SavedJobSearchSource), `[{"key":"email","enable":true}]`)
+}
+
 func (us *UserNotificationConfigService) convertToEntity(ctx context.Context, userID string,
 	source constant.NotificationSource, channel schema.NotificationChannelConfig) (c *entity.UserNotificationConfig) {
 	var channels schema.NotificationChannels
 	channels = append(channels, &channel)
 	c = &entity.UserNotificationConfig{
-		UserID:   userID,
-		Source:   string(source),
-		Channels: channels.ToJsonString(),
+		UserID:    userID,
+		Source:    string(source),
+		Channels:  channels.ToJsonString(),
+		Frequency: string(constant.NotificationFrequencyImmediate),
+	}
+	if channel.IsDigest() {
+		c.Frequency = string(channel.Frequency)
 	}
 	for _, ch := range channels {
 		if ch.Enable {
@@ -0,0 +1,411 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	errpkg "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/pager"
+	"github.com/apache/answer/internal/base/queue"
+	"github.com/apache/answer/internal/base/reason"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/apache/answer/internal/service/event_queue"
+	"github.com/apache/answer/internal/service/service_config"
+	"github.com/apache/answer/pkg/token"
+	"github.com/segmentfault/pacman/errors"
+	"github.com/segmentfault/pacman/log"
+)
+
+const (
+	HeaderEvent     = "X-Answer-Event"
+	HeaderDelivery  = "X-Answer-Delivery"
+	HeaderSignature = "X-Answer-Signature-256"
+
+	deliveryTimeout         = 10 * time.Second
+	deliveryResponseMaxSize = 2048
+)
+
+// WebhookRepo webhook repository
+type WebhookRepo interface {
+	AddWebhook(ctx context.Context, webhook *entity.Webhook) (err error)
+	UpdateWebhook(ctx context.Context, webhook *entity.Webhook, cols []string) (err error)
+	RemoveWebhook(ctx context.Context, id string) (err error)
+	GetWebhook(ctx context.Context, id string) (webhook *entity.Webhook, exist bool, err error)
+	GetWebhookList(ctx context.Context, onlyActive bool) (webhooks []*entity.Webhook, err error)
+	AddWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery) (err error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *entity.WebhookDelivery, cols []string) (err error)
+	GetWebhookDelivery(ctx context.Context, id string) (delivery *entity.WebhookDelivery, exist bool, err error)
+	GetWebhookDeliveryByEvent(ctx context.Context, webhookID, eventID string) (
+		delivery *entity.WebhookDelivery, exist bool, err error)
+	GetWebhookDeliveryPage(ctx context.Context, page, pageSize int, webhookID, status string) (
+		deliveries []*entity.WebhookDelivery, total int64, err error)
+}
+
+// WebhookService deliver the site events to the webhooks registered by the admin
+type WebhookService struct {
+	webhookRepo   WebhookRepo
+	deliveryQueue *queue.Queue[*schema.WebhookDeliveryMsg]
+	httpClient    *http.Client
+}
+
+// NewWebhookService new webhook service, the deliveries are retried by the queue until they succeed
+func NewWebhookService(
+	webhookRepo WebhookRepo,
+	eventQueueService event_queue.EventQueueService,
+	store queue.Store,
+	serviceConfig *service_config.ServiceConfig,
+) (*WebhookService, func()) {
+	ws := &WebhookService{
+		webhookRepo: webhookRepo,
+		httpClient:  &http.Client{Timeout: deliveryTimeout},
+	}
+	ws.deliveryQueue = queue.New[*schema.WebhookDeliveryMsg]("webhook_delivery", store, serviceConfig.Queue)
+	ws.deliveryQueue.RegisterHandler(ws.handleDelivery)
+	eventQueueService.RegisterHandler(ws.HandleEvent)
+	return ws, ws.deliveryQueue.Close
+}
+
+// Sign the HMAC-SHA256 signature of the payload, the receiver should compare it with the signature header
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// GetEventTypes get the event types which could be subscribed
+func (ws *WebhookService) GetEventTypes(ctx context.Context) (events []string) {
+	events = make([]string, 0, len(constant.EventTypes))
+	for _, event := range constant.EventTypes {
+		events = append(events, string(event))
+	}
+	return events
+}
+
+// GetWebhookList get all webhooks
+func (ws *WebhookService) GetWebhookList(ctx context.Context) (resp []*schema.WebhookResp, err error) {
+	webhooks, err := ws.webhookRepo.GetWebhookList(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	resp = make([]*schema.WebhookResp, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		r := &schema.WebhookResp{}
+		r.ConvertFromWebhook(webhook)
+		resp = append(resp, r)
+	}
+	return resp, nil
+}
+
+// AddWebhook add webhook, the generated secret is only returned here
+func (ws *WebhookService) AddWebhook(ctx context.Context, req *schema.AddWebhookReq) (
+	resp *schema.WebhookResp, err error) {
+	events, err := ws.formatEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+	webhook := &entity.Webhook{
+		Name:     strings.TrimSpace(req.Name),
+		URL:      req.URL,
+		Secret:   req.Secret,
+		Events:   events,
+		IsActive: req.IsActive,
+	}
+	generated := len(webhook.Secret) == 0
+	if generated {
+		webhook.Secret = token.GenerateToken()
+	}
+	if err = ws.webhookRepo.AddWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+	resp = &schema.WebhookResp{}
+	resp.ConvertFromWebhook(webhook)
+	if generated {
+		resp.Secret = webhook.Secret
+	}
+	return resp, nil
+}
+
+// UpdateWebhook update webhook, the secret is kept if it is not changed
+func (ws *WebhookService) UpdateWebhook(ctx context.Context, req *schema.UpdateWebhookReq) (err error) {
+	webhook, exist, err := ws.webhookRepo.GetWebhook(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.NotFound(reason.WebhookNotFound)
+	}
+	events, err := ws.formatEvents(req.Events)
+	if err != nil {
+		return err
+	}
+	webhook.Name = strings.TrimSpace(req.Name)
+	webhook.URL = req.URL
+	webhook.Events = events
+	webhook.IsActive = req.IsActive
+	cols := []string{"name", "url", "events", "is_active"}
+	if len(req.Secret) > 0 {
+		webhook.Secret = req.Secret
+		cols = append(cols, "secret")
+	}
+	return ws.webhookRepo.UpdateWebhook(ctx, webhook, cols)
+}
+
+// RemoveWebhook remove webhook, the pending deliveries of it will fail
+func (ws *WebhookService) RemoveWebhook(ctx context.Context, req *schema.RemoveWebhookReq) (err error) {
+	return ws.webhookRepo.RemoveWebhook(ctx, req.ID)
+}
+
+// GetDeliveryPage get the delivery log by page
+func (ws *WebhookService) GetDeliveryPage(ctx context.Context, req *schema.GetWebhookDeliveriesReq) (
+	pageModel *pager.PageModel, err error) {
+	deliveries, total, err := ws.webhookRepo.GetWebhookDeliveryPage(ctx, req.Page, req.PageSize, req.WebhookID, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]*schema.WebhookDeliveryResp, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		r := &schema.WebhookDeliveryResp{}
+		r.ConvertFromWebhookDelivery(delivery)
+		resp = append(resp, r)
+	}
+	return pager.NewPageModel(total, resp), nil
+}
+
+// Redeliver deliver the payload of the delivery again as a new delivery
+func (ws *WebhookService) Redeliver(ctx context.Context, req *schema.RedeliverWebhookReq) (
+	resp *schema.WebhookDeliveryResp, err error) {
+	delivery, exist, err := ws.webhookRepo.GetWebhookDelivery(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.WebhookDeliveryNotFound)
+	}
+	_, exist, err = ws.webhookRepo.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.WebhookNotFound)
+	}
+
+	redelivery := &entity.WebhookDelivery{
+		WebhookID:    delivery.WebhookID,
+		EventType:    delivery.EventType,
+		Payload:      delivery.Payload,
+		Status:       entity.WebhookDeliveryStatusPending,
+		RedeliveryOf: delivery.ID,
+	}
+	if err = ws.webhookRepo.AddWebhookDelivery(ctx, redelivery); err != nil {
+		return nil, err
+	}
+	ws.deliveryQueue.Send(ctx, &schema.WebhookDeliveryMsg{DeliveryID: redelivery.ID})
+	resp = &schema.WebhookDeliveryResp{}
+	resp.ConvertFromWebhookDelivery(redelivery)
+	return resp, nil
+}
+
+// Ping send the ping event to the webhook right now and return the result of the delivery
+func (ws *WebhookService) Ping(ctx context.Context, req *schema.PingWebhookReq) (
+	resp *schema.WebhookDeliveryResp, err error) {
+	webhook, exist, err := ws.webhookRepo.GetWebhook(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !exist {
+		return nil, errors.NotFound(reason.WebhookNotFound)
+	}
+
+	delivery, err := ws.addDelivery(ctx, webhook, "", schema.WebhookEventPing, &schema.WebhookEventData{WebhookID: webhook.ID})
+	if err != nil {
+		return nil, err
+	}
+	if err = ws.deliver(ctx, webhook, delivery); err != nil {
+		log.Debugf("ping webhook %s failed: %s", webhook.ID, err)
+	}
+	resp = &schema.WebhookDeliveryResp{}
+	resp.ConvertFromWebhookDelivery(delivery)
+	return resp, nil
+}
+
+// HandleEvent create the deliveries of the event for the active webhooks which subscribe to it
+func (ws *WebhookService) HandleEvent(ctx context.Context, msg *schema.EventMsg) (err error) {
+	webhooks, err := ws.webhookRepo.GetWebhookList(ctx, true)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, webhook := range webhooks {
+		if !subscribed(webhook, string(msg.EventType)) {
+			continue
+		}
+		// the event is retried when any handler failed, the delivery which has been added is not added again
+		if len(msg.ID) > 0 {
+			_, exist, err := ws.webhookRepo.GetWebhookDeliveryByEvent(ctx, webhook.ID, msg.ID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if exist {
+				continue
+			}
+		}
+		data := &schema.WebhookEventData{}
+		data.ConvertFromEventMsg(msg)
+		delivery, err := ws.addDelivery(ctx, webhook, msg.ID, string(msg.EventType), data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ws.deliveryQueue.Send(ctx, &schema.WebhookDeliveryMsg{DeliveryID: delivery.ID})
+	}
+	return errpkg.Join(errs...)
+}
+
+func (ws *WebhookService) handleDelivery(ctx context.Context, msg *schema.WebhookDeliveryMsg) (err error) {
+	delivery, exist, err := ws.webhookRepo.GetWebhookDelivery(ctx, msg.DeliveryID)
+	if err != nil {
+		return err
+	}
+	// the delivery may be received again after it succeeded
+	if !exist || delivery.Status == entity.WebhookDeliveryStatusSuccess {
+		return nil
+	}
+	webhook, exist, err := ws.webhookRepo.GetWebhook(ctx, delivery.WebhookID)
+	if err != nil {
+		return err
+	}
+	if !exist || !webhook.IsActive {
+		delivery.Status = entity.WebhookDeliveryStatusFailed
+		delivery.Error = "the webhook is removed or inactive"
+		return ws.webhookRepo.UpdateWebhookDelivery(ctx, delivery, []string{"status", "error"})
+	}
+	return ws.deliver(ctx, webhook, delivery)
+}
+
+func (ws *WebhookService) addDelivery(ctx context.Context, webhook *entity.Webhook, eventID, event string,
+	data *schema.WebhookEventData) (delivery *entity.WebhookDelivery, err error) {
+	payload, err := json.Marshal(&schema.WebhookPayload{
+		Event:     event,
+		Timestamp: time.Now().Unix(),
+		Data:      data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery = &entity.WebhookDelivery{
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		EventType: event,
+		Payload:   string(payload),
+		Status:    entity.WebhookDeliveryStatusPending,
+	}
+	if err = ws.webhookRepo.AddWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// deliver post the signed payload to the webhook and log the result,
+// the error is returned if the webhook does not respond with 2xx
+func (ws *WebhookService) deliver(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) (err error) {
+	start := time.Now()
+	err = ws.post(ctx, webhook, delivery)
+	delivery.Attempts++
+	delivery.DurationMs = time.Since(start).Milliseconds()
+	delivery.DeliveredAt = time.Now()
+	if err != nil {
+		delivery.Status = entity.WebhookDeliveryStatusFailed
+		delivery.Error = err.Error()
+	} else {
+		delivery.Status = entity.WebhookDeliveryStatusSuccess
+		delivery.Error = ""
+	}
+	updateErr := ws.webhookRepo.UpdateWebhookDelivery(ctx, delivery, []string{"status", "attempts",
+		"response_code", "response_body", "error", "duration_ms", "delivered_at"})
+	if updateErr != nil {
+		log.Error(updateErr)
+	}
+	return err
+}
+
+func (ws *WebhookService) post(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Answer-Webhook/"+constant.Version)
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, []byte(delivery.Payload)))
+
+	resp, err := ws.httpClient.Do(req)
+	if err != nil {
+		delivery.ResponseCode = 0
+		delivery.ResponseBody = ""
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, deliveryResponseMaxSize))
+	delivery.ResponseCode = resp.StatusCode
+	delivery.ResponseBody = string(body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// formatEvents check the subscribed events and convert them to JSON
+func (ws *WebhookService) formatEvents(events []string) (string, error) {
+	formatted := make([]string, 0, len(events))
+	for _, event := range events {
+		if !slices.Contains(constant.EventTypes, constant.EventType(event)) {
+			return "", errors.BadRequest(reason.WebhookEventInvalid)
+		}
+		if !slices.Contains(formatted, event) {
+			formatted = append(formatted, event)
+		}
+	}
+	data, _ := json.Marshal(formatted)
+	return string(data), nil
+}
+
+// subscribed whether the webhook subscribes to the event, it subscribes to all the events if no event is chosen
+func subscribed(webhook *entity.Webhook, event string) bool {
+	var events []string
+	if len(webhook.Events) > 0 {
+		_ = json.Unmarshal([]byte(webhook.Events), &events)
+	}
+	return len(events) == 0 || slices.Contains(events, event)
+}
@@ -0,0 +1,265 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/answer/internal/base/constant"
+	"github.com/apache/answer/internal/base/queue"
+	"github.com/apache/answer/internal/entity"
+	"github.com/apache/answer/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryWebhookRepo struct {
+	lock       sync.Mutex
+	seq        int
+	webhooks   map[string]*entity.Webhook
+	deliveries map[string]*entity.WebhookDelivery
+}
+
+func newMemoryWebhookRepo() *memoryWebhookRepo {
+	return &memoryWebhookRepo{
+		webhooks:   make(map[string]*entity.Webhook),
+		deliveries: make(map[string]*entity.WebhookDelivery),
+	}
+}
+
+func (r *memoryWebhookRepo) nextID() string {
+	r.seq++
+	return strconv.Itoa(r.seq)
+}
+
+func (r *memoryWebhookRepo) AddWebhook(_ context.Context, webhook *entity.Webhook) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	webhook.ID = r.nextID()
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+func (r *memoryWebhookRepo) UpdateWebhook(_ context.Context, webhook *entity.Webhook, _ []string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.webhooks[webhook.ID] = webhook
+	return nil
+}
+
+func (r *memoryWebhookRepo) RemoveWebhook(_ context.Context, id string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.webhooks, id)
+	return nil
+}
+
+func (r *memoryWebhookRepo) GetWebhook(_ context.Context, id string) (*entity.Webhook, bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	webhook, ok := r.webhooks[id]
+	return webhook, ok, nil
+}
+
+func (r *memoryWebhookRepo) GetWebhookList(_ context.Context, onlyActive bool) ([]*entity.Webhook, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	webhooks := make([]*entity.Webhook, 0)
+	for _, webhook := range r.webhooks {
+		if !onlyActive || webhook.IsActive {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+func (r *memoryWebhookRepo) AddWebhookDelivery(_ context.Context, delivery *entity.WebhookDelivery) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delivery.ID = r.nextID()
+	r.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *memoryWebhookRepo) UpdateWebhookDelivery(_ context.Context, delivery *entity.WebhookDelivery, _ []string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deliveries[delivery.ID] = delivery
+	return nil
+}
+
+func (r *memoryWebhookRepo) GetWebhookDelivery(_ context.Context, id string) (*entity.WebhookDelivery, bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delivery, ok := r.deliveries[id]
+	return delivery, ok, nil
+}
+
+func (r *memoryWebhookRepo) GetWebhookDeliveryByEvent(_ context.Context, webhookID, eventID string) (
+	*entity.WebhookDelivery, bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, delivery := range r.deliveries {
+		if delivery.WebhookID == webhookID && delivery.EventID == eventID && len(delivery.RedeliveryOf) == 0 {
+			return delivery, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (r *memoryWebhookRepo) GetWebhookDeliveryPage(_ context.Context, _, _ int, _, _ string) (
+	[]*entity.WebhookDelivery, int64, error) {
+	return nil, 0, nil
+}
+
+// sentStore keeps the pushed messages without delivering them
+type sentStore struct {
+	lock     sync.Mutex
+	payloads []string
+}
+
+func (s *sentStore) Push(_ context.Context, _, payload string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.payloads = append(s.payloads, payload)
+	return nil
+}
+
+func (s *sentStore) Fetch(context.Context, string, int, time.Duration) ([]*entity.QueueMessage, error) {
+	return nil, nil
+}
+
+func (s *sentStore) Ack(context.Context, *entity.QueueMessage) error { return nil }
+
+func (s *sentStore) Retry(context.Context, *entity.QueueMessage, time.Time, string) error { return nil }
+
+func (s *sentStore) DeadLetter(context.Context, *entity.QueueMessage, string) error { return nil }
+
+func newTestWebhookService(t *testing.T) (*WebhookService, *memoryWebhookRepo, *sentStore) {
+	repo := newMemoryWebhookRepo()
+	store := &sentStore{}
+	ws := &WebhookService{
+		webhookRepo:   repo,
+		deliveryQueue: queue.New[*schema.WebhookDeliveryMsg]("webhook_delivery", store, nil),
+		httpClient:    &http.Client{Timeout: time.Second},
+	}
+	t.Cleanup(ws.deliveryQueue.Close)
+	return ws, repo, store
+}
+
+func TestWebhookService_Ping(t *testing.T) {
+	var (
+		received  schema.WebhookPayload
+		signature string
+		event     string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		signature = r.Header.Get(HeaderSignature)
+		event = r.Header.Get(HeaderEvent)
+		assert.Equal(t, Sign("secret", body), signature)
+		_ = json.Unmarshal(body, &received)
+		_, _ = w.Write([]byte("pong"))
+	}))
+	defer server.Close()
+
+	ws, _, _ := newTestWebhookService(t)
+	webhook, err := ws.AddWebhook(context.TODO(), &schema.AddWebhookReq{
+		Name: "receiver", URL: server.URL, Secret: "secret", IsActive: true,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, webhook.Secret)
+
+	resp, err := ws.Ping(context.TODO(), &schema.PingWebhookReq{ID: webhook.ID})
+	require.NoError(t, err)
+	assert.Equal(t, entity.WebhookDeliveryStatusSuccess, resp.Status)
+	assert.Equal(t, http.StatusOK, resp.ResponseCode)
+	assert.Equal(t, "pong", resp.ResponseBody)
+	assert.Equal(t, schema.WebhookEventPing, event)
+	assert.Equal(t, schema.WebhookEventPing, received.Event)
+	assert.Equal(t, webhook.ID, received.Data.WebhookID)
+	assert.NotEqual(t, Sign("other", []byte(resp.Payload)), signature)
+}
+
+func TestWebhookService_HandleEvent(t *testing.T) {
+	var failed atomic.Bool
+	failed.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failed.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	ws, repo, store := newTestWebhookService(t)
+	subscriber, err := ws.AddWebhook(context.TODO(), &schema.AddWebhookReq{
+		Name: "subscriber", URL: server.URL, Events: []string{string(constant.EventQuestionAccept)}, IsActive: true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, subscriber.Secret)
+	_, err = ws.AddWebhook(context.TODO(), &schema.AddWebhookReq{
+		Name: "other", URL: server.URL, Events: []string{string(constant.EventCommentFlag)}, IsActive: true,
+	})
+	require.NoError(t, err)
+	_, err = ws.AddWebhook(context.TODO(), &schema.AddWebhookReq{
+		Name: "invalid", URL: server.URL, Events: []string{"answer.unknown"},
+	})
+	assert.Error(t, err)
+
+	msg := schema.NewEvent(constant.EventQuestionAccept, "1").QID("10", "2").AID("20", "3")
+	msg.ID = "event-1"
+	require.NoError(t, ws.HandleEvent(context.TODO(), msg))
+	require.Len(t, store.payloads, 1)
+
+	// the retried event is not delivered again
+	require.NoError(t, ws.HandleEvent(context.TODO(), msg))
+	require.Len(t, store.payloads, 1)
+
+	deliveryMsg := &schema.WebhookDeliveryMsg{}
+	require.NoError(t, json.Unmarshal([]byte(store.payloads[0]), deliveryMsg))
+	delivery := repo.deliveries[deliveryMsg.DeliveryID]
+	assert.Equal(t, subscriber.ID, delivery.WebhookID)
+	assert.Contains(t, delivery.Payload, `"answer_user_id":"3"`)
+
+	// the failed delivery is returned as the error to be retried by the queue
+	assert.Error(t, ws.handleDelivery(context.TODO(), deliveryMsg))
+	assert.Equal(t, entity.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+
+	failed.Store(false)
+	assert.NoError(t, ws.handleDelivery(context.TODO(), deliveryMsg))
+	assert.Equal(t, entity.WebhookDeliveryStatusSuccess, delivery.Status)
+	assert.Equal(t, 2, delivery.Attempts)
+
+	redelivery, err := ws.Redeliver(context.TODO(), &schema.RedeliverWebhookReq{ID: delivery.ID})
+	require.NoError(t, err)
+	assert.Equal(t, delivery.ID, redelivery.RedeliveryOf)
+	assert.Equal(t, delivery.Payload, redelivery.Payload)
+	assert.Len(t, store.payloads, 2)
+}
@@ -111,7 +111,8 @@ func addUniqueID(questionID, answerID string, linkType int, uniqueIDs map[string
 		}
 	}
 
-	if objectType, err := obj.GetObjectTypeStrByObjectID(uid.DeShortID(questionID)); err == nil {
+	if objectType, err := obj.GetObjectTypeStrByObjectID(uid.DeShortID(questionID)); err == nil &&
+		(objectType == constant.QuestionObjectType || objectType == constant.AnswerObjectType) {
 		if _, ok := uniqueIDs[questionID]; !ok {
 			uniqueIDs[questionID] = struct{}{}
 			isAdd = true
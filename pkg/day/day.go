@@ -20,6 +20,8 @@
 package day
 
 import (
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -190,3 +192,52 @@ func nextStdChunk(from []rune) (to, suffix []rune) {
 	suffix = from[len([]rune(old)):]
 	return
 }
+
+// UTCOffset the current offset of the time zone from UTC in minutes. The time zone can be
+// an IANA name like "Asia/Shanghai" or a fixed offset like "UTC+8", "GMT-03:30" and "+0530".
+func UTCOffset(tz string) (minutes int, ok bool) {
+	tz = strings.TrimSpace(tz)
+	offset := strings.ToUpper(tz)
+	if strings.HasPrefix(offset, "UTC") || strings.HasPrefix(offset, "GMT") {
+		offset = strings.TrimSpace(offset[3:])
+		if len(offset) == 0 {
+			return 0, true
+		}
+	}
+	if strings.HasPrefix(offset, "+") || strings.HasPrefix(offset, "-") {
+		return parseFixedOffset(offset)
+	}
+	if len(tz) == 0 || tz == "Local" {
+		return 0, false
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return 0, false
+	}
+	_, seconds := time.Now().In(loc).Zone()
+	return seconds / 60, true
+}
+
+// parseFixedOffset parse the offset like "+8", "-03:30" or "+0530"
+func parseFixedOffset(offset string) (minutes int, ok bool) {
+	sign := 1
+	if offset[0] == '-' {
+		sign = -1
+	}
+	hourStr, minuteStr, found := strings.Cut(offset[1:], ":")
+	if !found && len(hourStr) == 4 {
+		hourStr, minuteStr = hourStr[:2], hourStr[2:]
+	}
+	hour, err := strconv.Atoi(hourStr)
+	if err != nil || len(hourStr) > 2 || hour > 14 {
+		return 0, false
+	}
+	minute := 0
+	if len(minuteStr) > 0 {
+		minute, err = strconv.Atoi(minuteStr)
+		if err != nil || len(minuteStr) != 2 || minute >= 60 {
+			return 0, false
+		}
+	}
+	return sign * (hour*60 + minute), true
+}
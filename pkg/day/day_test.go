@@ -33,3 +33,30 @@ func TestFormat(t *testing.T) {
 	expected := time.Unix(sec, 0).Format("2006-01-02 15:04:05")
 	assert.Equal(t, expected, actual)
 }
+
+func TestUTCOffset(t *testing.T) {
+	cases := []struct {
+		tz      string
+		minutes int
+		ok      bool
+	}{
+		{"UTC", 0, true},
+		{"GMT", 0, true},
+		{"UTC+8", 480, true},
+		{"utc-3:30", -210, true},
+		{"GMT +05:45", 345, true},
+		{"+0530", 330, true},
+		{"-11", -660, true},
+		{"Asia/Shanghai", 480, true},
+		{"", 0, false},
+		{"UTC+15", 0, false},
+		{"+5:7", 0, false},
+		{"+abc", 0, false},
+		{"Mars/Olympus", 0, false},
+	}
+	for _, c := range cases {
+		minutes, ok := UTCOffset(c.tz)
+		assert.Equal(t, c.ok, ok, c.tz)
+		assert.Equal(t, c.minutes, minutes, c.tz)
+	}
+}
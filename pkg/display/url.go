@@ -63,3 +63,13 @@ func CommentURL(permalink int, siteUrl, questionID, title, answerID, commentID s
 func UserURL(siteUrl, username string) string {
 	return siteUrl + "/users/" + username
 }
+
+// ConversationURL get conversation url
+func ConversationURL(siteUrl, conversationID string) string {
+	return siteUrl + "/conversations/" + conversationID
+}
+
+// JobPostingURL get job posting url
+func JobPostingURL(siteUrl, jobPostingID string) string {
+	return siteUrl + "/jobs/" + jobPostingID
+}
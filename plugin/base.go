@@ -40,3 +40,19 @@ var (
 	CallBase,
 	registerBase = MakePlugin[Base](true)
 )
+
+var siteLanguageFn func() string
+
+// SiteLanguage The default interface language of the site. e.g. en_US
+// The plugins which process the text by language, like the search plugins, can use it.
+func SiteLanguage() string {
+	if siteLanguageFn != nil {
+		return siteLanguageFn()
+	}
+	return ""
+}
+
+// RegisterGetSiteLanguageFunc Register a function to get the site language.
+func RegisterGetSiteLanguageFunc(fn func() string) {
+	siteLanguageFn = fn
+}
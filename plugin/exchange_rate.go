@@ -0,0 +1,53 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package plugin
+
+// ExchangeRate is the provider of the currency exchange rates,
+// the amounts in different currencies are converted to the base currency of the site to be compared
+type ExchangeRate interface {
+	Base
+
+	// GetRate returns how many units of the currency `to` one unit of the currency `from` is worth.
+	// The currencies are ISO 4217 codes such as USD. It returns ok false if the rate is unknown.
+	GetRate(from, to string) (rate float64, ok bool, err error)
+}
+
+var (
+	// CallExchangeRate is a function that calls all registered exchange rate plugins
+	CallExchangeRate,
+	registerExchangeRate = MakePlugin[ExchangeRate](false)
+)
+
+func coordinatedExchangeRatePlugins(slugName string) (enabledSlugNames []string) {
+	isExchangeRate := false
+	_ = CallExchangeRate(func(exchangeRate ExchangeRate) error {
+		name := exchangeRate.Info().SlugName
+		if slugName == name {
+			isExchangeRate = true
+		} else {
+			enabledSlugNames = append(enabledSlugNames, name)
+		}
+		return nil
+	})
+	if isExchangeRate {
+		return enabledSlugNames
+	}
+	return nil
+}
@@ -43,6 +43,7 @@ const (
 	NotificationInvitedYouToAnswer     NotificationType = "notification.action.invited_you_to_answer"
 	NotificationNewQuestion            NotificationType = "notification.action.new_question"
 	NotificationNewQuestionFollowedTag NotificationType = "notification.action.new_question_followed_tag"
+	NotificationNewMessage             NotificationType = "notification.action.new_message"
 )
 
 type Notification interface {
@@ -83,6 +84,10 @@ type NotificationMessage struct {
 	AnswerUrl string `json:"answer_url"`
 	// the comment url (optional, only for new comment notification)
 	CommentUrl string `json:"comment_url"`
+	// the conversation url (optional, only for new message notification)
+	ConversationUrl string `json:"conversation_url"`
+	// the message summary (optional, only for new message notification)
+	MessageSummary string `json:"message_summary"`
 }
 
 var (
@@ -0,0 +1,73 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package plugin
+
+// PaymentOrder is the order of a milestone payment
+type PaymentOrder struct {
+	// the contract id
+	ContractID string `json:"contract_id"`
+	// the milestone id, it is unique for each order
+	MilestoneID string `json:"milestone_id"`
+	// the client who pays for the milestone
+	PayerUserID string `json:"payer_user_id"`
+	// the freelancer who receives the payment
+	PayeeUserID string `json:"payee_user_id"`
+	// the amount of the milestone
+	Amount float64 `json:"amount"`
+	// the currency code, such as USD
+	Currency string `json:"currency"`
+	// the milestone title
+	Description string `json:"description"`
+}
+
+type Payment interface {
+	Base
+
+	// Fund holds the amount of the order from the payer in escrow.
+	// It returns the transaction id of the payment provider.
+	Fund(order *PaymentOrder) (transactionID string, err error)
+
+	// Release transfers the amount held by the fund transaction to the payee.
+	// It returns the transaction id of the payment provider.
+	Release(order *PaymentOrder, fundTransactionID string) (transactionID string, err error)
+}
+
+var (
+	// CallPayment is a function that calls all registered payment plugins
+	CallPayment,
+	registerPayment = MakePlugin[Payment](false)
+)
+
+func coordinatedPaymentPlugins(slugName string) (enabledSlugNames []string) {
+	isPayment := false
+	_ = CallPayment(func(payment Payment) error {
+		name := payment.Info().SlugName
+		if slugName == name {
+			isPayment = true
+		} else {
+			enabledSlugNames = append(enabledSlugNames, name)
+		}
+		return nil
+	})
+	if isPayment {
+		return enabledSlugNames
+	}
+	return nil
+}
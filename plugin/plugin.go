@@ -126,6 +126,14 @@ func Register(p Base) {
 	if _, ok := p.(KVStorage); ok {
 		registerKVStorage(p.(KVStorage))
 	}
+
+	if _, ok := p.(Payment); ok {
+		registerPayment(p.(Payment))
+	}
+
+	if _, ok := p.(ExchangeRate); ok {
+		registerExchangeRate(p.(ExchangeRate))
+	}
 }
 
 type Stack[T Base] struct {
@@ -191,6 +199,14 @@ func (m *statusManager) Enable(name string, enabled bool) {
 	for _, slugName := range coordinatedCDNPlugins(name) {
 		m.status[slugName] = false
 	}
+
+	for _, slugName := range coordinatedPaymentPlugins(name) {
+		m.status[slugName] = false
+	}
+
+	for _, slugName := range coordinatedExchangeRatePlugins(name) {
+		m.status[slugName] = false
+	}
 }
 
 func (m *statusManager) IsEnabled(name string) bool {
@@ -28,6 +28,9 @@ type SearchResult struct {
 	ID string
 	// Type content type, example: "answer", "question"
 	Type string
+	// Highlight the snippet of the content around the matched words. optional
+	// It is used as the excerpt of the result instead of the first matched word if it is not empty.
+	Highlight string
 }
 
 type SearchContent struct {
@@ -55,15 +58,37 @@ type SearchBasicCond struct {
 
 	// The keywords for search.
 	Words []string
+	// KeywordGroups is the boolean structure of the keywords, Words is flattened from it
+	// for the plugins which do not support it. All groups must be matched, and the
+	// content matches a group if it matches any keyword of the group.
+	KeywordGroups [][]SearchKeyword
+	// ExcludedKeywords the content must not match any of them.
+	ExcludedKeywords []SearchKeyword
 	// TagIDs is a list of tag IDs.
 	TagIDs [][]string
+	// ExcludedTagIDs the content must not have any of the tags.
+	ExcludedTagIDs []string
 	// The object's owner user ID.
 	UserID string
 	// The order of the search result.
 	Order SearchOrderCond
 
+	// The unix timestamps of the time range, the content must be created or active
+	// since the after time and before the before time, they are ignored if they are zero.
+	CreatedAfter  int64
+	CreatedBefore int64
+	ActiveAfter   int64
+	ActiveBefore  int64
+
+	// ObjectIDs the content must be one of them if HasObjectIDs is true,
+	// such as the collections of the user.
+	ObjectIDs    []string
+	HasObjectIDs bool
+
 	// Weathers the question is accepted or not. Only support search question.
 	QuestionAccepted SearchAcceptedCond
+	// Weathers the question is closed or not. Only support search question.
+	QuestionClosed SearchClosedCond
 	// Weathers the answer is accepted or not. Only support search answer.
 	AnswerAccepted SearchAcceptedCond
 
@@ -78,9 +103,20 @@ type SearchBasicCond struct {
 	AnswerAmount int
 }
 
+// SearchKeyword is a keyword of the search query
+type SearchKeyword struct {
+	Text string
+	// Phrase the text must be matched as a whole, such as "hello world"
+	Phrase bool
+	// Field the keyword only matches this field, empty for both the title and the content
+	Field SearchKeywordField
+}
+
 type SearchAcceptedCond int
+type SearchClosedCond int
 type SearchContentStatus int
 type SearchOrderCond string
+type SearchKeywordField string
 
 const (
 	AcceptedCondAll SearchAcceptedCond = iota
@@ -88,11 +124,23 @@ const (
 	AcceptedCondFalse
 )
 
+const (
+	ClosedCondAll SearchClosedCond = iota
+	ClosedCondTrue
+	ClosedCondFalse
+)
+
 const (
 	SearchContentStatusAvailable = 1
+	SearchContentStatusClosed    = 2
 	SearchContentStatusDeleted   = 10
 )
 
+const (
+	SearchKeywordFieldTitle   SearchKeywordField = "title"
+	SearchKeywordFieldContent SearchKeywordField = "content"
+)
+
 const (
 	SearchNewestOrder    SearchOrderCond = "newest"
 	SearchActiveOrder    SearchOrderCond = "active"
@@ -111,6 +159,102 @@ type Search interface {
 	DeleteContent(ctx context.Context, objectID string) (err error)
 }
 
+// ListingSearch is an optional interface of the search plugin. The plugin implementing it
+// indexes freelancer profiles and job postings alongside questions and answers, and the
+// freelancer and job listings are searched by it instead of the database.
+type ListingSearch interface {
+	SearchListings(ctx context.Context, cond *SearchListingCond) (res []SearchResult, total int64, err error)
+	UpdateListing(ctx context.Context, content *SearchListingContent) (err error)
+	DeleteListing(ctx context.Context, listingType, objectID string) (err error)
+}
+
+// ListingSearchSyncer is implemented by the syncer given to RegisterSyncer,
+// the plugin implementing ListingSearch can use it to index all listings.
+type ListingSearchSyncer interface {
+	GetListingsPage(ctx context.Context, listingType string, page, pageSize int) (listingList []*SearchListingContent, err error)
+}
+
+const (
+	SearchListingTypeFreelancer = "freelancer"
+	SearchListingTypeJobPosting = "job_posting"
+)
+
+type SearchListingContent struct {
+	// the user id for freelancer, the job posting id for job posting
+	ObjectID string `json:"objectID"`
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Content  string `json:"content"`
+	// Tags the skill tag ids
+	Tags   []string `json:"tags"`
+	UserID string   `json:"userID"`
+	// the hourly rate for freelancer, the budget for job posting
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+	// BaseAmount the amount converted to the base currency of the site, it is used to filter and sort
+	BaseAmount      float64 `json:"baseAmount"`
+	ExperienceLevel string  `json:"experienceLevel"`
+	LocationType    string  `json:"locationType"`
+	Availability    string  `json:"availability"`
+	// the UTC offset in minutes, only valid if HasUTCOffset is true
+	UTCOffset    int     `json:"utcOffset"`
+	HasUTCOffset bool    `json:"hasUTCOffset"`
+	Verified     bool    `json:"verified"`
+	Rating       float64 `json:"rating"`
+	// Listed whether the freelancer is available or the job posting is active and not expired
+	Listed    bool   `json:"listed"`
+	Status    string `json:"status"`
+	ExpiresAt int64  `json:"expiresAt"`
+	Created   int64  `json:"created"`
+	Active    int64  `json:"active"`
+}
+
+type SearchListingCond struct {
+	// From zero-based page number
+	Page int
+	// Page size
+	PageSize int
+	// freelancer or job_posting
+	Type string
+
+	// The keywords for search.
+	Words []string
+	// TagIDs each element is a skill tag and its synonyms.
+	TagIDs [][]string
+	// MatchAllTags the listing must have all skills if true, otherwise any of them.
+	MatchAllTags bool
+
+	// MinAmount and MaxAmount are in the base currency and compared with the BaseAmount,
+	// they are ignored if they are zero.
+	MinAmount       float64
+	MaxAmount       float64
+	Currency        string
+	ExperienceLevel string
+	LocationType    string
+	Availability    string
+	// The UTC offset window in minutes, only valid if HasUTCOffsetWindow is true.
+	MinUTCOffset       int
+	MaxUTCOffset       int
+	HasUTCOffsetWindow bool
+	VerifiedOnly       bool
+	// Only support search job posting.
+	Status string
+	// The unix timestamp, the listing must be created after it if it is not zero.
+	CreatedAfter int64
+
+	// The order of the search result.
+	Order SearchListingOrderCond
+}
+
+type SearchListingOrderCond string
+
+const (
+	SearchListingNewestOrder    SearchListingOrderCond = "newest"
+	SearchListingBudgetOrder    SearchListingOrderCond = "budget"
+	SearchListingRatingOrder    SearchListingOrderCond = "rating"
+	SearchListingRelevanceOrder SearchListingOrderCond = "relevance"
+)
+
 type SearchDesc struct {
 	// A svg icon it wil be display in search result page. optional
 	Icon string `json:"icon"`
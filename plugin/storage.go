@@ -26,6 +26,10 @@ const (
 	UserPost           UploadSource = "user_post"
 	UserPostAttachment UploadSource = "user_post_attachment"
 	AdminBranding      UploadSource = "admin_branding"
+
+	UserMessageAttachment    UploadSource = "user_message_attachment"
+	UserVerificationEvidence UploadSource = "user_verification_evidence"
+	UserPortfolioFile        UploadSource = "user_portfolio_file"
 )
 
 var (
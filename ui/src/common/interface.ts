@@ -702,11 +702,13 @@ export interface UserOauthConnectorItem {
 export interface NotificationConfigItem {
   enable: boolean;
   key: string;
+  frequency?: 'immediate' | 'daily' | 'weekly';
 }
 export interface NotificationConfig {
   all_new_question: NotificationConfigItem;
   all_new_question_for_following_tags: NotificationConfigItem;
   inbox: NotificationConfigItem;
+  saved_job_search: NotificationConfigItem;
 }
 
 export interface ActivatedPlugin {
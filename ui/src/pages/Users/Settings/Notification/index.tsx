@@ -25,6 +25,8 @@ import { useToast } from '@/hooks';
 import { useGetNotificationConfig, putNotificationConfig } from '@/services';
 import { SchemaForm, JSONSchema, UISchema, initFormData } from '@/components';
 
+const FREQUENCIES = ['immediate', 'daily', 'weekly'];
+
 const Index = () => {
   const toast = useToast();
   const { t } = useTranslation('translation', {
@@ -41,6 +43,14 @@ const Index = () => {
         description: t('inbox.description'),
         default: configData?.inbox.enable,
       },
+      inbox_frequency: {
+        type: 'string',
+        title: t('frequency.label'),
+        description: t('frequency.description'),
+        enum: FREQUENCIES,
+        enumNames: FREQUENCIES.map((v) => t(`frequency.${v}`)),
+        default: configData?.inbox.frequency || 'immediate',
+      },
       all_new_question: {
         type: 'boolean',
         title: t('all_new_question.label'),
@@ -53,6 +63,22 @@ const Index = () => {
         description: t('all_new_question_for_following_tags.description'),
         default: configData?.all_new_question_for_following_tags.enable,
       },
+      all_new_question_for_following_tags_frequency: {
+        type: 'string',
+        title: t('frequency.label'),
+        description: t('frequency.description'),
+        enum: FREQUENCIES,
+        enumNames: FREQUENCIES.map((v) => t(`frequency.${v}`)),
+        default:
+          configData?.all_new_question_for_following_tags.frequency ||
+          'immediate',
+      },
+      saved_job_search: {
+        type: 'boolean',
+        title: t('saved_job_search.label'),
+        description: t('saved_job_search.description'),
+        default: configData?.saved_job_search.enable,
+      },
     },
   };
   const uiSchema: UISchema = {
@@ -62,6 +88,9 @@ const Index = () => {
         label: t('turn_on'),
       },
     },
+    inbox_frequency: {
+      'ui:widget': 'select',
+    },
     all_new_question: {
       'ui:widget': 'switch',
       'ui:options': {
@@ -75,6 +104,15 @@ const Index = () => {
         text: t('all_new_question_for_following_tags.description'),
       },
     },
+    all_new_question_for_following_tags_frequency: {
+      'ui:widget': 'select',
+    },
+    saved_job_search: {
+      'ui:widget': 'switch',
+      'ui:options': {
+        label: t('turn_on'),
+      },
+    },
   };
   const [formData, setFormData] = useState<FormDataType>(initFormData(schema));
 
@@ -89,6 +127,7 @@ const Index = () => {
       inbox: {
         enable: formData.inbox.value,
         key: configData?.inbox.key,
+        frequency: formData.inbox_frequency.value,
       },
       all_new_question: {
         enable: formData.all_new_question.value,
@@ -97,6 +136,12 @@ const Index = () => {
       all_new_question_for_following_tags: {
         enable: formData.all_new_question_for_following_tags.value,
         key: configData?.all_new_question_for_following_tags.key,
+        frequency:
+          formData.all_new_question_for_following_tags_frequency.value,
+      },
+      saved_job_search: {
+        enable: formData.saved_job_search.value,
+        key: configData?.saved_job_search.key,
       },
     } as NotificationConfig;
 